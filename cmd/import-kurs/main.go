@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"carapp.com/m/internal/db"
+	"carapp.com/m/internal/kurs"
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "Validasi CSV tanpa menyimpan ke database")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Pemakaian: go run ./cmd/import-kurs [-dry-run] <file.csv>")
+		fmt.Fprintln(os.Stderr, "Header CSV: mata_uang_asal,mata_uang_tujuan,rate,berlaku_mulai")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	log.Println("==========================================")
+	log.Println("💱 Import Kurs Mata Uang...")
+	log.Println("==========================================")
+	log.Println("")
+
+	// 1. Baca dan validasi CSV
+	file, err := os.Open(flag.Arg(0))
+	if err != nil {
+		log.Fatalf("❌ Gagal membuka file: %v", err)
+	}
+	defer file.Close()
+
+	daftar, errs, err := kurs.ParseCSV(file)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	if len(errs) > 0 {
+		for _, e := range errs {
+			log.Printf("❌ %s", e)
+		}
+		log.Fatalf("❌ %d baris tidak valid, tidak ada kurs yang disimpan", len(errs))
+	}
+	for _, k := range daftar {
+		log.Printf("   %s -> %s = %s (berlaku %s)", k.Asal, k.Tujuan, k.RateString(), k.BerlakuMulai.Format(kurs.FormatTanggal))
+	}
+
+	if *dryRun {
+		log.Printf("✅ Dry run: %d kurs valid, tidak disimpan", len(daftar))
+		return
+	}
+
+	// 2. Load .env lalu simpan ke database
+	if err := godotenv.Load(); err != nil {
+		log.Println("⚠️  .env tidak ditemukan, menggunakan environment variables")
+	}
+	dbConn := db.ConnectDB()
+	defer dbConn.Close()
+
+	if err := kurs.Simpan(context.Background(), dbConn, daftar); err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	log.Println("")
+	log.Printf("✅ %d kurs berhasil disimpan", len(daftar))
+}
+
+// PENJELASAN FILE cmd/import-kurs/main.go:
+// CLI untuk mengisi tabel kurs dari file CSV (alternatif RPC ImportKurs untuk admin/ops)
+//
+// Contoh kurs.csv:
+//   mata_uang_asal,mata_uang_tujuan,rate,berlaku_mulai
+//   USD,IDR,16250,2025-01-01
+//   SGD,IDR,12050.5,2025-01-01
+//
+// Jalankan:
+//   go run ./cmd/import-kurs kurs.csv
+//   go run ./cmd/import-kurs -dry-run kurs.csv   (hanya validasi)
+//
+// Catatan:
+// - Semua baris divalidasi dulu; jika ada yang salah, tidak ada yang disimpan
+// - Kurs dengan pasangan mata uang + tanggal yang sama ditimpa
+// - Seeder (cmd/seeder) membutuhkan kurs USD -> IDR dari tabel ini
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"carapp.com/m/internal/db"
+	"carapp.com/m/internal/inventaris"
+	"carapp.com/m/internal/mobil"
+	"carapp.com/m/internal/pencarian"
+	pb "carapp.com/m/proto"
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		pemakaian()
+		os.Exit(2)
+	}
+	switch os.Args[1] {
+	case "impor":
+		impor(os.Args[2:])
+	case "ekspor":
+		ekspor(os.Args[2:])
+	default:
+		pemakaian()
+		os.Exit(2)
+	}
+}
+
+func pemakaian() {
+	fmt.Fprintln(os.Stderr, "Pemakaian:")
+	fmt.Fprintln(os.Stderr, "  go run ./cmd/inventaris impor -email <dealer> [-dry-run] [-format csv|json] <file>")
+	fmt.Fprintln(os.Stderr, "  go run ./cmd/inventaris ekspor [-email <dealer>] [-format csv|jsonl] [-status ...] [-o file]")
+}
+
+// koneksiDB membuka database setelah memuat .env
+func koneksiDB() *sql.DB {
+	if err := godotenv.Load(); err != nil {
+		log.Println("⚠️  .env tidak ditemukan, menggunakan environment variables")
+	}
+	return db.ConnectDB()
+}
+
+// cariUser mengambil ID user dari email
+func cariUser(dbConn *sql.DB, email string) string {
+	var userID string
+	err := dbConn.QueryRow("SELECT id FROM users WHERE email = $1", email).Scan(&userID)
+	if err == sql.ErrNoRows {
+		log.Fatalf("❌ User dengan email %s tidak ditemukan", email)
+	}
+	if err != nil {
+		log.Fatalf("❌ Gagal mengambil user: %v", err)
+	}
+	return userID
+}
+
+func impor(args []string) {
+	fs := flag.NewFlagSet("impor", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "Validasi dan tampilkan hasil per baris tanpa menyimpan")
+	email := fs.String("email", "", "Email akun dealer pemilik stok (wajib)")
+	format := fs.String("format", "", "csv atau json (default dari ekstensi file)")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Pemakaian: go run ./cmd/inventaris impor -email <dealer> [-dry-run] [-format csv|json] <file>")
+		fmt.Fprintln(os.Stderr, "Kolom: nomor_stok,vin,merk,model,tahun,kondisi,deskripsi,harga,mata_uang,foto_url,lokasi,")
+		fmt.Fprintln(os.Stderr, "       kilometer,transmisi,bahan_bakar,warna,tipe_bodi,jumlah_kursi,plat_region")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 1 || *email == "" {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if *format == "" {
+		*format = inventaris.FormatDariNama(fs.Arg(0))
+	}
+
+	log.Println("==========================================")
+	log.Println("🚗 Import Stok Dealer...")
+	log.Println("==========================================")
+	log.Println("")
+
+	// 1. Baca dan validasi file (sebelum koneksi DB, agar kesalahan format cepat terlihat)
+	file, err := os.Open(fs.Arg(0))
+	if err != nil {
+		log.Fatalf("❌ Gagal membuka file: %v", err)
+	}
+	defer file.Close()
+
+	daftar, gagal, err := inventaris.Parse(file, strings.ToLower(*format))
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	// 2. Upsert ke database
+	dbConn := koneksiDB()
+	defer dbConn.Close()
+	ownerID := cariUser(dbConn, *email)
+
+	statusBaru := mobil.StatusTersedia
+	if mobil.ModerasiAktif() {
+		statusBaru = pencarian.StatusMenungguModerasi
+	}
+	laporan, err := inventaris.Terapkan(context.Background(), dbConn, ownerID, daftar, gagal, inventaris.Opsi{
+		DryRun:          *dryRun,
+		StatusBaru:      statusBaru,
+		MasaBerlakuHari: mobil.MasaBerlaku(),
+	})
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	// 3. Laporan per baris
+	for _, h := range laporan.Hasil {
+		kunci := h.NomorStok
+		if kunci == "" {
+			kunci = h.VIN
+		}
+		if h.Aksi == inventaris.AksiGagal {
+			log.Printf("❌ baris %d (%s): %s", h.Baris, kunci, strings.Join(h.Errors, "; "))
+			continue
+		}
+		log.Printf("   baris %d (%s): %s %s [%s]", h.Baris, kunci, h.Aksi, h.MobilID, h.Status)
+	}
+	log.Println("")
+
+	switch {
+	case laporan.Gagal > 0:
+		log.Fatalf("❌ %d baris gagal, tidak ada mobil yang disimpan", laporan.Gagal)
+	case *dryRun:
+		log.Printf("✅ Dry run: %d akan dibuat, %d akan diperbarui, tidak disimpan", laporan.Dibuat, laporan.Diperbarui)
+	default:
+		laporan.KirimNotifikasi(dbConn)
+		log.Printf("✅ %d mobil dibuat, %d mobil diperbarui", laporan.Dibuat, laporan.Diperbarui)
+	}
+}
+
+func ekspor(args []string) {
+	fs := flag.NewFlagSet("ekspor", flag.ExitOnError)
+	email := fs.String("email", "", "Email owner (kosong = semua owner)")
+	format := fs.String("format", inventaris.FormatEksporCSV, "csv atau jsonl")
+	statusList := fs.String("status", "", "Daftar status dipisah koma, misal tersedia,terjual (kosong = semua)")
+	merk := fs.String("merk", "", "Filter merk")
+	model := fs.String("model", "", "Filter model")
+	tahunMin := fs.Int("tahun-min", 0, "Filter tahun minimal")
+	tahunMax := fs.Int("tahun-max", 0, "Filter tahun maksimal")
+	lokasi := fs.String("lokasi", "", "Filter lokasi (mengandung teks)")
+	output := fs.String("o", "", "File output (default stdout)")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Pemakaian: go run ./cmd/inventaris ekspor [flag]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	// 1. Validasi filter sebelum koneksi DB
+	filter, err := pencarian.ParseFilter(&pb.FilterMobil{
+		Merk: *merk, Model: *model, TahunMin: int32(*tahunMin), TahunMax: int32(*tahunMax), Lokasi: *lokasi,
+	})
+	if err != nil {
+		log.Fatalf("❌ Filter tidak valid: %v", err)
+	}
+	f := inventaris.FilterEkspor{Filter: filter}
+	for _, st := range strings.Split(*statusList, ",") {
+		if st = strings.ToLower(strings.TrimSpace(st)); st != "" {
+			f.Status = append(f.Status, st)
+		}
+	}
+	if err := f.Validasi(); err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	// 2. Tujuan output (log tetap ke stderr agar tidak bercampur dengan data di stdout)
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			log.Fatalf("❌ Gagal membuat file: %v", err)
+		}
+		defer file.Close()
+		w = file
+	}
+	penulis, err := inventaris.NewPenulis(w, strings.ToLower(*format))
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	// 3. Ekspor bertahap dari database
+	dbConn := koneksiDB()
+	defer dbConn.Close()
+	if *email != "" {
+		f.OwnerID = cariUser(dbConn, *email)
+	}
+	jumlah, err := inventaris.Ekspor(context.Background(), dbConn, f, penulis)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	log.Printf("✅ %d mobil diekspor", jumlah)
+}
+
+// PENJELASAN FILE cmd/inventaris/main.go:
+// CLI inventaris dealer: impor dari CSV / JSON dan ekspor ke CSV / JSON Lines
+// (logika sama dengan RPC BulkImportMobil dan ExportInventory, langsung ke database)
+//
+// Subcommand impor:
+//   go run ./cmd/inventaris impor -email dealer@carapp.com stok.csv
+//   go run ./cmd/inventaris impor -email dealer@carapp.com -dry-run stok.json   (hanya validasi & laporan)
+//
+//   Contoh stok.csv:
+//     nomor_stok,vin,merk,model,tahun,kondisi,deskripsi,harga,foto_url,lokasi,kilometer,transmisi
+//     STK-001,MHFJW8EM2K1234567,Toyota,Fortuner,2021,bekas,"VRZ, servis rutin",485000000,https://cdn.dealer.id/stk-001.jpg,Bandung,42000,otomatis
+//
+//   Contoh stok.json:
+//     [{"nomor_stok": "STK-001", "merk": "Toyota", "model": "Fortuner", "tahun": 2021, "harga": 485000000, ...}]
+//
+//   - Mobil dicocokkan per nomor_stok lalu VIN dan diperbarui (tidak dihapus lalu dibuat ulang seperti
+//     cmd/seeder), sehingga transaksi, watchlist, dan riwayat harga tetap utuh
+//   - Jika ada baris yang salah, tidak ada yang disimpan; perbaiki file lalu jalankan ulang
+//   - Mobil yang sedang dipesan / sudah terjual / belum tayang / sedang ditinjau tidak bisa ditimpa;
+//     mobil tayang dinilai ulang risikonya dan bisa ditahan ke antrean moderasi
+//
+// Subcommand ekspor:
+//   go run ./cmd/inventaris ekspor -email dealer@carapp.com -o stok.csv
+//   go run ./cmd/inventaris ekspor -format jsonl -status terjual,dipesan > stok.jsonl   (semua owner)
+//
+//   - Data ke stdout atau -o, log ke stderr
+//   - Mobil terjual membawa status_transaksi, harga_terjual, dan terjual_pada
+//   - CSV diawali BOM UTF-8 dan sel berawalan =, +, - atau @ diberi awalan ' (aman dibuka di Excel)
+//   - Rows ditulis bertahap, inventaris besar tidak dimuat ke memori
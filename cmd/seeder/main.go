@@ -4,13 +4,21 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"math/big"
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
+	"carapp.com/m/internal/atribut"
 	"carapp.com/m/internal/db"
+	"carapp.com/m/internal/geo"
+	"carapp.com/m/internal/hargapasar"
+	"carapp.com/m/internal/kurs"
+	"carapp.com/m/internal/money"
 	"carapp.com/m/internal/utils"
 	"github.com/joho/godotenv"
 )
@@ -22,12 +30,14 @@ type MarketcheckResponse struct {
 		Heading string `json:"heading"` // "2019 Honda Civic..."
 		VIN     string `json:"vin"`
 		Build   struct {
-			Make      string `json:"make"`
-			Model     string `json:"model"`
-			Year      int    `json:"year"`
-			BodyType  string `json:"body_type"`
-			DriveType string `json:"drivetrain"`
-			FuelType  string `json:"fuel_type"`
+			Make         string `json:"make"`
+			Model        string `json:"model"`
+			Year         int    `json:"year"`
+			BodyType     string `json:"body_type"`
+			DriveType    string `json:"drivetrain"`
+			FuelType     string `json:"fuel_type"`
+			Transmission string `json:"transmission"`
+			SeatingCap   int    `json:"std_seating"`
 		} `json:"build"`
 		Media struct {
 			PhotoLinks []string `json:"photo_links"`
@@ -77,15 +87,8 @@ func main() {
 	// RESET MOBIL LAMA (Hapus semua mobil dealer)
 	log.Println("🗑️  Menghapus mobil lama dari dealer...")
 
-	// Hapus transaksi terlebih dahulu untuk menghindari foreign key constraint
-	_, err = dbConn.Exec("DELETE FROM transaksi_jual WHERE penjual_id = $1 OR pembeli_id = $1", dealerUserID)
-	if err != nil {
-		log.Printf("⚠️  Warning: Gagal menghapus transaksi lama: %v", err)
-	}
-
-	// Sekarang hapus mobil
-	_, err = dbConn.Exec("DELETE FROM mobils WHERE owner_id = $1", dealerUserID)
-	if err != nil {
+	// Transaksi, pembayaran, penawaran, chat, janji temu, dll ikut dihapus (urut dari tabel anak)
+	if err := hapusInventarisDealer(context.Background(), dbConn, dealerUserID); err != nil {
 		log.Fatalf("❌ Gagal menghapus mobil lama: %v", err)
 	}
 	log.Println("✅ Mobil lama berhasil dihapus.")
@@ -131,6 +134,27 @@ func main() {
 	// 6. Simpan ke Database
 	log.Println("💾 Menyimpan mobil ke database...")
 	ctx := context.Background()
+
+	// Kurs USD -> IDR diambil dari tabel kurs (bukan konstanta lagi)
+	rateUSD, err := kurs.RateBerlaku(ctx, dbConn, "USD", money.DefaultCurrency, time.Now())
+	if errors.Is(err, kurs.ErrKursTidakAda) {
+		// Tabel kurs kosong (DB baru / kurs dihapus): isi kurs awal yang sama dengan migrasi 011
+		log.Println("⚠️  Kurs USD -> IDR belum ada, menyimpan kurs awal 1 USD = 15.800 IDR")
+		awal := kurs.Kurs{
+			Asal:         "USD",
+			Tujuan:       money.DefaultCurrency,
+			Rate:         big.NewRat(15800, 1),
+			BerlakuMulai: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+		if err = kurs.Simpan(ctx, dbConn, []kurs.Kurs{awal}); err == nil {
+			rateUSD, err = kurs.RateBerlaku(ctx, dbConn, "USD", money.DefaultCurrency, time.Now())
+		}
+	}
+	if err != nil {
+		log.Fatalf("❌ Gagal mengambil kurs USD -> IDR: %v (impor dulu dengan: go run ./cmd/import-kurs kurs.csv)", err)
+	}
+	log.Printf("💱 Kurs USD -> IDR: %s", rateUSD.FloatString(2))
+
 	tx, err := dbConn.BeginTx(ctx, nil)
 	if err != nil {
 		log.Fatalf("❌ Gagal memulai transaksi DB: %v", err)
@@ -140,8 +164,11 @@ func main() {
 	stmt, err := tx.PrepareContext(ctx, `
 		INSERT INTO mobils (
 			owner_id, merk, model, tahun, kondisi, deskripsi, 
-			harga_jual, foto_url, lokasi, status
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			harga_jual, harga_asli, mata_uang_asli, foto_url, lokasi, status,
+			kilometer, transmisi, bahan_bakar, warna, tipe_bodi, jumlah_kursi, latitude, longitude, vin
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12,
+		          $13, NULLIF($14, ''), NULLIF($15, ''), NULLIF($16, ''), NULLIF($17, ''), NULLIF($18, 0), $19, $20,
+		          NULLIF($21, ''))
 		ON CONFLICT DO NOTHING
 	`)
 	if err != nil {
@@ -176,9 +203,14 @@ func main() {
 		)
 
 		// KONVERSI USD KE IDR
-		// Kurs: 1 USD = 15.800 IDR (approximate)
-		const USD_TO_IDR = 15800.0
-		hargaJualIDR := mobil.Price * USD_TO_IDR
+		// Harga asli (USD) tetap disimpan, harga_jual IDR pakai kurs yang berlaku hari ini
+		hargaAsli := money.FromFloat(mobil.Price, "USD")
+		hargaJualIDR, err := hargaAsli.Convert(rateUSD, money.DefaultCurrency)
+		if err != nil {
+			log.Printf("⚠️  Gagal konversi harga mobil #%d (%s): %v", i+1, mobil.Heading, err)
+			skipped++
+			continue
+		}
 
 		// Ambil foto pertama jika ada
 		fotoUrl := ""
@@ -186,13 +218,39 @@ func main() {
 			fotoUrl = mobil.Media.PhotoLinks[0]
 		}
 
+		// Atribut terstruktur (nilai yang tidak dikenali dibiarkan kosong)
+		transmisi, _ := atribut.Transmisi(mobil.Build.Transmission)
+		bahanBakar, _ := atribut.BahanBakar(mobil.Build.FuelType)
+		warna := atribut.Warna(mobil.Exterior)
+		if warna == "unknown" || len(warna) > 30 {
+			warna = ""
+		}
+		var kilometer *int32
+		if mobil.Miles > 0 || kondisi == "baru" {
+			km := atribut.KilometerDariMil(mobil.Miles)
+			kilometer = &km
+		}
+		vin, ok := atribut.VIN(mobil.VIN)
+		if !ok {
+			vin = ""
+		}
+		jumlahKursi := int32(0)
+		if mobil.Build.SeatingCap >= atribut.MinJumlahKursi && mobil.Build.SeatingCap <= atribut.MaksJumlahKursi {
+			jumlahKursi = int32(mobil.Build.SeatingCap)
+		}
+
 		// Lokasi
 		lokasi := "Jakarta, Indonesia" // Default
 		if mobil.Dealer.City != "" && mobil.Dealer.State != "" {
 			lokasi = fmt.Sprintf("%s, %s", mobil.Dealer.City, mobil.Dealer.State)
 		}
+		// Koordinat hanya untuk kota yang ada di gazetteer (dealer luar negeri dibiarkan NULL)
+		var lat, lng *float64
+		if k, _, ok := geo.Geocode(lokasi); ok {
+			lat, lng = &k.Lat, &k.Lng
+		}
 
-		_, err := stmt.ExecContext(ctx,
+		_, err = stmt.ExecContext(ctx,
 			dealerUserID,
 			mobil.Build.Make,
 			mobil.Build.Model,
@@ -200,9 +258,20 @@ func main() {
 			kondisi,
 			deskripsi,
 			hargaJualIDR,
+			hargaAsli,
+			hargaAsli.Currency,
 			fotoUrl,
 			lokasi,
 			"tersedia",
+			kilometer,
+			transmisi,
+			bahanBakar,
+			warna,
+			atribut.TipeBodiLonggar(mobil.Build.BodyType),
+			jumlahKursi,
+			lat,
+			lng,
+			vin,
 		)
 		if err != nil {
 			log.Printf("⚠️  Gagal menyimpan mobil #%d (%s): %v", i+1, mobil.Heading, err)
@@ -217,6 +286,11 @@ func main() {
 		}
 	}
 
+	// Harga awal listing baru masuk riwayat harga (dipakai statistik harga pasar)
+	if _, err := hargapasar.BackfillListing(ctx, tx); err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
 	if err := tx.Commit(); err != nil {
 		log.Fatalf("❌ Gagal commit ke DB: %v", err)
 	}
@@ -269,6 +343,54 @@ func getOrCreateDealerUser(db *sql.DB) (string, error) {
 	return userID, nil
 }
 
+// hapusInventarisDealer menghapus mobil dealer beserta semua data yang mereferensikannya
+// (FK tanpa ON DELETE CASCADE) dalam satu transaksi DB
+func hapusInventarisDealer(ctx context.Context, db *sql.DB, dealerID string) error {
+	const mobilDealer = `SELECT id FROM mobils WHERE owner_id = $1`
+	const transaksiDealer = `SELECT id FROM transaksi_jual
+		WHERE penjual_id = $1 OR pembeli_id = $1 OR mobil_id IN (` + mobilDealer + `)`
+
+	langkah := []struct {
+		tabel string
+		query string
+	}{
+		{"ulasan", `DELETE FROM ulasan WHERE transaksi_id IN (` + transaksiDealer + `)`},
+		{"invoice", `DELETE FROM invoice WHERE transaksi_id IN (` + transaksiDealer + `)`},
+		{"pembayaran", `DELETE FROM pembayaran WHERE transaksi_id IN (` + transaksiDealer + `)`},
+		{"riwayat_harga", `DELETE FROM riwayat_harga WHERE transaksi_id IN (` + transaksiDealer + `)`},
+		{"penawaran", `DELETE FROM penawaran WHERE mobil_id IN (` + mobilDealer + `) OR transaksi_id IN (` + transaksiDealer + `)`},
+		// trade_in <-> transaksi_jual saling mereferensikan: lepas dulu dari sisi transaksi
+		{"transaksi_jual.trade_in_id", `UPDATE transaksi_jual SET trade_in_id = NULL WHERE id IN (` + transaksiDealer + `)`},
+		{"trade_in", `DELETE FROM trade_in WHERE pembeli_id = $1 OR penjual_id = $1
+			OR mobil_id IN (` + mobilDealer + `) OR mobil_baru_id IN (` + mobilDealer + `)
+			OR transaksi_id IN (` + transaksiDealer + `)`},
+		{"janji_temu", `DELETE FROM janji_temu WHERE mobil_id IN (` + mobilDealer + `)
+			OR slot_id IN (SELECT id FROM jadwal_slot WHERE mobil_id IN (` + mobilDealer + `))`},
+		{"jadwal_slot", `DELETE FROM jadwal_slot WHERE mobil_id IN (` + mobilDealer + `)`},
+		// Laporan user tetap disimpan, hanya tautan ke percakapan yang dilepas
+		{"laporan_user", `UPDATE laporan_user SET percakapan_id = NULL
+			WHERE percakapan_id IN (SELECT id FROM percakapan WHERE mobil_id IN (` + mobilDealer + `))`},
+		{"percakapan", `DELETE FROM percakapan WHERE mobil_id IN (` + mobilDealer + `)`},
+		{"transaksi_jual", `DELETE FROM transaksi_jual WHERE penjual_id = $1 OR pembeli_id = $1
+			OR mobil_id IN (` + mobilDealer + `)`},
+		// watchlist, riwayat_harga, laporan_listing, penilaian_risiko: ON DELETE CASCADE
+		{"mobils", `DELETE FROM mobils WHERE owner_id = $1`},
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, l := range langkah {
+		if _, err := tx.ExecContext(ctx, l.query, dealerID); err != nil {
+			return fmt.Errorf("gagal menghapus %s: %w", l.tabel, err)
+		}
+	}
+	return tx.Commit()
+}
+
 // PENJELASAN FILE cmd/seeder/main.go:
 // File ini untuk mengisi database dengan data mobil dummy dari Marketcheck API
 //
@@ -276,12 +398,18 @@ func getOrCreateDealerUser(db *sql.DB) (string, error) {
 // 1. Load .env untuk konfigurasi (DB_SOURCE, MARKETCHECK_API_KEY)
 // 2. Koneksi ke database PostgreSQL
 // 3. Buat atau cari user "Dealer" (email: dealer@carapp.com)
-// 4. Hapus mobil lama milik dealer (RESET data)
+// 4. Hapus mobil lama milik dealer (RESET data) lewat hapusInventarisDealer: tabel anak
+//    (ulasan, invoice, pembayaran, penawaran, trade-in, janji temu, chat, transaksi) dihapus lebih dulu
 // 5. Call Marketcheck API untuk ambil 50 mobil bekas
 // 6. Loop setiap mobil dan insert ke database
-// 7. Convert harga dari USD ke IDR (kurs 1 USD = 15,800 IDR)
+// 7. Convert harga dari USD ke IDR (kurs dari tabel kurs, harga USD disimpan di harga_asli;
+//    jika tabel kurs kosong, kurs awal 1 USD = 15.800 IDR disimpan dulu)
+//    Atribut terstruktur (kilometer dari miles, transmisi, bahan bakar, warna, tipe bodi, kursi, VIN)
+//    dinormalkan lewat paket atribut; deskripsi tetap berisi ringkasan lengkap
+//    Koordinat dari lokasi lewat gazetteer (geo.Geocode) jika kotanya dikenal
 // 8. Set harga rental = 0.5% dari harga jual per hari (min 100k)
-// 9. Commit transaction ke database
+// 9. Catat harga awal listing baru ke riwayat_harga (hargapasar.BackfillListing)
+// 10. Commit transaction ke database
 //
 // Fungsi getOrCreateDealerUser:
 // - Cek apakah user dealer sudah ada di database
@@ -304,6 +432,7 @@ func getOrCreateDealerUser(db *sql.DB) (string, error) {
 // Catatan:
 // - API Key Marketcheck harus valid (dari https://www.marketcheck.com)
 // - Seeder bisa dijalankan berulang kali (akan reset data dealer)
+// - Impor / ekspor stok dealer (CSV, JSON, JSON Lines) tanpa reset: cmd/inventaris impor | ekspor
 // - Untuk testing/development, tidak untuk production
 
 // PENJELASAN FILE cmd/seeder/main.go:
@@ -313,12 +442,15 @@ func getOrCreateDealerUser(db *sql.DB) (string, error) {
 // 1. Load .env untuk konfigurasi (DB_SOURCE, MARKETCHECK_API_KEY)
 // 2. Koneksi ke database PostgreSQL
 // 3. Buat atau cari user "Dealer" (email: dealer@carapp.com)
-// 4. Hapus mobil lama milik dealer (RESET data)
+// 4. Hapus mobil lama milik dealer (RESET data) lewat hapusInventarisDealer: tabel anak
+//    (ulasan, invoice, pembayaran, penawaran, trade-in, janji temu, chat, transaksi) dihapus lebih dulu
 // 5. Call Marketcheck API untuk ambil 50 mobil bekas
 // 6. Loop setiap mobil dan insert ke database
-// 7. Convert harga dari USD ke IDR (kurs 1 USD = 15,800 IDR)
+// 7. Convert harga dari USD ke IDR (kurs dari tabel kurs, harga USD disimpan di harga_asli;
+//    jika tabel kurs kosong, kurs awal 1 USD = 15.800 IDR disimpan dulu)
 // 8. Set harga rental = 0.5% dari harga jual per hari (min 100k)
-// 9. Commit transaction ke database
+// 9. Catat harga awal listing baru ke riwayat_harga (hargapasar.BackfillListing)
+// 10. Commit transaction ke database
 //
 // Fungsi getOrCreateDealerUser:
 // - Cek apakah user dealer sudah ada di database
@@ -341,4 +473,5 @@ func getOrCreateDealerUser(db *sql.DB) (string, error) {
 // Catatan:
 // - API Key Marketcheck harus valid (dari https://www.marketcheck.com)
 // - Seeder bisa dijalankan berulang kali (akan reset data dealer)
+// - Impor / ekspor stok dealer (CSV, JSON, JSON Lines) tanpa reset: cmd/inventaris impor | ekspor
 // - Untuk testing/development, tidak untuk production
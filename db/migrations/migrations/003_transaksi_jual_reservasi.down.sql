@@ -0,0 +1,14 @@
+-- Rollback: Hapus kolom alur reservasi
+DROP INDEX IF EXISTS idx_transaksi_jual_reservasi;
+
+ALTER TABLE transaksi_jual ALTER COLUMN status SET DEFAULT 'diproses';
+
+ALTER TABLE transaksi_jual DROP COLUMN IF EXISTS alasan_batal;
+ALTER TABLE transaksi_jual DROP COLUMN IF EXISTS cancelled_at;
+ALTER TABLE transaksi_jual DROP COLUMN IF EXISTS completed_at;
+ALTER TABLE transaksi_jual DROP COLUMN IF EXISTS confirmed_at;
+ALTER TABLE transaksi_jual DROP COLUMN IF EXISTS paid_at;
+ALTER TABLE transaksi_jual DROP COLUMN IF EXISTS reserved_until;
+
+-- Mobil yang masih dipesan dikembalikan ke 'tersedia'
+UPDATE mobils SET status = 'tersedia' WHERE status = 'dipesan';
@@ -0,0 +1,14 @@
+-- Alur pembelian dengan reservasi: menunggu_pembayaran -> dibayar -> diproses -> selesai
+-- atau dibatalkan / kedaluwarsa (mobil kembali 'tersedia')
+ALTER TABLE transaksi_jual ADD COLUMN IF NOT EXISTS reserved_until TIMESTAMP;
+ALTER TABLE transaksi_jual ADD COLUMN IF NOT EXISTS paid_at TIMESTAMP;
+ALTER TABLE transaksi_jual ADD COLUMN IF NOT EXISTS confirmed_at TIMESTAMP;
+ALTER TABLE transaksi_jual ADD COLUMN IF NOT EXISTS completed_at TIMESTAMP;
+ALTER TABLE transaksi_jual ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMP;
+ALTER TABLE transaksi_jual ADD COLUMN IF NOT EXISTS alasan_batal TEXT;
+
+ALTER TABLE transaksi_jual ALTER COLUMN status SET DEFAULT 'menunggu_pembayaran';
+
+-- Index untuk job yang mencari reservasi kedaluwarsa
+CREATE INDEX IF NOT EXISTS idx_transaksi_jual_reservasi
+    ON transaksi_jual (status, reserved_until);
@@ -0,0 +1,3 @@
+-- Rollback: Hapus tabel pembayaran
+DROP TABLE IF EXISTS pembayaran_events;
+DROP TABLE IF EXISTS pembayaran;
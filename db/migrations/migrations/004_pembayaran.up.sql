@@ -0,0 +1,26 @@
+-- Tagihan pembayaran per transaksi jual
+CREATE TABLE IF NOT EXISTS pembayaran (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    transaksi_id UUID REFERENCES transaksi_jual(id),
+    provider TEXT NOT NULL,
+    charge_id TEXT NOT NULL,
+    amount NUMERIC NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending', -- pending/paid/failed/refunded
+    payment_url TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (provider, charge_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_pembayaran_transaksi ON pembayaran (transaksi_id);
+
+-- Event webhook yang sudah diproses (idempotensi berdasarkan event ID provider)
+CREATE TABLE IF NOT EXISTS pembayaran_events (
+    provider TEXT NOT NULL,
+    event_id TEXT NOT NULL,
+    charge_id TEXT NOT NULL,
+    status TEXT NOT NULL,
+    payload JSONB,
+    received_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (provider, event_id)
+);
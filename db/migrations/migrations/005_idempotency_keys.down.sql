@@ -0,0 +1,2 @@
+-- Rollback: Hapus tabel idempotency_keys
+DROP TABLE IF EXISTS idempotency_keys;
@@ -0,0 +1,16 @@
+-- Idempotency key untuk RPC yang mengubah data (header metadata 'idempotency-key')
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    user_id UUID NOT NULL REFERENCES users(id),
+    idem_key TEXT NOT NULL,
+    method TEXT NOT NULL,
+    request_hash TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'proses', -- proses/selesai
+    response BYTEA,          -- response proto (ter-marshal) jika sukses
+    grpc_code INT,           -- kode error gRPC jika gagal
+    grpc_message TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    expires_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, idem_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires ON idempotency_keys (expires_at);
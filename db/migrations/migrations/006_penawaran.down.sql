@@ -0,0 +1,3 @@
+-- Rollback: Hapus tabel penawaran
+DROP TABLE IF EXISTS penawaran_riwayat;
+DROP TABLE IF EXISTS penawaran;
@@ -0,0 +1,31 @@
+-- Negosiasi harga: satu baris per pembeli per mobil, harga & giliran selalu yang terbaru
+CREATE TABLE IF NOT EXISTS penawaran (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    mobil_id UUID NOT NULL REFERENCES mobils(id),
+    pembeli_id UUID NOT NULL REFERENCES users(id),
+    penjual_id UUID NOT NULL REFERENCES users(id),
+    harga NUMERIC NOT NULL,
+    status TEXT NOT NULL DEFAULT 'menunggu', -- menunggu/diterima/ditolak/dibatalkan/kedaluwarsa
+    giliran TEXT NOT NULL DEFAULT 'penjual', -- pembeli/penjual
+    pesan TEXT,
+    transaksi_id UUID REFERENCES transaksi_jual(id),
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+-- Satu penawaran aktif per pembeli per mobil
+CREATE UNIQUE INDEX IF NOT EXISTS idx_penawaran_aktif
+    ON penawaran (mobil_id, pembeli_id) WHERE status = 'menunggu';
+CREATE INDEX IF NOT EXISTS idx_penawaran_expires ON penawaran (status, expires_at);
+
+-- Riwayat setiap langkah negosiasi
+CREATE TABLE IF NOT EXISTS penawaran_riwayat (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    penawaran_id UUID NOT NULL REFERENCES penawaran(id) ON DELETE CASCADE,
+    user_id UUID REFERENCES users(id),
+    aksi TEXT NOT NULL, -- tawar/tawar_balik/terima/tolak/batal/kedaluwarsa
+    harga NUMERIC,
+    pesan TEXT,
+    created_at TIMESTAMP DEFAULT NOW()
+);
@@ -0,0 +1,5 @@
+-- Rollback: Hapus tabel chat
+DROP TABLE IF EXISTS laporan_user;
+DROP TABLE IF EXISTS blokir_user;
+DROP TABLE IF EXISTS pesan_chat;
+DROP TABLE IF EXISTS percakapan;
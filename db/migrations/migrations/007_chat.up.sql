@@ -0,0 +1,40 @@
+-- Percakapan pembeli dengan penjual, satu per mobil per pembeli
+CREATE TABLE IF NOT EXISTS percakapan (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    mobil_id UUID NOT NULL REFERENCES mobils(id),
+    pembeli_id UUID NOT NULL REFERENCES users(id),
+    penjual_id UUID NOT NULL REFERENCES users(id),
+    last_message_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (mobil_id, pembeli_id)
+);
+
+CREATE TABLE IF NOT EXISTS pesan_chat (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    percakapan_id UUID NOT NULL REFERENCES percakapan(id) ON DELETE CASCADE,
+    pengirim_id UUID NOT NULL REFERENCES users(id),
+    isi TEXT NOT NULL,
+    read_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_pesan_chat_percakapan ON pesan_chat (percakapan_id, created_at);
+
+-- User yang diblokir (pemblokir tidak menerima pesan dari yang diblokir)
+CREATE TABLE IF NOT EXISTS blokir_user (
+    pemblokir_id UUID NOT NULL REFERENCES users(id),
+    diblokir_id UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (pemblokir_id, diblokir_id)
+);
+
+-- Laporan user yang kasar/penipu untuk ditinjau admin
+CREATE TABLE IF NOT EXISTS laporan_user (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    pelapor_id UUID NOT NULL REFERENCES users(id),
+    dilaporkan_id UUID NOT NULL REFERENCES users(id),
+    percakapan_id UUID REFERENCES percakapan(id),
+    alasan TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'baru', -- baru/ditinjau/ditutup
+    created_at TIMESTAMP DEFAULT NOW()
+);
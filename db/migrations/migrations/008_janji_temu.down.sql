@@ -0,0 +1,3 @@
+-- Rollback: Hapus tabel janji temu
+DROP TABLE IF EXISTS janji_temu;
+DROP TABLE IF EXISTS jadwal_slot;
@@ -0,0 +1,37 @@
+-- Slot ketersediaan penjual untuk test drive / lihat mobil (per mobil atau per lokasi dealer)
+CREATE TABLE IF NOT EXISTS jadwal_slot (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    penjual_id UUID NOT NULL REFERENCES users(id),
+    mobil_id UUID REFERENCES mobils(id),
+    lokasi TEXT,
+    mulai TIMESTAMP NOT NULL,
+    selesai TIMESTAMP NOT NULL,
+    status TEXT NOT NULL DEFAULT 'tersedia', -- tersedia/dipesan/dibatalkan
+    created_at TIMESTAMP DEFAULT NOW(),
+    CHECK (selesai > mulai),
+    CHECK (mobil_id IS NOT NULL OR lokasi IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_jadwal_slot_penjual ON jadwal_slot (penjual_id, mulai);
+CREATE INDEX IF NOT EXISTS idx_jadwal_slot_mobil ON jadwal_slot (mobil_id, mulai);
+
+-- Janji temu pembeli pada satu slot
+CREATE TABLE IF NOT EXISTS janji_temu (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    slot_id UUID NOT NULL REFERENCES jadwal_slot(id),
+    mobil_id UUID NOT NULL REFERENCES mobils(id),
+    pembeli_id UUID NOT NULL REFERENCES users(id),
+    penjual_id UUID NOT NULL REFERENCES users(id),
+    tipe TEXT NOT NULL DEFAULT 'lihat', -- lihat/test_drive
+    status TEXT NOT NULL DEFAULT 'dikonfirmasi', -- dikonfirmasi/dibatalkan
+    catatan TEXT,
+    alasan_batal TEXT,
+    reminder_sent_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+-- Satu slot hanya boleh punya satu janji temu aktif (pengaman terakhir anti double-booking)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_janji_temu_slot_aktif
+    ON janji_temu (slot_id) WHERE status = 'dikonfirmasi';
+CREATE INDEX IF NOT EXISTS idx_janji_temu_mobil ON janji_temu (mobil_id, status);
@@ -0,0 +1,3 @@
+-- Rollback: Hapus index riwayat transaksi
+DROP INDEX IF EXISTS idx_transaksi_jual_penjual;
+DROP INDEX IF EXISTS idx_transaksi_jual_pembeli;
@@ -0,0 +1,3 @@
+-- Index untuk riwayat transaksi per pembeli / penjual (ListMyTransactions)
+CREATE INDEX IF NOT EXISTS idx_transaksi_jual_pembeli ON transaksi_jual (pembeli_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_transaksi_jual_penjual ON transaksi_jual (penjual_id, created_at DESC);
@@ -0,0 +1,3 @@
+-- Rollback: Hapus tabel invoice
+DROP TABLE IF EXISTS invoice;
+DROP TABLE IF EXISTS invoice_counter;
@@ -0,0 +1,18 @@
+-- Counter nomor invoice per tahun (dikunci per baris saat alokasi agar nomor tidak loncat)
+CREATE TABLE IF NOT EXISTS invoice_counter (
+    tahun INT PRIMARY KEY,
+    nomor_terakhir INT NOT NULL DEFAULT 0
+);
+
+-- Invoice / kwitansi untuk transaksi jual yang 'selesai'
+CREATE TABLE IF NOT EXISTS invoice (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    transaksi_id UUID NOT NULL UNIQUE REFERENCES transaksi_jual(id),
+    nomor TEXT NOT NULL UNIQUE, -- INV/2025/000001
+    tahun INT NOT NULL,
+    urutan INT NOT NULL,
+    total NUMERIC NOT NULL,
+    file_path TEXT, -- Diisi setelah PDF dibuat
+    created_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (tahun, urutan)
+);
@@ -0,0 +1,4 @@
+-- Rollback: Hapus kurs dan harga asli
+ALTER TABLE mobils DROP COLUMN IF EXISTS mata_uang_asli;
+ALTER TABLE mobils DROP COLUMN IF EXISTS harga_asli;
+DROP TABLE IF EXISTS kurs;
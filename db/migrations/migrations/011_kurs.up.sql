@@ -0,0 +1,19 @@
+-- Kurs mata uang per tanggal berlaku (1 mata_uang_asal = rate mata_uang_tujuan)
+CREATE TABLE IF NOT EXISTS kurs (
+    mata_uang_asal TEXT NOT NULL,
+    mata_uang_tujuan TEXT NOT NULL,
+    rate NUMERIC NOT NULL CHECK (rate > 0),
+    berlaku_mulai DATE NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (mata_uang_asal, mata_uang_tujuan, berlaku_mulai)
+);
+
+-- Kurs awal menggantikan konstanta USD_TO_IDR di seeder
+INSERT INTO kurs (mata_uang_asal, mata_uang_tujuan, rate, berlaku_mulai)
+VALUES ('USD', 'IDR', 15800, '2024-01-01')
+ON CONFLICT DO NOTHING;
+
+-- Harga listing dalam mata uang aslinya (harga_jual tetap dalam IDR untuk transaksi & filter)
+ALTER TABLE mobils ADD COLUMN IF NOT EXISTS harga_asli NUMERIC;
+ALTER TABLE mobils ADD COLUMN IF NOT EXISTS mata_uang_asli TEXT NOT NULL DEFAULT 'IDR';
+UPDATE mobils SET harga_asli = harga_jual WHERE harga_asli IS NULL;
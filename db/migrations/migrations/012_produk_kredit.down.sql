@@ -0,0 +1,2 @@
+-- Rollback: Hapus produk kredit
+DROP TABLE IF EXISTS produk_kredit;
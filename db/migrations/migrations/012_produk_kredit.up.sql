@@ -0,0 +1,28 @@
+-- Produk kredit (leasing / bank) untuk simulasi cicilan mobil
+CREATE TABLE IF NOT EXISTS produk_kredit (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    nama TEXT NOT NULL,
+    lembaga TEXT NOT NULL,                 -- Nama bank / perusahaan pembiayaan
+    bunga_flat_persen NUMERIC(6,3),        -- Bunga flat per tahun (NULL = skema flat tidak tersedia)
+    bunga_efektif_persen NUMERIC(6,3),     -- Bunga efektif/anuitas per tahun (NULL = tidak tersedia)
+    tenor_min_bulan INT NOT NULL DEFAULT 12,
+    tenor_max_bulan INT NOT NULL DEFAULT 60,
+    dp_min_persen NUMERIC(5,2) NOT NULL DEFAULT 20,
+    biaya_admin NUMERIC NOT NULL DEFAULT 0, -- IDR, dibayar di muka
+    kondisi TEXT,                          -- baru/bekas, NULL = semua kondisi
+    usia_maks_tahun INT,                   -- Usia mobil maksimal saat kredit lunas (NULL = tanpa batas)
+    aktif BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    CHECK (bunga_flat_persen IS NOT NULL OR bunga_efektif_persen IS NOT NULL),
+    CHECK (tenor_min_bulan > 0 AND tenor_max_bulan >= tenor_min_bulan),
+    CHECK (dp_min_persen >= 0 AND dp_min_persen < 100)
+);
+
+-- Produk awal (contoh, sesuaikan dengan kerja sama leasing)
+INSERT INTO produk_kredit (nama, lembaga, bunga_flat_persen, bunga_efektif_persen, tenor_min_bulan, tenor_max_bulan, dp_min_persen, biaya_admin, kondisi, usia_maks_tahun)
+SELECT * FROM (VALUES
+    ('Kredit Mobil Baru', 'Leasing Mitra A', 3.5::NUMERIC, 6.5::NUMERIC, 12, 60, 20::NUMERIC, 2500000::NUMERIC, 'baru', NULL::INT),
+    ('Kredit Mobil Bekas', 'Leasing Mitra A', 5.5::NUMERIC, 10.0::NUMERIC, 12, 48, 25::NUMERIC, 3000000::NUMERIC, 'bekas', 15),
+    ('KKB Bank Mitra', 'Bank Mitra B', NULL::NUMERIC, 8.25::NUMERIC, 12, 84, 30::NUMERIC, 1500000::NUMERIC, NULL, 12)
+) AS v
+WHERE NOT EXISTS (SELECT 1 FROM produk_kredit);
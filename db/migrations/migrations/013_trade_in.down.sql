@@ -0,0 +1,4 @@
+-- Rollback: Hapus trade-in
+ALTER TABLE transaksi_jual DROP COLUMN IF EXISTS potongan_trade_in;
+ALTER TABLE transaksi_jual DROP COLUMN IF EXISTS trade_in_id;
+DROP TABLE IF EXISTS trade_in;
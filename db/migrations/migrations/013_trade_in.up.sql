@@ -0,0 +1,31 @@
+-- Trade-in: pembeli menukar mobil lamanya sebagai potongan pembelian mobil dealer
+CREATE TABLE IF NOT EXISTS trade_in (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    pembeli_id UUID NOT NULL REFERENCES users(id),
+    penjual_id UUID NOT NULL REFERENCES users(id),      -- Dealer pemilik mobil yang akan dibeli
+    mobil_id UUID NOT NULL REFERENCES mobils(id),       -- Mobil yang akan dibeli
+    merk TEXT NOT NULL,
+    model TEXT NOT NULL,
+    tahun INT NOT NULL,
+    kondisi TEXT,
+    kilometer INT,
+    deskripsi TEXT,
+    vin TEXT,
+    vin_terverifikasi BOOLEAN NOT NULL DEFAULT FALSE,   -- TRUE jika VIN berhasil di-decode NHTSA
+    status TEXT NOT NULL DEFAULT 'diajukan',            -- diajukan/dinilai/diterima/ditolak/dibatalkan/dipakai/selesai
+    nilai_taksiran NUMERIC,
+    catatan_penilai TEXT,
+    berlaku_sampai TIMESTAMP,                           -- Batas waktu taksiran bisa diterima / dipakai
+    transaksi_id UUID REFERENCES transaksi_jual(id),    -- Diisi saat dipakai sebagai potongan
+    mobil_baru_id UUID REFERENCES mobils(id),           -- Listing draft dealer setelah transaksi selesai
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_trade_in_pembeli ON trade_in (pembeli_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_trade_in_penjual ON trade_in (penjual_id, created_at DESC);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_trade_in_transaksi ON trade_in (transaksi_id) WHERE transaksi_id IS NOT NULL;
+
+-- Potongan trade-in di transaksi (total = harga mobil - potongan)
+ALTER TABLE transaksi_jual ADD COLUMN IF NOT EXISTS trade_in_id UUID REFERENCES trade_in(id);
+ALTER TABLE transaksi_jual ADD COLUMN IF NOT EXISTS potongan_trade_in NUMERIC NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- Rollback: Hapus ulasan
+DROP TABLE IF EXISTS ulasan;
@@ -0,0 +1,18 @@
+-- Ulasan (rating 1-5) antar pihak transaksi jual yang sudah selesai
+CREATE TABLE IF NOT EXISTS ulasan (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    transaksi_id UUID NOT NULL REFERENCES transaksi_jual(id),
+    penulis_id UUID NOT NULL REFERENCES users(id),
+    target_id UUID NOT NULL REFERENCES users(id),
+    peran_penulis TEXT NOT NULL,               -- pembeli (mengulas penjual) / penjual (mengulas pembeli)
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    komentar TEXT,
+    disembunyikan BOOLEAN NOT NULL DEFAULT FALSE, -- Disembunyikan admin (ulasan kasar/spam)
+    alasan_disembunyikan TEXT,
+    disembunyikan_oleh UUID REFERENCES users(id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (transaksi_id, penulis_id)          -- Satu ulasan per pihak per transaksi
+);
+
+CREATE INDEX IF NOT EXISTS idx_ulasan_target ON ulasan (target_id, peran_penulis, created_at DESC) WHERE NOT disembunyikan;
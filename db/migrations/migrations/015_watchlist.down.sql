@@ -0,0 +1,2 @@
+-- Rollback: Hapus watchlist
+DROP TABLE IF EXISTS watchlist;
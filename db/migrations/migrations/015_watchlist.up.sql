@@ -0,0 +1,11 @@
+-- Watchlist: mobil yang disimpan pembeli untuk dipantau (harga turun, terjual, ditarik)
+CREATE TABLE IF NOT EXISTS watchlist (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    mobil_id UUID NOT NULL REFERENCES mobils(id) ON DELETE CASCADE,
+    harga_saat_ditambah NUMERIC NOT NULL,       -- harga_jual (IDR) saat mobil ditambahkan
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (user_id, mobil_id)
+);
+
+-- Mencari semua watcher satu mobil saat harga/status berubah
+CREATE INDEX IF NOT EXISTS idx_watchlist_mobil ON watchlist (mobil_id);
@@ -0,0 +1,2 @@
+-- Rollback: Hapus pencarian tersimpan
+DROP TABLE IF EXISTS saved_search;
@@ -0,0 +1,16 @@
+-- Pencarian tersimpan: filter ListMobil milik user + alert listing baru yang cocok
+CREATE TABLE IF NOT EXISTS saved_search (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    nama TEXT NOT NULL,
+    filter JSONB NOT NULL,                         -- pb.FilterMobil dalam format protojson
+    frekuensi_alert TEXT NOT NULL DEFAULT 'harian', -- instan/harian/mingguan/nonaktif
+    terakhir_dicek TIMESTAMP NOT NULL DEFAULT NOW(), -- Listing dengan created_at setelah ini dianggap baru
+    terakhir_dikirim TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_saved_search_user ON saved_search (user_id);
+-- Dipakai job matcher: hanya pencarian yang alert-nya aktif
+CREATE INDEX IF NOT EXISTS idx_saved_search_aktif ON saved_search (frekuensi_alert) WHERE frekuensi_alert <> 'nonaktif';
@@ -0,0 +1,2 @@
+-- Rollback: Hapus riwayat harga
+DROP TABLE IF EXISTS riwayat_harga;
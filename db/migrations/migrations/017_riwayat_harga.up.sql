@@ -0,0 +1,27 @@
+-- Riwayat harga per mobil: harga awal listing, setiap perubahan harga, dan harga terjual
+CREATE TABLE IF NOT EXISTS riwayat_harga (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    mobil_id UUID NOT NULL REFERENCES mobils(id) ON DELETE CASCADE,
+    jenis TEXT NOT NULL,                          -- listing/perubahan/terjual
+    harga NUMERIC NOT NULL,                       -- IDR (sama dengan mobils.harga_jual)
+    harga_asli NUMERIC,                           -- Harga dalam mata uang asli listing (jika ada)
+    mata_uang_asli TEXT,
+    transaksi_id UUID REFERENCES transaksi_jual(id), -- Diisi untuk jenis 'terjual'
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_riwayat_harga_mobil ON riwayat_harga (mobil_id, created_at);
+-- Harga terjual per periode untuk statistik harga pasar
+CREATE INDEX IF NOT EXISTS idx_riwayat_harga_terjual ON riwayat_harga (created_at) WHERE jenis = 'terjual';
+
+-- Backfill: harga awal semua listing yang sudah ada
+INSERT INTO riwayat_harga (mobil_id, jenis, harga, harga_asli, mata_uang_asli, created_at)
+SELECT id, 'listing', harga_jual, harga_asli, mata_uang_asli, COALESCE(created_at, NOW())
+FROM mobils
+WHERE harga_jual IS NOT NULL;
+
+-- Backfill: harga terjual dari transaksi selesai (harga mobil = total + potongan trade-in)
+INSERT INTO riwayat_harga (mobil_id, jenis, harga, transaksi_id, created_at)
+SELECT mobil_id, 'terjual', total + potongan_trade_in, id, COALESCE(completed_at, updated_at, created_at)
+FROM transaksi_jual
+WHERE status = 'selesai' AND total IS NOT NULL;
@@ -0,0 +1,13 @@
+-- Rollback: Hapus atribut terstruktur mobil (data di deskripsi tidak berubah)
+DROP INDEX IF EXISTS idx_mobils_kilometer;
+DROP INDEX IF EXISTS idx_mobils_transmisi;
+DROP INDEX IF EXISTS idx_mobils_tipe_bodi;
+
+ALTER TABLE mobils
+    DROP COLUMN IF EXISTS plat_region,
+    DROP COLUMN IF EXISTS jumlah_kursi,
+    DROP COLUMN IF EXISTS tipe_bodi,
+    DROP COLUMN IF EXISTS warna,
+    DROP COLUMN IF EXISTS bahan_bakar,
+    DROP COLUMN IF EXISTS transmisi,
+    DROP COLUMN IF EXISTS kilometer;
@@ -0,0 +1,60 @@
+-- Atribut terstruktur mobil (sebelumnya hanya ada di deskripsi)
+ALTER TABLE mobils
+    ADD COLUMN IF NOT EXISTS kilometer INTEGER CHECK (kilometer >= 0),
+    ADD COLUMN IF NOT EXISTS transmisi TEXT CHECK (transmisi IN ('manual', 'matic')),
+    ADD COLUMN IF NOT EXISTS bahan_bakar TEXT CHECK (bahan_bakar IN ('bensin', 'diesel', 'hybrid', 'listrik')),
+    ADD COLUMN IF NOT EXISTS warna TEXT,
+    ADD COLUMN IF NOT EXISTS tipe_bodi TEXT
+        CHECK (tipe_bodi IN ('sedan', 'hatchback', 'suv', 'mpv', 'pickup', 'coupe', 'konvertibel', 'wagon', 'van')),
+    ADD COLUMN IF NOT EXISTS jumlah_kursi SMALLINT CHECK (jumlah_kursi BETWEEN 1 AND 20),
+    ADD COLUMN IF NOT EXISTS plat_region TEXT;
+
+-- Filter ListMobil
+CREATE INDEX IF NOT EXISTS idx_mobils_tipe_bodi ON mobils (tipe_bodi) WHERE status = 'tersedia';
+CREATE INDEX IF NOT EXISTS idx_mobils_transmisi ON mobils (transmisi) WHERE status = 'tersedia';
+CREATE INDEX IF NOT EXISTS idx_mobils_kilometer ON mobils (kilometer) WHERE status = 'tersedia';
+
+-- Backfill kilometer dari deskripsi trade-in: "... Kilometer: 35000 km. ..."
+UPDATE mobils
+SET kilometer = substring(deskripsi FROM 'Kilometer: (\d+) km')::integer
+WHERE kilometer IS NULL AND deskripsi ~ 'Kilometer: \d+ km';
+
+-- Backfill dari deskripsi seeder Marketcheck:
+-- "<heading> - 45120 miles. Black exterior, Gray interior. SUV. VIN: ..."
+UPDATE mobils
+SET kilometer = ROUND(substring(deskripsi FROM ' - (\d+) miles\.')::numeric * 1.609344)::integer
+WHERE kilometer IS NULL AND deskripsi ~ ' - \d+ miles\.';
+
+UPDATE mobils
+SET warna = CASE LOWER(TRIM(w.warna))
+        WHEN 'black' THEN 'hitam' WHEN 'white' THEN 'putih' WHEN 'silver' THEN 'silver'
+        WHEN 'gray' THEN 'abu-abu' WHEN 'grey' THEN 'abu-abu' WHEN 'red' THEN 'merah'
+        WHEN 'blue' THEN 'biru' WHEN 'green' THEN 'hijau' WHEN 'brown' THEN 'coklat'
+        WHEN 'yellow' THEN 'kuning' WHEN 'orange' THEN 'oranye' WHEN 'gold' THEN 'emas'
+        WHEN 'beige' THEN 'krem' WHEN 'purple' THEN 'ungu'
+        ELSE LOWER(TRIM(w.warna))
+    END
+FROM (
+    SELECT id, substring(deskripsi FROM ' miles\. ([^,.]+) exterior,') AS warna FROM mobils
+) w
+WHERE mobils.id = w.id AND mobils.warna IS NULL
+  AND w.warna IS NOT NULL AND TRIM(w.warna) NOT IN ('', 'Unknown')
+  AND LENGTH(TRIM(w.warna)) <= 30;
+
+-- Tipe bodi: kata kunci sama dengan atribut.TipeBodiLonggar (yang paling spesifik dulu)
+UPDATE mobils
+SET tipe_bodi = CASE
+        WHEN b.bodi ~* 'convertible|cabriolet' THEN 'konvertibel'
+        WHEN b.bodi ~* 'pickup|truck' THEN 'pickup'
+        WHEN b.bodi ~* 'minivan|mpv' THEN 'mpv'
+        WHEN b.bodi ~* 'van' THEN 'van'
+        WHEN b.bodi ~* 'suv|sport utility|crossover' THEN 'suv'
+        WHEN b.bodi ~* 'hatchback' THEN 'hatchback'
+        WHEN b.bodi ~* 'wagon' THEN 'wagon'
+        WHEN b.bodi ~* 'coupe' THEN 'coupe'
+        WHEN b.bodi ~* 'sedan' THEN 'sedan'
+    END
+FROM (
+    SELECT id, substring(deskripsi FROM ' interior\. ([^.]*)\. VIN:') AS bodi FROM mobils
+) b
+WHERE mobils.id = b.id AND mobils.tipe_bodi IS NULL AND b.bodi IS NOT NULL;
@@ -0,0 +1,6 @@
+-- Rollback: Hapus koordinat listing
+DROP INDEX IF EXISTS idx_mobils_koordinat;
+
+ALTER TABLE mobils
+    DROP COLUMN IF EXISTS longitude,
+    DROP COLUMN IF EXISTS latitude;
@@ -0,0 +1,11 @@
+-- Koordinat listing untuk pencarian "mobil di sekitar saya" (tanpa PostGIS)
+ALTER TABLE mobils
+    ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180);
+
+-- Pra-filter bounding box sebelum haversine
+CREATE INDEX IF NOT EXISTS idx_mobils_koordinat ON mobils (latitude, longitude)
+    WHERE status = 'tersedia' AND latitude IS NOT NULL;
+
+-- Backfill dari teks lokasi dilakukan aplikasi (geo.BackfillKoordinat, gazetteer offline)
+-- saat server start
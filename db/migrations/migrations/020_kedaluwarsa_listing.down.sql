@@ -0,0 +1,8 @@
+-- Rollback: Hapus masa tayang listing (listing kedaluwarsa dikembalikan ke tersedia)
+UPDATE mobils SET status = 'tersedia' WHERE status = 'kedaluwarsa';
+
+DROP INDEX IF EXISTS idx_mobils_berlaku_sampai;
+
+ALTER TABLE mobils
+    DROP COLUMN IF EXISTS peringatan_kedaluwarsa_dikirim,
+    DROP COLUMN IF EXISTS berlaku_sampai;
@@ -0,0 +1,13 @@
+-- Masa tayang listing: lewat berlaku_sampai, listing 'tersedia' dipindah ke 'kedaluwarsa'
+ALTER TABLE mobils
+    ADD COLUMN IF NOT EXISTS berlaku_sampai TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS peringatan_kedaluwarsa_dikirim TIMESTAMP;
+
+-- Job kedaluwarsa & peringatan hanya memindai listing tersedia
+CREATE INDEX IF NOT EXISTS idx_mobils_berlaku_sampai ON mobils (berlaku_sampai) WHERE status = 'tersedia';
+
+-- Listing yang sudah tayang diberi masa tayang penuh sejak migrasi (default 30 hari),
+-- agar tidak langsung kedaluwarsa. Listing yang masih NULL diisi job sesuai LISTING_MASA_BERLAKU_HARI.
+UPDATE mobils
+SET berlaku_sampai = NOW() + INTERVAL '30 days'
+WHERE status = 'tersedia' AND berlaku_sampai IS NULL;
@@ -0,0 +1,10 @@
+-- Rollback: Hapus kolom moderasi (listing yang belum disetujui dikembalikan ke draft)
+UPDATE mobils SET status = 'draft' WHERE status IN ('menunggu_moderasi', 'ditolak');
+
+DROP INDEX IF EXISTS idx_mobils_antrean_moderasi;
+
+ALTER TABLE mobils
+    DROP COLUMN IF EXISTS dipublikasikan_pada,
+    DROP COLUMN IF EXISTS dimoderasi_pada,
+    DROP COLUMN IF EXISTS dimoderasi_oleh,
+    DROP COLUMN IF EXISTS alasan_penolakan;
@@ -0,0 +1,15 @@
+-- Draft, publikasi, dan antrean moderasi listing
+-- Status baru: 'menunggu_moderasi' (menunggu staf) dan 'ditolak' (dengan alasan_penolakan)
+ALTER TABLE mobils
+    ADD COLUMN IF NOT EXISTS alasan_penolakan TEXT,
+    ADD COLUMN IF NOT EXISTS dimoderasi_oleh UUID REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS dimoderasi_pada TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS dipublikasikan_pada TIMESTAMP;
+
+-- Antrean moderasi diurutkan dari pengajuan paling lama
+CREATE INDEX IF NOT EXISTS idx_mobils_antrean_moderasi ON mobils (updated_at) WHERE status = 'menunggu_moderasi';
+
+-- Listing yang sudah pernah tayang dianggap dipublikasikan saat dibuat
+UPDATE mobils
+SET dipublikasikan_pada = created_at
+WHERE status <> 'draft' AND dipublikasikan_pada IS NULL;
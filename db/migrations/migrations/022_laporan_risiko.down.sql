@@ -0,0 +1,10 @@
+-- Rollback: Hapus laporan listing dan penilaian risiko
+DROP TABLE IF EXISTS penilaian_risiko;
+DROP TABLE IF EXISTS laporan_listing;
+
+DROP INDEX IF EXISTS idx_mobils_foto_phash;
+DROP INDEX IF EXISTS idx_mobils_vin;
+
+ALTER TABLE mobils
+    DROP COLUMN IF EXISTS foto_phash,
+    DROP COLUMN IF EXISTS vin;
@@ -0,0 +1,41 @@
+-- Laporan penyalahgunaan listing dan penilaian risiko penipuan
+
+-- VIN dan hash perseptual foto untuk deteksi listing duplikat
+ALTER TABLE mobils
+    ADD COLUMN IF NOT EXISTS vin TEXT CHECK (vin ~ '^[A-HJ-NPR-Z0-9]{17}$'),
+    ADD COLUMN IF NOT EXISTS foto_phash BIGINT;
+
+CREATE INDEX IF NOT EXISTS idx_mobils_vin ON mobils (vin) WHERE vin IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_mobils_foto_phash ON mobils (foto_phash) WHERE foto_phash IS NOT NULL;
+
+-- VIN listing lama diambil dari deskripsi ("VIN: ..." dari seeder dan listing trade-in)
+UPDATE mobils
+SET vin = substring(deskripsi FROM 'VIN:\s*([A-HJ-NPR-Z0-9]{17})')
+WHERE vin IS NULL AND deskripsi ~ 'VIN:\s*[A-HJ-NPR-Z0-9]{17}';
+
+-- Laporan dari user (satu laporan terbuka per pelapor per listing)
+CREATE TABLE IF NOT EXISTS laporan_listing (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    mobil_id UUID NOT NULL REFERENCES mobils(id) ON DELETE CASCADE,
+    pelapor_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    alasan TEXT NOT NULL,                   -- penipuan/harga_tidak_wajar/foto_palsu/data_salah/duplikat/lainnya
+    keterangan TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'terbuka', -- terbuka/ditindak/diabaikan
+    catatan_admin TEXT NOT NULL DEFAULT '',
+    ditangani_oleh UUID REFERENCES users(id) ON DELETE SET NULL,
+    ditangani_pada TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_laporan_listing_terbuka ON laporan_listing (mobil_id, pelapor_id)
+    WHERE status = 'terbuka';
+CREATE INDEX IF NOT EXISTS idx_laporan_listing_status ON laporan_listing (status, created_at);
+
+-- Hasil penilaian risiko terakhir per listing (dinilai saat dipublikasikan / dilaporkan)
+CREATE TABLE IF NOT EXISTS penilaian_risiko (
+    mobil_id UUID PRIMARY KEY REFERENCES mobils(id) ON DELETE CASCADE,
+    skor INT NOT NULL CHECK (skor BETWEEN 0 AND 100),
+    sinyal JSONB NOT NULL DEFAULT '[]',     -- [{aturan, skor, keterangan}]
+    ditahan BOOLEAN NOT NULL DEFAULT FALSE, -- Listing ditahan otomatis ke antrean moderasi
+    dinilai_pada TIMESTAMP NOT NULL DEFAULT NOW()
+);
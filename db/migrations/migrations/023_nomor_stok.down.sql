@@ -0,0 +1,5 @@
+-- Rollback: Hapus nomor stok dealer
+DROP INDEX IF EXISTS idx_mobils_nomor_stok;
+
+ALTER TABLE mobils
+    DROP COLUMN IF EXISTS nomor_stok;
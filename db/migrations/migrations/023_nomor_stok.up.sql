@@ -0,0 +1,5 @@
+-- Nomor stok dealer: kunci upsert BulkImportMobil (selain VIN), unik per owner
+ALTER TABLE mobils
+    ADD COLUMN IF NOT EXISTS nomor_stok TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_mobils_nomor_stok ON mobils (owner_id, nomor_stok) WHERE nomor_stok IS NOT NULL;
@@ -0,0 +1,7 @@
+-- Rollback: Kembalikan jadwal janji temu ke TIMESTAMP (UTC)
+ALTER TABLE jadwal_slot
+    ALTER COLUMN mulai TYPE TIMESTAMP USING mulai AT TIME ZONE 'UTC',
+    ALTER COLUMN selesai TYPE TIMESTAMP USING selesai AT TIME ZONE 'UTC';
+
+ALTER TABLE janji_temu
+    ALTER COLUMN reminder_sent_at TYPE TIMESTAMP;
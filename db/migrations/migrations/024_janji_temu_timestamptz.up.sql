@@ -0,0 +1,9 @@
+-- Jadwal janji temu disimpan sebagai TIMESTAMPTZ agar perbandingan dengan NOW() tidak
+-- bergantung pada timezone sesi DB. Nilai lama ditulis dari AsTime() (UTC), jadi dibaca sebagai UTC.
+ALTER TABLE jadwal_slot
+    ALTER COLUMN mulai TYPE TIMESTAMPTZ USING mulai AT TIME ZONE 'UTC',
+    ALTER COLUMN selesai TYPE TIMESTAMPTZ USING selesai AT TIME ZONE 'UTC';
+
+-- reminder_sent_at diisi NOW() (waktu sesi), cast biasa memakai timezone sesi yang sama
+ALTER TABLE janji_temu
+    ALTER COLUMN reminder_sent_at TYPE TIMESTAMPTZ;
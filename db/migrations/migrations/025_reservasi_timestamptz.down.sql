@@ -0,0 +1,3 @@
+-- Rollback: Kembalikan reserved_until ke TIMESTAMP
+ALTER TABLE transaksi_jual
+    ALTER COLUMN reserved_until TYPE TIMESTAMP;
@@ -0,0 +1,5 @@
+-- Batas reservasi disimpan sebagai TIMESTAMPTZ agar perbandingan dengan NOW() (job) dan
+-- time.Now() (PayTransaksi) tidak bergantung pada timezone app / sesi DB.
+-- Nilai lama dibaca dengan timezone sesi (reservasi lama paling lama RESERVASI_DURASI_JAM).
+ALTER TABLE transaksi_jual
+    ALTER COLUMN reserved_until TYPE TIMESTAMPTZ;
@@ -0,0 +1,3 @@
+-- Rollback: Kembalikan berlaku_sampai trade-in ke TIMESTAMP
+ALTER TABLE trade_in
+    ALTER COLUMN berlaku_sampai TYPE TIMESTAMP;
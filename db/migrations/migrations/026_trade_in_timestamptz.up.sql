@@ -0,0 +1,5 @@
+-- Batas berlaku taksiran trade-in disimpan sebagai TIMESTAMPTZ agar perbandingan dengan
+-- time.Now() (RespondTradeIn / BuyMobil) tidak bergantung pada timezone app / sesi DB.
+-- Nilai lama dibaca dengan timezone sesi.
+ALTER TABLE trade_in
+    ALTER COLUMN berlaku_sampai TYPE TIMESTAMPTZ;
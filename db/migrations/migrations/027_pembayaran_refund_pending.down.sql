@@ -0,0 +1,3 @@
+-- Rollback: Hapus index refund tertunda (status dikembalikan ke 'paid' agar tidak dianggap refunded)
+DROP INDEX IF EXISTS idx_pembayaran_refund_pending;
+UPDATE pembayaran SET status = 'paid' WHERE status = 'refund_pending';
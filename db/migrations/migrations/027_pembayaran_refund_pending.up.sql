@@ -0,0 +1,4 @@
+-- Status pembayaran lokal 'refund_pending': dana harus dikembalikan tapi refund ke provider
+-- belum berhasil. Index parsial untuk job refund (baris seperti ini seharusnya sedikit).
+CREATE INDEX IF NOT EXISTS idx_pembayaran_refund_pending
+    ON pembayaran (provider, updated_at) WHERE status = 'refund_pending';
@@ -0,0 +1,8 @@
+-- Rollback: Hapus kolom penanganan laporan user
+DROP INDEX IF EXISTS idx_laporan_user_dilaporkan;
+DROP INDEX IF EXISTS idx_laporan_user_status;
+
+ALTER TABLE laporan_user
+    DROP COLUMN IF EXISTS ditangani_pada,
+    DROP COLUMN IF EXISTS ditangani_oleh,
+    DROP COLUMN IF EXISTS catatan_admin;
@@ -0,0 +1,8 @@
+-- Penanganan laporan user (ChatService.ReportUser) oleh admin lewat AdminService
+ALTER TABLE laporan_user
+    ADD COLUMN IF NOT EXISTS catatan_admin TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS ditangani_oleh UUID REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS ditangani_pada TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_laporan_user_status ON laporan_user (status, created_at);
+CREATE INDEX IF NOT EXISTS idx_laporan_user_dilaporkan ON laporan_user (dilaporkan_id) WHERE status <> 'ditutup';
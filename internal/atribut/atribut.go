@@ -0,0 +1,254 @@
+package atribut
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Transmisi
+const (
+	TransmisiManual = "manual"
+	TransmisiMatic  = "matic"
+)
+
+// Bahan bakar
+const (
+	BahanBakarBensin  = "bensin"
+	BahanBakarDiesel  = "diesel"
+	BahanBakarHybrid  = "hybrid"
+	BahanBakarListrik = "listrik"
+)
+
+// Tipe bodi
+const (
+	TipeBodiSedan       = "sedan"
+	TipeBodiHatchback   = "hatchback"
+	TipeBodiSUV         = "suv"
+	TipeBodiMPV         = "mpv"
+	TipeBodiPickup      = "pickup"
+	TipeBodiCoupe       = "coupe"
+	TipeBodiKonvertibel = "konvertibel"
+	TipeBodiWagon       = "wagon"
+	TipeBodiVan         = "van"
+)
+
+const (
+	MaksKilometer    = 2000000
+	MinJumlahKursi   = 1
+	MaksJumlahKursi  = 20
+	maksPanjangWarna = 30
+)
+
+// Atribut adalah spesifikasi terstruktur listing. Nilai kosong / nil / 0 = tidak diketahui.
+type Atribut struct {
+	Kilometer   *int32
+	Transmisi   string
+	BahanBakar  string
+	Warna       string
+	TipeBodi    string
+	JumlahKursi int32
+	PlatRegion  string
+	VIN         string
+}
+
+// Alias input (termasuk istilah bahasa Inggris dari Marketcheck/NHTSA) ke nilai kanonik
+var (
+	aliasTransmisi = map[string]string{
+		"manual": TransmisiManual, "mt": TransmisiManual, "m/t": TransmisiManual,
+		"matic": TransmisiMatic, "otomatis": TransmisiMatic, "automatic": TransmisiMatic,
+		"at": TransmisiMatic, "a/t": TransmisiMatic, "cvt": TransmisiMatic,
+	}
+	aliasBahanBakar = map[string]string{
+		"bensin": BahanBakarBensin, "gasoline": BahanBakarBensin, "petrol": BahanBakarBensin, "unleaded": BahanBakarBensin,
+		"diesel": BahanBakarDiesel, "solar": BahanBakarDiesel,
+		"hybrid": BahanBakarHybrid, "hibrida": BahanBakarHybrid, "plug-in hybrid": BahanBakarHybrid,
+		"listrik": BahanBakarListrik, "electric": BahanBakarListrik, "ev": BahanBakarListrik,
+	}
+	aliasTipeBodi = map[string]string{
+		"sedan": TipeBodiSedan, "hatchback": TipeBodiHatchback,
+		"suv": TipeBodiSUV, "crossover": TipeBodiSUV,
+		"mpv": TipeBodiMPV, "minivan": TipeBodiMPV,
+		"pickup": TipeBodiPickup, "pick-up": TipeBodiPickup, "pickup truck": TipeBodiPickup, "truck": TipeBodiPickup,
+		"coupe":       TipeBodiCoupe,
+		"konvertibel": TipeBodiKonvertibel, "convertible": TipeBodiKonvertibel, "cabriolet": TipeBodiKonvertibel,
+		"wagon": TipeBodiWagon, "station wagon": TipeBodiWagon,
+		"van": TipeBodiVan, "cargo van": TipeBodiVan, "passenger van": TipeBodiVan,
+	}
+	// Warna bahasa Inggris dari data seeder diterjemahkan; warna lain disimpan apa adanya (huruf kecil)
+	aliasWarna = map[string]string{
+		"black": "hitam", "white": "putih", "silver": "silver", "gray": "abu-abu", "grey": "abu-abu",
+		"red": "merah", "blue": "biru", "green": "hijau", "brown": "coklat", "yellow": "kuning",
+		"orange": "oranye", "gold": "emas", "beige": "krem", "purple": "ungu",
+	}
+)
+
+// polaVIN: 17 karakter alfanumerik tanpa I, O, Q (mudah tertukar dengan 1 dan 0)
+var polaVIN = regexp.MustCompile(`^[A-HJ-NPR-Z0-9]{17}$`)
+
+// RegionPlat adalah kode wilayah plat nomor (huruf depan) beserta wilayahnya
+var RegionPlat = map[string]string{
+	"A": "Banten", "B": "Jakarta, Depok, Tangerang, Bekasi", "D": "Bandung", "E": "Cirebon",
+	"F": "Bogor, Sukabumi, Cianjur", "G": "Pekalongan", "H": "Semarang", "K": "Pati",
+	"L": "Surabaya", "M": "Madura", "N": "Malang", "P": "Besuki", "R": "Banyumas",
+	"S": "Bojonegoro", "T": "Purwakarta, Karawang", "W": "Sidoarjo, Gresik", "Z": "Garut, Tasikmalaya",
+	"AA": "Kedu", "AB": "Yogyakarta", "AD": "Surakarta", "AE": "Madiun", "AG": "Kediri",
+	"BA": "Sumatera Barat", "BB": "Sumatera Utara (Tapanuli)", "BD": "Bengkulu", "BE": "Lampung",
+	"BG": "Sumatera Selatan", "BH": "Jambi", "BK": "Sumatera Utara", "BL": "Aceh",
+	"BM": "Riau", "BN": "Bangka Belitung", "BP": "Kepulauan Riau",
+	"DA": "Kalimantan Selatan", "DB": "Sulawesi Utara", "DC": "Sulawesi Barat", "DD": "Sulawesi Selatan",
+	"DE": "Maluku", "DG": "Maluku Utara", "DH": "NTT (Timor)", "DK": "Bali", "DL": "Sulawesi Utara (Sangihe)",
+	"DM": "Gorontalo", "DN": "Sulawesi Tengah", "DP": "Sulawesi Selatan (Parepare)", "DR": "NTB (Lombok)",
+	"DS": "Papua", "DT": "Sulawesi Tenggara", "EA": "NTB (Sumbawa)", "EB": "NTT (Flores)", "ED": "NTT (Sumba)",
+	"KB": "Kalimantan Barat", "KH": "Kalimantan Tengah", "KT": "Kalimantan Timur", "KU": "Kalimantan Utara",
+	"PA": "Papua", "PB": "Papua Barat",
+}
+
+// Normalisasi memvalidasi atribut dari request dan mengubahnya ke nilai kanonik
+func Normalisasi(a Atribut) (Atribut, error) {
+	hasil := Atribut{Kilometer: a.Kilometer, JumlahKursi: a.JumlahKursi}
+	if a.Kilometer != nil && (*a.Kilometer < 0 || *a.Kilometer > MaksKilometer) {
+		return Atribut{}, fmt.Errorf("kilometer harus 0-%d", MaksKilometer)
+	}
+	if a.JumlahKursi != 0 && (a.JumlahKursi < MinJumlahKursi || a.JumlahKursi > MaksJumlahKursi) {
+		return Atribut{}, fmt.Errorf("jumlah kursi harus %d-%d", MinJumlahKursi, MaksJumlahKursi)
+	}
+
+	var ok bool
+	if hasil.Transmisi, ok = Transmisi(a.Transmisi); !ok {
+		return Atribut{}, fmt.Errorf("transmisi harus manual atau matic")
+	}
+	if hasil.BahanBakar, ok = BahanBakar(a.BahanBakar); !ok {
+		return Atribut{}, fmt.Errorf("bahan bakar harus %s", strings.Join(nilaiKanonik(aliasBahanBakar), ", "))
+	}
+	if hasil.TipeBodi, ok = TipeBodi(a.TipeBodi); !ok {
+		return Atribut{}, fmt.Errorf("tipe bodi harus %s", strings.Join(nilaiKanonik(aliasTipeBodi), ", "))
+	}
+	if hasil.PlatRegion, ok = PlatRegion(a.PlatRegion); !ok {
+		return Atribut{}, fmt.Errorf("kode wilayah plat nomor %q tidak dikenal", a.PlatRegion)
+	}
+	if hasil.VIN, ok = VIN(a.VIN); !ok {
+		return Atribut{}, fmt.Errorf("VIN harus 17 karakter huruf/angka tanpa I, O, Q")
+	}
+	hasil.Warna = Warna(a.Warna)
+	if utf8.RuneCountInString(hasil.Warna) > maksPanjangWarna {
+		return Atribut{}, fmt.Errorf("warna maksimal %d karakter", maksPanjangWarna)
+	}
+	return hasil, nil
+}
+
+// Transmisi menormalkan transmisi. Input kosong valid (tidak diketahui).
+func Transmisi(s string) (string, bool) {
+	return kanonik(aliasTransmisi, s)
+}
+
+// BahanBakar menormalkan bahan bakar. Input kosong valid (tidak diketahui).
+func BahanBakar(s string) (string, bool) {
+	return kanonik(aliasBahanBakar, s)
+}
+
+// TipeBodi menormalkan tipe bodi. Input kosong valid (tidak diketahui).
+func TipeBodi(s string) (string, bool) {
+	return kanonik(aliasTipeBodi, s)
+}
+
+// PlatRegion menormalkan kode wilayah plat nomor ("b" -> "B"). Input kosong valid.
+func PlatRegion(s string) (string, bool) {
+	kode := strings.ToUpper(strings.TrimSpace(s))
+	if kode == "" {
+		return "", true
+	}
+	_, ok := RegionPlat[kode]
+	return kode, ok
+}
+
+// VIN menormalkan nomor rangka: huruf besar, 17 karakter tanpa I, O, Q. Kosong dianggap valid.
+func VIN(s string) (string, bool) {
+	vin := strings.ToUpper(strings.TrimSpace(s))
+	if vin == "" {
+		return "", true
+	}
+	return vin, polaVIN.MatchString(vin)
+}
+
+// Warna menormalkan warna (huruf kecil, warna bahasa Inggris diterjemahkan)
+func Warna(s string) string {
+	warna := strings.ToLower(strings.Join(strings.Fields(s), " "))
+	if terjemahan, ok := aliasWarna[warna]; ok {
+		return terjemahan
+	}
+	return warna
+}
+
+// TipeBodiLonggar mencari tipe bodi dari teks bebas (misal "Sport Utility Vehicle (SUV)/Multi-Purpose
+// Vehicle (MPV)" dari NHTSA atau "Cargo Van" dari Marketcheck). "" jika tidak dikenali.
+func TipeBodiLonggar(s string) string {
+	teks := strings.ToLower(s)
+	if tipe, ok := TipeBodi(teks); ok {
+		return tipe
+	}
+	// Kata kunci dicek dari yang paling spesifik
+	for _, k := range []struct{ kata, tipe string }{
+		{"convertible", TipeBodiKonvertibel}, {"cabriolet", TipeBodiKonvertibel},
+		{"pickup", TipeBodiPickup}, {"truck", TipeBodiPickup},
+		{"minivan", TipeBodiMPV}, {"van", TipeBodiVan},
+		{"suv", TipeBodiSUV}, {"sport utility", TipeBodiSUV}, {"crossover", TipeBodiSUV},
+		{"hatchback", TipeBodiHatchback}, {"wagon", TipeBodiWagon},
+		{"coupe", TipeBodiCoupe}, {"sedan", TipeBodiSedan},
+	} {
+		if strings.Contains(teks, k.kata) {
+			return k.tipe
+		}
+	}
+	return ""
+}
+
+// KilometerDariMil mengubah jarak tempuh dalam mil (data Marketcheck) ke kilometer, dibulatkan
+func KilometerDariMil(mil int) int32 {
+	return int32((int64(mil)*1609344 + 500000) / 1000000)
+}
+
+func kanonik(alias map[string]string, s string) (string, bool) {
+	kunci := strings.ToLower(strings.Join(strings.Fields(s), " "))
+	if kunci == "" {
+		return "", true
+	}
+	nilai, ok := alias[kunci]
+	return nilai, ok
+}
+
+// nilaiKanonik mengembalikan daftar nilai unik alias (untuk pesan error), terurut
+func nilaiKanonik(alias map[string]string) []string {
+	unik := map[string]bool{}
+	for _, v := range alias {
+		unik[v] = true
+	}
+	daftar := make([]string, 0, len(unik))
+	for v := range unik {
+		daftar = append(daftar, v)
+	}
+	sort.Strings(daftar)
+	return daftar
+}
+
+// PENJELASAN FILE atribut.go:
+// File ini berisi daftar nilai dan validasi atribut terstruktur mobil
+// (kilometer, transmisi, bahan bakar, warna, tipe bodi, jumlah kursi, plat region, VIN)
+//
+// Fungsi Normalisasi:
+// - Dipakai CreateMobil; kilometer 0-2.000.000, jumlah kursi 1-20 (0 = tidak diketahui)
+// - Transmisi/bahan bakar/tipe bodi menerima alias (misal "automatic" -> "matic",
+//   "gasoline" -> "bensin", "minivan" -> "mpv") dan disimpan dalam nilai kanonik
+// - Plat region harus salah satu kode wilayah di RegionPlat (misal "B", "D", "AB")
+// - Warna bebas (maks 30 karakter), warna bahasa Inggris dasar diterjemahkan
+//
+// Fungsi Transmisi / BahanBakar / TipeBodi / PlatRegion / Warna:
+// - Dipakai juga oleh filter ListMobil (pencarian) agar nilai filter sama dengan yang tersimpan
+//
+// Fungsi VIN:
+// - Nomor rangka 17 karakter (standar ISO 3779), dipakai CreateMobil dan deteksi VIN duplikat
+//
+// Fungsi TipeBodiLonggar / KilometerDariMil:
+// - Untuk data eksternal (seeder Marketcheck, decode VIN NHTSA) yang formatnya bebas
@@ -21,6 +21,15 @@ const (
 	UserRoleKey  contextKey = "user_role"
 )
 
+// RoleAdmin adalah role user dengan akses admin (lihat kolom users.role)
+const RoleAdmin = "admin"
+
+// IsAdmin mengecek apakah user di context memiliki role admin
+func IsAdmin(ctx context.Context) bool {
+	role, ok := ctx.Value(UserRoleKey).(string)
+	return ok && role == RoleAdmin
+}
+
 // AuthInterceptor adalah gRPC Unary Interceptor untuk validasi JWT
 func AuthInterceptor(
 	ctx context.Context,
@@ -37,13 +46,33 @@ func AuthInterceptor(
 		"/carapp.NhtsaDataService/GetModelsForMake": true, // Diubah dari MobilService
 
 		// --- TAMBAHAN BARU ---
-		"/carapp.MobilService/ListMobil": true, // Publik bisa lihat daftar mobil
-		"/carapp.MobilService/GetMobil":  true,
+		"/carapp.MobilService/ListMobil":       true, // Publik bisa lihat daftar mobil
+		"/carapp.MobilService/GetMobil":        true,
+		"/carapp.MobilService/GetSimilarMobil": true,
+		"/carapp.MobilService/CompareMobil":    true,
+
+		// Simulasi kredit bisa dilihat tanpa login
+		"/carapp.KreditService/ListProdukKredit": true,
+		"/carapp.KreditService/SimulateKredit":   true,
+
+		// Ulasan & profil penjual bisa dilihat tanpa login
+		"/carapp.UlasanService/ListUlasan":       true,
+		"/carapp.UlasanService/GetProfilPenjual": true,
+
+		// Riwayat & statistik harga pasar
+		"/carapp.HargaPasarService/GetPriceHistory": true,
+		"/carapp.HargaPasarService/GetMarketPrice":  true,
 	}
 
 	// Cek apakah method ini publik
 	if publicMethods[info.FullMethod] {
-		// Jika ya, langsung teruskan ke handler tanpa cek token
+		// Jika ya, teruskan ke handler tanpa wajib token. Token yang valid tetap
+		// dibaca agar handler bisa membedakan admin (mis. ulasan tersembunyi)
+		if claims := tokenOpsional(ctx); claims != nil {
+			ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
+			ctx = context.WithValue(ctx, UserEmailKey, claims.Email)
+			ctx = context.WithValue(ctx, UserRoleKey, claims.Role)
+		}
 		return handler(ctx, req)
 	}
 
@@ -156,6 +185,28 @@ func StreamAuthInterceptor(
 	return handler(srv, wrappedStream)
 }
 
+// tokenOpsional membaca token "Bearer <token>" jika ada dan valid, tanpa error.
+// Dipakai untuk method publik yang hasilnya bisa berbeda untuk user login/admin
+func tokenOpsional(ctx context.Context) *utils.JwtCustomClaims {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 {
+		return nil
+	}
+	parts := strings.Split(authHeaders[0], " ")
+	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+		return nil
+	}
+	claims, err := utils.ValidateToken(parts[1])
+	if err != nil {
+		return nil
+	}
+	return claims
+}
+
 // wrappedServerStream wraps grpc.ServerStream with new context
 type wrappedServerStream struct {
 	grpc.ServerStream
@@ -173,6 +224,7 @@ func (w *wrappedServerStream) Context() context.Context {
 // Constant Context Keys:
 // - UserIDKey, UserEmailKey, UserRoleKey: Digunakan untuk menyimpan data user di context
 // - Setelah token valid, info user disimpan di context untuk diakses handler
+// - IsAdmin(ctx): Helper untuk cek role admin dari context
 //
 // Fungsi AuthInterceptor (Unary RPC):
 // - Berjalan sebelum setiap request sampai ke handler
@@ -190,7 +242,11 @@ func (w *wrappedServerStream) Context() context.Context {
 // Public Methods (tidak perlu token):
 // - /carapp.AuthService/Login dan /Register
 // - /carapp.NhtsaDataService/GetMakes dan /GetModelsForMake
-// - /carapp.MobilService/ListMobil dan /GetMobil
+// - /carapp.MobilService/ListMobil, /GetMobil, /GetSimilarMobil, dan /CompareMobil
+// - /carapp.KreditService/ListProdukKredit dan /SimulateKredit
+// - /carapp.UlasanService/ListUlasan dan /GetProfilPenjual
+// - /carapp.HargaPasarService/GetPriceHistory dan /GetMarketPrice
+// - Token tetap dibaca jika dikirim (tokenOpsional), tapi token invalid tidak ditolak
 //
 // Flow:
 // Client Request -> Interceptor -> Cek Public Method -> Validate Token
@@ -0,0 +1,65 @@
+package chat
+
+import (
+	"sync"
+
+	pb "carapp.com/m/proto"
+)
+
+// hubBuffer adalah kapasitas channel per subscriber; event dibuang jika client terlalu lambat
+const hubBuffer = 32
+
+// hub menyebarkan event chat ke semua stream yang sedang membuka percakapan.
+// Hanya bekerja di dalam satu proses server; client lain tetap bisa polling ListPesan.
+type hub struct {
+	mu   sync.RWMutex
+	subs map[string]map[chan *pb.ChatEvent]struct{}
+}
+
+func newHub() *hub {
+	return &hub{subs: make(map[string]map[chan *pb.ChatEvent]struct{})}
+}
+
+// subscribe mendaftarkan channel baru untuk percakapan
+func (h *hub) subscribe(percakapanID string) chan *pb.ChatEvent {
+	ch := make(chan *pb.ChatEvent, hubBuffer)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subs[percakapanID] == nil {
+		h.subs[percakapanID] = make(map[chan *pb.ChatEvent]struct{})
+	}
+	h.subs[percakapanID][ch] = struct{}{}
+	return ch
+}
+
+// unsubscribe menghapus channel (dipanggil saat stream ditutup)
+func (h *hub) unsubscribe(percakapanID string, ch chan *pb.ChatEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subs[percakapanID], ch)
+	if len(h.subs[percakapanID]) == 0 {
+		delete(h.subs, percakapanID)
+	}
+}
+
+// publish mengirim event ke semua subscriber tanpa memblokir pengirim
+func (h *hub) publish(event *pb.ChatEvent) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for ch := range h.subs[event.PercakapanId] {
+		select {
+		case ch <- event:
+		default:
+			// Subscriber lambat, event dilewati (client bisa sinkron ulang lewat ListPesan)
+		}
+	}
+}
+
+// PENJELASAN FILE chat_hub.go:
+// File ini berisi pub/sub in-memory untuk chat live
+//
+// - subscribe/unsubscribe: Dipanggil oleh StreamPesan saat stream dibuka/ditutup
+// - publish: Dipanggil setelah SendPesan / MarkDibaca commit ke database
+// - Channel ber-buffer, publish tidak pernah memblokir (event dibuang jika penuh)
+// - Hanya satu proses server; untuk beberapa instance perlu broker (Redis/NATS) di kemudian hari
@@ -0,0 +1,501 @@
+package chat
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"carapp.com/m/internal/auth"
+	"carapp.com/m/internal/notifikasi"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxPanjangPesan = 2000
+	defaultLimit    = 50
+	maxLimit        = 200
+)
+
+// Tipe ChatEvent
+const (
+	EventPesan  = "pesan"
+	EventDibaca = "dibaca"
+)
+
+// ChatServiceServer adalah implementasi dari pb.ChatServiceServer
+type ChatServiceServer struct {
+	pb.UnimplementedChatServiceServer
+	DB  *sql.DB
+	hub *hub
+}
+
+// NewChatService membuat instance baru
+func NewChatService(db *sql.DB) *ChatServiceServer {
+	return &ChatServiceServer{DB: db, hub: newHub()}
+}
+
+// percakapanInfo adalah data minimal untuk cek hak akses
+type percakapanInfo struct {
+	ID        string
+	PembeliID string
+	PenjualID string
+}
+
+// lawanBicara mengembalikan ID pihak lain dalam percakapan
+func (p *percakapanInfo) lawanBicara(userID string) string {
+	if userID == p.PembeliID {
+		return p.PenjualID
+	}
+	return p.PembeliID
+}
+
+// StartPercakapan membuat percakapan baru (atau mengembalikan yang sudah ada)
+func (s *ChatServiceServer) StartPercakapan(ctx context.Context, req *pb.StartPercakapanRequest) (*pb.Percakapan, error) {
+	pembeliID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.MobilId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "MobilID tidak boleh kosong")
+	}
+
+	// 1. Cari pemilik mobil
+	var penjualID string
+	err := s.DB.QueryRowContext(ctx, `SELECT owner_id FROM mobils WHERE id = $1`, req.MobilId).Scan(&penjualID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "Mobil tidak ditemukan")
+		}
+		return nil, status.Errorf(codes.Internal, "Gagal mengecek mobil")
+	}
+	if penjualID == pembeliID {
+		return nil, status.Errorf(codes.FailedPrecondition, "Anda tidak bisa mengirim pesan ke diri sendiri")
+	}
+	if err := s.cekBlokir(ctx, pembeliID, penjualID); err != nil {
+		return nil, err
+	}
+
+	// 2. Get-or-create (UNIQUE mobil_id + pembeli_id)
+	var percakapanID string
+	err = s.DB.QueryRowContext(ctx, `
+		INSERT INTO percakapan (mobil_id, pembeli_id, penjual_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (mobil_id, pembeli_id) DO UPDATE SET mobil_id = EXCLUDED.mobil_id
+		RETURNING id
+	`, req.MobilId, pembeliID, penjualID).Scan(&percakapanID)
+	if err != nil {
+		log.Printf("Gagal membuat percakapan: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal membuat percakapan")
+	}
+
+	list, err := s.queryPercakapan(ctx, pembeliID, "p.id = $2", percakapanID)
+	if err != nil || len(list) == 0 {
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil percakapan")
+	}
+	return list[0], nil
+}
+
+// ListPercakapan menampilkan semua percakapan user (sebagai pembeli maupun penjual)
+func (s *ChatServiceServer) ListPercakapan(ctx context.Context, req *pb.ListPercakapanRequest) (*pb.ListPercakapanResponse, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+
+	list, err := s.queryPercakapan(ctx, userID, "(p.pembeli_id = $1 OR p.penjual_id = $1)")
+	if err != nil {
+		log.Printf("Gagal query ListPercakapan: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil percakapan")
+	}
+	return &pb.ListPercakapanResponse{Percakapan: list}, nil
+}
+
+// SendPesan menyimpan pesan baru dan menyebarkannya ke stream yang terbuka
+func (s *ChatServiceServer) SendPesan(ctx context.Context, req *pb.SendPesanRequest) (*pb.PesanChat, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	isi := strings.TrimSpace(req.Isi)
+	if isi == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Pesan tidak boleh kosong")
+	}
+	if utf8.RuneCountInString(isi) > maxPanjangPesan {
+		return nil, status.Errorf(codes.InvalidArgument, "Pesan maksimal %d karakter", maxPanjangPesan)
+	}
+
+	p, err := s.getPeserta(ctx, req.PercakapanId, userID)
+	if err != nil {
+		return nil, err
+	}
+	penerimaID := p.lawanBicara(userID)
+	if err := s.cekBlokir(ctx, userID, penerimaID); err != nil {
+		return nil, err
+	}
+
+	// 1. Simpan pesan dan update waktu pesan terakhir
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal memulai transaksi DB")
+	}
+	defer tx.Rollback()
+
+	var pesan pb.PesanChat
+	var createdAt time.Time
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO pesan_chat (percakapan_id, pengirim_id, isi)
+		VALUES ($1, $2, $3)
+		RETURNING id, percakapan_id, pengirim_id, isi, created_at
+	`, p.ID, userID, isi).Scan(&pesan.Id, &pesan.PercakapanId, &pesan.PengirimId, &pesan.Isi, &createdAt)
+	if err != nil {
+		log.Printf("Gagal menyimpan pesan: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan pesan")
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE percakapan SET last_message_at = $1 WHERE id = $2`, createdAt, p.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan pesan")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan pesan")
+	}
+	pesan.CreatedAt = timestamppb.New(createdAt)
+
+	// 2. Kirim ke stream live + notifikasi
+	s.hub.publish(&pb.ChatEvent{Tipe: EventPesan, Pesan: &pesan, PercakapanId: p.ID})
+	go notifikasi.CreateNotification(s.DB, context.Background(), penerimaID, "chat",
+		fmt.Sprintf("Pesan baru: %s", potong(isi, 80)))
+
+	return &pesan, nil
+}
+
+// ListPesan mengambil pesan dalam percakapan (dipakai juga sebagai polling fallback gRPC-Web)
+func (s *ChatServiceServer) ListPesan(ctx context.Context, req *pb.ListPesanRequest) (*pb.ListPesanResponse, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	p, err := s.getPeserta(ctx, req.PercakapanId, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := defaultLimit
+	if req.Limit > 0 && req.Limit <= maxLimit {
+		limit = int(req.Limit)
+	}
+
+	var rows *sql.Rows
+	if req.Setelah != nil {
+		// Polling: pesan baru setelah waktu tertentu, urut lama -> baru
+		rows, err = s.DB.QueryContext(ctx, `
+			SELECT id, percakapan_id, pengirim_id, isi, created_at, read_at
+			FROM pesan_chat
+			WHERE percakapan_id = $1 AND created_at > $2
+			ORDER BY created_at ASC
+			LIMIT $3
+		`, p.ID, req.Setelah.AsTime(), limit)
+	} else {
+		// Riwayat: N pesan terakhir, tetap dikembalikan urut lama -> baru
+		rows, err = s.DB.QueryContext(ctx, `
+			SELECT * FROM (
+				SELECT id, percakapan_id, pengirim_id, isi, created_at, read_at
+				FROM pesan_chat
+				WHERE percakapan_id = $1
+				ORDER BY created_at DESC
+				LIMIT $2
+			) terakhir ORDER BY created_at ASC
+		`, p.ID, limit)
+	}
+	if err != nil {
+		log.Printf("Gagal query ListPesan: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil pesan")
+	}
+	defer rows.Close()
+
+	var list []*pb.PesanChat
+	for rows.Next() {
+		var pesan pb.PesanChat
+		var createdAt time.Time
+		var readAt sql.NullTime
+		if err := rows.Scan(&pesan.Id, &pesan.PercakapanId, &pesan.PengirimId, &pesan.Isi, &createdAt, &readAt); err != nil {
+			log.Printf("Gagal scan pesan: %v", err)
+			continue
+		}
+		pesan.CreatedAt = timestamppb.New(createdAt)
+		if readAt.Valid {
+			pesan.ReadAt = timestamppb.New(readAt.Time)
+		}
+		list = append(list, &pesan)
+	}
+
+	return &pb.ListPesanResponse{Pesan: list}, nil
+}
+
+// StreamPesan mengirim event pesan/dibaca secara live sampai client menutup stream
+func (s *ChatServiceServer) StreamPesan(req *pb.StreamPesanRequest, stream pb.ChatService_StreamPesanServer) error {
+	ctx := stream.Context()
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	p, err := s.getPeserta(ctx, req.PercakapanId, userID)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("ChatService: Stream percakapan %s dibuka oleh %s", p.ID, userID)
+	ch := s.hub.subscribe(p.ID)
+	defer s.hub.unsubscribe(p.ID, ch)
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Printf("ChatService: Stream percakapan %s ditutup oleh %s", p.ID, userID)
+			return nil
+		case event := <-ch:
+			if err := stream.Send(event); err != nil {
+				log.Printf("Gagal mengirim event chat ke stream: %v", err)
+				return status.Errorf(codes.Aborted, "Stream client ditutup")
+			}
+		}
+	}
+}
+
+// MarkDibaca menandai semua pesan dari lawan bicara sebagai sudah dibaca (read receipt)
+func (s *ChatServiceServer) MarkDibaca(ctx context.Context, req *pb.MarkDibacaRequest) (*pb.MarkDibacaResponse, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	p, err := s.getPeserta(ctx, req.PercakapanId, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	res, err := s.DB.ExecContext(ctx, `
+		UPDATE pesan_chat SET read_at = $1
+		WHERE percakapan_id = $2 AND pengirim_id <> $3 AND read_at IS NULL
+	`, now, p.ID, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menandai pesan")
+	}
+	n, _ := res.RowsAffected()
+
+	if n > 0 {
+		s.hub.publish(&pb.ChatEvent{
+			Tipe:         EventDibaca,
+			PercakapanId: p.ID,
+			DibacaOleh:   userID,
+			DibacaAt:     timestamppb.New(now),
+		})
+	}
+	return &pb.MarkDibacaResponse{Jumlah: int32(n)}, nil
+}
+
+// BlockUser memblokir user lain (tidak bisa saling kirim pesan)
+func (s *ChatServiceServer) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*emptypb.Empty, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.UserId == "" || req.UserId == userID {
+		return nil, status.Errorf(codes.InvalidArgument, "UserID tidak valid")
+	}
+
+	_, err := s.DB.ExecContext(ctx, `
+		INSERT INTO blokir_user (pemblokir_id, diblokir_id) VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, userID, req.UserId)
+	if err != nil {
+		log.Printf("Gagal memblokir user: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal memblokir user")
+	}
+	log.Printf("ChatService: %s memblokir %s", userID, req.UserId)
+	return &emptypb.Empty{}, nil
+}
+
+// UnblockUser membuka blokir user
+func (s *ChatServiceServer) UnblockUser(ctx context.Context, req *pb.BlockUserRequest) (*emptypb.Empty, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	_, err := s.DB.ExecContext(ctx, `DELETE FROM blokir_user WHERE pemblokir_id = $1 AND diblokir_id = $2`, userID, req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal membuka blokir user")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ReportUser melaporkan user yang kasar/mencurigakan untuk ditinjau admin
+func (s *ChatServiceServer) ReportUser(ctx context.Context, req *pb.ReportUserRequest) (*emptypb.Empty, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.UserId == "" || req.UserId == userID {
+		return nil, status.Errorf(codes.InvalidArgument, "UserID tidak valid")
+	}
+	if strings.TrimSpace(req.Alasan) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Alasan laporan harus diisi")
+	}
+
+	// Laporan dari dalam percakapan hanya boleh oleh peserta
+	if req.PercakapanId != "" {
+		p, err := s.getPeserta(ctx, req.PercakapanId, userID)
+		if err != nil {
+			return nil, err
+		}
+		if p.lawanBicara(userID) != req.UserId {
+			return nil, status.Errorf(codes.InvalidArgument, "User yang dilaporkan bukan lawan bicara di percakapan ini")
+		}
+	}
+
+	_, err := s.DB.ExecContext(ctx, `
+		INSERT INTO laporan_user (pelapor_id, dilaporkan_id, percakapan_id, alasan)
+		VALUES ($1, $2, NULLIF($3, '')::uuid, $4)
+	`, userID, req.UserId, req.PercakapanId, req.Alasan)
+	if err != nil {
+		log.Printf("Gagal menyimpan laporan user: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan laporan")
+	}
+	log.Printf("ChatService: %s melaporkan %s", userID, req.UserId)
+	return &emptypb.Empty{}, nil
+}
+
+// getPeserta mengambil percakapan dan memastikan user adalah peserta
+func (s *ChatServiceServer) getPeserta(ctx context.Context, percakapanID, userID string) (*percakapanInfo, error) {
+	if percakapanID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "PercakapanID tidak boleh kosong")
+	}
+	var p percakapanInfo
+	err := s.DB.QueryRowContext(ctx, `SELECT id, pembeli_id, penjual_id FROM percakapan WHERE id = $1`, percakapanID).
+		Scan(&p.ID, &p.PembeliID, &p.PenjualID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "Percakapan tidak ditemukan")
+		}
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil percakapan")
+	}
+	if userID != p.PembeliID && userID != p.PenjualID {
+		return nil, status.Errorf(codes.PermissionDenied, "Anda bukan peserta percakapan ini")
+	}
+	return &p, nil
+}
+
+// cekBlokir menolak jika salah satu pihak memblokir yang lain
+func (s *ChatServiceServer) cekBlokir(ctx context.Context, userA, userB string) error {
+	var diblokir bool
+	err := s.DB.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM blokir_user
+			WHERE (pemblokir_id = $1 AND diblokir_id = $2) OR (pemblokir_id = $2 AND diblokir_id = $1)
+		)
+	`, userA, userB).Scan(&diblokir)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Gagal mengecek blokir")
+	}
+	if diblokir {
+		return status.Errorf(codes.PermissionDenied, "Percakapan dengan user ini diblokir")
+	}
+	return nil
+}
+
+// queryPercakapan mengambil percakapan beserta pesan terakhir dan jumlah belum dibaca.
+// $1 selalu userID (untuk hitung belum dibaca), filter tambahan memakai $2 dst.
+func (s *ChatServiceServer) queryPercakapan(ctx context.Context, userID, filter string, args ...interface{}) ([]*pb.Percakapan, error) {
+	query := `
+		SELECT p.id, p.mobil_id, p.pembeli_id, p.penjual_id, ub.name, uj.name, m.merk, m.model, p.created_at,
+		       lm.id, lm.pengirim_id, lm.isi, lm.created_at, lm.read_at,
+		       (SELECT COUNT(*) FROM pesan_chat c
+		        WHERE c.percakapan_id = p.id AND c.pengirim_id <> $1 AND c.read_at IS NULL)
+		FROM percakapan p
+		JOIN mobils m ON m.id = p.mobil_id
+		JOIN users ub ON ub.id = p.pembeli_id
+		JOIN users uj ON uj.id = p.penjual_id
+		LEFT JOIN LATERAL (
+			SELECT id, pengirim_id, isi, created_at, read_at FROM pesan_chat
+			WHERE percakapan_id = p.id ORDER BY created_at DESC LIMIT 1
+		) lm ON true
+		WHERE ` + filter + `
+		ORDER BY COALESCE(p.last_message_at, p.created_at) DESC
+		LIMIT 100
+	`
+	rows, err := s.DB.QueryContext(ctx, query, append([]interface{}{userID}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*pb.Percakapan
+	for rows.Next() {
+		var p pb.Percakapan
+		var createdAt time.Time
+		var lmID, lmPengirim, lmIsi sql.NullString
+		var lmCreatedAt, lmReadAt sql.NullTime
+
+		err := rows.Scan(&p.Id, &p.MobilId, &p.PembeliId, &p.PenjualId, &p.PembeliName, &p.PenjualName,
+			&p.Merk, &p.Model, &createdAt, &lmID, &lmPengirim, &lmIsi, &lmCreatedAt, &lmReadAt, &p.BelumDibaca)
+		if err != nil {
+			log.Printf("Gagal scan percakapan: %v", err)
+			continue
+		}
+		p.CreatedAt = timestamppb.New(createdAt)
+		if lmID.Valid {
+			p.PesanTerakhir = &pb.PesanChat{
+				Id:           lmID.String,
+				PercakapanId: p.Id,
+				PengirimId:   lmPengirim.String,
+				Isi:          lmIsi.String,
+				CreatedAt:    timestamppb.New(lmCreatedAt.Time),
+			}
+			if lmReadAt.Valid {
+				p.PesanTerakhir.ReadAt = timestamppb.New(lmReadAt.Time)
+			}
+		}
+		list = append(list, &p)
+	}
+	return list, nil
+}
+
+// potong memendekkan teks untuk pesan notifikasi
+func potong(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n]) + "..."
+}
+
+// PENJELASAN FILE chat_service.go:
+// File ini menangani pesan antara pembeli dan penjual per listing mobil
+//
+// Fungsi StartPercakapan:
+// - Pembeli membuka percakapan untuk satu mobil (get-or-create, UNIQUE mobil_id + pembeli_id)
+// - Ditolak jika salah satu pihak memblokir yang lain
+//
+// Fungsi SendPesan:
+// - Validasi isi (tidak kosong, maksimal 2000 karakter) dan cek blokir
+// - Simpan ke pesan_chat, update last_message_at, publish ke hub, buat notifikasi 'chat'
+//
+// Fungsi ListPesan:
+// - Tanpa 'setelah': 50 pesan terakhir (riwayat)
+// - Dengan 'setelah': pesan baru sejak waktu itu (polling fallback untuk gRPC-Web)
+//
+// Fungsi StreamPesan (Server Streaming):
+// - Subscribe ke hub, kirim ChatEvent (pesan/dibaca) sampai client disconnect
+//
+// Fungsi MarkDibaca: Isi read_at pesan dari lawan bicara, publish event 'dibaca'
+// Fungsi BlockUser / UnblockUser: Kelola tabel blokir_user
+// Fungsi ReportUser: Simpan laporan ke laporan_user untuk ditinjau admin (AdminService.ListLaporanUser / TanganiLaporanUser)
+//
+// Keamanan:
+// - Semua RPC cek peserta lewat getPeserta (PermissionDenied jika bukan pembeli/penjual)
@@ -7,7 +7,8 @@ import (
 	"sync" // Kita akan menggunakan WaitGroup untuk query paralel
 
 	"carapp.com/m/internal/auth" // Sesuaikan dengan modul Anda
-	pb "carapp.com/m/proto"      // Sesuaikan modul Anda
+	"carapp.com/m/internal/money"
+	pb "carapp.com/m/proto" // Sesuaikan modul Anda
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -54,10 +55,11 @@ func (s *DashboardServiceServer) GetDashboard(ctx context.Context, req *emptypb.
 	// Goroutine 2: Hitung transaksi aktif
 	go func() {
 		defer wg.Done()
-		// Hanya transaksi jual yang "diproses" yang melibatkan user
+		// Transaksi jual yang masih berjalan (belum selesai/batal) yang melibatkan user
 		query := `
 			SELECT COUNT(*) FROM transaksi_jual 
-			WHERE (penjual_id = $1 OR pembeli_id = $1) AND status = 'diproses'
+			WHERE (penjual_id = $1 OR pembeli_id = $1)
+			  AND status IN ('menunggu_pembayaran', 'dibayar', 'diproses')
 		`
 		err := s.DB.QueryRowContext(ctx, query, userID).Scan(&resp.TransaksiAktif)
 		if err != nil {
@@ -70,10 +72,14 @@ func (s *DashboardServiceServer) GetDashboard(ctx context.Context, req *emptypb.
 		defer wg.Done()
 		// Menggunakan COALESCE untuk memastikan 0 jika hasilnya NULL
 		query := `SELECT COALESCE(SUM(total), 0) FROM transaksi_jual WHERE penjual_id = $1 AND status = 'selesai'`
-		err := s.DB.QueryRowContext(ctx, query, userID).Scan(&resp.PendapatanTerakhir)
+		var pendapatan money.Money // SUM NUMERIC di-scan lossless
+		err := s.DB.QueryRowContext(ctx, query, userID).Scan(&pendapatan)
 		if err != nil {
 			errChan <- err
+			return
 		}
+		resp.PendapatanTerakhirMoney = pendapatan.ToProto()
+		resp.PendapatanTerakhir = pendapatan.Float64() // Field lama (deprecated)
 	}()
 
 	// Goroutine 4: Hitung notifikasi baru (belum dibaca)
@@ -110,8 +116,9 @@ func (s *DashboardServiceServer) GetDashboard(ctx context.Context, req *emptypb.
 // - Mengambil user_id dari context (sudah tervalidasi di middleware)
 // - Jalankan 4 query secara PARALEL menggunakan goroutine & WaitGroup:
 //   1. Total mobil milik user (COUNT dari mobils WHERE owner_id)
-//   2. Transaksi aktif (COUNT transaksi_jual status='diproses')
-//   3. Pendapatan terakhir (SUM total dari transaksi_jual WHERE penjual_id dan status='selesai')
+//   2. Transaksi aktif (COUNT transaksi_jual status menunggu_pembayaran/dibayar/diproses)
+//   3. Pendapatan terakhir (SUM total dari transaksi_jual WHERE penjual_id dan status='selesai'),
+//      di-scan ke money.Money dan dikirim sebagai pendapatan_terakhir_money
 //   4. Notifikasi baru (COUNT notifikasi WHERE user_id dan read_at IS NULL)
 // - Gunakan errChan untuk capture error dari goroutine
 // - Return DashboardSummary dengan semua data aggregate
@@ -0,0 +1,137 @@
+package geo
+
+import "strings"
+
+// gazetteer adalah koordinat pusat kota-kota di Indonesia (offline, tanpa layanan geocoding).
+// Kunci dalam huruf kecil tanpa awalan "kota"/"kabupaten".
+var gazetteer = map[string]Koordinat{
+	// Jabodetabek & Banten
+	"jakarta":           {-6.2088, 106.8456},
+	"jakarta pusat":     {-6.1865, 106.8341},
+	"jakarta selatan":   {-6.2615, 106.8106},
+	"jakarta barat":     {-6.1674, 106.7637},
+	"jakarta timur":     {-6.2250, 106.9004},
+	"jakarta utara":     {-6.1384, 106.8636},
+	"bogor":             {-6.5950, 106.8166},
+	"depok":             {-6.4025, 106.7942},
+	"tangerang":         {-6.1783, 106.6319},
+	"tangerang selatan": {-6.2886, 106.7179},
+	"bekasi":            {-6.2383, 106.9756},
+	"serang":            {-6.1200, 106.1503},
+	"cilegon":           {-6.0025, 106.0111},
+	// Jawa Barat
+	"bandung":     {-6.9175, 107.6191},
+	"cimahi":      {-6.8722, 107.5425},
+	"cirebon":     {-6.7320, 108.5523},
+	"sukabumi":    {-6.9277, 106.9300},
+	"tasikmalaya": {-7.3274, 108.2207},
+	"karawang":    {-6.3227, 107.3376},
+	"purwakarta":  {-6.5569, 107.4433},
+	"garut":       {-7.2279, 107.9087},
+	// Jawa Tengah & DIY
+	"semarang":   {-6.9667, 110.4167},
+	"surakarta":  {-7.5755, 110.8243},
+	"yogyakarta": {-7.7956, 110.3695},
+	"magelang":   {-7.4797, 110.2177},
+	"pekalongan": {-6.8898, 109.6746},
+	"tegal":      {-6.8694, 109.1402},
+	"purwokerto": {-7.4245, 109.2396},
+	"kudus":      {-6.8048, 110.8405},
+	// Jawa Timur
+	"surabaya":    {-7.2575, 112.7521},
+	"sidoarjo":    {-7.4478, 112.7183},
+	"gresik":      {-7.1539, 112.6561},
+	"malang":      {-7.9666, 112.6326},
+	"kediri":      {-7.8480, 112.0178},
+	"madiun":      {-7.6298, 111.5239},
+	"jember":      {-8.1845, 113.6681},
+	"banyuwangi":  {-8.2192, 114.3691},
+	"probolinggo": {-7.7543, 113.2159},
+	// Bali & Nusa Tenggara
+	"denpasar": {-8.6500, 115.2167},
+	"mataram":  {-8.5833, 116.1167},
+	"kupang":   {-10.1772, 123.6070},
+	// Sumatera
+	"banda aceh":      {5.5483, 95.3238},
+	"medan":           {3.5952, 98.6722},
+	"binjai":          {3.6001, 98.4854},
+	"pematangsiantar": {2.9595, 99.0687},
+	"padang":          {-0.9471, 100.4172},
+	"pekanbaru":       {0.5071, 101.4478},
+	"batam":           {1.0456, 104.0305},
+	"tanjung pinang":  {0.9186, 104.4565},
+	"jambi":           {-1.6101, 103.6131},
+	"palembang":       {-2.9761, 104.7754},
+	"bengkulu":        {-3.8004, 102.2655},
+	"bandar lampung":  {-5.3971, 105.2668},
+	"pangkal pinang":  {-2.1291, 106.1090},
+	// Kalimantan
+	"pontianak":     {-0.0263, 109.3425},
+	"palangka raya": {-2.2136, 113.9108},
+	"banjarmasin":   {-3.3186, 114.5944},
+	"balikpapan":    {-1.2379, 116.8529},
+	"samarinda":     {-0.5022, 117.1536},
+	"tarakan":       {3.3000, 117.6333},
+	// Sulawesi, Maluku, Papua
+	"makassar":  {-5.1477, 119.4327},
+	"manado":    {1.4748, 124.8421},
+	"palu":      {-0.8917, 119.8707},
+	"kendari":   {-3.9985, 122.5130},
+	"gorontalo": {0.5435, 123.0568},
+	"ambon":     {-3.6954, 128.1814},
+	"ternate":   {0.7833, 127.3667},
+	"jayapura":  {-2.5337, 140.7181},
+	"sorong":    {-0.8762, 131.2558},
+	"manokwari": {-0.8615, 134.0620},
+}
+
+// aliasKota adalah penulisan lain yang umum dipakai di kolom lokasi
+var aliasKota = map[string]string{
+	"dki jakarta": "jakarta", "jkt": "jakarta",
+	"jakpus": "jakarta pusat", "jaksel": "jakarta selatan", "jakbar": "jakarta barat",
+	"jaktim": "jakarta timur", "jakut": "jakarta utara",
+	"tangsel": "tangerang selatan",
+	"solo":    "surakarta",
+	"jogja":   "yogyakarta", "jogjakarta": "yogyakarta", "yogya": "yogyakarta", "diy": "yogyakarta",
+	"di yogyakarta": "yogyakarta",
+	"ujung pandang": "makassar",
+	"lampung":       "bandar lampung",
+	"palangkaraya":  "palangka raya",
+	"pangkalpinang": "pangkal pinang",
+	"tanjungpinang": "tanjung pinang",
+	"siantar":       "pematangsiantar",
+}
+
+// Geocode mencari koordinat dari teks lokasi bebas seperti "Bandung, Jawa Barat" atau
+// "Kota Surabaya". Bagian dipisah koma dicoba berurutan (kota biasanya di depan).
+// Mengembalikan koordinat, nama kota di gazetteer, dan ok = false jika tidak dikenal.
+func Geocode(lokasi string) (Koordinat, string, bool) {
+	for _, bagian := range strings.Split(lokasi, ",") {
+		nama := normalisasiKota(bagian)
+		if alias, ok := aliasKota[nama]; ok {
+			nama = alias
+		}
+		if k, ok := gazetteer[nama]; ok {
+			return k, nama, true
+		}
+	}
+	return Koordinat{}, "", false
+}
+
+// normalisasiKota: huruf kecil, spasi dirapikan, awalan administratif dibuang
+func normalisasiKota(s string) string {
+	nama := strings.ToLower(strings.Join(strings.Fields(s), " "))
+	for _, awalan := range []string{"kota administrasi ", "kota ", "kabupaten ", "kab. ", "kab "} {
+		nama = strings.TrimPrefix(nama, awalan)
+	}
+	return nama
+}
+
+// PENJELASAN FILE gazetteer.go:
+// File ini berisi gazetteer offline kota-kota besar Indonesia untuk geocoding teks lokasi
+//
+// Fungsi Geocode:
+// - Dipakai CreateMobil (jika koordinat tidak dikirim), filter dekat_kota di ListMobil,
+//   seeder, dan backfill koordinat listing lama
+// - Tidak case-sensitive, menerima awalan "Kota"/"Kabupaten" dan alias umum (Solo, Jogja, Tangsel)
+// - Koordinat adalah pusat kota, cukup untuk pencarian radius puluhan km
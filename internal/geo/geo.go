@@ -0,0 +1,149 @@
+package geo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"math"
+)
+
+// RadiusBumiKm adalah radius rata-rata bumi yang dipakai rumus haversine
+const RadiusBumiKm = 6371.0
+
+// MaksRadiusKm membatasi radius pencarian (kira-kira panjang pulau Jawa + Sumatera)
+const MaksRadiusKm = 2000
+
+// Koordinat adalah titik lintang/bujur dalam derajat desimal
+type Koordinat struct {
+	Lat float64
+	Lng float64
+}
+
+// Valid mengecek rentang lintang (-90..90) dan bujur (-180..180)
+func (k Koordinat) Valid() bool {
+	return k.Lat >= -90 && k.Lat <= 90 && k.Lng >= -180 && k.Lng <= 180 &&
+		!math.IsNaN(k.Lat) && !math.IsNaN(k.Lng)
+}
+
+// Haversine menghitung jarak lingkaran besar antara dua titik dalam km
+func Haversine(a, b Koordinat) float64 {
+	dLat := radian(b.Lat - a.Lat)
+	dLng := radian(b.Lng - a.Lng)
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(radian(a.Lat))*math.Cos(radian(b.Lat))*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * RadiusBumiKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Kotak adalah bounding box lintang/bujur. LngMin > LngMax tidak dipakai: jika kotak
+// melewati garis bujur 180, SemuaBujur = true dan hanya lintang yang dibatasi.
+type Kotak struct {
+	LatMin, LatMax float64
+	LngMin, LngMax float64
+	SemuaBujur     bool
+}
+
+// BoundingBox menghitung kotak yang pasti memuat semua titik dalam radiusKm dari pusat.
+// Dipakai sebagai pra-filter murah (index lintang/bujur) sebelum haversine yang presisi.
+func BoundingBox(pusat Koordinat, radiusKm float64) Kotak {
+	dLat := radiusKm / RadiusBumiKm * 180 / math.Pi
+	k := Kotak{LatMin: pusat.Lat - dLat, LatMax: pusat.Lat + dLat}
+	if k.LatMin <= -90 || k.LatMax >= 90 {
+		// Kotak memuat kutub: semua bujur mungkin
+		k.LatMin, k.LatMax, k.SemuaBujur = math.Max(k.LatMin, -90), math.Min(k.LatMax, 90), true
+		return k
+	}
+	// Selisih bujur terbesar terjadi di lintang terjauh dari ekuator di dalam kotak
+	dLng := math.Asin(math.Min(1, math.Sin(radiusKm/RadiusBumiKm)/math.Cos(radian(pusat.Lat)))) * 180 / math.Pi
+	k.LngMin, k.LngMax = pusat.Lng-dLng, pusat.Lng+dLng
+	if k.LngMin < -180 || k.LngMax > 180 {
+		k.SemuaBujur = true
+	}
+	return k
+}
+
+// SQLJarak menghasilkan ekspresi haversine PostgreSQL (km) dari kolom latitude/longitude
+// ke titik di placeholder $lat dan $lng. prefix adalah alias tabel beserta titiknya ("m.") atau "".
+func SQLJarak(prefix string, lat, lng int) string {
+	return fmt.Sprintf(`(2 * %[4]g * ASIN(LEAST(1, SQRT(
+		POWER(SIN(RADIANS(%[1]slatitude - $%[2]d) / 2), 2) +
+		COS(RADIANS($%[2]d)) * COS(RADIANS(%[1]slatitude)) * POWER(SIN(RADIANS(%[1]slongitude - $%[3]d) / 2), 2)))))`,
+		prefix, lat, lng, RadiusBumiKm)
+}
+
+// BulatkanKm membulatkan jarak ke 1 desimal untuk ditampilkan
+func BulatkanKm(km float64) float64 {
+	return math.Round(km*10) / 10
+}
+
+func radian(derajat float64) float64 {
+	return derajat * math.Pi / 180
+}
+
+// BackfillKoordinat mengisi latitude/longitude listing yang belum punya koordinat
+// dari teks lokasi (gazetteer). Lokasi yang tidak dikenal dibiarkan NULL.
+func BackfillKoordinat(ctx context.Context, db *sql.DB) (int64, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT DISTINCT lokasi FROM mobils
+		WHERE latitude IS NULL AND lokasi IS NOT NULL AND lokasi <> ''
+	`)
+	if err != nil {
+		return 0, fmt.Errorf("gagal query lokasi tanpa koordinat: %w", err)
+	}
+	var daftar []string
+	for rows.Next() {
+		var lokasi string
+		if err := rows.Scan(&lokasi); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		daftar = append(daftar, lokasi)
+	}
+	rows.Close()
+
+	var total int64
+	for _, lokasi := range daftar {
+		k, _, ok := Geocode(lokasi)
+		if !ok {
+			continue
+		}
+		res, err := db.ExecContext(ctx, `
+			UPDATE mobils SET latitude = $1, longitude = $2
+			WHERE lokasi = $3 AND latitude IS NULL
+		`, k.Lat, k.Lng, lokasi)
+		if err != nil {
+			return total, fmt.Errorf("gagal menyimpan koordinat lokasi %q: %w", lokasi, err)
+		}
+		n, _ := res.RowsAffected()
+		total += n
+	}
+	return total, nil
+}
+
+// StartBackfill menjalankan BackfillKoordinat sekali saat server start (listing dari sebelum
+// kolom koordinat ada, atau yang di-insert langsung ke DB)
+func StartBackfill(db *sql.DB) {
+	n, err := BackfillKoordinat(context.Background(), db)
+	if err != nil {
+		log.Printf("Backfill koordinat gagal: %v", err)
+		return
+	}
+	if n > 0 {
+		log.Printf("Backfill koordinat: %d listing diberi koordinat dari gazetteer", n)
+	}
+}
+
+// PENJELASAN FILE geo.go:
+// File ini berisi perhitungan jarak untuk pencarian mobil berdasarkan lokasi (tanpa PostGIS)
+//
+// Fungsi Haversine / SQLJarak:
+// - Jarak lingkaran besar (radius bumi 6371 km); versi Go untuk jarak_km di response,
+//   versi SQL untuk filter radius dan urutan jarak di ListMobil
+// - LEAST(1, ...) / math.Min mencegah ASIN di luar domain karena pembulatan float
+//
+// Fungsi BoundingBox:
+// - Pra-filter lintang/bujur yang bisa memakai index, lalu disaring presisi dengan haversine
+// - Kotak yang memuat kutub atau melewati bujur 180 hanya membatasi lintang
+//
+// Fungsi BackfillKoordinat / StartBackfill:
+// - Mengisi koordinat listing lama dari teks lokasi lewat gazetteer (gazetteer.go)
+// - Dijalankan sekali saat server start
@@ -0,0 +1,202 @@
+package hargapasar
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"carapp.com/m/internal/money"
+)
+
+// Jenis baris riwayat_harga
+const (
+	JenisListing   = "listing"   // Harga awal saat listing dibuat
+	JenisPerubahan = "perubahan" // Owner mengubah harga
+	JenisTerjual   = "terjual"   // Harga mobil pada transaksi selesai
+)
+
+// BatasDiAtasPasarPersen: listing dengan harga >= median acuan + 20% ditandai di atas pasar
+const BatasDiAtasPasarPersen = 20
+
+// MinSampel adalah jumlah harga minimal agar median dipakai sebagai acuan penilaian
+const MinSampel = 3
+
+// execer bisa *sql.DB atau *sql.Tx
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// queryer bisa *sql.DB atau *sql.Tx
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// Statistik adalah ringkasan sekelompok harga (semua dalam mata uang yang sama)
+type Statistik struct {
+	Jumlah   int
+	Minimum  money.Money
+	P25      money.Money
+	Median   money.Money
+	P75      money.Money
+	Maksimum money.Money
+}
+
+// Catat menyimpan satu baris riwayat harga. hargaAsli boleh nil jika sama dengan harga (IDR).
+// transaksiID hanya diisi untuk jenis 'terjual'.
+func Catat(ctx context.Context, e execer, mobilID, jenis string, harga money.Money, hargaAsli *money.Money, transaksiID string) error {
+	var asli interface{}
+	var mataUangAsli sql.NullString
+	if hargaAsli != nil {
+		asli = *hargaAsli
+		mataUangAsli = sql.NullString{String: hargaAsli.Currency, Valid: true}
+	}
+	_, err := e.ExecContext(ctx, `
+		INSERT INTO riwayat_harga (mobil_id, jenis, harga, harga_asli, mata_uang_asli, transaksi_id)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, '')::uuid)
+	`, mobilID, jenis, harga, asli, mataUangAsli, transaksiID)
+	if err != nil {
+		return fmt.Errorf("gagal mencatat riwayat harga mobil %s: %w", mobilID, err)
+	}
+	return nil
+}
+
+// BackfillListing mencatat harga awal untuk listing yang belum punya riwayat
+// (dipakai seeder yang insert langsung ke tabel mobils)
+func BackfillListing(ctx context.Context, e execer) (int64, error) {
+	res, err := e.ExecContext(ctx, `
+		INSERT INTO riwayat_harga (mobil_id, jenis, harga, harga_asli, mata_uang_asli, created_at)
+		SELECT m.id, $1, m.harga_jual, m.harga_asli, m.mata_uang_asli, COALESCE(m.created_at, NOW())
+		FROM mobils m
+		WHERE m.harga_jual IS NOT NULL
+		  AND NOT EXISTS (SELECT 1 FROM riwayat_harga r WHERE r.mobil_id = m.id)
+	`, JenisListing)
+	if err != nil {
+		return 0, fmt.Errorf("gagal backfill riwayat harga: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+// HitungStatistik menghitung min, persentil 25/50/75, dan max. Semua harga harus
+// dalam mata uang yang sama; daftar kosong menghasilkan Statistik{Jumlah: 0}.
+func HitungStatistik(harga []money.Money) Statistik {
+	if len(harga) == 0 {
+		return Statistik{}
+	}
+	currency := harga[0].Currency
+	minor := make([]int64, len(harga))
+	for i, h := range harga {
+		minor[i] = h.Minor
+	}
+	sort.Slice(minor, func(i, j int) bool { return minor[i] < minor[j] })
+
+	return Statistik{
+		Jumlah:   len(minor),
+		Minimum:  money.New(minor[0], currency),
+		P25:      money.New(Persentil(minor, 25), currency),
+		Median:   money.New(Persentil(minor, 50), currency),
+		P75:      money.New(Persentil(minor, 75), currency),
+		Maksimum: money.New(minor[len(minor)-1], currency),
+	}
+}
+
+// Persentil menghitung persentil p (0-100) dari data terurut dengan interpolasi linear
+// (metode yang sama dengan percentile_cont PostgreSQL), dibulatkan ke minor unit terdekat
+func Persentil(terurut []int64, p int) int64 {
+	if len(terurut) == 0 {
+		return 0
+	}
+	posisi := p * (len(terurut) - 1) // Posisi x100 agar tetap integer
+	i, sisa := posisi/100, int64(posisi%100)
+	if sisa == 0 {
+		return terurut[i]
+	}
+	selisih := terurut[i+1] - terurut[i]
+	return terurut[i] + (selisih*sisa+50)/100
+}
+
+// SelisihPersen menghitung (harga - acuan) / acuan x 100, dibulatkan 1 desimal
+func SelisihPersen(harga, acuan money.Money) float64 {
+	if acuan.Minor == 0 {
+		return 0
+	}
+	selisih := (harga.Minor - acuan.Minor) * 1000
+	// Pembulatan setengah menjauhi nol di integer
+	if selisih >= 0 {
+		return float64((selisih+acuan.Minor/2)/acuan.Minor) / 10
+	}
+	return -float64((-selisih+acuan.Minor/2)/acuan.Minor) / 10
+}
+
+// DiAtasPasar mengecek apakah harga jauh di atas acuan (>= BatasDiAtasPasarPersen)
+func DiAtasPasar(harga, acuan money.Money) bool {
+	return acuan.Minor > 0 && (harga.Minor-acuan.Minor)*100 >= acuan.Minor*BatasDiAtasPasarPersen
+}
+
+// AcuanPasar menghitung median acuan bucket merk/model/tahun dengan aturan yang sama seperti
+// GetMarketPrice (tanpa toleransi tahun, periode default): median harga terjual jika sampel cukup,
+// jika tidak median harga minta listing tersedia. Listing kecualiID (boleh "") tidak ikut dihitung.
+// ok = false jika data belum cukup untuk menilai harga.
+func AcuanPasar(ctx context.Context, q queryer, merk, model string, tahun int32, kecualiID string) (money.Money, bool, error) {
+	terjual, err := daftarHarga(ctx, q, `
+		SELECT rh.harga FROM riwayat_harga rh
+		JOIN mobils m ON m.id = rh.mobil_id
+		WHERE rh.jenis = $1 AND rh.created_at >= NOW() - make_interval(months => $2)
+		  AND LOWER(m.merk) = LOWER($3) AND LOWER(m.model) = LOWER($4) AND m.tahun = $5
+	`, JenisTerjual, defaultPeriodeBulan, merk, model, tahun)
+	if err != nil {
+		return money.Money{}, false, fmt.Errorf("gagal query harga terjual: %w", err)
+	}
+	if stat := HitungStatistik(terjual); stat.Jumlah >= MinSampel {
+		return stat.Median, true, nil
+	}
+
+	minta, err := daftarHarga(ctx, q, `
+		SELECT harga_jual FROM mobils
+		WHERE LOWER(merk) = LOWER($1) AND LOWER(model) = LOWER($2) AND tahun = $3
+		  AND status = 'tersedia' AND harga_jual IS NOT NULL AND id::text <> $4
+	`, merk, model, tahun, kecualiID)
+	if err != nil {
+		return money.Money{}, false, fmt.Errorf("gagal query harga minta: %w", err)
+	}
+	if stat := HitungStatistik(minta); stat.Jumlah >= MinSampel {
+		return stat.Median, true, nil
+	}
+	return money.Money{}, false, nil
+}
+
+// daftarHarga menjalankan query satu kolom harga
+func daftarHarga(ctx context.Context, q queryer, query string, args ...interface{}) ([]money.Money, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var daftar []money.Money
+	for rows.Next() {
+		var h money.Money
+		if err := rows.Scan(&h); err != nil {
+			return nil, err
+		}
+		daftar = append(daftar, h)
+	}
+	return daftar, rows.Err()
+}
+
+// PENJELASAN FILE hargapasar.go:
+// File ini berisi pencatatan riwayat harga dan perhitungan statistik harga pasar
+//
+// Fungsi Catat:
+// - Dipanggil di setiap perubahan harga: CreateMobil (listing), UpdateHargaMobil (perubahan),
+//   transaksi selesai (terjual, di dalam tx applyTransition), listing draft trade-in (listing)
+// - Harga selalu IDR (sama dengan mobils.harga_jual), harga asli disimpan jika ada
+//
+// Fungsi HitungStatistik / Persentil:
+// - Persentil dengan interpolasi linear seperti percentile_cont, dihitung di integer minor unit
+//
+// Fungsi SelisihPersen / DiAtasPasar:
+// - Posisi harga terhadap median acuan; di atas pasar jika >= 20% lebih mahal
+//
+// Fungsi AcuanPasar:
+// - Median acuan satu bucket untuk dipakai package lain (deteksi harga tidak wajar di moderasi)
@@ -0,0 +1,262 @@
+package hargapasar
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"carapp.com/m/internal/money"
+	"carapp.com/m/internal/pencarian"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Acuan median untuk penilaian harga
+const (
+	AcuanTerjual = "terjual" // Median harga terjual (diutamakan)
+	AcuanMinta   = "minta"   // Median harga minta listing tersedia (jika data terjual kurang)
+)
+
+const (
+	maksToleransiTahun  = 3
+	defaultPeriodeBulan = 12
+	maksPeriodeBulan    = 60
+	maksDiAtasPasar     = 20
+)
+
+// HargaPasarServiceServer adalah implementasi dari pb.HargaPasarServiceServer
+type HargaPasarServiceServer struct {
+	pb.UnimplementedHargaPasarServiceServer
+	DB *sql.DB
+}
+
+// NewHargaPasarService membuat instance baru
+func NewHargaPasarService(db *sql.DB) *HargaPasarServiceServer {
+	return &HargaPasarServiceServer{DB: db}
+}
+
+// listingHarga adalah satu listing tersedia di bucket
+type listingHarga struct {
+	MobilID string
+	Harga   money.Money
+}
+
+// GetPriceHistory menampilkan riwayat harga satu mobil, urut dari yang paling lama
+func (s *HargaPasarServiceServer) GetPriceHistory(ctx context.Context, req *pb.GetPriceHistoryRequest) (*pb.GetPriceHistoryResponse, error) {
+	if req.MobilId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "MobilID tidak boleh kosong")
+	}
+	// Listing draft / menunggu moderasi belum publik
+	var statusMobil string
+	err := s.DB.QueryRowContext(ctx, `SELECT status FROM mobils WHERE id = $1`, req.MobilId).Scan(&statusMobil)
+	if err == sql.ErrNoRows || (err == nil && pencarian.StatusPrivat(statusMobil)) {
+		return nil, status.Errorf(codes.NotFound, "Mobil tidak ditemukan")
+	}
+	if err != nil {
+		log.Printf("Gagal query mobil untuk riwayat harga: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil data mobil")
+	}
+
+	rows, err := s.DB.QueryContext(ctx, `
+		SELECT jenis, harga, harga_asli::text, mata_uang_asli, created_at
+		FROM riwayat_harga
+		WHERE mobil_id = $1
+		ORDER BY created_at, id
+	`, req.MobilId)
+	if err != nil {
+		log.Printf("Gagal query riwayat harga: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil riwayat harga")
+	}
+	defer rows.Close()
+
+	var riwayat []*pb.RiwayatHarga
+	for rows.Next() {
+		var r pb.RiwayatHarga
+		var harga money.Money
+		var hargaAsli, mataUangAsli sql.NullString
+		var createdAt time.Time
+		if err := rows.Scan(&r.Jenis, &harga, &hargaAsli, &mataUangAsli, &createdAt); err != nil {
+			log.Printf("Gagal scan riwayat harga: %v", err)
+			continue
+		}
+		r.Harga = harga.ToProto()
+		if hargaAsli.Valid && mataUangAsli.String != "" && mataUangAsli.String != harga.Currency {
+			if asli, err := money.Parse(hargaAsli.String, mataUangAsli.String); err == nil {
+				r.HargaAsli = asli.ToProto()
+			}
+		}
+		r.CreatedAt = timestamppb.New(createdAt)
+		riwayat = append(riwayat, &r)
+	}
+
+	return &pb.GetPriceHistoryResponse{MobilId: req.MobilId, Riwayat: riwayat}, nil
+}
+
+// GetMarketPrice menghitung statistik harga minta & terjual untuk satu bucket merk/model/tahun
+// dan menandai listing yang harganya jauh di atas pasar
+func (s *HargaPasarServiceServer) GetMarketPrice(ctx context.Context, req *pb.GetMarketPriceRequest) (*pb.MarketPrice, error) {
+	// 1. Tentukan bucket: dari mobil_id atau dari merk/model/tahun
+	merk, model, tahun := strings.TrimSpace(req.Merk), strings.TrimSpace(req.Model), req.Tahun
+	var listing *listingHarga
+	if req.MobilId != "" {
+		var l listingHarga
+		var statusMobil string
+		err := s.DB.QueryRowContext(ctx, `SELECT id, merk, model, tahun, harga_jual, status FROM mobils WHERE id = $1`, req.MobilId).
+			Scan(&l.MobilID, &merk, &model, &tahun, &l.Harga, &statusMobil)
+		if err == sql.ErrNoRows || (err == nil && pencarian.StatusPrivat(statusMobil)) {
+			return nil, status.Errorf(codes.NotFound, "Mobil tidak ditemukan")
+		}
+		if err != nil {
+			log.Printf("Gagal query mobil untuk harga pasar: %v", err)
+			return nil, status.Errorf(codes.Internal, "Gagal mengambil data mobil")
+		}
+		listing = &l
+	}
+	if merk == "" || model == "" || tahun <= 1900 {
+		return nil, status.Errorf(codes.InvalidArgument, "Isi mobil_id atau merk, model, dan tahun")
+	}
+	if req.ToleransiTahun < 0 || req.ToleransiTahun > maksToleransiTahun {
+		return nil, status.Errorf(codes.InvalidArgument, "Toleransi tahun harus 0-%d", maksToleransiTahun)
+	}
+	periode := int(req.PeriodeBulan)
+	if periode <= 0 {
+		periode = defaultPeriodeBulan
+	}
+	if periode > maksPeriodeBulan {
+		return nil, status.Errorf(codes.InvalidArgument, "Periode maksimal %d bulan", maksPeriodeBulan)
+	}
+	tahunMin, tahunMax := tahun-req.ToleransiTahun, tahun+req.ToleransiTahun
+
+	// 2. Harga minta: listing tersedia di bucket
+	rows, err := s.DB.QueryContext(ctx, `
+		SELECT id, harga_jual FROM mobils
+		WHERE LOWER(merk) = LOWER($1) AND LOWER(model) = LOWER($2) AND tahun BETWEEN $3 AND $4
+		  AND status = 'tersedia' AND harga_jual IS NOT NULL
+	`, merk, model, tahunMin, tahunMax)
+	if err != nil {
+		log.Printf("Gagal query harga minta: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal menghitung harga pasar")
+	}
+	var tersedia []listingHarga
+	var hargaMinta []money.Money
+	for rows.Next() {
+		var l listingHarga
+		if err := rows.Scan(&l.MobilID, &l.Harga); err != nil {
+			rows.Close()
+			log.Printf("Gagal scan harga minta: %v", err)
+			return nil, status.Errorf(codes.Internal, "Gagal menghitung harga pasar")
+		}
+		tersedia = append(tersedia, l)
+		hargaMinta = append(hargaMinta, l.Harga)
+	}
+	rows.Close()
+
+	// 3. Harga terjual dalam periode (riwayat_harga jenis 'terjual')
+	rows, err = s.DB.QueryContext(ctx, `
+		SELECT rh.harga FROM riwayat_harga rh
+		JOIN mobils m ON m.id = rh.mobil_id
+		WHERE rh.jenis = $1 AND rh.created_at >= NOW() - make_interval(months => $2)
+		  AND LOWER(m.merk) = LOWER($3) AND LOWER(m.model) = LOWER($4) AND m.tahun BETWEEN $5 AND $6
+	`, JenisTerjual, periode, merk, model, tahunMin, tahunMax)
+	if err != nil {
+		log.Printf("Gagal query harga terjual: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal menghitung harga pasar")
+	}
+	var hargaTerjual []money.Money
+	for rows.Next() {
+		var h money.Money
+		if err := rows.Scan(&h); err != nil {
+			rows.Close()
+			log.Printf("Gagal scan harga terjual: %v", err)
+			return nil, status.Errorf(codes.Internal, "Gagal menghitung harga pasar")
+		}
+		hargaTerjual = append(hargaTerjual, h)
+	}
+	rows.Close()
+
+	statMinta, statTerjual := HitungStatistik(hargaMinta), HitungStatistik(hargaTerjual)
+	resp := &pb.MarketPrice{
+		Merk:         merk,
+		Model:        model,
+		TahunMin:     tahunMin,
+		TahunMax:     tahunMax,
+		HargaMinta:   statistikToProto(statMinta),
+		HargaTerjual: statistikToProto(statTerjual),
+	}
+
+	// 4. Median acuan: harga terjual jika cukup data, jika tidak harga minta
+	var acuan money.Money
+	switch {
+	case statTerjual.Jumlah >= MinSampel:
+		resp.Acuan, acuan = AcuanTerjual, statTerjual.Median
+	case statMinta.Jumlah >= MinSampel:
+		resp.Acuan, acuan = AcuanMinta, statMinta.Median
+	default:
+		return resp, nil // Data belum cukup untuk menilai harga
+	}
+
+	if listing != nil {
+		resp.Listing = listingToProto(*listing, acuan)
+		resp.ListingDiAtasPasar = DiAtasPasar(listing.Harga, acuan)
+	}
+
+	var diAtas []listingHarga
+	for _, l := range tersedia {
+		if DiAtasPasar(l.Harga, acuan) {
+			diAtas = append(diAtas, l)
+		}
+	}
+	sort.Slice(diAtas, func(i, j int) bool { return diAtas[i].Harga.Minor > diAtas[j].Harga.Minor })
+	if len(diAtas) > maksDiAtasPasar {
+		diAtas = diAtas[:maksDiAtasPasar]
+	}
+	for _, l := range diAtas {
+		resp.DiAtasPasar = append(resp.DiAtasPasar, listingToProto(l, acuan))
+	}
+
+	return resp, nil
+}
+
+func statistikToProto(s Statistik) *pb.StatistikHarga {
+	if s.Jumlah == 0 {
+		return &pb.StatistikHarga{}
+	}
+	return &pb.StatistikHarga{
+		Jumlah:   int32(s.Jumlah),
+		Minimum:  s.Minimum.ToProto(),
+		P25:      s.P25.ToProto(),
+		Median:   s.Median.ToProto(),
+		P75:      s.P75.ToProto(),
+		Maksimum: s.Maksimum.ToProto(),
+	}
+}
+
+func listingToProto(l listingHarga, acuan money.Money) *pb.ListingPasar {
+	return &pb.ListingPasar{
+		MobilId:       l.MobilID,
+		Harga:         l.Harga.ToProto(),
+		SelisihPersen: SelisihPersen(l.Harga, acuan),
+	}
+}
+
+// PENJELASAN FILE hargapasar_service.go:
+// File ini berisi implementasi HargaPasarService (Service 15), keduanya RPC publik
+//
+// Fungsi GetPriceHistory:
+// - Riwayat harga satu mobil dari tabel riwayat_harga (listing, perubahan, terjual)
+// - Listing yang belum publik (draft, menunggu moderasi, ditolak) dianggap tidak ada
+//
+// Fungsi GetMarketPrice:
+// - Bucket: merk + model (tidak case-sensitive) + tahun +- toleransi_tahun (maks 3),
+//   diambil dari mobil_id jika diisi
+// - Harga minta: harga_jual listing 'tersedia' di bucket
+// - Harga terjual: riwayat_harga 'terjual' dalam periode_bulan terakhir (default 12)
+// - Statistik: jumlah, min, p25, median, p75, max (hargapasar.go)
+// - Acuan penilaian: median terjual jika >= 3 data, jika tidak median minta (>= 3 data)
+// - Listing di atas pasar: harga >= median acuan + 20%, maksimal 20 listing termahal
+// - Jika mobil_id diisi: posisi harga mobil tsb (selisih_persen) dan flag listing_di_atas_pasar
@@ -0,0 +1,331 @@
+package idempotensi
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"log"
+	"strings"
+	"time"
+
+	"carapp.com/m/internal/auth"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	// HeaderKey adalah nama metadata yang dikirim client
+	HeaderKey = "idempotency-key"
+	// keyTTL adalah masa berlaku idempotency key
+	keyTTL = 24 * time.Hour
+	// staleProses adalah batas waktu request 'proses' dianggap macet (server crash, dll)
+	staleProses  = 2 * time.Minute
+	maxKeyLength = 128
+)
+
+// prefixBaca adalah awalan nama RPC yang hanya membaca data. RPC unary lain dianggap
+// mengubah data, sehingga RPC baru otomatis mendukung idempotency key tanpa daftar manual.
+var prefixBaca = []string{"Get", "List", "Search", "Compare", "Simulate", "Decode", "Export"}
+
+// layananPublik dipanggil tanpa login (tidak ada UserID untuk menyimpan key)
+var layananPublik = map[protoreflect.FullName]bool{
+	"carapp.AuthService": true,
+}
+
+// mutatingMethods adalah RPC yang mendukung idempotency key, diturunkan dari descriptor proto
+var mutatingMethods = daftarMutating(pb.File_proto_carapp_proto)
+
+// daftarMutating mengumpulkan RPC unary yang namanya tidak diawali prefixBaca
+// (contoh "/carapp.WatchlistService/RemoveFromWatchlist")
+func daftarMutating(fd protoreflect.FileDescriptor) map[string]bool {
+	hasil := make(map[string]bool)
+	services := fd.Services()
+	for i := 0; i < services.Len(); i++ {
+		svc := services.Get(i)
+		if layananPublik[svc.FullName()] {
+			continue
+		}
+		methods := svc.Methods()
+		for j := 0; j < methods.Len(); j++ {
+			m := methods.Get(j)
+			if m.IsStreamingClient() || m.IsStreamingServer() || rpcBaca(string(m.Name())) {
+				continue
+			}
+			hasil["/"+string(svc.FullName())+"/"+string(m.Name())] = true
+		}
+	}
+	return hasil
+}
+
+// rpcBaca mengecek apakah nama RPC diawali salah satu prefixBaca
+func rpcBaca(nama string) bool {
+	for _, prefix := range prefixBaca {
+		if strings.HasPrefix(nama, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// replayableCodes adalah error yang hasilnya pasti sama jika diulang, jadi ikut disimpan.
+// Error lain (Internal, Unavailable, dll) tidak disimpan agar client bisa retry.
+var replayableCodes = map[codes.Code]bool{
+	codes.InvalidArgument:    true,
+	codes.NotFound:           true,
+	codes.AlreadyExists:      true,
+	codes.PermissionDenied:   true,
+	codes.FailedPrecondition: true,
+}
+
+// Interceptor menyimpan response RPC berdasarkan idempotency key per user
+type Interceptor struct {
+	DB *sql.DB
+}
+
+// NewInterceptor membuat interceptor idempotensi
+func NewInterceptor(db *sql.DB) *Interceptor {
+	return &Interceptor{DB: db}
+}
+
+// storedKey adalah baris idempotency_keys yang sudah ada
+type storedKey struct {
+	Method      string
+	RequestHash string
+	Status      string
+	Response    []byte
+	GrpcCode    sql.NullInt64
+	GrpcMessage sql.NullString
+}
+
+// Unary adalah gRPC UnaryServerInterceptor. Harus dipasang SETELAH auth.AuthInterceptor
+// karena membutuhkan UserID dari context.
+func (i *Interceptor) Unary(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if !mutatingMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	// 1. Ambil idempotency key dari metadata (opsional)
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get(HeaderKey)
+	if len(keys) == 0 || strings.TrimSpace(keys[0]) == "" {
+		return handler(ctx, req)
+	}
+	key := strings.TrimSpace(keys[0])
+	if len(key) > maxKeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Idempotency-key maksimal %d karakter", maxKeyLength)
+	}
+
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+
+	// 2. Hash request (marshal deterministik)
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return handler(ctx, req)
+	}
+	hash, err := hashRequest(msg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal memproses idempotency-key")
+	}
+
+	// 3. Klaim key (baru, kedaluwarsa, atau macet di 'proses')
+	claimed, err := i.claim(ctx, userID, key, info.FullMethod, hash)
+	if err != nil {
+		log.Printf("Idempotensi: gagal klaim key %s: %v", key, err)
+		return nil, status.Errorf(codes.Internal, "Gagal memproses idempotency-key")
+	}
+
+	if !claimed {
+		return i.replay(ctx, userID, key, info.FullMethod, hash)
+	}
+
+	// 4. Jalankan handler asli lalu simpan hasilnya
+	resp, handlerErr := handler(ctx, req)
+	i.simpanHasil(userID, key, resp, handlerErr)
+	return resp, handlerErr
+}
+
+// claim mencoba menjadi pemilik key. Return true jika request ini yang harus diproses.
+// expires_at dan batas 'proses' macet dihitung dari NOW() DB (sama dengan created_at),
+// bukan jam app, agar tidak bergeser jika timezone app dan sesi DB berbeda.
+func (i *Interceptor) claim(ctx context.Context, userID, key, method, hash string) (bool, error) {
+	query := `
+		INSERT INTO idempotency_keys (user_id, idem_key, method, request_hash, status, expires_at)
+		VALUES ($1, $2, $3, $4, 'proses', NOW() + make_interval(secs => $5))
+		ON CONFLICT (user_id, idem_key) DO UPDATE
+		SET method = EXCLUDED.method, request_hash = EXCLUDED.request_hash, status = 'proses',
+		    response = NULL, grpc_code = NULL, grpc_message = NULL,
+		    created_at = NOW(), expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at < NOW()
+		   OR (idempotency_keys.status = 'proses' AND idempotency_keys.created_at < NOW() - make_interval(secs => $6))
+		RETURNING idem_key
+	`
+	var returned string
+	err := i.DB.QueryRowContext(ctx, query, userID, key, method, hash,
+		keyTTL.Seconds(), staleProses.Seconds()).Scan(&returned)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// replay mengembalikan hasil tersimpan untuk key yang sudah pernah dipakai
+func (i *Interceptor) replay(ctx context.Context, userID, key, method, hash string) (interface{}, error) {
+	var k storedKey
+	err := i.DB.QueryRowContext(ctx, `
+		SELECT method, request_hash, status, response, grpc_code, grpc_message
+		FROM idempotency_keys WHERE user_id = $1 AND idem_key = $2
+	`, userID, key).Scan(&k.Method, &k.RequestHash, &k.Status, &k.Response, &k.GrpcCode, &k.GrpcMessage)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal membaca idempotency-key")
+	}
+
+	if k.Method != method || k.RequestHash != hash {
+		return nil, status.Errorf(codes.InvalidArgument, "Idempotency-key sudah dipakai untuk request yang berbeda")
+	}
+	if k.Status != "selesai" {
+		return nil, status.Errorf(codes.Aborted, "Request dengan idempotency-key ini masih diproses")
+	}
+
+	log.Printf("Idempotensi: replay response %s untuk UserID %s (key %s)", method, userID, key)
+
+	if k.GrpcCode.Valid {
+		return nil, status.Error(codes.Code(k.GrpcCode.Int64), k.GrpcMessage.String)
+	}
+
+	resp, err := newResponse(method)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal membaca response tersimpan")
+	}
+	if err := proto.Unmarshal(k.Response, resp); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal membaca response tersimpan")
+	}
+	return resp, nil
+}
+
+// simpanHasil menyimpan response/error. Error yang tidak deterministik menghapus key
+// supaya client bisa mencoba lagi dengan key yang sama.
+func (i *Interceptor) simpanHasil(userID, key string, resp interface{}, handlerErr error) {
+	ctx := context.Background()
+
+	if handlerErr != nil {
+		st, _ := status.FromError(handlerErr)
+		if !replayableCodes[st.Code()] {
+			if _, err := i.DB.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE user_id = $1 AND idem_key = $2`, userID, key); err != nil {
+				log.Printf("Idempotensi: gagal menghapus key %s: %v", key, err)
+			}
+			return
+		}
+		_, err := i.DB.ExecContext(ctx, `
+			UPDATE idempotency_keys SET status = 'selesai', grpc_code = $1, grpc_message = $2
+			WHERE user_id = $3 AND idem_key = $4
+		`, int64(st.Code()), st.Message(), userID, key)
+		if err != nil {
+			log.Printf("Idempotensi: gagal menyimpan error untuk key %s: %v", key, err)
+		}
+		return
+	}
+
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		log.Printf("Idempotensi: gagal marshal response untuk key %s: %v", key, err)
+		return
+	}
+	_, err = i.DB.ExecContext(ctx, `
+		UPDATE idempotency_keys SET status = 'selesai', response = $1
+		WHERE user_id = $2 AND idem_key = $3
+	`, data, userID, key)
+	if err != nil {
+		log.Printf("Idempotensi: gagal menyimpan response untuk key %s: %v", key, err)
+	}
+}
+
+// hashRequest menghitung SHA-256 dari request proto (deterministik)
+func hashRequest(msg proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// newResponse membuat instance kosong dari tipe output RPC berdasarkan nama method
+// (contoh "/carapp.TransaksiService/BuyMobil" -> *pb.TransaksiJualResponse)
+func newResponse(fullMethod string) (proto.Message, error) {
+	name := strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", ".")
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, err
+	}
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, protoregistry.NotFound
+	}
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+	if err != nil {
+		return nil, err
+	}
+	return mt.New().Interface(), nil
+}
+
+// StartCleanupJob menghapus idempotency key yang sudah kedaluwarsa secara berkala
+func StartCleanupJob(db *sql.DB, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		res, err := db.Exec(`DELETE FROM idempotency_keys WHERE expires_at < NOW()`)
+		if err != nil {
+			log.Printf("Idempotensi: gagal membersihkan key kedaluwarsa: %v", err)
+			continue
+		}
+		if n, _ := res.RowsAffected(); n > 0 {
+			log.Printf("Idempotensi: %d key kedaluwarsa dihapus", n)
+		}
+	}
+}
+
+// PENJELASAN FILE idempotensi_interceptor.go:
+// File ini berisi unary interceptor untuk header metadata 'idempotency-key'
+//
+// Tujuan:
+// - Client mobile yang retry (misal timeout) tidak membuat listing/transaksi ganda
+//
+// Alur Unary:
+// 1. Hanya untuk method di mutatingMethods, dan hanya jika header dikirim
+//    mutatingMethods diturunkan dari descriptor proto: semua RPC unary yang login-only dan namanya
+//    tidak diawali Get/List/Search/Compare/Simulate/Decode/Export. RPC baca dengan awalan lain
+//    perlu ditambahkan ke prefixBaca
+// 2. Hash request proto (SHA-256, marshal deterministik)
+// 3. claim: INSERT key dengan status 'proses' (atau ambil alih jika kedaluwarsa / macet > 2 menit)
+// 4. Jika key milik request lain:
+//    - method/hash berbeda -> InvalidArgument (key dipakai ulang dengan payload beda)
+//    - masih 'proses' -> Aborted (client sebaiknya retry sebentar lagi)
+//    - 'selesai' -> replay response/error tersimpan
+// 5. Jika key baru: jalankan handler, simpan response (proto bytes) atau error deterministik
+//    Error sementara (Internal/Unavailable) menghapus key agar bisa di-retry
+//
+// Key disimpan per user (PRIMARY KEY user_id + idem_key) dan kedaluwarsa setelah 24 jam.
+// StartCleanupJob dijalankan dari main.go untuk menghapus key kedaluwarsa.
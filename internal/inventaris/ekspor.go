@@ -0,0 +1,309 @@
+package inventaris
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"carapp.com/m/internal/money"
+	"carapp.com/m/internal/pencarian"
+)
+
+// Format file ekspor
+const (
+	FormatEksporCSV   = "csv"
+	FormatEksporJSONL = "jsonl" // JSON Lines: satu objek per baris
+)
+
+// statusMobilValid adalah status listing yang boleh dipakai sebagai filter ekspor
+var statusMobilValid = map[string]bool{
+	"tersedia": true, "dipesan": true, "terjual": true, "ditarik": true, "kedaluwarsa": true,
+	pencarian.StatusDraft: true, pencarian.StatusMenungguModerasi: true, pencarian.StatusDitolak: true,
+}
+
+// FilterEkspor memilih mobil yang diekspor
+type FilterEkspor struct {
+	OwnerID string   // Kosong = semua owner (hanya untuk admin)
+	Status  []string // Kosong = semua status
+	Filter  pencarian.Filter
+}
+
+// Validasi mengecek status filter
+func (f FilterEkspor) Validasi() error {
+	for _, s := range f.Status {
+		if !statusMobilValid[s] {
+			return fmt.Errorf("status %q tidak dikenal", s)
+		}
+	}
+	return nil
+}
+
+// BarisEkspor adalah satu mobil di file ekspor. Kolom yang sama dengan file impor
+// (nomor_stok, vin, merk, harga, mata_uang, ...) memakai nama yang sama.
+type BarisEkspor struct {
+	MobilID            string     `json:"mobil_id"`
+	OwnerID            string     `json:"owner_id"`
+	NomorStok          string     `json:"nomor_stok"`
+	VIN                string     `json:"vin"`
+	Merk               string     `json:"merk"`
+	Model              string     `json:"model"`
+	Tahun              int32      `json:"tahun"`
+	Kondisi            string     `json:"kondisi"`
+	Status             string     `json:"status"`
+	Harga              string     `json:"harga"`          // Harga asli (desimal), kosong untuk draft tanpa harga
+	MataUang           string     `json:"mata_uang"`      // Mata uang harga asli
+	HargaJualIDR       string     `json:"harga_jual_idr"` // harga_jual setelah konversi
+	Kilometer          *int32     `json:"kilometer"`
+	Transmisi          string     `json:"transmisi"`
+	BahanBakar         string     `json:"bahan_bakar"`
+	Warna              string     `json:"warna"`
+	TipeBodi           string     `json:"tipe_bodi"`
+	JumlahKursi        int32      `json:"jumlah_kursi"`
+	PlatRegion         string     `json:"plat_region"`
+	Lokasi             string     `json:"lokasi"`
+	FotoURL            string     `json:"foto_url"`
+	Deskripsi          string     `json:"deskripsi"`
+	DibuatPada         time.Time  `json:"dibuat_pada"`
+	DipublikasikanPada *time.Time `json:"dipublikasikan_pada"`
+	BerlakuSampai      *time.Time `json:"berlaku_sampai"`
+	StatusTransaksi    string     `json:"status_transaksi"` // Transaksi jual terakhir yang tidak batal
+	HargaTerjual       string     `json:"harga_terjual"`    // Harga jual sebelum potongan trade-in (IDR), hanya jika selesai
+	TerjualPada        *time.Time `json:"terjual_pada"`
+}
+
+// kolomEkspor adalah header CSV, urutannya sama dengan BarisEkspor.nilaiCSV
+var kolomEkspor = []string{
+	"mobil_id", "owner_id", "nomor_stok", "vin", "merk", "model", "tahun", "kondisi", "status",
+	"harga", "mata_uang", "harga_jual_idr", "kilometer", "transmisi", "bahan_bakar", "warna", "tipe_bodi",
+	"jumlah_kursi", "plat_region", "lokasi", "foto_url", "deskripsi", "dibuat_pada", "dipublikasikan_pada",
+	"berlaku_sampai", "status_transaksi", "harga_terjual", "terjual_pada",
+}
+
+func (b BarisEkspor) nilaiCSV() []string {
+	waktu := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	}
+	kilometer, jumlahKursi := "", ""
+	if b.Kilometer != nil {
+		kilometer = strconv.Itoa(int(*b.Kilometer))
+	}
+	if b.JumlahKursi > 0 {
+		jumlahKursi = strconv.Itoa(int(b.JumlahKursi))
+	}
+	return []string{
+		b.MobilID, b.OwnerID, b.NomorStok, b.VIN, b.Merk, b.Model, strconv.Itoa(int(b.Tahun)), b.Kondisi, b.Status,
+		b.Harga, b.MataUang, b.HargaJualIDR, kilometer, b.Transmisi, b.BahanBakar, b.Warna, b.TipeBodi,
+		jumlahKursi, b.PlatRegion, b.Lokasi, b.FotoURL, b.Deskripsi, waktu(&b.DibuatPada), waktu(b.DipublikasikanPada),
+		waktu(b.BerlakuSampai), b.StatusTransaksi, b.HargaTerjual, waktu(b.TerjualPada),
+	}
+}
+
+// amankanSel mencegah formula injection saat CSV dibuka di spreadsheet: sel yang diawali
+// =, +, - atau @ (misal deskripsi "=HYPERLINK(...)" dari user) diberi awalan ' agar dibaca sebagai teks
+func amankanSel(v string) string {
+	if v != "" && strings.ContainsRune("=+-@", rune(v[0])) {
+		return "'" + v
+	}
+	return v
+}
+
+// Penulis menulis baris ekspor satu per satu ke io.Writer (tidak menampung seluruh file)
+type Penulis interface {
+	Tulis(b BarisEkspor) error
+	Selesai() error // Flush sisa buffer
+}
+
+// NewPenulis membuat penulis untuk format csv atau jsonl
+func NewPenulis(w io.Writer, format string) (Penulis, error) {
+	switch format {
+	case FormatEksporCSV:
+		return &penulisCSV{out: w, w: csv.NewWriter(w)}, nil
+	case FormatEksporJSONL:
+		buf := bufio.NewWriter(w)
+		return &penulisJSONL{buf: buf, enc: json.NewEncoder(buf)}, nil
+	}
+	return nil, fmt.Errorf("format %q tidak didukung (csv atau jsonl)", format)
+}
+
+type penulisCSV struct {
+	out         io.Writer // Tujuan asli, untuk BOM sebelum isi buffer csv.Writer
+	w           *csv.Writer
+	sudahHeader bool
+}
+
+// tulisHeader menulis BOM UTF-8 (agar Excel membaca huruf non-ASCII dengan benar) lalu header
+func (p *penulisCSV) tulisHeader() error {
+	if p.sudahHeader {
+		return nil
+	}
+	p.sudahHeader = true
+	if _, err := io.WriteString(p.out, "\ufeff"); err != nil {
+		return err
+	}
+	return p.w.Write(kolomEkspor)
+}
+
+func (p *penulisCSV) Tulis(b BarisEkspor) error {
+	if err := p.tulisHeader(); err != nil {
+		return err
+	}
+	sel := b.nilaiCSV()
+	for i, v := range sel {
+		sel[i] = amankanSel(v)
+	}
+	return p.w.Write(sel)
+}
+
+func (p *penulisCSV) Selesai() error {
+	// File tanpa baris data tetap punya header
+	if err := p.tulisHeader(); err != nil {
+		return err
+	}
+	p.w.Flush()
+	return p.w.Error()
+}
+
+type penulisJSONL struct {
+	buf *bufio.Writer
+	enc *json.Encoder
+}
+
+func (p *penulisJSONL) Tulis(b BarisEkspor) error {
+	return p.enc.Encode(b) // Encode menambahkan newline
+}
+
+func (p *penulisJSONL) Selesai() error {
+	return p.buf.Flush()
+}
+
+// Ekspor membaca mobil sesuai filter dan menulisnya satu per satu (rows dibaca bertahap dari DB,
+// tidak dimuat sekaligus ke memori). Mengembalikan jumlah baris yang ditulis.
+func Ekspor(ctx context.Context, db *sql.DB, f FilterEkspor, p Penulis) (int, error) {
+	var kondisi []string
+	var args []interface{}
+	if f.OwnerID != "" {
+		args = append(args, f.OwnerID)
+		kondisi = append(kondisi, fmt.Sprintf("m.owner_id = $%d", len(args)))
+	}
+	if len(f.Status) > 0 {
+		placeholder := make([]string, len(f.Status))
+		for i, s := range f.Status {
+			args = append(args, s)
+			placeholder[i] = fmt.Sprintf("$%d", len(args))
+		}
+		kondisi = append(kondisi, "m.status IN ("+strings.Join(placeholder, ", ")+")")
+	}
+	where := "WHERE TRUE"
+	if len(kondisi) > 0 {
+		where = "WHERE " + strings.Join(kondisi, " AND ")
+	}
+	klausa, args := f.Filter.Klausa("m.", args)
+
+	// Transaksi jual terakhir yang tidak batal / kedaluwarsa (yang selesai diutamakan).
+	// total sudah dipotong nilai trade-in, harga terjual = total + potongan_trade_in.
+	rows, err := db.QueryContext(ctx, `
+		SELECT m.id, m.owner_id, COALESCE(m.nomor_stok, ''), COALESCE(m.vin, ''), COALESCE(m.merk, ''),
+		       COALESCE(m.model, ''), COALESCE(m.tahun, 0), COALESCE(m.kondisi, ''), m.status,
+		       m.harga_asli::text, m.mata_uang_asli, m.harga_jual::text, m.kilometer,
+		       COALESCE(m.transmisi, ''), COALESCE(m.bahan_bakar, ''), COALESCE(m.warna, ''),
+		       COALESCE(m.tipe_bodi, ''), COALESCE(m.jumlah_kursi, 0), COALESCE(m.plat_region, ''),
+		       COALESCE(m.lokasi, ''), COALESCE(m.foto_url, ''), COALESCE(m.deskripsi, ''),
+		       m.created_at, m.dipublikasikan_pada, m.berlaku_sampai,
+		       COALESCE(t.status, ''), t.harga_terjual::text, t.completed_at
+		FROM mobils m
+		LEFT JOIN LATERAL (
+			SELECT tj.status, tj.total + COALESCE(tj.potongan_trade_in, 0) AS harga_terjual, tj.completed_at
+			FROM transaksi_jual tj
+			WHERE tj.mobil_id = m.id AND tj.status NOT IN ('dibatalkan', 'kedaluwarsa')
+			ORDER BY (tj.status = 'selesai') DESC, tj.created_at DESC
+			LIMIT 1
+		) t ON TRUE
+		`+where+klausa+`
+		ORDER BY m.created_at, m.id
+	`, args...)
+	if err != nil {
+		return 0, fmt.Errorf("gagal query ekspor inventaris: %w", err)
+	}
+	defer rows.Close()
+
+	jumlah := 0
+	for rows.Next() {
+		var b BarisEkspor
+		var hargaAsli, hargaJual, hargaTerjual sql.NullString
+		var kilometer sql.NullInt32
+		var dipublikasikan, berlaku, terjual sql.NullTime
+		err := rows.Scan(&b.MobilID, &b.OwnerID, &b.NomorStok, &b.VIN, &b.Merk, &b.Model, &b.Tahun, &b.Kondisi, &b.Status,
+			&hargaAsli, &b.MataUang, &hargaJual, &kilometer,
+			&b.Transmisi, &b.BahanBakar, &b.Warna, &b.TipeBodi, &b.JumlahKursi, &b.PlatRegion,
+			&b.Lokasi, &b.FotoURL, &b.Deskripsi,
+			&b.DibuatPada, &dipublikasikan, &berlaku,
+			&b.StatusTransaksi, &hargaTerjual, &terjual)
+		if err != nil {
+			return jumlah, fmt.Errorf("gagal membaca mobil ekspor: %w", err)
+		}
+		b.Harga = desimal(hargaAsli, b.MataUang)
+		b.HargaJualIDR = desimal(hargaJual, money.DefaultCurrency)
+		if kilometer.Valid {
+			b.Kilometer = &kilometer.Int32
+		}
+		b.DipublikasikanPada, b.BerlakuSampai = waktuOpsional(dipublikasikan), waktuOpsional(berlaku)
+		if b.StatusTransaksi == "selesai" {
+			b.HargaTerjual = desimal(hargaTerjual, money.DefaultCurrency)
+			b.TerjualPada = waktuOpsional(terjual)
+		}
+		if err := p.Tulis(b); err != nil {
+			return jumlah, fmt.Errorf("gagal menulis baris ekspor: %w", err)
+		}
+		jumlah++
+	}
+	if err := rows.Err(); err != nil {
+		return jumlah, fmt.Errorf("gagal membaca mobil ekspor: %w", err)
+	}
+	return jumlah, p.Selesai()
+}
+
+// desimal menormalkan NUMERIC ke format Money.String (kosong jika NULL)
+func desimal(ns sql.NullString, currency string) string {
+	if !ns.Valid {
+		return ""
+	}
+	if harga, err := money.Parse(ns.String, currency); err == nil {
+		return harga.String()
+	}
+	return ns.String
+}
+
+func waktuOpsional(nt sql.NullTime) *time.Time {
+	if !nt.Valid {
+		return nil
+	}
+	return &nt.Time
+}
+
+// PENJELASAN FILE ekspor.go:
+// File ini berisi ekspor inventaris mobil (ExportInventory dan `cmd/inventaris ekspor`)
+//
+// Format:
+// - csv: header + satu baris per mobil; jsonl: satu objek JSON per baris
+// - Nama kolom yang sama dengan file impor (nomor_stok, vin, harga, mata_uang, ...) tidak diubah
+// - Waktu dalam RFC 3339; harga dalam desimal persis (format NUMERIC)
+// - CSV diawali BOM UTF-8 (dibaca benar oleh Excel, impor sudah mengabaikannya)
+// - Sel CSV yang diawali =, +, - atau @ diberi awalan ' (cegah formula injection di spreadsheet);
+//   JSON Lines tidak diubah
+//
+// Fungsi Ekspor:
+// - Filter owner (kosong = semua, untuk admin), status, dan FilterMobil (pencarian.Filter)
+// - Rows dibaca bertahap dan langsung ditulis ke Penulis, inventaris besar tidak dimuat ke memori
+// - status_transaksi dari transaksi jual terakhir yang tidak batal; harga_terjual & terjual_pada
+//   hanya untuk transaksi 'selesai'; harga_terjual = total + potongan_trade_in (harga mobil
+//   sebelum dipotong nilai trade-in, sama dengan riwayat_harga 'terjual')
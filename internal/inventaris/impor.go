@@ -0,0 +1,339 @@
+package inventaris
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"carapp.com/m/internal/atribut"
+	"carapp.com/m/internal/geo"
+	"carapp.com/m/internal/money"
+)
+
+// Format file impor
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+// Aksi hasil impor per baris
+const (
+	AksiBuat     = "buat"
+	AksiPerbarui = "perbarui"
+	AksiGagal    = "gagal"
+)
+
+// MaksBaris adalah jumlah baris maksimal per file impor
+const MaksBaris = 5000
+
+// kolomImpor adalah nama kolom CSV / key objek JSON yang dikenali
+var kolomImpor = map[string]bool{
+	"nomor_stok": true, "vin": true, "merk": true, "model": true, "tahun": true, "kondisi": true,
+	"deskripsi": true, "harga": true, "mata_uang": true, "foto_url": true, "lokasi": true,
+	"kilometer": true, "transmisi": true, "bahan_bakar": true, "warna": true, "tipe_bodi": true,
+	"jumlah_kursi": true, "plat_region": true,
+}
+
+// Baris adalah satu mobil dari file impor yang sudah divalidasi dan dinormalkan
+type Baris struct {
+	Nomor     int // Nomor baris CSV (header = 1) atau urutan objek JSON (mulai 1)
+	NomorStok string
+	Merk      string
+	Model     string
+	Tahun     int32
+	Kondisi   string
+	Deskripsi string
+	Harga     money.Money // Dalam mata uang asli (dikonversi ke IDR saat disimpan)
+	FotoURL   string
+	Lokasi    string
+	Spek      atribut.Atribut // Termasuk VIN
+	Koordinat *geo.Koordinat  // nil jika lokasi tidak ada di gazetteer
+}
+
+// Hasil adalah laporan impor satu baris
+type Hasil struct {
+	Baris     int
+	NomorStok string
+	VIN       string
+	Aksi      string
+	MobilID   string
+	Status    string // Status listing setelah impor
+	Errors    []string
+}
+
+// Parse membaca file impor (CSV dengan header, atau JSON array of object dengan key yang sama).
+// Setiap baris divalidasi; baris yang salah dikembalikan di gagal (semua error per baris)
+// tanpa menghentikan parsing. err hanya untuk file yang tidak bisa dibaca sama sekali.
+func Parse(r io.Reader, format string) (daftar []Baris, gagal []Hasil, err error) {
+	var mentah []barisMentah
+	switch format {
+	case FormatCSV:
+		mentah, gagal, err = bacaCSV(r)
+	case FormatJSON:
+		mentah, gagal, err = bacaJSON(r)
+	default:
+		return nil, nil, fmt.Errorf("format %q tidak didukung (csv atau json)", format)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(mentah)+len(gagal) == 0 {
+		return nil, nil, errors.New("file impor tidak berisi baris data")
+	}
+	if len(mentah)+len(gagal) > MaksBaris {
+		return nil, nil, fmt.Errorf("file impor maksimal %d baris", MaksBaris)
+	}
+
+	// Nomor stok & VIN adalah kunci upsert, tidak boleh dipakai dua baris dalam satu file
+	stokDipakai, vinDipakai := map[string]int{}, map[string]int{}
+	for _, m := range mentah {
+		b, errs := parseBaris(m)
+		if b.NomorStok != "" {
+			if n, ok := stokDipakai[b.NomorStok]; ok {
+				errs = append(errs, fmt.Sprintf("nomor_stok %q sudah dipakai baris %d", b.NomorStok, n))
+			} else {
+				stokDipakai[b.NomorStok] = m.nomor
+			}
+		}
+		if b.Spek.VIN != "" {
+			if n, ok := vinDipakai[b.Spek.VIN]; ok {
+				errs = append(errs, fmt.Sprintf("vin %q sudah dipakai baris %d", b.Spek.VIN, n))
+			} else {
+				vinDipakai[b.Spek.VIN] = m.nomor
+			}
+		}
+		if len(errs) > 0 {
+			gagal = append(gagal, Hasil{Baris: m.nomor, NomorStok: m.ambil("nomor_stok"), VIN: m.ambil("vin"),
+				Aksi: AksiGagal, Errors: errs})
+			continue
+		}
+		daftar = append(daftar, b)
+	}
+	return daftar, gagal, nil
+}
+
+// barisMentah adalah satu baris CSV / objek JSON sebelum divalidasi
+type barisMentah struct {
+	nomor int
+	nilai map[string]string
+}
+
+func (m barisMentah) ambil(nama string) string {
+	return strings.TrimSpace(m.nilai[nama])
+}
+
+// bacaCSV memetakan kolom dari header (urutan kolom bebas, kolom tak dikenal ditolak)
+func bacaCSV(r io.Reader) ([]barisMentah, []Hasil, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil, errors.New("CSV kosong")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("gagal membaca header CSV: %w", err)
+	}
+	for i, h := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))
+		if !kolomImpor[header[i]] {
+			return nil, nil, fmt.Errorf("kolom %q tidak dikenal", header[i])
+		}
+	}
+
+	var daftar []barisMentah
+	var gagal []Hasil
+	baris := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		baris++
+		if err != nil {
+			gagal = append(gagal, Hasil{Baris: baris, Aksi: AksiGagal, Errors: []string{err.Error()}})
+			continue
+		}
+		if len(record) > len(header) {
+			gagal = append(gagal, Hasil{Baris: baris, Aksi: AksiGagal,
+				Errors: []string{fmt.Sprintf("jumlah kolom %d melebihi header (%d)", len(record), len(header))}})
+			continue
+		}
+		m := barisMentah{nomor: baris, nilai: map[string]string{}}
+		for i, v := range record {
+			m.nilai[header[i]] = v
+		}
+		daftar = append(daftar, m)
+	}
+	return daftar, gagal, nil
+}
+
+// bacaJSON membaca array objek; angka dibaca sebagai teks agar harga tidak melewati float64
+func bacaJSON(r io.Reader) ([]barisMentah, []Hasil, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	var objek []map[string]interface{}
+	if err := decoder.Decode(&objek); err != nil {
+		return nil, nil, fmt.Errorf("JSON tidak valid (harus array objek): %w", err)
+	}
+
+	var daftar []barisMentah
+	var gagal []Hasil
+	for i, o := range objek {
+		m := barisMentah{nomor: i + 1, nilai: map[string]string{}}
+		var errs []string
+		keys := make([]string, 0, len(o))
+		for key := range o {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys) // Urutan error per baris stabil
+		for _, key := range keys {
+			v := o[key]
+			nama := strings.ToLower(strings.TrimSpace(key))
+			if !kolomImpor[nama] {
+				errs = append(errs, fmt.Sprintf("key %q tidak dikenal", key))
+				continue
+			}
+			switch nilai := v.(type) {
+			case nil:
+			case string:
+				m.nilai[nama] = nilai
+			case json.Number:
+				m.nilai[nama] = nilai.String()
+			default:
+				errs = append(errs, fmt.Sprintf("%s harus teks atau angka", nama))
+			}
+		}
+		if len(errs) > 0 {
+			gagal = append(gagal, Hasil{Baris: m.nomor, NomorStok: m.ambil("nomor_stok"), VIN: m.ambil("vin"),
+				Aksi: AksiGagal, Errors: errs})
+			continue
+		}
+		daftar = append(daftar, m)
+	}
+	return daftar, gagal, nil
+}
+
+// parseBaris memvalidasi satu baris dengan aturan yang sama seperti CreateMobil.
+// Semua kesalahan dikumpulkan agar dealer bisa memperbaiki baris sekaligus.
+func parseBaris(m barisMentah) (Baris, []string) {
+	var errs []string
+	b := Baris{
+		Nomor:     m.nomor,
+		NomorStok: m.ambil("nomor_stok"),
+		Merk:      m.ambil("merk"),
+		Model:     m.ambil("model"),
+		Kondisi:   strings.ToLower(m.ambil("kondisi")),
+		Deskripsi: m.ambil("deskripsi"),
+		FotoURL:   m.ambil("foto_url"),
+		Lokasi:    m.ambil("lokasi"),
+	}
+
+	if b.NomorStok == "" && m.ambil("vin") == "" {
+		errs = append(errs, "nomor_stok atau vin harus diisi")
+	}
+	if len(b.NomorStok) > 50 {
+		errs = append(errs, "nomor_stok maksimal 50 karakter")
+	}
+	if b.Merk == "" {
+		errs = append(errs, "merk harus diisi")
+	}
+	if b.Model == "" {
+		errs = append(errs, "model harus diisi")
+	}
+	tahun, err := strconv.Atoi(m.ambil("tahun"))
+	if err != nil || tahun <= 1900 || tahun > time.Now().Year()+1 {
+		errs = append(errs, fmt.Sprintf("tahun %q tidak valid", m.ambil("tahun")))
+	}
+	b.Tahun = int32(tahun)
+	switch b.Kondisi {
+	case "":
+		b.Kondisi = "bekas"
+	case "baru", "bekas":
+	default:
+		errs = append(errs, fmt.Sprintf("kondisi %q harus baru atau bekas", b.Kondisi))
+	}
+	if b.Deskripsi == "" {
+		errs = append(errs, "deskripsi harus diisi")
+	}
+	if b.FotoURL == "" {
+		errs = append(errs, "foto_url harus diisi")
+	}
+
+	// Harga: desimal dalam mata_uang (default IDR), dikonversi ke IDR saat disimpan
+	mataUang := strings.ToUpper(m.ambil("mata_uang"))
+	if mataUang == "" {
+		mataUang = money.DefaultCurrency
+	}
+	if !money.IsSupported(mataUang) {
+		errs = append(errs, fmt.Sprintf("mata_uang %q tidak dikenal", mataUang))
+	} else if b.Harga, err = money.Parse(m.ambil("harga"), mataUang); err != nil || !b.Harga.IsPositive() {
+		errs = append(errs, fmt.Sprintf("harga %q tidak valid (harus angka > 0)", m.ambil("harga")))
+	}
+
+	// Atribut terstruktur opsional, dinormalkan seperti CreateMobil
+	spek := atribut.Atribut{
+		Transmisi:  m.ambil("transmisi"),
+		BahanBakar: m.ambil("bahan_bakar"),
+		Warna:      m.ambil("warna"),
+		TipeBodi:   m.ambil("tipe_bodi"),
+		PlatRegion: m.ambil("plat_region"),
+		VIN:        m.ambil("vin"),
+	}
+	if km := m.ambil("kilometer"); km != "" {
+		if n, err := strconv.ParseInt(km, 10, 32); err == nil {
+			kilometer := int32(n)
+			spek.Kilometer = &kilometer
+		} else {
+			errs = append(errs, fmt.Sprintf("kilometer %q harus bilangan bulat", km))
+		}
+	}
+	if kursi := m.ambil("jumlah_kursi"); kursi != "" {
+		if n, err := strconv.ParseInt(kursi, 10, 32); err == nil {
+			spek.JumlahKursi = int32(n)
+		} else {
+			errs = append(errs, fmt.Sprintf("jumlah_kursi %q harus bilangan bulat", kursi))
+		}
+	}
+	if b.Spek, err = atribut.Normalisasi(spek); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	if k, _, ok := geo.Geocode(b.Lokasi); ok {
+		b.Koordinat = &k
+	}
+	return b, errs
+}
+
+// FormatDariNama menebak format dari ekstensi file (dipakai CLI)
+func FormatDariNama(nama string) string {
+	switch {
+	case strings.HasSuffix(strings.ToLower(nama), ".json"):
+		return FormatJSON
+	case strings.HasSuffix(strings.ToLower(nama), ".csv"):
+		return FormatCSV
+	}
+	return ""
+}
+
+// PENJELASAN FILE impor.go:
+// File ini berisi parsing & validasi file impor stok dealer (BulkImportMobil dan `cmd/inventaris impor`)
+//
+// Format file:
+// - CSV dengan header, urutan kolom bebas; atau JSON array objek dengan key yang sama
+// - Kolom: nomor_stok, vin, merk, model, tahun, kondisi, deskripsi, harga, mata_uang, foto_url,
+//   lokasi, kilometer, transmisi, bahan_bakar, warna, tipe_bodi, jumlah_kursi, plat_region
+// - Kolom / key yang tidak dikenal ditolak (mencegah salah ketik nama kolom)
+//
+// Fungsi Parse:
+// - Validasi sama dengan CreateMobil (merk, model, tahun, harga, deskripsi, foto wajib),
+//   atribut dinormalkan lewat atribut.Normalisasi, koordinat dari lokasi (geo.Geocode)
+// - nomor_stok atau vin wajib (kunci upsert) dan tidak boleh dobel dalam satu file
+// - Semua error satu baris dikumpulkan sekaligus (Hasil.Errors), parsing tidak berhenti
@@ -0,0 +1,310 @@
+package inventaris
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"carapp.com/m/internal/hargapasar"
+	"carapp.com/m/internal/kurs"
+	"carapp.com/m/internal/moderasi"
+	"carapp.com/m/internal/money"
+	"carapp.com/m/internal/pencarian"
+	"carapp.com/m/internal/watchlist"
+)
+
+// Opsi mengatur penyimpanan hasil impor
+type Opsi struct {
+	DryRun          bool   // true = semua baris diproses lalu di-rollback
+	StatusBaru      string // Status listing baru: 'tersedia' atau 'menunggu_moderasi' (LISTING_MODERASI)
+	MasaBerlakuHari int    // Masa tayang listing baru yang langsung tersedia
+}
+
+// Laporan adalah ringkasan impor satu file
+type Laporan struct {
+	Hasil      []Hasil // Urut nomor baris
+	Dibuat     int
+	Diperbarui int
+	Gagal      int
+	Disimpan   bool // false jika dry run atau ada baris gagal
+
+	hargaTurun []perubahanHarga // Untuk notifikasi watchlist setelah commit
+	ditahan    []string         // Mobil tayang yang ditahan ke antrean moderasi, owner diberi tahu setelah commit
+}
+
+type perubahanHarga struct {
+	mobilID    string
+	lama, baru money.Money
+}
+
+// Terapkan meng-upsert daftar baris ke mobil milik ownerID dalam satu transaksi:
+// dicari per nomor_stok, lalu per VIN; jika tidak ada dibuat listing baru.
+// Semua atau tidak sama sekali: jika ada baris gagal (termasuk gagal dari Parse) atau
+// opsi.DryRun, transaksi di-rollback dan Laporan tetap berisi hasil setiap baris.
+func Terapkan(ctx context.Context, db *sql.DB, ownerID string, daftar []Baris, gagal []Hasil, opsi Opsi) (Laporan, error) {
+	laporan := Laporan{Hasil: append([]Hasil{}, gagal...)}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return laporan, fmt.Errorf("gagal memulai transaksi DB: %w", err)
+	}
+	defer tx.Rollback()
+
+	konverter := kurs.NewKonverter(db, time.Now())
+	for _, b := range daftar {
+		// Savepoint per baris: error DB satu baris dilaporkan tanpa membatalkan baris lain
+		if _, err := tx.ExecContext(ctx, `SAVEPOINT baris_impor`); err != nil {
+			return laporan, fmt.Errorf("gagal membuat savepoint: %w", err)
+		}
+		hasil, turun, err := upsertBaris(ctx, tx, konverter, ownerID, b, opsi)
+		if err != nil {
+			if _, errRollback := tx.ExecContext(ctx, `ROLLBACK TO SAVEPOINT baris_impor`); errRollback != nil {
+				return laporan, fmt.Errorf("gagal rollback savepoint: %w", errRollback)
+			}
+			hasil = Hasil{Baris: b.Nomor, NomorStok: b.NomorStok, VIN: b.Spek.VIN, Aksi: AksiGagal,
+				Errors: []string{err.Error()}}
+		} else if _, err := tx.ExecContext(ctx, `RELEASE SAVEPOINT baris_impor`); err != nil {
+			return laporan, fmt.Errorf("gagal melepas savepoint: %w", err)
+		}
+		if turun != nil {
+			laporan.hargaTurun = append(laporan.hargaTurun, *turun)
+		}
+		// Mobil lama yang sedang ditinjau ditolak cariMobil, jadi status ini berarti baru ditahan
+		if hasil.Aksi == AksiPerbarui && hasil.Status == pencarian.StatusMenungguModerasi {
+			laporan.ditahan = append(laporan.ditahan, hasil.MobilID)
+		}
+		laporan.Hasil = append(laporan.Hasil, hasil)
+	}
+
+	sort.SliceStable(laporan.Hasil, func(i, j int) bool { return laporan.Hasil[i].Baris < laporan.Hasil[j].Baris })
+	for _, h := range laporan.Hasil {
+		switch h.Aksi {
+		case AksiBuat:
+			laporan.Dibuat++
+		case AksiPerbarui:
+			laporan.Diperbarui++
+		default:
+			laporan.Gagal++
+		}
+	}
+
+	if opsi.DryRun || laporan.Gagal > 0 {
+		// Baris baru belum benar-benar dibuat, ID-nya tidak dilaporkan
+		for i := range laporan.Hasil {
+			if laporan.Hasil[i].Aksi == AksiBuat {
+				laporan.Hasil[i].MobilID = ""
+			}
+		}
+		laporan.hargaTurun, laporan.ditahan = nil, nil
+		return laporan, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return laporan, fmt.Errorf("gagal menyimpan impor: %w", err)
+	}
+	laporan.Disimpan = true
+	return laporan, nil
+}
+
+// upsertBaris menyimpan satu baris. error = baris gagal (pesan untuk dealer).
+func upsertBaris(ctx context.Context, tx *sql.Tx, konverter *kurs.Konverter, ownerID string, b Baris, opsi Opsi) (Hasil, *perubahanHarga, error) {
+	hasil := Hasil{Baris: b.Nomor, NomorStok: b.NomorStok, VIN: b.Spek.VIN}
+
+	// 1. Harga jual selalu IDR (kurs hari ini), harga asli disimpan apa adanya
+	hargaJual := b.Harga
+	if b.Harga.Currency != money.DefaultCurrency {
+		var err error
+		if hargaJual, err = konverter.Konversi(ctx, b.Harga, money.DefaultCurrency); err != nil {
+			return hasil, nil, fmt.Errorf("kurs %s -> %s tidak tersedia", b.Harga.Currency, money.DefaultCurrency)
+		}
+	}
+
+	// 2. Cari mobil yang sudah ada
+	mobilID, statusLama, hargaLama, err := cariMobil(ctx, tx, ownerID, b)
+	if err != nil {
+		return hasil, nil, err
+	}
+	var lat, lng *float64
+	if b.Koordinat != nil {
+		lat, lng = &b.Koordinat.Lat, &b.Koordinat.Lng
+	}
+	spek := b.Spek
+
+	// 3a. Mobil baru: dinilai risiko penipuan seperti CreateMobil
+	if mobilID == "" {
+		statusBaru := opsi.StatusBaru
+		penilaian, err := moderasi.Nilai(ctx, tx, moderasi.Listing{
+			OwnerID: ownerID, Merk: b.Merk, Model: b.Model, Tahun: b.Tahun,
+			Harga: hargaJual, FotoURL: b.FotoURL, VIN: spek.VIN,
+		})
+		if err != nil {
+			return hasil, nil, fmt.Errorf("gagal menilai risiko listing: %w", err)
+		}
+		if penilaian.Ditahan {
+			statusBaru = pencarian.StatusMenungguModerasi
+		}
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO mobils (
+				owner_id, nomor_stok, vin, merk, model, tahun, kondisi, deskripsi,
+				harga_jual, harga_asli, mata_uang_asli, foto_url, lokasi, status,
+				kilometer, transmisi, bahan_bakar, warna, tipe_bodi, jumlah_kursi, plat_region,
+				latitude, longitude, berlaku_sampai, dipublikasikan_pada
+			) VALUES ($1, NULLIF($2, ''), NULLIF($3, ''), $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14,
+			          $15, NULLIF($16, ''), NULLIF($17, ''), NULLIF($18, ''), NULLIF($19, ''), NULLIF($20, 0), NULLIF($21, ''),
+			          $22, $23, CASE WHEN $14 = 'tersedia' THEN NOW() + make_interval(days => $24) END,
+			          CASE WHEN $14 = 'tersedia' THEN NOW() END)
+			RETURNING id
+		`, ownerID, b.NomorStok, spek.VIN, b.Merk, b.Model, b.Tahun, b.Kondisi, b.Deskripsi,
+			hargaJual, b.Harga, b.Harga.Currency, b.FotoURL, b.Lokasi, statusBaru,
+			spek.Kilometer, spek.Transmisi, spek.BahanBakar, spek.Warna, spek.TipeBodi, spek.JumlahKursi, spek.PlatRegion,
+			lat, lng, opsi.MasaBerlakuHari).Scan(&mobilID)
+		if err != nil {
+			log.Printf("Gagal impor baris %d (owner %s): %v", b.Nomor, ownerID, err)
+			return hasil, nil, fmt.Errorf("gagal menyimpan mobil")
+		}
+		if err := hargapasar.Catat(ctx, tx, mobilID, hargapasar.JenisListing, hargaJual, &b.Harga, ""); err != nil {
+			return hasil, nil, err
+		}
+		if err := moderasi.Simpan(ctx, tx, mobilID, penilaian); err != nil {
+			return hasil, nil, err
+		}
+		hasil.Aksi, hasil.MobilID, hasil.Status = AksiBuat, mobilID, statusBaru
+		return hasil, nil, nil
+	}
+
+	// 3b. Mobil lama: data ditimpa, masa tayang tidak berubah.
+	// Kunci yang kosong di file tidak menghapus nomor_stok / VIN yang sudah ada.
+	_, err = tx.ExecContext(ctx, `
+		UPDATE mobils SET nomor_stok = COALESCE(NULLIF($2, ''), nomor_stok), vin = COALESCE(NULLIF($3, ''), vin),
+		       merk = $4, model = $5, tahun = $6, kondisi = $7, deskripsi = $8,
+		       harga_jual = $9, harga_asli = $10, mata_uang_asli = $11,
+		       foto_phash = CASE WHEN foto_url IS DISTINCT FROM $12 THEN NULL ELSE foto_phash END,
+		       foto_url = $12, lokasi = $13,
+		       kilometer = $14, transmisi = NULLIF($15, ''), bahan_bakar = NULLIF($16, ''), warna = NULLIF($17, ''),
+		       tipe_bodi = NULLIF($18, ''), jumlah_kursi = NULLIF($19, 0), plat_region = NULLIF($20, ''),
+		       latitude = $21, longitude = $22, updated_at = NOW()
+		WHERE id = $1
+	`, mobilID, b.NomorStok, spek.VIN, b.Merk, b.Model, b.Tahun, b.Kondisi, b.Deskripsi,
+		hargaJual, b.Harga, b.Harga.Currency, b.FotoURL, b.Lokasi,
+		spek.Kilometer, spek.Transmisi, spek.BahanBakar, spek.Warna, spek.TipeBodi, spek.JumlahKursi, spek.PlatRegion,
+		lat, lng)
+	if err != nil {
+		log.Printf("Gagal impor baris %d (mobil %s): %v", b.Nomor, mobilID, err)
+		return hasil, nil, fmt.Errorf("gagal memperbarui mobil")
+	}
+
+	// Listing tayang dinilai ulang seperti mobil baru; skor tinggi -> ditahan ke antrean moderasi
+	statusBaru := statusLama
+	if statusLama == "tersedia" {
+		penilaian, err := moderasi.Nilai(ctx, tx, moderasi.Listing{
+			ID: mobilID, OwnerID: ownerID, Merk: b.Merk, Model: b.Model, Tahun: b.Tahun,
+			Harga: hargaJual, FotoURL: b.FotoURL, VIN: spek.VIN,
+		})
+		if err != nil {
+			return hasil, nil, fmt.Errorf("gagal menilai risiko listing: %w", err)
+		}
+		if err := moderasi.Simpan(ctx, tx, mobilID, penilaian); err != nil {
+			return hasil, nil, err
+		}
+		if penilaian.Ditahan {
+			ditahan, err := moderasi.Tahan(ctx, tx, mobilID)
+			if err != nil {
+				return hasil, nil, err
+			}
+			if ditahan {
+				statusBaru = pencarian.StatusMenungguModerasi
+			}
+		}
+	}
+
+	// Harga berubah dicatat ke riwayat; harga turun listing tayang diberitahukan ke watcher
+	var turun *perubahanHarga
+	if hargaJual.Minor != hargaLama.Minor {
+		jenis := hargapasar.JenisPerubahan
+		if !hargaLama.IsPositive() {
+			jenis = hargapasar.JenisListing
+		}
+		if err := hargapasar.Catat(ctx, tx, mobilID, jenis, hargaJual, &b.Harga, ""); err != nil {
+			return hasil, nil, err
+		}
+		if statusBaru == "tersedia" && hargaLama.IsPositive() && hargaJual.Minor < hargaLama.Minor {
+			turun = &perubahanHarga{mobilID: mobilID, lama: hargaLama, baru: hargaJual}
+		}
+	}
+	hasil.Aksi, hasil.MobilID, hasil.Status = AksiPerbarui, mobilID, statusBaru
+	return hasil, turun, nil
+}
+
+// cariMobil mencari mobil owner per nomor_stok, lalu per VIN (mobil terjual dengan VIN yang
+// sama diabaikan: mobil yang dibeli kembali dibuat sebagai listing baru).
+// mobilID kosong = belum ada.
+func cariMobil(ctx context.Context, tx *sql.Tx, ownerID string, b Baris) (mobilID, statusMobil string, harga money.Money, err error) {
+	err = sql.ErrNoRows
+	if b.NomorStok != "" {
+		err = tx.QueryRowContext(ctx, `
+			SELECT id, status, harga_jual FROM mobils WHERE owner_id = $1 AND nomor_stok = $2 FOR UPDATE
+		`, ownerID, b.NomorStok).Scan(&mobilID, &statusMobil, &harga)
+	}
+	if err == sql.ErrNoRows && b.Spek.VIN != "" {
+		err = tx.QueryRowContext(ctx, `
+			SELECT id, status, harga_jual FROM mobils
+			WHERE owner_id = $1 AND vin = $2 AND status <> 'terjual'
+			ORDER BY created_at DESC
+			LIMIT 1
+			FOR UPDATE
+		`, ownerID, b.Spek.VIN).Scan(&mobilID, &statusMobil, &harga)
+	}
+	if err == sql.ErrNoRows {
+		return "", "", money.Money{}, nil
+	}
+	if err != nil {
+		log.Printf("Gagal mencari mobil impor baris %d: %v", b.Nomor, err)
+		return "", "", money.Money{}, fmt.Errorf("gagal mencari mobil yang sudah ada")
+	}
+
+	// Mobil yang sedang dipesan / sudah terjual tidak boleh ditimpa impor. Draft, antrean
+	// moderasi, dan ditolak juga tidak: perubahannya harus lewat PublishMobil / ModerasiMobil
+	// agar tidak melewati penilaian staf.
+	switch {
+	case statusMobil == "dipesan":
+		return "", "", money.Money{}, fmt.Errorf("mobil %s sedang dipesan, tidak bisa diperbarui", mobilID)
+	case statusMobil == "terjual":
+		return "", "", money.Money{}, fmt.Errorf("mobil %s dengan nomor_stok ini sudah terjual", mobilID)
+	case pencarian.StatusPrivat(statusMobil):
+		return "", "", money.Money{}, fmt.Errorf("mobil %s berstatus '%s' (belum tayang / sedang ditinjau), tidak bisa diperbarui lewat impor",
+			mobilID, statusMobil)
+	}
+	return mobilID, statusMobil, harga, nil
+}
+
+// KirimNotifikasi memberi tahu watcher mobil yang harganya turun karena impor dan owner
+// mobil yang ditahan ke antrean moderasi.
+// Dipanggil setelah Terapkan berhasil menyimpan (RPC menjalankannya sebagai goroutine).
+func (l Laporan) KirimNotifikasi(db *sql.DB) {
+	for _, p := range l.hargaTurun {
+		watchlist.NotifyHargaTurun(db, p.mobilID, p.lama, p.baru)
+	}
+	for _, mobilID := range l.ditahan {
+		moderasi.NotifyDitahan(db, mobilID)
+	}
+}
+
+// PENJELASAN FILE upsert.go:
+// File ini berisi penyimpanan hasil impor stok dealer (upsert, bukan hapus lalu insert ulang)
+//
+// Fungsi Terapkan:
+// - Satu transaksi untuk seluruh file, savepoint per baris agar error DB tercatat per baris
+// - Semua atau tidak sama sekali: ada baris gagal atau dry run -> rollback, laporan tetap lengkap
+//
+// Fungsi upsertBaris:
+// - Kunci: nomor_stok (unik per owner), lalu VIN (mobil terjual dengan VIN sama diabaikan)
+// - Baru: status opsi.StatusBaru, dinilai moderasi.Nilai (skor tinggi -> 'menunggu_moderasi'),
+//   harga awal dicatat ke riwayat_harga
+// - Lama: data ditimpa, masa tayang tetap; mobil 'dipesan' / 'terjual' / 'draft' /
+//   'menunggu_moderasi' / 'ditolak' ditolak (harus lewat PublishMobil / ModerasiMobil)
+// - Lama yang 'tersedia' dinilai ulang moderasi.Nilai; skor tinggi -> 'menunggu_moderasi',
+//   owner diberi tahu (KirimNotifikasi)
+// - Harga asing dikonversi ke IDR dengan kurs hari ini; harga berubah dicatat ke riwayat_harga,
+//   harga turun listing 'tersedia' diberitahukan ke watcher (KirimNotifikasi)
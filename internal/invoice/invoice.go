@@ -0,0 +1,303 @@
+package invoice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"carapp.com/m/internal/money"
+)
+
+// UploadDir adalah folder penyimpanan PDF invoice (di dalam folder uploads, tapi
+// tidak dilayani file server publik; lihat main.go)
+const UploadDir = "uploads/invoice"
+
+// ErrBelumAda dikembalikan jika transaksi belum punya invoice (belum 'selesai')
+var ErrBelumAda = errors.New("invoice belum dibuat")
+
+// Invoice adalah baris tabel invoice
+type Invoice struct {
+	ID          string
+	TransaksiID string
+	Nomor       string
+	Total       money.Money
+	FilePath    sql.NullString
+	CreatedAt   time.Time
+}
+
+// detail adalah data yang dicetak di PDF
+type detail struct {
+	Invoice
+	PenjualName, PenjualEmail, PenjualPhone string
+	PembeliName, PembeliEmail, PembeliPhone string
+	Merk, Model, Kondisi, Lokasi            string
+	Tahun                                   int
+	CompletedAt                             time.Time
+	PotonganTradeIn                         money.Money
+}
+
+// AlokasiNomor membuat baris invoice dengan nomor urut per tahun. Harus dipanggil di dalam
+// transaksi DB yang sama dengan perubahan status ke 'selesai': counter dikunci per baris
+// (UPSERT), sehingga jika transaksi di-rollback nomornya ikut batal dan tidak ada nomor yang loncat.
+func AlokasiNomor(ctx context.Context, tx *sql.Tx, transaksiID string, total money.Money) (string, error) {
+	tahun := time.Now().Year()
+
+	var urutan int
+	err := tx.QueryRowContext(ctx, `
+		INSERT INTO invoice_counter (tahun, nomor_terakhir) VALUES ($1, 1)
+		ON CONFLICT (tahun) DO UPDATE SET nomor_terakhir = invoice_counter.nomor_terakhir + 1
+		RETURNING nomor_terakhir
+	`, tahun).Scan(&urutan)
+	if err != nil {
+		return "", fmt.Errorf("gagal alokasi nomor invoice: %w", err)
+	}
+
+	nomor := fmt.Sprintf("INV/%d/%06d", tahun, urutan)
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO invoice (transaksi_id, nomor, tahun, urutan, total)
+		VALUES ($1, $2, $3, $4, $5)
+	`, transaksiID, nomor, tahun, urutan, total)
+	if err != nil {
+		return "", fmt.Errorf("gagal menyimpan invoice: %w", err)
+	}
+	return nomor, nil
+}
+
+// Ambil mengembalikan invoice beserta isi PDF. Jika PDF belum ada (misal server mati
+// sebelum Generate selesai) maka PDF dibuat ulang saat itu juga.
+func Ambil(ctx context.Context, db *sql.DB, transaksiID string) (*Invoice, []byte, error) {
+	inv, err := getInvoice(ctx, db, transaksiID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if inv.FilePath.Valid {
+		data, err := os.ReadFile(inv.FilePath.String)
+		if err == nil {
+			return inv, data, nil
+		}
+		log.Printf("Invoice %s: file %s tidak terbaca, dibuat ulang: %v", inv.Nomor, inv.FilePath.String, err)
+	}
+	return Generate(ctx, db, transaksiID)
+}
+
+// Generate merender PDF invoice, menyimpannya ke UploadDir, dan mengisi file_path.
+// Idempoten per transaksi: nama file = ID invoice, dan file yang sudah ada dipakai ulang,
+// sehingga pemanggilan bersamaan (job setelah 'selesai' dan GetInvoice) tidak membuat file ganda.
+func Generate(ctx context.Context, db *sql.DB, transaksiID string) (*Invoice, []byte, error) {
+	d, err := getDetail(ctx, db, transaksiID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 1. File sudah ada (dari file_path lama atau nama deterministik) -> pakai ulang
+	path := filepath.Join(UploadDir, d.ID+".pdf")
+	for _, p := range []string{d.FilePath.String, path} {
+		if p == "" {
+			continue
+		}
+		if data, err := os.ReadFile(p); err == nil {
+			if err := catatFile(ctx, db, d, p); err != nil {
+				return nil, nil, err
+			}
+			return &d.Invoice, data, nil
+		}
+	}
+
+	// 2. Render lalu tulis ke file sementara + rename (atomik, pembaca tidak melihat file setengah jadi)
+	data := render(d)
+	if err := os.MkdirAll(UploadDir, 0755); err != nil {
+		return nil, nil, fmt.Errorf("gagal membuat folder invoice: %w", err)
+	}
+	tmp, err := os.CreateTemp(UploadDir, d.ID+"-*.tmp")
+	if err != nil {
+		return nil, nil, fmt.Errorf("gagal menulis file invoice: %w", err)
+	}
+	_, err = tmp.Write(data)
+	if errClose := tmp.Close(); err == nil {
+		err = errClose
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return nil, nil, fmt.Errorf("gagal menulis file invoice: %w", err)
+	}
+
+	// 3. Catat lokasi file
+	if err := catatFile(ctx, db, d, path); err != nil {
+		return nil, nil, err
+	}
+
+	log.Printf("Invoice %s dibuat: %s (%d bytes)", d.Nomor, path, len(data))
+	return &d.Invoice, data, nil
+}
+
+// catatFile mengisi invoice.file_path jika belum sama
+func catatFile(ctx context.Context, db *sql.DB, d *detail, path string) error {
+	if d.FilePath.Valid && d.FilePath.String == path {
+		return nil
+	}
+	if _, err := db.ExecContext(ctx, `UPDATE invoice SET file_path = $1 WHERE id = $2`, path, d.ID); err != nil {
+		return fmt.Errorf("gagal menyimpan lokasi invoice: %w", err)
+	}
+	d.FilePath = sql.NullString{String: path, Valid: true}
+	return nil
+}
+
+// getInvoice mengambil baris invoice berdasarkan transaksi
+func getInvoice(ctx context.Context, db *sql.DB, transaksiID string) (*Invoice, error) {
+	var inv Invoice
+	err := db.QueryRowContext(ctx, `
+		SELECT id, transaksi_id, nomor, total, file_path, created_at FROM invoice WHERE transaksi_id = $1
+	`, transaksiID).Scan(&inv.ID, &inv.TransaksiID, &inv.Nomor, &inv.Total, &inv.FilePath, &inv.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, ErrBelumAda
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &inv, nil
+}
+
+// getDetail mengambil semua data yang dicetak di invoice
+func getDetail(ctx context.Context, db *sql.DB, transaksiID string) (*detail, error) {
+	var d detail
+	var penjualPhone, pembeliPhone, kondisi, lokasi sql.NullString
+	var tahun sql.NullInt32
+	var completedAt sql.NullTime
+
+	err := db.QueryRowContext(ctx, `
+		SELECT i.id, i.transaksi_id, i.nomor, i.total, i.file_path, i.created_at,
+		       uj.name, uj.email, uj.phone, ub.name, ub.email, ub.phone,
+		       m.merk, m.model, m.tahun, m.kondisi, m.lokasi, t.completed_at, t.potongan_trade_in
+		FROM invoice i
+		JOIN transaksi_jual t ON t.id = i.transaksi_id
+		JOIN mobils m ON m.id = t.mobil_id
+		JOIN users uj ON uj.id = t.penjual_id
+		JOIN users ub ON ub.id = t.pembeli_id
+		WHERE i.transaksi_id = $1
+	`, transaksiID).Scan(
+		&d.ID, &d.TransaksiID, &d.Nomor, &d.Total, &d.FilePath, &d.CreatedAt,
+		&d.PenjualName, &d.PenjualEmail, &penjualPhone, &d.PembeliName, &d.PembeliEmail, &pembeliPhone,
+		&d.Merk, &d.Model, &tahun, &kondisi, &lokasi, &completedAt, &d.PotonganTradeIn,
+	)
+	if err == sql.ErrNoRows {
+		return nil, ErrBelumAda
+	}
+	if err != nil {
+		return nil, err
+	}
+	d.PenjualPhone = penjualPhone.String
+	d.PembeliPhone = pembeliPhone.String
+	d.Kondisi = kondisi.String
+	d.Lokasi = lokasi.String
+	d.Tahun = int(tahun.Int32)
+	d.CompletedAt = d.CreatedAt
+	if completedAt.Valid {
+		d.CompletedAt = completedAt.Time
+	}
+	return &d, nil
+}
+
+// render menyusun layout invoice A4
+func render(d *detail) []byte {
+	h := &halamanPDF{}
+	kiri, kanan := 50.0, lebarA4-50
+	y := tinggiA4 - 60
+
+	// Header
+	h.teks(kiri, y, 18, true, "INVOICE / KWITANSI PEMBELIAN MOBIL")
+	y -= 24
+	h.teks(kiri, y, 10, false, "Nomor: "+d.Nomor)
+	h.teksKanan(kanan, y, 10, false, "Tanggal: "+d.CompletedAt.Local().Format("02 Jan 2006"))
+	y -= 14
+	h.teks(kiri, y, 9, false, "ID Transaksi: "+d.TransaksiID)
+	y -= 12
+	h.garis(kiri, y, kanan, y, 1)
+
+	// Penjual & pembeli (dua kolom)
+	y -= 22
+	tengah := kiri + (kanan-kiri)/2
+	h.teks(kiri, y, 11, true, "Penjual")
+	h.teks(tengah, y, 11, true, "Pembeli")
+	for _, baris := range [][2]string{
+		{d.PenjualName, d.PembeliName},
+		{d.PenjualEmail, d.PembeliEmail},
+		{d.PenjualPhone, d.PembeliPhone},
+	} {
+		y -= 15
+		h.teks(kiri, y, 10, false, baris[0])
+		h.teks(tengah, y, 10, false, baris[1])
+	}
+
+	// Tabel mobil
+	y -= 30
+	h.teks(kiri, y, 11, true, "Rincian Kendaraan")
+	y -= 8
+	h.garis(kiri, y, kanan, y, 0.5)
+	for _, baris := range [][2]string{
+		{"Merk / Model", d.Merk + " " + d.Model},
+		{"Tahun", fmt.Sprintf("%d", d.Tahun)},
+		{"Kondisi", d.Kondisi},
+		{"Lokasi", d.Lokasi},
+	} {
+		y -= 16
+		h.teks(kiri, y, 10, false, baris[0])
+		h.teks(kiri+130, y, 10, false, baris[1])
+	}
+	y -= 10
+	h.garis(kiri, y, kanan, y, 0.5)
+
+	// Potongan trade-in (harga mobil - potongan = total)
+	if d.PotonganTradeIn.IsPositive() {
+		harga := money.New(d.Total.Minor+d.PotonganTradeIn.Minor, d.Total.Currency)
+		y -= 18
+		h.teks(kiri, y, 10, false, "Harga Mobil")
+		h.teksKanan(kanan, y, 10, false, harga.Format())
+		y -= 16
+		h.teks(kiri, y, 10, false, "Potongan Trade-in")
+		h.teksKanan(kanan, y, 10, false, "- "+d.PotonganTradeIn.Format())
+	}
+
+	// Total
+	y -= 22
+	h.teks(kiri, y, 12, true, "TOTAL HARGA")
+	h.teksKanan(kanan, y, 12, true, d.Total.Format())
+	y -= 10
+	h.garis(kiri, y, kanan, y, 1)
+
+	// Footer
+	y -= 30
+	h.teks(kiri, y, 9, false, "Dokumen ini adalah bukti pembelian yang sah untuk proses balik nama BPKB/STNK.")
+	y -= 12
+	h.teks(kiri, y, 9, false, "Dibuat otomatis oleh sistem pada "+d.CreatedAt.Local().Format("02 Jan 2006 15:04")+".")
+
+	return h.bytes()
+}
+
+// PENJELASAN FILE invoice.go:
+// File ini menangani nomor dan PDF invoice untuk transaksi jual yang 'selesai'
+//
+// Fungsi AlokasiNomor:
+// - Dipanggil dari transaksi.applyTransition di dalam transaksi DB yang sama (status -> 'selesai')
+// - UPSERT invoice_counter per tahun mengunci baris counter -> nomor berurutan tanpa loncat
+//   (jika transaksi DB gagal, increment counter ikut di-rollback)
+// - Format nomor: INV/<tahun>/<urutan 6 digit>
+//
+// Fungsi Generate:
+// - Ambil data penjual, pembeli, mobil, harga -> render PDF (pdf.go, pure Go)
+// - Jika ada trade-in, PDF menampilkan harga mobil, potongan trade-in, lalu total
+// - Simpan ke uploads/invoice/<id invoice>.pdf (tulis file sementara lalu rename) dan isi invoice.file_path
+// - Idempoten: file yang sudah ada dipakai ulang, pemanggilan bersamaan menghasilkan satu file yang sama
+//
+// Fungsi Ambil:
+// - Dipakai GetInvoice; jika file belum ada/hilang, PDF dibuat ulang (nomor tetap sama)
+//
+// Keamanan: folder uploads/invoice tidak dilayani file server publik, PDF hanya bisa diambil
+// lewat RPC GetInvoice yang mengecek peserta transaksi.
@@ -0,0 +1,105 @@
+package invoice
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Ukuran halaman A4 dalam point (1/72 inch)
+const (
+	lebarA4  = 595.0
+	tinggiA4 = 842.0
+)
+
+// halamanPDF adalah penulis PDF satu halaman yang sangat sederhana (tanpa library luar).
+// Hanya mendukung teks Helvetica / Helvetica-Bold dan garis lurus.
+type halamanPDF struct {
+	konten bytes.Buffer
+}
+
+// teks menulis satu baris teks pada posisi (x, y) dari kiri bawah halaman
+func (h *halamanPDF) teks(x, y, ukuran float64, tebal bool, s string) {
+	font := "F1"
+	if tebal {
+		font = "F2"
+	}
+	fmt.Fprintf(&h.konten, "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", font, ukuran, x, y, escapePDF(s))
+}
+
+// teksKanan menulis teks rata kanan dengan lebar karakter perkiraan Helvetica
+func (h *halamanPDF) teksKanan(xKanan, y, ukuran float64, tebal bool, s string) {
+	h.teks(xKanan-perkiraanLebar(s, ukuran), y, ukuran, tebal, s)
+}
+
+// garis menggambar garis lurus
+func (h *halamanPDF) garis(x1, y1, x2, y2, tebal float64) {
+	fmt.Fprintf(&h.konten, "%.2f w %.2f %.2f m %.2f %.2f l S\n", tebal, x1, y1, x2, y2)
+}
+
+// bytes menyusun dokumen PDF lengkap (catalog, pages, page, font, content + xref)
+func (h *halamanPDF) bytes() []byte {
+	konten := h.konten.Bytes()
+	objek := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] "+
+			"/Resources << /Font << /F1 4 0 R /F2 5 0 R >> >> /Contents 6 0 R >>", lebarA4, tinggiA4),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(konten), konten),
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	offsets := make([]int, len(objek))
+	for i, o := range objek {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, o)
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objek)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objek)+1, xref)
+	return buf.Bytes()
+}
+
+// escapePDF meng-escape karakter khusus string PDF dan mengganti karakter di luar Latin-1
+func escapePDF(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n' || r == '\r' || r == '\t':
+			b.WriteByte(' ')
+		case r < 0x20:
+			// Karakter kontrol dibuang
+		case r < 0x80:
+			b.WriteRune(r)
+		case r <= 0xFF:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+// perkiraanLebar menghitung lebar teks Helvetica secara kasar (rata-rata 0.5 em per karakter)
+func perkiraanLebar(s string, ukuran float64) float64 {
+	return float64(len([]rune(s))) * ukuran * 0.5
+}
+
+// PENJELASAN FILE pdf.go:
+// File ini berisi penulis PDF minimal (pure Go, tanpa dependency) untuk invoice
+//
+// - halamanPDF.teks / teksKanan: Tulis teks dengan font standar Helvetica (tidak perlu embed font)
+// - halamanPDF.garis: Garis pemisah tabel
+// - halamanPDF.bytes: Susun objek PDF 1.4 + tabel xref (offset byte tiap objek)
+// - escapePDF: Escape ( ) \ dan karakter Latin-1 (WinAnsiEncoding), karakter lain jadi '?'
@@ -0,0 +1,123 @@
+package janjitemu
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"carapp.com/m/internal/notifikasi"
+	"carapp.com/m/internal/transaksi"
+)
+
+const defaultJedaReminder = 2 * time.Hour
+
+// StartReminderJob mengirim pengingat janji temu dan membatalkan janji temu untuk mobil
+// yang sudah terjual. Dipanggil sebagai goroutine dari main.go.
+func StartReminderJob(db *sql.DB, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		ctx := context.Background()
+		if err := kirimReminder(ctx, db); err != nil {
+			log.Printf("Job janji temu: gagal mengirim reminder: %v", err)
+		}
+		if err := batalkanMobilTerjual(ctx, db); err != nil {
+			log.Printf("Job janji temu: gagal membatalkan janji temu: %v", err)
+		}
+	}
+}
+
+// kirimReminder menandai janji temu yang akan dimulai dan mengirim notifikasi satu kali
+func kirimReminder(ctx context.Context, db *sql.DB) error {
+	rows, err := db.QueryContext(ctx, `
+		UPDATE janji_temu j SET reminder_sent_at = NOW()
+		FROM jadwal_slot s, mobils m
+		WHERE s.id = j.slot_id AND m.id = j.mobil_id
+		  AND j.status = $1 AND j.reminder_sent_at IS NULL
+		  AND s.mulai > NOW() AND s.mulai <= $2
+		RETURNING j.pembeli_id, j.penjual_id, j.tipe, s.mulai, COALESCE(s.lokasi, m.lokasi, ''), m.merk, m.model
+	`, StatusDikonfirmasi, time.Now().Add(jedaReminder()))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	count := 0
+	for rows.Next() {
+		var pembeliID, penjualID, tipe, lokasi, merk, model string
+		var mulai time.Time
+		if err := rows.Scan(&pembeliID, &penjualID, &tipe, &mulai, &lokasi, &merk, &model); err != nil {
+			continue
+		}
+		count++
+		pesan := fmt.Sprintf("Pengingat: %s mobil %s %s pada %s di %s",
+			labelTipe(tipe), merk, model, mulai.Local().Format("02 Jan 2006 15:04"), lokasi)
+		go notifikasi.CreateNotification(db, context.Background(), pembeliID, "janji_temu", pesan)
+		go notifikasi.CreateNotification(db, context.Background(), penjualID, "janji_temu", pesan)
+	}
+
+	if count > 0 {
+		log.Printf("Job janji temu: %d reminder dikirim", count)
+	}
+	return rows.Err()
+}
+
+// batalkanMobilTerjual membatalkan janji temu yang akan datang jika mobilnya sudah
+// terjual, lalu membebaskan slotnya
+func batalkanMobilTerjual(ctx context.Context, db *sql.DB) error {
+	rows, err := db.QueryContext(ctx, `
+		WITH batal AS (
+			UPDATE janji_temu j SET status = $1, alasan_batal = 'Mobil sudah terjual', updated_at = NOW()
+			FROM jadwal_slot s, mobils m
+			WHERE s.id = j.slot_id AND m.id = j.mobil_id
+			  AND j.status = $2 AND s.mulai > NOW() AND m.status = $3
+			RETURNING j.slot_id, j.pembeli_id, m.merk, m.model
+		), slot AS (
+			UPDATE jadwal_slot SET status = CASE WHEN mobil_id IS NULL THEN $4 ELSE $5 END
+			WHERE id IN (SELECT slot_id FROM batal)
+		)
+		SELECT pembeli_id, merk, model FROM batal
+	`, StatusDibatalkan, StatusDikonfirmasi, transaksi.MobilTerjual, SlotTersedia, SlotDibatalkan)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pembeliID, merk, model string
+		if err := rows.Scan(&pembeliID, &merk, &model); err != nil {
+			continue
+		}
+		go notifikasi.CreateNotification(db, context.Background(), pembeliID, "janji_temu",
+			fmt.Sprintf("Janji temu untuk mobil %s %s dibatalkan karena mobil sudah terjual", merk, model))
+	}
+	return rows.Err()
+}
+
+// jedaReminder membaca jarak reminder sebelum janji temu dari env JANJI_TEMU_REMINDER_JAM (default 2 jam)
+func jedaReminder() time.Duration {
+	if jam, err := strconv.Atoi(os.Getenv("JANJI_TEMU_REMINDER_JAM")); err == nil && jam > 0 {
+		return time.Duration(jam) * time.Hour
+	}
+	return defaultJedaReminder
+}
+
+// PENJELASAN FILE janjitemu_job.go:
+// File ini berisi job terjadwal untuk janji temu
+//
+// Fungsi StartReminderJob:
+// - Dijalankan sebagai goroutine dari main.go dengan time.Ticker
+//
+// Fungsi kirimReminder:
+// - Janji temu 'dikonfirmasi' yang dimulai dalam JANJI_TEMU_REMINDER_JAM ke depan
+// - reminder_sent_at diisi di query yang sama sehingga reminder hanya terkirim sekali
+// - Notifikasi 'janji_temu' ke pembeli dan penjual
+//
+// Fungsi batalkanMobilTerjual:
+// - Janji temu yang akan datang untuk mobil yang sudah terjual dibatalkan otomatis
+// - Slot per mobil ditutup, slot lokasi dibuka lagi untuk mobil lain; pembeli diberi notifikasi
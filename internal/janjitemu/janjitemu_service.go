@@ -0,0 +1,521 @@
+package janjitemu
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"carapp.com/m/internal/auth"
+	"carapp.com/m/internal/notifikasi"
+	"carapp.com/m/internal/transaksi"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Status slot
+const (
+	SlotTersedia   = "tersedia"
+	SlotDipesan    = "dipesan"
+	SlotDibatalkan = "dibatalkan"
+)
+
+// Status janji temu
+const (
+	StatusDikonfirmasi = "dikonfirmasi"
+	StatusDibatalkan   = "dibatalkan"
+)
+
+// Tipe janji temu
+const (
+	TipeLihat     = "lihat"
+	TipeTestDrive = "test_drive"
+)
+
+const (
+	minDurasiSlot = 15 * time.Minute
+	maxDurasiSlot = 4 * time.Hour
+)
+
+// kolomJanjiTemu dipakai di semua SELECT agar urutan scan selalu sama
+const kolomJanjiTemu = `
+	j.id, j.slot_id, j.mobil_id, j.pembeli_id, j.penjual_id, j.tipe, j.status, j.catatan, j.alasan_batal,
+	s.mulai, s.selesai, COALESCE(s.lokasi, m.lokasi, ''), m.merk, m.model, j.created_at
+`
+
+// JanjiTemuServiceServer adalah implementasi dari pb.JanjiTemuServiceServer
+type JanjiTemuServiceServer struct {
+	pb.UnimplementedJanjiTemuServiceServer
+	DB *sql.DB
+}
+
+// NewJanjiTemuService membuat instance baru
+func NewJanjiTemuService(db *sql.DB) *JanjiTemuServiceServer {
+	return &JanjiTemuServiceServer{DB: db}
+}
+
+// CreateSlot membuka slot ketersediaan penjual
+func (s *JanjiTemuServiceServer) CreateSlot(ctx context.Context, req *pb.CreateSlotRequest) (*pb.SlotJadwal, error) {
+	penjualID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+
+	// 1. Validasi input
+	lokasi := strings.TrimSpace(req.Lokasi)
+	if (req.MobilId == "") == (lokasi == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "Isi salah satu: mobil_id atau lokasi")
+	}
+	if req.Mulai == nil || req.Selesai == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Waktu mulai dan selesai harus diisi")
+	}
+	mulai, selesai := req.Mulai.AsTime(), req.Selesai.AsTime()
+	durasi := selesai.Sub(mulai)
+	if durasi < minDurasiSlot || durasi > maxDurasiSlot {
+		return nil, status.Errorf(codes.InvalidArgument, "Durasi slot harus antara %v dan %v", minDurasiSlot, maxDurasiSlot)
+	}
+	if !mulai.After(time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "Slot harus di masa depan")
+	}
+
+	// 2. Slot per mobil hanya untuk mobil milik sendiri
+	if req.MobilId != "" {
+		var ownerID string
+		err := s.DB.QueryRowContext(ctx, `SELECT owner_id FROM mobils WHERE id = $1`, req.MobilId).Scan(&ownerID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, status.Errorf(codes.NotFound, "Mobil tidak ditemukan")
+			}
+			return nil, status.Errorf(codes.Internal, "Gagal mengecek mobil")
+		}
+		if ownerID != penjualID {
+			return nil, status.Errorf(codes.PermissionDenied, "Anda hanya bisa membuka slot untuk mobil Anda sendiri")
+		}
+	}
+
+	// 3. Tolak slot yang bertabrakan dengan slot aktif penjual di mobil/lokasi yang sama
+	var bentrok bool
+	err := s.DB.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM jadwal_slot
+			WHERE penjual_id = $1 AND status <> $2
+			  AND (mobil_id = NULLIF($3, '')::uuid OR LOWER(lokasi) = LOWER(NULLIF($4, '')))
+			  AND mulai < $6 AND selesai > $5
+		)
+	`, penjualID, SlotDibatalkan, req.MobilId, lokasi, mulai, selesai).Scan(&bentrok)
+	if err != nil {
+		log.Printf("Gagal cek slot bentrok: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan slot")
+	}
+	if bentrok {
+		return nil, status.Errorf(codes.AlreadyExists, "Slot bertabrakan dengan slot lain yang sudah ada")
+	}
+
+	// 4. Simpan slot
+	slot := pb.SlotJadwal{PenjualId: penjualID, MobilId: req.MobilId, Lokasi: lokasi, Status: SlotTersedia}
+	err = s.DB.QueryRowContext(ctx, `
+		INSERT INTO jadwal_slot (penjual_id, mobil_id, lokasi, mulai, selesai)
+		VALUES ($1, NULLIF($2, '')::uuid, NULLIF($3, ''), $4, $5)
+		RETURNING id
+	`, penjualID, req.MobilId, lokasi, mulai, selesai).Scan(&slot.Id)
+	if err != nil {
+		log.Printf("Gagal menyimpan slot: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan slot")
+	}
+	slot.Mulai = timestamppb.New(mulai)
+	slot.Selesai = timestamppb.New(selesai)
+
+	return &slot, nil
+}
+
+// ListSlot menampilkan slot yang masih tersedia (hanya yang akan datang)
+func (s *JanjiTemuServiceServer) ListSlot(ctx context.Context, req *pb.ListSlotRequest) (*pb.ListSlotResponse, error) {
+	query := `
+		SELECT s.id, s.penjual_id, COALESCE(s.mobil_id::text, ''), COALESCE(s.lokasi, ''), s.mulai, s.selesai, s.status
+		FROM jadwal_slot s
+		WHERE s.status = $1 AND s.mulai > NOW()`
+	args := []interface{}{SlotTersedia}
+
+	if req.MobilId != "" {
+		// Slot khusus mobil ini + slot lokasi dealer pemilik mobil
+		args = append(args, req.MobilId)
+		query += fmt.Sprintf(` AND (s.mobil_id = $%d OR EXISTS (
+			SELECT 1 FROM mobils m
+			WHERE m.id = $%d AND s.mobil_id IS NULL AND m.owner_id = s.penjual_id AND LOWER(m.lokasi) = LOWER(s.lokasi)
+		))`, len(args), len(args))
+	}
+	if req.PenjualId != "" {
+		args = append(args, req.PenjualId)
+		query += fmt.Sprintf(" AND s.penjual_id = $%d", len(args))
+	}
+	if req.Lokasi != "" {
+		args = append(args, req.Lokasi)
+		query += fmt.Sprintf(" AND LOWER(s.lokasi) = LOWER($%d)", len(args))
+	}
+	query += " ORDER BY s.mulai ASC LIMIT 200"
+
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Printf("Gagal query ListSlot: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil slot")
+	}
+	defer rows.Close()
+
+	var list []*pb.SlotJadwal
+	for rows.Next() {
+		var slot pb.SlotJadwal
+		var mulai, selesai time.Time
+		if err := rows.Scan(&slot.Id, &slot.PenjualId, &slot.MobilId, &slot.Lokasi, &mulai, &selesai, &slot.Status); err != nil {
+			log.Printf("Gagal scan slot: %v", err)
+			continue
+		}
+		slot.Mulai = timestamppb.New(mulai)
+		slot.Selesai = timestamppb.New(selesai)
+		list = append(list, &slot)
+	}
+
+	return &pb.ListSlotResponse{Slot: list}, nil
+}
+
+// DeleteSlot menutup slot milik penjual yang belum dipesan
+func (s *JanjiTemuServiceServer) DeleteSlot(ctx context.Context, req *pb.DeleteSlotRequest) (*emptypb.Empty, error) {
+	penjualID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+
+	res, err := s.DB.ExecContext(ctx, `
+		UPDATE jadwal_slot SET status = $1
+		WHERE id = $2 AND penjual_id = $3 AND status = $4
+	`, SlotDibatalkan, req.SlotId, penjualID, SlotTersedia)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menghapus slot")
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Slot tidak ditemukan atau sudah dipesan (batalkan janji temunya terlebih dahulu)")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// BookJanjiTemu memesan slot untuk melihat / test drive mobil
+func (s *JanjiTemuServiceServer) BookJanjiTemu(ctx context.Context, req *pb.BookJanjiTemuRequest) (*pb.JanjiTemu, error) {
+	pembeliID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.SlotId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "SlotID tidak boleh kosong")
+	}
+	tipe := req.Tipe
+	if tipe == "" {
+		tipe = TipeLihat
+	}
+	if tipe != TipeLihat && tipe != TipeTestDrive {
+		return nil, status.Errorf(codes.InvalidArgument, "Tipe harus lihat atau test_drive")
+	}
+
+	log.Printf("JanjiTemuService: BookJanjiTemu slot %s oleh %s (%s)", req.SlotId, pembeliID, tipe)
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal memulai transaksi DB")
+	}
+	defer tx.Rollback()
+
+	// 1. Kunci slot (PENTING: FOR UPDATE, mencegah dua pembeli memesan slot yang sama)
+	var penjualID, statusSlot string
+	var slotMobilID, slotLokasi sql.NullString
+	var mulai, selesai time.Time
+	err = tx.QueryRowContext(ctx, `
+		SELECT penjual_id, mobil_id, lokasi, mulai, selesai, status FROM jadwal_slot WHERE id = $1 FOR UPDATE
+	`, req.SlotId).Scan(&penjualID, &slotMobilID, &slotLokasi, &mulai, &selesai, &statusSlot)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "Slot tidak ditemukan")
+		}
+		return nil, status.Errorf(codes.Internal, "Gagal mengecek slot")
+	}
+	if statusSlot != SlotTersedia {
+		return nil, status.Errorf(codes.FailedPrecondition, "Slot sudah tidak tersedia")
+	}
+	if !mulai.After(time.Now()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Slot sudah lewat")
+	}
+	if penjualID == pembeliID {
+		return nil, status.Errorf(codes.FailedPrecondition, "Anda tidak bisa memesan slot Anda sendiri")
+	}
+
+	// 2. Tentukan mobil: slot per mobil memakai mobilnya, slot lokasi wajib pilih mobil
+	mobilID := slotMobilID.String
+	if !slotMobilID.Valid {
+		if req.MobilId == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "Pilih mobil yang ingin dilihat untuk slot lokasi ini")
+		}
+		mobilID = req.MobilId
+	}
+
+	// Mobil dikunci: slot per mobil dan slot lokasi bisa bertumpuk untuk mobil yang sama,
+	// sehingga pemesanan bersamaan lewat slot berbeda harus antre di baris mobil
+	var ownerID, statusMobil, lokasiMobil string
+	err = tx.QueryRowContext(ctx, `SELECT owner_id, status, COALESCE(lokasi, '') FROM mobils WHERE id = $1 FOR UPDATE`, mobilID).
+		Scan(&ownerID, &statusMobil, &lokasiMobil)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "Mobil tidak ditemukan")
+		}
+		return nil, status.Errorf(codes.Internal, "Gagal mengecek mobil")
+	}
+	if ownerID != penjualID {
+		return nil, status.Errorf(codes.InvalidArgument, "Mobil bukan milik penjual slot ini")
+	}
+	if slotLokasi.Valid && !strings.EqualFold(lokasiMobil, slotLokasi.String) {
+		return nil, status.Errorf(codes.InvalidArgument, "Mobil tidak berada di lokasi slot ini")
+	}
+	if statusMobil != transaksi.MobilTersedia {
+		return nil, status.Errorf(codes.FailedPrecondition, "Mobil saat ini tidak tersedia")
+	}
+
+	// Tolak jika mobil sudah punya janji temu terkonfirmasi yang bertabrakan (dari slot mana pun)
+	var bentrok bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM janji_temu j
+			JOIN jadwal_slot sl ON sl.id = j.slot_id
+			WHERE j.mobil_id = $1 AND j.status = $2 AND sl.mulai < $4 AND sl.selesai > $3
+		)
+	`, mobilID, StatusDikonfirmasi, mulai, selesai).Scan(&bentrok)
+	if err != nil {
+		log.Printf("Gagal cek janji temu bentrok mobil %s: %v", mobilID, err)
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan janji temu")
+	}
+	if bentrok {
+		return nil, status.Errorf(codes.FailedPrecondition, "Mobil sudah punya janji temu lain di waktu tersebut")
+	}
+
+	// 3. Simpan janji temu dan tandai slot dipesan
+	var janjiID string
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO janji_temu (slot_id, mobil_id, pembeli_id, penjual_id, tipe, status, catatan)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''))
+		RETURNING id
+	`, req.SlotId, mobilID, pembeliID, penjualID, tipe, StatusDikonfirmasi, req.Catatan).Scan(&janjiID)
+	if err != nil {
+		if strings.Contains(err.Error(), "unique constraint") {
+			return nil, status.Errorf(codes.FailedPrecondition, "Slot sudah tidak tersedia")
+		}
+		log.Printf("Gagal menyimpan janji temu: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan janji temu")
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE jadwal_slot SET status = $1 WHERE id = $2`, SlotDipesan, req.SlotId); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal update slot")
+	}
+
+	j, err := getJanjiTemu(ctx, tx, janjiID, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan janji temu")
+	}
+
+	// 4. Notifikasi penjual
+	go notifikasi.CreateNotification(s.DB, context.Background(), penjualID, "janji_temu",
+		fmt.Sprintf("Janji temu baru (%s) untuk mobil %s %s pada %s", labelTipe(j.Tipe), j.Merk, j.Model, formatWaktu(j.Mulai)))
+
+	return j, nil
+}
+
+// CancelJanjiTemu membatalkan janji temu (pembeli atau penjual), slot dibuka kembali jika belum lewat
+func (s *JanjiTemuServiceServer) CancelJanjiTemu(ctx context.Context, req *pb.CancelJanjiTemuRequest) (*pb.JanjiTemu, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.JanjiTemuId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "JanjiTemuID tidak boleh kosong")
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal memulai transaksi DB")
+	}
+	defer tx.Rollback()
+
+	j, err := getJanjiTemu(ctx, tx, req.JanjiTemuId, true)
+	if err != nil {
+		return nil, err
+	}
+	if userID != j.PembeliId && userID != j.PenjualId {
+		return nil, status.Errorf(codes.PermissionDenied, "Anda bukan bagian dari janji temu ini")
+	}
+	if j.Status != StatusDikonfirmasi {
+		return nil, status.Errorf(codes.FailedPrecondition, "Janji temu sudah %s", j.Status)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE janji_temu SET status = $1, alasan_batal = NULLIF($2, ''), updated_at = NOW() WHERE id = $3
+	`, StatusDibatalkan, req.Alasan, j.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal membatalkan janji temu")
+	}
+	// Slot yang belum lewat bisa dipesan pembeli lain
+	_, err = tx.ExecContext(ctx, `
+		UPDATE jadwal_slot SET status = CASE WHEN mulai > NOW() THEN $1 ELSE $2 END WHERE id = $3
+	`, SlotTersedia, SlotDibatalkan, j.SlotId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal update slot")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal membatalkan janji temu")
+	}
+
+	j.Status = StatusDibatalkan
+	j.AlasanBatal = req.Alasan
+
+	pihakLain := j.PenjualId
+	if userID == j.PenjualId {
+		pihakLain = j.PembeliId
+	}
+	go notifikasi.CreateNotification(s.DB, context.Background(), pihakLain, "janji_temu",
+		fmt.Sprintf("Janji temu untuk mobil %s %s pada %s dibatalkan", j.Merk, j.Model, formatWaktu(j.Mulai)))
+
+	return j, nil
+}
+
+// ListJanjiTemu menampilkan janji temu user sebagai pembeli atau penjual
+func (s *JanjiTemuServiceServer) ListJanjiTemu(ctx context.Context, req *pb.ListJanjiTemuRequest) (*pb.ListJanjiTemuResponse, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+
+	kolomUser := "j.pembeli_id"
+	switch req.Peran {
+	case "", "pembeli":
+	case "penjual":
+		kolomUser = "j.penjual_id"
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Peran harus pembeli atau penjual")
+	}
+
+	query := `SELECT ` + kolomJanjiTemu + `
+		FROM janji_temu j
+		JOIN jadwal_slot s ON s.id = j.slot_id
+		JOIN mobils m ON m.id = j.mobil_id
+		WHERE ` + kolomUser + ` = $1`
+	if !req.TermasukLampau {
+		query += " AND s.selesai > NOW()"
+	}
+	query += " ORDER BY s.mulai ASC LIMIT 100"
+
+	rows, err := s.DB.QueryContext(ctx, query, userID)
+	if err != nil {
+		log.Printf("Gagal query ListJanjiTemu: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil janji temu")
+	}
+	defer rows.Close()
+
+	var list []*pb.JanjiTemu
+	for rows.Next() {
+		j, err := scanJanjiTemu(rows)
+		if err != nil {
+			log.Printf("Gagal scan janji temu: %v", err)
+			continue
+		}
+		list = append(list, j)
+	}
+
+	return &pb.ListJanjiTemuResponse{JanjiTemu: list}, nil
+}
+
+// rowScanner adalah interface bersama *sql.Row dan *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanJanjiTemu membaca satu baris dengan urutan kolom kolomJanjiTemu
+func scanJanjiTemu(row rowScanner) (*pb.JanjiTemu, error) {
+	var j pb.JanjiTemu
+	var catatan, alasanBatal sql.NullString
+	var mulai, selesai, createdAt time.Time
+
+	err := row.Scan(&j.Id, &j.SlotId, &j.MobilId, &j.PembeliId, &j.PenjualId, &j.Tipe, &j.Status, &catatan, &alasanBatal,
+		&mulai, &selesai, &j.Lokasi, &j.Merk, &j.Model, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+	j.Catatan = catatan.String
+	j.AlasanBatal = alasanBatal.String
+	j.Mulai = timestamppb.New(mulai)
+	j.Selesai = timestamppb.New(selesai)
+	j.CreatedAt = timestamppb.New(createdAt)
+	return &j, nil
+}
+
+// getJanjiTemu mengambil satu janji temu di dalam tx (forUpdate mengunci baris janji temu)
+func getJanjiTemu(ctx context.Context, tx *sql.Tx, janjiID string, forUpdate bool) (*pb.JanjiTemu, error) {
+	query := `SELECT ` + kolomJanjiTemu + `
+		FROM janji_temu j
+		JOIN jadwal_slot s ON s.id = j.slot_id
+		JOIN mobils m ON m.id = j.mobil_id
+		WHERE j.id = $1`
+	if forUpdate {
+		query += " FOR UPDATE OF j"
+	}
+	j, err := scanJanjiTemu(tx.QueryRowContext(ctx, query, janjiID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "Janji temu tidak ditemukan")
+		}
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil janji temu")
+	}
+	return j, nil
+}
+
+// labelTipe mengubah tipe janji temu menjadi teks untuk notifikasi
+func labelTipe(tipe string) string {
+	if tipe == TipeTestDrive {
+		return "test drive"
+	}
+	return "lihat mobil"
+}
+
+// formatWaktu memformat waktu janji temu untuk notifikasi
+func formatWaktu(t *timestamppb.Timestamp) string {
+	return t.AsTime().Local().Format("02 Jan 2006 15:04")
+}
+
+// PENJELASAN FILE janjitemu_service.go:
+// File ini menangani jadwal test drive / lihat mobil sebelum membeli
+//
+// Model data:
+// - jadwal_slot: ketersediaan penjual, per mobil (mobil_id) atau per lokasi dealer (lokasi)
+// - mulai/selesai bertipe TIMESTAMPTZ (migrasi 024) sehingga perbandingan dengan NOW() tidak
+//   bergantung pada timezone sesi DB
+// - janji_temu: pemesanan satu slot oleh pembeli (tipe lihat/test_drive)
+//
+// Fungsi CreateSlot:
+// - Validasi durasi (15 menit - 4 jam), waktu di masa depan, mobil milik sendiri
+// - Tolak slot yang bertabrakan dengan slot aktif di mobil/lokasi yang sama
+//
+// Fungsi ListSlot: Slot 'tersedia' yang akan datang, filter mobil/penjual/lokasi
+// Fungsi DeleteSlot: Penjual menutup slot yang belum dipesan
+//
+// Fungsi BookJanjiTemu:
+// - Kunci slot (FOR UPDATE) seperti BuyMobil mengunci mobil, cek status 'tersedia'
+// - Slot lokasi: pembeli memilih mobil milik penjual di lokasi yang sama
+// - Mobil dikunci (FOR UPDATE) dan harus 'tersedia'; janji temu terkonfirmasi lain untuk mobil yang
+//   sama di waktu yang bertabrakan ditolak (slot per mobil dan slot lokasi bisa bertumpuk)
+// - Unique index slot aktif sebagai pengaman terakhir double-booking slot yang sama
+//
+// Fungsi CancelJanjiTemu: Pembeli/penjual membatalkan, slot dibuka lagi jika belum lewat
+// Fungsi ListJanjiTemu: Daftar janji temu sebagai pembeli/penjual
+//
+// Mobil tidak bisa dipesan (BuyMobil / terima penawaran) selama test drive yang dikonfirmasi
+// sedang berlangsung; pengecekannya ada di transaksi.reserveMobil.
+// Reminder dikirim oleh StartReminderJob (janjitemu_job.go).
@@ -0,0 +1,214 @@
+package kredit
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"carapp.com/m/internal/money"
+)
+
+// Skema bunga yang didukung
+const (
+	SkemaFlat    = "flat"    // Bunga dihitung dari pokok awal, angsuran sama tiap bulan
+	SkemaAnuitas = "anuitas" // Bunga efektif dari sisa pokok, angsuran tetap (pokok naik, bunga turun)
+)
+
+// Produk adalah baris tabel produk_kredit
+type Produk struct {
+	ID            string
+	Nama          string
+	Lembaga       string
+	BungaFlat     *big.Rat // Persen per tahun, nil = skema flat tidak tersedia
+	BungaEfektif  *big.Rat // Persen per tahun, nil = skema anuitas tidak tersedia
+	TenorMin      int
+	TenorMax      int
+	DPMinPersen   *big.Rat
+	BiayaAdmin    money.Money
+	Kondisi       string // "" = semua kondisi
+	UsiaMaksTahun int    // 0 = tanpa batas
+}
+
+// Angsuran adalah satu baris jadwal cicilan
+type Angsuran struct {
+	BulanKe   int
+	Angsuran  money.Money
+	Pokok     money.Money
+	Bunga     money.Money
+	SisaPokok money.Money
+}
+
+// ErrSkemaTidakTersedia dikembalikan jika produk tidak punya bunga untuk skema yang diminta
+var ErrSkemaTidakTersedia = errors.New("skema bunga tidak tersedia untuk produk ini")
+
+// BungaUntuk mengembalikan bunga per tahun (persen) untuk skema tertentu
+func (p Produk) BungaUntuk(skema string) (*big.Rat, error) {
+	var bunga *big.Rat
+	switch skema {
+	case SkemaFlat:
+		bunga = p.BungaFlat
+	case SkemaAnuitas:
+		bunga = p.BungaEfektif
+	default:
+		return nil, fmt.Errorf("skema %q tidak dikenal (flat/anuitas)", skema)
+	}
+	if bunga == nil {
+		return nil, ErrSkemaTidakTersedia
+	}
+	return bunga, nil
+}
+
+// CekMobil memeriksa aturan produk terhadap mobil: kondisi dan usia mobil saat kredit lunas
+func (p Produk) CekMobil(kondisi string, tahunMobil, tenorBulan int) error {
+	if p.Kondisi != "" && p.Kondisi != kondisi {
+		return fmt.Errorf("produk %s hanya untuk mobil %s", p.Nama, p.Kondisi)
+	}
+	if p.UsiaMaksTahun > 0 {
+		// Usia saat lunas = usia sekarang + tenor (dibulatkan ke atas per tahun)
+		usiaLunas := time.Now().Year() - tahunMobil + (tenorBulan+11)/12
+		if usiaLunas > p.UsiaMaksTahun {
+			return fmt.Errorf("usia mobil saat kredit lunas (%d tahun) melebihi batas produk %s (%d tahun)",
+				usiaLunas, p.Nama, p.UsiaMaksTahun)
+		}
+	}
+	return nil
+}
+
+// HitungDP menghitung uang muka dari persentase harga (dibulatkan ke rupiah terdekat)
+func HitungDP(harga money.Money, dpPersen *big.Rat) (money.Money, error) {
+	dp := new(big.Rat).Mul(harga.Rat(), dpPersen)
+	dp.Quo(dp, big.NewRat(100, 1))
+	return money.FromRat(dp, harga.Currency)
+}
+
+// HitungJadwal membuat jadwal angsuran untuk pokok pinjaman, bunga per tahun (persen), dan tenor.
+// Selisih pembulatan diserap angsuran terakhir sehingga total pokok selalu sama persis.
+func HitungJadwal(pokok money.Money, bungaPersen *big.Rat, tenor int, skema string) ([]Angsuran, error) {
+	if tenor <= 0 {
+		return nil, errors.New("tenor harus lebih dari 0")
+	}
+	bungaTahun := new(big.Rat).Quo(bungaPersen, big.NewRat(100, 1))
+
+	switch skema {
+	case SkemaFlat:
+		return jadwalFlat(pokok, bungaTahun, tenor)
+	case SkemaAnuitas:
+		return jadwalAnuitas(pokok, bungaTahun, tenor)
+	default:
+		return nil, fmt.Errorf("skema %q tidak dikenal (flat/anuitas)", skema)
+	}
+}
+
+// jadwalFlat: total bunga = pokok x bunga/tahun x tenor/12, dibagi rata per bulan
+func jadwalFlat(pokok money.Money, bungaTahun *big.Rat, tenor int) ([]Angsuran, error) {
+	n := big.NewRat(int64(tenor), 1)
+	totalBunga := new(big.Rat).Mul(pokok.Rat(), bungaTahun)
+	totalBunga.Mul(totalBunga, new(big.Rat).Quo(n, big.NewRat(12, 1)))
+	totalBungaMoney, err := money.FromRat(totalBunga, pokok.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	pokokBulanan, err := money.FromRat(new(big.Rat).Quo(pokok.Rat(), n), pokok.Currency)
+	if err != nil {
+		return nil, err
+	}
+	bungaBulanan, err := money.FromRat(new(big.Rat).Quo(totalBungaMoney.Rat(), n), pokok.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	jadwal := make([]Angsuran, 0, tenor)
+	sisaPokok, sisaBunga := pokok.Minor, totalBungaMoney.Minor
+	for bulan := 1; bulan <= tenor; bulan++ {
+		p, b := pokokBulanan.Minor, bungaBulanan.Minor
+		if bulan == tenor {
+			p, b = sisaPokok, sisaBunga
+		}
+		sisaPokok -= p
+		sisaBunga -= b
+		jadwal = append(jadwal, baris(bulan, p, b, sisaPokok, pokok.Currency))
+	}
+	return jadwal, nil
+}
+
+// jadwalAnuitas: angsuran = P x i / (1 - (1+i)^-n), bunga tiap bulan = sisa pokok x i
+func jadwalAnuitas(pokok money.Money, bungaTahun *big.Rat, tenor int) ([]Angsuran, error) {
+	i := new(big.Rat).Quo(bungaTahun, big.NewRat(12, 1))
+	if i.Sign() == 0 {
+		return jadwalFlat(pokok, i, tenor)
+	}
+
+	// (1+i)^n dihitung persis dengan big.Rat (tenor maksimal beberapa ratus bulan)
+	faktor := big.NewRat(1, 1)
+	satuPlusI := new(big.Rat).Add(big.NewRat(1, 1), i)
+	for k := 0; k < tenor; k++ {
+		faktor.Mul(faktor, satuPlusI)
+	}
+	angsuran := new(big.Rat).Mul(pokok.Rat(), i)
+	angsuran.Mul(angsuran, faktor)
+	angsuran.Quo(angsuran, new(big.Rat).Sub(faktor, big.NewRat(1, 1)))
+	angsuranMoney, err := money.FromRat(angsuran, pokok.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	jadwal := make([]Angsuran, 0, tenor)
+	sisaPokok := pokok
+	for bulan := 1; bulan <= tenor; bulan++ {
+		bunga, err := money.FromRat(new(big.Rat).Mul(sisaPokok.Rat(), i), pokok.Currency)
+		if err != nil {
+			return nil, err
+		}
+		p := angsuranMoney.Minor - bunga.Minor
+		if bulan == tenor || p > sisaPokok.Minor {
+			p = sisaPokok.Minor
+		}
+		sisaPokok.Minor -= p
+		jadwal = append(jadwal, baris(bulan, p, bunga.Minor, sisaPokok.Minor, pokok.Currency))
+	}
+	return jadwal, nil
+}
+
+func baris(bulan int, pokok, bunga, sisa int64, currency string) Angsuran {
+	return Angsuran{
+		BulanKe:   bulan,
+		Angsuran:  money.New(pokok+bunga, currency),
+		Pokok:     money.New(pokok, currency),
+		Bunga:     money.New(bunga, currency),
+		SisaPokok: money.New(sisa, currency),
+	}
+}
+
+// formatPersen menulis persen tanpa nol berlebih (contoh 6.500 -> "6.5")
+func formatPersen(r *big.Rat) string {
+	if r == nil {
+		return ""
+	}
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	s := r.FloatString(3)
+	for s[len(s)-1] == '0' {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+// PENJELASAN FILE kredit.go:
+// File ini berisi perhitungan simulasi kredit mobil (tanpa akses database)
+//
+// Skema bunga:
+// - flat: total bunga = pokok x bunga/tahun x tenor/12, angsuran sama setiap bulan
+// - anuitas (efektif): angsuran tetap = P x i / (1 - (1+i)^-n) dengan i = bunga/tahun / 12;
+//   bunga bulan ke-k = sisa pokok x i sehingga porsi bunga turun dan porsi pokok naik
+//
+// Presisi:
+// - Semua hitungan memakai big.Rat lalu dibulatkan ke minor unit (money.FromRat)
+// - Selisih pembulatan diserap angsuran terakhir: jumlah porsi pokok = pokok pinjaman persis
+//
+// Aturan produk:
+// - BungaUntuk: skema hanya tersedia jika bunga skema tsb diisi di produk_kredit
+// - CekMobil: kondisi mobil (baru/bekas) dan usia mobil saat kredit lunas
+// - DP minimal dan rentang tenor dicek di kredit_service.go
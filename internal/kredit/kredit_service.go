@@ -0,0 +1,272 @@
+package kredit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"carapp.com/m/internal/money"
+	"carapp.com/m/internal/pencarian"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// KreditServiceServer adalah implementasi dari pb.KreditServiceServer
+type KreditServiceServer struct {
+	pb.UnimplementedKreditServiceServer
+	DB *sql.DB
+}
+
+// NewKreditService membuat instance baru dari KreditServiceServer
+func NewKreditService(db *sql.DB) *KreditServiceServer {
+	return &KreditServiceServer{DB: db}
+}
+
+// mobilKredit adalah data mobil yang dibutuhkan untuk simulasi
+type mobilKredit struct {
+	Harga   money.Money
+	Kondisi string
+	Tahun   int
+	Status  string
+}
+
+// rowScanner bisa *sql.Row atau *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+const kolomProduk = `
+	id, nama, lembaga, bunga_flat_persen::text, bunga_efektif_persen::text,
+	tenor_min_bulan, tenor_max_bulan, dp_min_persen::text, biaya_admin, kondisi, usia_maks_tahun
+`
+
+// ListProdukKredit menampilkan produk kredit aktif
+func (s *KreditServiceServer) ListProdukKredit(ctx context.Context, req *pb.ListProdukKreditRequest) (*pb.ListProdukKreditResponse, error) {
+	// 1. Jika mobil_id diisi, hanya produk yang cocok dengan mobil tsb
+	var mobil *mobilKredit
+	if req.MobilId != "" {
+		var err error
+		mobil, err = s.getMobil(ctx, req.MobilId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := s.DB.QueryContext(ctx, `SELECT `+kolomProduk+` FROM produk_kredit WHERE aktif = TRUE ORDER BY lembaga, nama`)
+	if err != nil {
+		log.Printf("Gagal query ListProdukKredit: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil produk kredit")
+	}
+	defer rows.Close()
+
+	var daftar []*pb.ProdukKredit
+	for rows.Next() {
+		p, err := scanProduk(rows)
+		if err != nil {
+			log.Printf("Gagal scan produk kredit: %v", err)
+			continue
+		}
+		// Tenor minimal dipakai untuk cek usia mobil (tenor lebih panjang bisa saja ditolak saat simulasi)
+		if mobil != nil && p.CekMobil(mobil.Kondisi, mobil.Tahun, p.TenorMin) != nil {
+			continue
+		}
+		daftar = append(daftar, produkToProto(p))
+	}
+
+	return &pb.ListProdukKreditResponse{Produk: daftar}, nil
+}
+
+// SimulateKredit menghitung DP, angsuran, dan jadwal cicilan untuk satu mobil
+func (s *KreditServiceServer) SimulateKredit(ctx context.Context, req *pb.SimulateKreditRequest) (*pb.SimulasiKredit, error) {
+	log.Printf("KreditService: SimulateKredit dipanggil untuk MobilID %s", req.MobilId)
+
+	// 1. Validasi input dasar
+	if req.MobilId == "" || req.ProdukId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "MobilID dan ProdukID harus diisi")
+	}
+	skema := strings.ToLower(strings.TrimSpace(req.Skema))
+	if skema == "" {
+		skema = SkemaAnuitas
+	}
+	if req.DpPersen < 0 || req.DpPersen >= 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "DP harus antara 0 dan kurang dari 100 persen")
+	}
+	// Lewat teks desimal agar 20.1 tetap 20.1 (bukan pecahan biner float)
+	dpPersen, ok := new(big.Rat).SetString(strconv.FormatFloat(req.DpPersen, 'f', -1, 64))
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "DP tidak valid")
+	}
+
+	// 2. Ambil mobil dan produk
+	mobil, err := s.getMobil(ctx, req.MobilId)
+	if err != nil {
+		return nil, err
+	}
+	if mobil.Status == "terjual" {
+		return nil, status.Errorf(codes.FailedPrecondition, "Mobil sudah terjual")
+	}
+
+	produk, err := scanProduk(s.DB.QueryRowContext(ctx,
+		`SELECT `+kolomProduk+` FROM produk_kredit WHERE id = $1 AND aktif = TRUE`, req.ProdukId))
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "Produk kredit tidak ditemukan")
+	}
+	if err != nil {
+		log.Printf("Gagal query produk kredit: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil produk kredit")
+	}
+
+	// 3. Validasi aturan produk: skema, tenor, DP minimal, kondisi & usia mobil
+	bunga, err := produk.BungaUntuk(skema)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	tenor := int(req.TenorBulan)
+	if tenor < produk.TenorMin || tenor > produk.TenorMax {
+		return nil, status.Errorf(codes.InvalidArgument, "Tenor produk %s harus %d-%d bulan",
+			produk.Nama, produk.TenorMin, produk.TenorMax)
+	}
+	if dpPersen.Cmp(produk.DPMinPersen) < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "DP minimal untuk produk %s adalah %s%%",
+			produk.Nama, formatPersen(produk.DPMinPersen))
+	}
+	if err := produk.CekMobil(mobil.Kondisi, mobil.Tahun, tenor); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	// 4. Hitung DP, pokok pinjaman, dan jadwal angsuran
+	dp, err := HitungDP(mobil.Harga, dpPersen)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Gagal menghitung DP: %v", err)
+	}
+	pokok := money.New(mobil.Harga.Minor-dp.Minor, mobil.Harga.Currency)
+	jadwal, err := HitungJadwal(pokok, bunga, tenor, skema)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Gagal menghitung angsuran: %v", err)
+	}
+
+	// 5. Ringkasan biaya
+	var totalBunga, totalAngsuran int64
+	pbJadwal := make([]*pb.AngsuranKredit, 0, len(jadwal))
+	for _, a := range jadwal {
+		totalBunga += a.Bunga.Minor
+		totalAngsuran += a.Angsuran.Minor
+		pbJadwal = append(pbJadwal, &pb.AngsuranKredit{
+			BulanKe:   int32(a.BulanKe),
+			Angsuran:  a.Angsuran.ToProto(),
+			Pokok:     a.Pokok.ToProto(),
+			Bunga:     a.Bunga.ToProto(),
+			SisaPokok: a.SisaPokok.ToProto(),
+		})
+	}
+	currency := mobil.Harga.Currency
+	pembayaranPertama := dp.Minor + produk.BiayaAdmin.Minor
+
+	return &pb.SimulasiKredit{
+		MobilId:             req.MobilId,
+		Produk:              produkToProto(produk),
+		Skema:               skema,
+		BungaPerTahunPersen: formatPersen(bunga),
+		TenorBulan:          int32(tenor),
+		Harga:               mobil.Harga.ToProto(),
+		Dp:                  dp.ToProto(),
+		PokokPinjaman:       pokok.ToProto(),
+		AngsuranPerBulan:    jadwal[0].Angsuran.ToProto(),
+		TotalBunga:          money.New(totalBunga, currency).ToProto(),
+		BiayaAdmin:          produk.BiayaAdmin.ToProto(),
+		PembayaranPertama:   money.New(pembayaranPertama, currency).ToProto(),
+		TotalBayar:          money.New(pembayaranPertama+totalAngsuran, currency).ToProto(),
+		Jadwal:              pbJadwal,
+	}, nil
+}
+
+// getMobil mengambil harga (IDR), kondisi, dan tahun mobil.
+// Listing draft / menunggu moderasi / ditolak belum publik, diperlakukan sebagai tidak ada.
+func (s *KreditServiceServer) getMobil(ctx context.Context, mobilID string) (*mobilKredit, error) {
+	var m mobilKredit
+	var kondisi sql.NullString
+	err := s.DB.QueryRowContext(ctx, `
+		SELECT harga_jual, kondisi, tahun, status FROM mobils WHERE id = $1
+	`, mobilID).Scan(&m.Harga, &kondisi, &m.Tahun, &m.Status)
+	if err == sql.ErrNoRows || (err == nil && pencarian.StatusPrivat(m.Status)) {
+		return nil, status.Errorf(codes.NotFound, "Mobil tidak ditemukan")
+	}
+	if err != nil {
+		log.Printf("Gagal query mobil untuk kredit: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil data mobil")
+	}
+	m.Kondisi = kondisi.String
+	return &m, nil
+}
+
+// scanProduk membaca satu baris produk_kredit (kolomProduk)
+func scanProduk(row rowScanner) (Produk, error) {
+	var p Produk
+	var flat, efektif, kondisi sql.NullString
+	var dpMin string
+	var usiaMaks sql.NullInt32
+
+	err := row.Scan(&p.ID, &p.Nama, &p.Lembaga, &flat, &efektif,
+		&p.TenorMin, &p.TenorMax, &dpMin, &p.BiayaAdmin, &kondisi, &usiaMaks)
+	if err != nil {
+		return Produk{}, err
+	}
+
+	var ok bool
+	if p.DPMinPersen, ok = new(big.Rat).SetString(dpMin); !ok {
+		return Produk{}, fmt.Errorf("dp_min_persen %q tidak valid", dpMin)
+	}
+	if flat.Valid {
+		if p.BungaFlat, ok = new(big.Rat).SetString(flat.String); !ok {
+			return Produk{}, fmt.Errorf("bunga_flat_persen %q tidak valid", flat.String)
+		}
+	}
+	if efektif.Valid {
+		if p.BungaEfektif, ok = new(big.Rat).SetString(efektif.String); !ok {
+			return Produk{}, fmt.Errorf("bunga_efektif_persen %q tidak valid", efektif.String)
+		}
+	}
+	p.Kondisi = kondisi.String
+	p.UsiaMaksTahun = int(usiaMaks.Int32)
+	return p, nil
+}
+
+func produkToProto(p Produk) *pb.ProdukKredit {
+	return &pb.ProdukKredit{
+		Id:                 p.ID,
+		Nama:               p.Nama,
+		Lembaga:            p.Lembaga,
+		BungaFlatPersen:    formatPersen(p.BungaFlat),
+		BungaEfektifPersen: formatPersen(p.BungaEfektif),
+		TenorMinBulan:      int32(p.TenorMin),
+		TenorMaxBulan:      int32(p.TenorMax),
+		DpMinPersen:        formatPersen(p.DPMinPersen),
+		BiayaAdmin:         p.BiayaAdmin.ToProto(),
+		Kondisi:            p.Kondisi,
+		UsiaMaksTahun:      int32(p.UsiaMaksTahun),
+	}
+}
+
+// PENJELASAN FILE kredit_service.go:
+// File ini berisi implementasi KreditService (Service 10) untuk simulasi cicilan
+//
+// Fungsi ListProdukKredit:
+// - Produk kredit aktif dari tabel produk_kredit (dikelola lewat database)
+// - Jika mobil_id diisi, produk yang tidak cocok (kondisi / usia mobil) disembunyikan
+//
+// Fungsi SimulateKredit:
+// - Input: mobil_id, produk_id, dp_persen, tenor_bulan, skema (flat/anuitas, default anuitas)
+// - Validasi: skema tersedia di produk, tenor dalam rentang produk, DP >= dp_min_persen,
+//   kondisi mobil sesuai dan usia mobil saat lunas tidak melebihi batas produk
+// - Hitung: DP dari harga_jual (IDR), pokok = harga - DP, jadwal angsuran (kredit.go)
+// - Ringkasan: total bunga, pembayaran pertama (DP + admin), total bayar
+//
+// Catatan:
+// - RPC publik (tidak perlu login), sama seperti ListMobil/GetMobil
+// - Listing privat (draft / menunggu moderasi / ditolak) dibalas NotFound, sama seperti harga pasar
+// - Hasil simulasi tidak disimpan; angka final tetap dari leasing
@@ -0,0 +1,172 @@
+package kredit
+
+import (
+	"math/big"
+	"testing"
+
+	"carapp.com/m/internal/money"
+)
+
+// cekInvarian memastikan sifat yang berlaku untuk semua jadwal: jumlah porsi pokok = pokok pinjaman,
+// sisa pokok terakhir nol, angsuran = pokok + bunga, dan nomor bulan berurutan
+func cekInvarian(t *testing.T, pokok money.Money, jadwal []Angsuran, tenor int) {
+	t.Helper()
+	if len(jadwal) != tenor {
+		t.Fatalf("jumlah baris = %d, ingin %d", len(jadwal), tenor)
+	}
+	var totalPokok int64
+	for i, a := range jadwal {
+		if a.BulanKe != i+1 {
+			t.Errorf("baris %d: BulanKe = %d", i, a.BulanKe)
+		}
+		if a.Angsuran.Minor != a.Pokok.Minor+a.Bunga.Minor {
+			t.Errorf("bulan %d: angsuran %s != pokok %s + bunga %s", a.BulanKe, a.Angsuran, a.Pokok, a.Bunga)
+		}
+		if a.Pokok.Currency != pokok.Currency {
+			t.Errorf("bulan %d: mata uang %q, ingin %q", a.BulanKe, a.Pokok.Currency, pokok.Currency)
+		}
+		totalPokok += a.Pokok.Minor
+	}
+	if totalPokok != pokok.Minor {
+		t.Errorf("total porsi pokok = %d, ingin %d", totalPokok, pokok.Minor)
+	}
+	if sisa := jadwal[len(jadwal)-1].SisaPokok; sisa.Minor != 0 {
+		t.Errorf("sisa pokok terakhir = %s, ingin 0", sisa)
+	}
+}
+
+func TestHitungJadwal(t *testing.T) {
+	tests := []struct {
+		nama  string
+		pokok money.Money
+		bunga *big.Rat // Persen per tahun
+		tenor int
+		skema string
+		// Baris pertama dan terakhir yang diharapkan (minor unit)
+		wantPertama, wantTerakhir Angsuran
+	}{
+		{
+			// Total bunga 100jt x 10% x 7/12 = 5.833.333,33; pokok 14.285.714,29 x 6 + sisa 14.285.714,26
+			nama:  "flat, pokok tidak habis dibagi tenor",
+			pokok: money.New(100_000_000_00, "IDR"), bunga: big.NewRat(10, 1), tenor: 7, skema: SkemaFlat,
+			wantPertama:  baris(1, 14_285_714_29, 833_333_33, 85_714_285_71, "IDR"),
+			wantTerakhir: baris(7, 14_285_714_26, 833_333_35, 0, "IDR"),
+		},
+		{
+			nama:  "flat, bunga pecahan 6.5%",
+			pokok: money.New(120_000_000_00, "IDR"), bunga: big.NewRat(13, 2), tenor: 36, skema: SkemaFlat,
+			wantPertama:  baris(1, 3_333_333_33, 650_000_00, 116_666_666_67, "IDR"),
+			wantTerakhir: baris(36, 3_333_333_45, 650_000_00, 0, "IDR"),
+		},
+		{
+			// Nilai acuan: PMT(1%, 12, -100.000.000) = 8.884.878,87
+			nama:  "anuitas 12% 12 bulan sesuai rumus PMT",
+			pokok: money.New(100_000_000_00, "IDR"), bunga: big.NewRat(12, 1), tenor: 12, skema: SkemaAnuitas,
+			wantPertama: baris(1, 7_884_878_87, 1_000_000_00, 92_115_121_13, "IDR"),
+		},
+		{
+			nama:  "anuitas bunga 0% sama dengan cicilan pokok rata",
+			pokok: money.New(120_000_000_00, "IDR"), bunga: new(big.Rat), tenor: 12, skema: SkemaAnuitas,
+			wantPertama:  baris(1, 10_000_000_00, 0, 110_000_000_00, "IDR"),
+			wantTerakhir: baris(12, 10_000_000_00, 0, 0, "IDR"),
+		},
+		{
+			nama:  "tenor 1 bulan",
+			pokok: money.New(50_000_000_00, "IDR"), bunga: big.NewRat(12, 1), tenor: 1, skema: SkemaAnuitas,
+			wantPertama:  baris(1, 50_000_000_00, 500_000_00, 0, "IDR"),
+			wantTerakhir: baris(1, 50_000_000_00, 500_000_00, 0, "IDR"),
+		},
+		{
+			nama:  "mata uang tanpa minor unit (JPY)",
+			pokok: money.New(1_000_000, "JPY"), bunga: big.NewRat(6, 1), tenor: 3, skema: SkemaFlat,
+			wantPertama:  baris(1, 333_333, 5_000, 666_667, "JPY"),
+			wantTerakhir: baris(3, 333_334, 5_000, 0, "JPY"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.nama, func(t *testing.T) {
+			jadwal, err := HitungJadwal(tt.pokok, tt.bunga, tt.tenor, tt.skema)
+			if err != nil {
+				t.Fatalf("error tidak diharapkan: %v", err)
+			}
+			cekInvarian(t, tt.pokok, jadwal, tt.tenor)
+			if got := jadwal[0]; got != tt.wantPertama {
+				t.Errorf("baris pertama = %+v, ingin %+v", got, tt.wantPertama)
+			}
+			if tt.wantTerakhir.BulanKe != 0 {
+				if got := jadwal[len(jadwal)-1]; got != tt.wantTerakhir {
+					t.Errorf("baris terakhir = %+v, ingin %+v", got, tt.wantTerakhir)
+				}
+			}
+		})
+	}
+}
+
+func TestJadwalAnuitasAngsuranTetap(t *testing.T) {
+	// Angsuran tetap kecuali baris terakhir (menyerap selisih pembulatan),
+	// porsi bunga turun dan porsi pokok naik setiap bulan
+	pokok := money.New(250_000_000_00, "IDR")
+	jadwal, err := jadwalAnuitas(pokok, big.NewRat(9, 100), 60)
+	if err != nil {
+		t.Fatalf("error tidak diharapkan: %v", err)
+	}
+	cekInvarian(t, pokok, jadwal, 60)
+
+	angsuran := jadwal[0].Angsuran
+	for _, a := range jadwal[1 : len(jadwal)-1] {
+		if a.Angsuran != angsuran {
+			t.Errorf("bulan %d: angsuran %s, ingin tetap %s", a.BulanKe, a.Angsuran, angsuran)
+		}
+	}
+	if selisih := jadwal[len(jadwal)-1].Angsuran.Minor - angsuran.Minor; selisih < -60 || selisih > 60 {
+		t.Errorf("angsuran terakhir beda %d minor unit dari angsuran tetap", selisih)
+	}
+	for k := 1; k < len(jadwal); k++ {
+		if jadwal[k].Bunga.Minor > jadwal[k-1].Bunga.Minor {
+			t.Errorf("bulan %d: bunga %s naik dari %s", jadwal[k].BulanKe, jadwal[k].Bunga, jadwal[k-1].Bunga)
+		}
+	}
+}
+
+func TestJadwalFlatBungaNol(t *testing.T) {
+	pokok := money.New(10_000_000_00, "IDR")
+	jadwal, err := jadwalFlat(pokok, new(big.Rat), 3)
+	if err != nil {
+		t.Fatalf("error tidak diharapkan: %v", err)
+	}
+	cekInvarian(t, pokok, jadwal, 3)
+	for _, a := range jadwal {
+		if a.Bunga.Minor != 0 {
+			t.Errorf("bulan %d: bunga %s, ingin 0", a.BulanKe, a.Bunga)
+		}
+	}
+}
+
+func TestHitungJadwalInputSalah(t *testing.T) {
+	pokok := money.New(100_000_000_00, "IDR")
+	tests := []struct {
+		nama  string
+		tenor int
+		skema string
+	}{
+		{"tenor nol", 0, SkemaFlat},
+		{"tenor negatif", -12, SkemaAnuitas},
+		{"skema tidak dikenal", 12, "balon"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.nama, func(t *testing.T) {
+			if _, err := HitungJadwal(pokok, big.NewRat(10, 1), tt.tenor, tt.skema); err == nil {
+				t.Error("ingin error, dapat nil")
+			}
+		})
+	}
+}
+
+// PENJELASAN FILE kredit_test.go:
+// Test table-driven untuk jadwal angsuran (fungsi murni, tanpa DB)
+// - Invarian semua jadwal: total porsi pokok = pokok pinjaman persis, sisa pokok akhir nol
+// - Flat: selisih pembulatan pokok & bunga diserap angsuran terakhir
+// - Anuitas: angsuran pertama dicek terhadap nilai acuan PMT, bunga 0% jatuh ke cicilan rata,
+//   angsuran tetap dengan porsi bunga menurun
+// - Tenor <= 0 dan skema tidak dikenal ditolak
@@ -0,0 +1,265 @@
+package kurs
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"time"
+
+	"carapp.com/m/internal/money"
+)
+
+// FormatTanggal adalah format kolom berlaku_mulai di CSV dan proto
+const FormatTanggal = "2006-01-02"
+
+// ErrKursTidakAda dikembalikan jika tidak ada kurs yang berlaku untuk pasangan mata uang
+var ErrKursTidakAda = errors.New("kurs tidak tersedia")
+
+// Kurs adalah satu baris tabel kurs: 1 Asal = Rate Tujuan mulai tanggal BerlakuMulai
+type Kurs struct {
+	Asal         string
+	Tujuan       string
+	Rate         *big.Rat
+	BerlakuMulai time.Time
+}
+
+// RateString mengembalikan rate sebagai desimal persis (tanpa nol berlebih)
+func (k Kurs) RateString() string {
+	return formatRate(k.Rate)
+}
+
+// RateBerlaku mencari rate asal->tujuan yang berlaku pada tanggal tertentu
+// (berlaku_mulai terbaru yang <= tanggal). Urutan pencarian:
+// 1. Mata uang sama -> 1
+// 2. Kurs langsung asal->tujuan
+// 3. Kebalikan kurs tujuan->asal
+// 4. Silang lewat IDR (asal->IDR->tujuan)
+func RateBerlaku(ctx context.Context, db *sql.DB, asal, tujuan string, tanggal time.Time) (*big.Rat, error) {
+	if asal == tujuan {
+		return big.NewRat(1, 1), nil
+	}
+
+	rate, err := rateLangsung(ctx, db, asal, tujuan, tanggal)
+	if err != ErrKursTidakAda {
+		return rate, err
+	}
+
+	if asal != money.DefaultCurrency && tujuan != money.DefaultCurrency {
+		keIDR, errKe := rateLangsung(ctx, db, asal, money.DefaultCurrency, tanggal)
+		dariIDR, errDari := rateLangsung(ctx, db, money.DefaultCurrency, tujuan, tanggal)
+		if errKe == nil && errDari == nil {
+			return new(big.Rat).Mul(keIDR, dariIDR), nil
+		}
+		for _, e := range []error{errKe, errDari} {
+			if e != nil && e != ErrKursTidakAda {
+				return nil, e
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: %s -> %s", ErrKursTidakAda, asal, tujuan)
+}
+
+// rateLangsung mencari kurs asal->tujuan atau kebalikannya (tanpa kurs silang)
+func rateLangsung(ctx context.Context, db *sql.DB, asal, tujuan string, tanggal time.Time) (*big.Rat, error) {
+	var rateText string
+	var dibalik bool
+	err := db.QueryRowContext(ctx, `
+		SELECT rate::text, mata_uang_asal <> $1
+		FROM kurs
+		WHERE ((mata_uang_asal = $1 AND mata_uang_tujuan = $2) OR (mata_uang_asal = $2 AND mata_uang_tujuan = $1))
+		  AND berlaku_mulai <= $3
+		ORDER BY berlaku_mulai DESC, (mata_uang_asal = $1) DESC
+		LIMIT 1
+	`, asal, tujuan, tanggal.Format(FormatTanggal)).Scan(&rateText, &dibalik)
+	if err == sql.ErrNoRows {
+		return nil, ErrKursTidakAda
+	}
+	if err != nil {
+		return nil, fmt.Errorf("gagal mengambil kurs: %w", err)
+	}
+
+	rate, err := money.ParseRate(rateText)
+	if err != nil {
+		return nil, fmt.Errorf("kurs %s -> %s tidak valid: %w", asal, tujuan, err)
+	}
+	if dibalik {
+		rate.Inv(rate)
+	}
+	return rate, nil
+}
+
+// Konverter mengonversi banyak harga dengan kurs pada satu tanggal. Rate di-cache per
+// pasangan mata uang agar ListMobil tidak query kurs untuk setiap baris.
+type Konverter struct {
+	db      *sql.DB
+	tanggal time.Time
+	cache   map[[2]string]*big.Rat
+}
+
+// NewKonverter membuat Konverter dengan kurs yang berlaku pada tanggal tertentu
+func NewKonverter(db *sql.DB, tanggal time.Time) *Konverter {
+	return &Konverter{db: db, tanggal: tanggal, cache: make(map[[2]string]*big.Rat)}
+}
+
+// Konversi mengubah harga ke mata uang tujuan
+func (k *Konverter) Konversi(ctx context.Context, harga money.Money, tujuan string) (money.Money, error) {
+	asal := harga.Currency
+	if asal == "" {
+		asal = money.DefaultCurrency
+	}
+	if asal == tujuan {
+		return harga, nil
+	}
+
+	kunci := [2]string{asal, tujuan}
+	rate, ok := k.cache[kunci]
+	if !ok {
+		var err error
+		rate, err = RateBerlaku(ctx, k.db, asal, tujuan, k.tanggal)
+		if err != nil {
+			return money.Money{}, err
+		}
+		k.cache[kunci] = rate
+	}
+	return harga.Convert(rate, tujuan)
+}
+
+// ParseCSV membaca CSV kurs dengan header mata_uang_asal,mata_uang_tujuan,rate,berlaku_mulai.
+// Baris yang tidak valid dikumpulkan di errs (dengan nomor baris) tanpa menghentikan parsing.
+func ParseCSV(r io.Reader) (daftar []Kurs, errs []string, err error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil, errors.New("CSV kosong")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("gagal membaca header CSV: %w", err)
+	}
+
+	// 1. Petakan kolom dari header (urutan kolom bebas)
+	kolom := map[string]int{}
+	for i, h := range header {
+		kolom[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))] = i
+	}
+	for _, wajib := range []string{"mata_uang_asal", "mata_uang_tujuan", "rate", "berlaku_mulai"} {
+		if _, ok := kolom[wajib]; !ok {
+			return nil, nil, fmt.Errorf("kolom %s tidak ada di header CSV", wajib)
+		}
+	}
+
+	// 2. Validasi setiap baris
+	baris := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		baris++
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("baris %d: %v", baris, err))
+			continue
+		}
+
+		k, err := parseBaris(record, kolom)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("baris %d: %v", baris, err))
+			continue
+		}
+		daftar = append(daftar, k)
+	}
+	return daftar, errs, nil
+}
+
+// parseBaris memvalidasi satu baris CSV
+func parseBaris(record []string, kolom map[string]int) (Kurs, error) {
+	ambil := func(nama string) string {
+		if i := kolom[nama]; i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	asal := strings.ToUpper(ambil("mata_uang_asal"))
+	tujuan := strings.ToUpper(ambil("mata_uang_tujuan"))
+	if !money.IsSupported(asal) {
+		return Kurs{}, fmt.Errorf("mata uang asal %q tidak dikenal", asal)
+	}
+	if !money.IsSupported(tujuan) {
+		return Kurs{}, fmt.Errorf("mata uang tujuan %q tidak dikenal", tujuan)
+	}
+	if asal == tujuan {
+		return Kurs{}, errors.New("mata uang asal dan tujuan sama")
+	}
+
+	rate, err := money.ParseRate(ambil("rate"))
+	if err != nil {
+		return Kurs{}, fmt.Errorf("rate %q tidak valid (harus angka > 0)", ambil("rate"))
+	}
+
+	tanggal, err := time.Parse(FormatTanggal, ambil("berlaku_mulai"))
+	if err != nil {
+		return Kurs{}, fmt.Errorf("berlaku_mulai %q harus berformat YYYY-MM-DD", ambil("berlaku_mulai"))
+	}
+
+	return Kurs{Asal: asal, Tujuan: tujuan, Rate: rate, BerlakuMulai: tanggal}, nil
+}
+
+// Simpan menyimpan daftar kurs dalam satu transaksi DB. Kurs dengan pasangan mata uang
+// dan tanggal yang sama akan ditimpa (koreksi rate).
+func Simpan(ctx context.Context, db *sql.DB, daftar []Kurs) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, k := range daftar {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO kurs (mata_uang_asal, mata_uang_tujuan, rate, berlaku_mulai)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (mata_uang_asal, mata_uang_tujuan, berlaku_mulai)
+			DO UPDATE SET rate = EXCLUDED.rate, created_at = NOW()
+		`, k.Asal, k.Tujuan, k.RateString(), k.BerlakuMulai.Format(FormatTanggal))
+		if err != nil {
+			return fmt.Errorf("gagal menyimpan kurs %s -> %s (%s): %w",
+				k.Asal, k.Tujuan, k.BerlakuMulai.Format(FormatTanggal), err)
+		}
+	}
+	return tx.Commit()
+}
+
+// formatRate menulis big.Rat sebagai desimal persis. Rate dari CSV selalu desimal berhingga;
+// hasil kebalikan (Inv) bisa tak berhingga sehingga dipotong ke 18 digit.
+func formatRate(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	s := r.FloatString(18)
+	return strings.TrimRight(strings.TrimRight(s, "0"), ".")
+}
+
+// PENJELASAN FILE kurs.go:
+// File ini berisi logika kurs mata uang untuk harga listing multi-currency
+//
+// Tabel kurs (migration 011):
+// - Satu baris = 1 mata_uang_asal bernilai rate mata_uang_tujuan, mulai tanggal berlaku_mulai
+// - Kurs yang dipakai adalah berlaku_mulai terbaru yang <= tanggal konversi (riwayat tetap tersimpan)
+//
+// Fungsi RateBerlaku:
+// - Cari kurs langsung, lalu kebalikannya (USD->IDR bisa dipakai untuk IDR->USD),
+//   lalu kurs silang lewat IDR (contoh SGD->USD = SGD->IDR x IDR->USD)
+// - Rate dihitung dengan big.Rat agar tidak ada error floating-point
+//
+// Konverter:
+// - Dipakai ListMobil/GetMobil untuk display_currency, rate di-cache per request
+//
+// ParseCSV + Simpan:
+// - Dipakai RPC ImportKurs (admin) dan CLI cmd/import-kurs
+// - Error dikumpulkan per baris; Simpan UPSERT dalam satu transaksi DB
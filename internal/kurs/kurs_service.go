@@ -0,0 +1,133 @@
+package kurs
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"carapp.com/m/internal/auth"
+	"carapp.com/m/internal/money"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maksUkuranCSV membatasi ukuran file kurs yang diimpor lewat RPC
+const maksUkuranCSV = 1 << 20 // 1 MB
+
+// KursServiceServer adalah implementasi dari pb.KursServiceServer
+type KursServiceServer struct {
+	pb.UnimplementedKursServiceServer
+	DB *sql.DB
+}
+
+// NewKursService membuat instance baru dari KursServiceServer
+func NewKursService(db *sql.DB) *KursServiceServer {
+	return &KursServiceServer{DB: db}
+}
+
+// ImportKurs menyimpan kurs dari CSV (khusus admin)
+func (s *KursServiceServer) ImportKurs(ctx context.Context, req *pb.ImportKursRequest) (*pb.ImportKursResponse, error) {
+	log.Println("KursService: ImportKurs dipanggil")
+
+	// 1. Hanya admin
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if !auth.IsAdmin(ctx) {
+		return nil, status.Errorf(codes.PermissionDenied, "Hanya admin yang dapat mengimpor kurs")
+	}
+
+	// 2. Validasi ukuran dan isi CSV
+	if len(req.CsvData) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "File CSV kosong")
+	}
+	if len(req.CsvData) > maksUkuranCSV {
+		return nil, status.Errorf(codes.InvalidArgument, "File CSV terlalu besar (maksimal 1 MB)")
+	}
+
+	daftar, errs, err := ParseCSV(bytes.NewReader(req.CsvData))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// 3. Jika ada baris yang salah, tidak ada yang disimpan (admin perbaiki file lalu impor ulang)
+	if len(errs) > 0 {
+		return &pb.ImportKursResponse{Jumlah: 0, Errors: errs}, nil
+	}
+
+	// 4. Simpan semua baris dalam satu transaksi
+	if err := Simpan(ctx, s.DB, daftar); err != nil {
+		log.Printf("Gagal menyimpan kurs: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan kurs")
+	}
+
+	log.Printf("Admin %s mengimpor %d kurs", userID, len(daftar))
+	return &pb.ImportKursResponse{Jumlah: int32(len(daftar))}, nil
+}
+
+// ListKurs menampilkan kurs yang tersimpan, terbaru dulu
+func (s *KursServiceServer) ListKurs(ctx context.Context, req *pb.ListKursRequest) (*pb.ListKursResponse, error) {
+	query := `
+		SELECT mata_uang_asal, mata_uang_tujuan, rate::text, berlaku_mulai
+		FROM kurs
+		WHERE 1=1
+	`
+	var args []interface{}
+	if req.MataUangAsal != "" {
+		args = append(args, strings.ToUpper(req.MataUangAsal))
+		query += fmt.Sprintf(" AND mata_uang_asal = $%d", len(args))
+	}
+	if req.MataUangTujuan != "" {
+		args = append(args, strings.ToUpper(req.MataUangTujuan))
+		query += fmt.Sprintf(" AND mata_uang_tujuan = $%d", len(args))
+	}
+	query += " ORDER BY berlaku_mulai DESC, mata_uang_asal, mata_uang_tujuan LIMIT 500"
+
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Printf("Gagal query ListKurs: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil data kurs")
+	}
+	defer rows.Close()
+
+	var daftar []*pb.Kurs
+	for rows.Next() {
+		var asal, tujuan, rateText string
+		var berlaku time.Time
+		if err := rows.Scan(&asal, &tujuan, &rateText, &berlaku); err != nil {
+			log.Printf("Gagal scan kurs: %v", err)
+			continue
+		}
+		if rate, err := money.ParseRate(rateText); err == nil {
+			rateText = formatRate(rate)
+		}
+		daftar = append(daftar, &pb.Kurs{
+			MataUangAsal:   asal,
+			MataUangTujuan: tujuan,
+			Rate:           rateText,
+			BerlakuMulai:   berlaku.Format(FormatTanggal),
+		})
+	}
+
+	return &pb.ListKursResponse{Kurs: daftar}, nil
+}
+
+// PENJELASAN FILE kurs_service.go:
+// File ini berisi implementasi KursService (Service 9)
+//
+// Fungsi ImportKurs:
+// - Hanya admin (role dari JWT)
+// - CSV maksimal 1 MB, header: mata_uang_asal,mata_uang_tujuan,rate,berlaku_mulai
+// - Semua baris divalidasi dulu; jika ada error, response berisi daftar error per baris
+//   dan tidak ada kurs yang disimpan
+// - Kurs dengan tanggal yang sama ditimpa (UPSERT)
+//
+// Fungsi ListKurs:
+// - Filter opsional per mata uang, urut berlaku_mulai terbaru
+// - Rate dikirim sebagai string desimal agar tidak kehilangan presisi
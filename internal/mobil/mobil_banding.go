@@ -0,0 +1,379 @@
+package mobil
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"carapp.com/m/internal/atribut"
+	"carapp.com/m/internal/hargapasar"
+	"carapp.com/m/internal/kurs"
+	"carapp.com/m/internal/money"
+	"carapp.com/m/internal/nhtsa"
+	"carapp.com/m/internal/pencarian"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	minMobilBanding  = 2
+	maksMobilBanding = 4
+	// batasDecodeVIN: spesifikasi VIN dilewati jika NHTSA lebih lambat dari ini
+	batasDecodeVIN = 5 * time.Second
+)
+
+// polaVINDeskripsi: VIN yang dicantumkan di deskripsi oleh seeder dan listing trade-in ("VIN: ..."),
+// dipakai jika kolom vin kosong
+var polaVINDeskripsi = regexp.MustCompile(`VIN:\s*([A-HJ-NPR-Z0-9]{17})`)
+
+// kolomPasarBanding adalah statistik pasar per mobil (bucket merk/model/tahun yang sama),
+// dihitung di query yang sama dengan data mobil lewat joinPasarBanding
+const kolomPasarBanding = `COALESCE(pt.jumlah, 0), pt.median, COALESCE(pm.jumlah, 0), pm.median`
+
+const joinPasarBanding = `LEFT JOIN LATERAL (
+			SELECT COUNT(*) AS jumlah,
+			       ROUND(percentile_cont(0.5) WITHIN GROUP (ORDER BY rh.harga)::numeric, 2) AS median
+			FROM riwayat_harga rh
+			JOIN mobils m2 ON m2.id = rh.mobil_id
+			WHERE rh.jenis = 'terjual' AND rh.created_at >= NOW() - INTERVAL '12 months'
+			  AND LOWER(m2.merk) = LOWER(mobils.merk) AND LOWER(m2.model) = LOWER(mobils.model) AND m2.tahun = mobils.tahun
+		) pt ON TRUE
+		LEFT JOIN LATERAL (
+			SELECT COUNT(*) AS jumlah,
+			       ROUND(percentile_cont(0.5) WITHIN GROUP (ORDER BY m3.harga_jual)::numeric, 2) AS median
+			FROM mobils m3
+			WHERE m3.status = 'tersedia' AND m3.harga_jual IS NOT NULL
+			  AND LOWER(m3.merk) = LOWER(mobils.merk) AND LOWER(m3.model) = LOWER(mobils.model) AND m3.tahun = mobils.tahun
+		) pm ON TRUE`
+
+// scanTambahan menambahkan kolom di belakang kolomListMobil agar scanMobilList tetap bisa dipakai
+type scanTambahan struct {
+	row      rowScanner
+	tambahan []interface{}
+}
+
+func (s scanTambahan) Scan(dest ...interface{}) error {
+	return s.row.Scan(append(dest, s.tambahan...)...)
+}
+
+// mobilBanding adalah satu kolom tabel perbandingan
+type mobilBanding struct {
+	barisMobil
+	VIN           string
+	Spesifikasi   *nhtsa.NhtsaVin
+	JumlahTerjual int
+	MedianTerjual money.Money
+	JumlahMinta   int
+	MedianMinta   money.Money
+}
+
+// posisiPasar mengembalikan median acuan seperti GetMarketPrice (terjual diutamakan, minimal 3 data)
+func (m mobilBanding) posisiPasar() (acuan money.Money, jenis string, jumlah int) {
+	switch {
+	case m.JumlahTerjual >= hargapasar.MinSampel:
+		return m.MedianTerjual, hargapasar.AcuanTerjual, m.JumlahTerjual
+	case m.JumlahMinta >= hargapasar.MinSampel:
+		return m.MedianMinta, hargapasar.AcuanMinta, m.JumlahMinta
+	}
+	return money.Money{}, "", 0
+}
+
+// CompareMobil membandingkan 2-4 mobil berdampingan. Semua mobil dan statistik pasarnya
+// diambil dalam satu query; spesifikasi VIN di-decode paralel dan boleh gagal.
+func (s *MobilServiceServer) CompareMobil(ctx context.Context, req *pb.CompareMobilRequest) (*pb.CompareMobilResponse, error) {
+	if len(req.MobilIds) < minMobilBanding || len(req.MobilIds) > maksMobilBanding {
+		return nil, status.Errorf(codes.InvalidArgument, "Pilih %d sampai %d mobil untuk dibandingkan", minMobilBanding, maksMobilBanding)
+	}
+	displayCurrency, err := validasiDisplayCurrency(req.DisplayCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Validasi ID (tidak boleh kosong/duplikat), susun placeholder IN
+	var args []interface{}
+	var placeholder []string
+	sudah := map[string]bool{}
+	for _, id := range req.MobilIds {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "MobilID tidak boleh kosong")
+		}
+		if sudah[id] {
+			return nil, status.Errorf(codes.InvalidArgument, "Mobil %s dipilih lebih dari sekali", id)
+		}
+		sudah[id] = true
+		args = append(args, id)
+		placeholder = append(placeholder, fmt.Sprintf("$%d", len(args)))
+	}
+
+	// 2. Satu query: data mobil + rating owner + median pasar
+	rows, err := s.DB.QueryContext(ctx, `
+		SELECT `+kolomListMobil+`, `+kolomPasarBanding+`
+		FROM mobils
+		`+joinRatingOwner+`
+		`+joinPasarBanding+`
+		WHERE id::text IN (`+strings.Join(placeholder, ", ")+`)
+	`, args...)
+	if err != nil {
+		log.Printf("Gagal query CompareMobil: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil data mobil")
+	}
+	defer rows.Close()
+
+	hasil := map[string]*mobilBanding{}
+	for rows.Next() {
+		var m mobilBanding
+		m.MedianTerjual, m.MedianMinta = money.New(0, money.DefaultCurrency), money.New(0, money.DefaultCurrency)
+		b, err := scanMobilList(scanTambahan{row: rows, tambahan: []interface{}{
+			&m.JumlahTerjual, &m.MedianTerjual, &m.JumlahMinta, &m.MedianMinta,
+		}})
+		if err != nil {
+			log.Printf("Gagal scan CompareMobil: %v", err)
+			return nil, status.Errorf(codes.Internal, "Gagal mengambil data mobil")
+		}
+		m.barisMobil = b
+		hasil[b.Mobil.Id] = &m
+	}
+	if err := rows.Err(); err != nil {
+		log.Printf("Gagal membaca hasil CompareMobil: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil data mobil")
+	}
+
+	// 3. Urutkan sesuai request; mobil tidak ada / belum publik = NotFound
+	daftar := make([]*mobilBanding, 0, len(req.MobilIds))
+	for _, arg := range args {
+		m, ok := hasil[arg.(string)]
+		if !ok || pencarian.StatusPrivat(m.Mobil.Status) {
+			return nil, status.Errorf(codes.NotFound, "Mobil %s tidak ditemukan", arg)
+		}
+		m.VIN = m.Mobil.Vin
+		if cocok := polaVINDeskripsi.FindStringSubmatch(m.Mobil.Deskripsi); m.VIN == "" && cocok != nil {
+			m.VIN = cocok[1]
+		}
+		daftar = append(daftar, m)
+	}
+
+	konverter := kurs.NewKonverter(s.DB, time.Now())
+	for _, m := range daftar {
+		if err := setHargaTampil(ctx, konverter, m.Mobil, m.HargaAsli, displayCurrency); err != nil {
+			return nil, err
+		}
+	}
+	decodeSpesifikasi(ctx, daftar)
+
+	resp := &pb.CompareMobilResponse{Baris: susunBarisBanding(daftar)}
+	for _, m := range daftar {
+		resp.Mobils = append(resp.Mobils, m.Mobil)
+	}
+	return resp, nil
+}
+
+// decodeSpesifikasi men-decode VIN semua mobil secara paralel. VIN yang gagal atau
+// melewati batasDecodeVIN dibiarkan tanpa spesifikasi.
+func decodeSpesifikasi(ctx context.Context, daftar []*mobilBanding) {
+	type hasilDecode struct {
+		indeks int
+		vin    *nhtsa.NhtsaVin
+	}
+	selesai := make(chan hasilDecode, len(daftar))
+	jumlah := 0
+	for i, m := range daftar {
+		if m.VIN == "" {
+			continue
+		}
+		jumlah++
+		go func(i int, vin string) {
+			hasil, err := nhtsa.DecodeVIN(vin)
+			if err != nil || !hasil.Valid() {
+				if err != nil {
+					log.Printf("Gagal decode VIN %s untuk perbandingan: %v", vin, err)
+				}
+				hasil = nil
+			}
+			selesai <- hasilDecode{indeks: i, vin: hasil}
+		}(i, m.VIN)
+	}
+
+	batas := time.NewTimer(batasDecodeVIN)
+	defer batas.Stop()
+	for ; jumlah > 0; jumlah-- {
+		select {
+		case h := <-selesai:
+			daftar[h.indeks].Spesifikasi = h.vin
+		case <-batas.C:
+			log.Printf("Decode VIN untuk perbandingan melewati %v, spesifikasi dilewati", batasDecodeVIN)
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// susunBarisBanding membuat tabel perbandingan yang sudah dinormalisasi (semua harga IDR
+// untuk penilaian, kilometer dalam km) dan menandai atribut yang berbeda
+func susunBarisBanding(daftar []*mobilBanding) []*pb.BarisPerbandingan {
+	n := len(daftar)
+	baris := func(kunci, label string, nilai func(m *mobilBanding) string) *pb.BarisPerbandingan {
+		b := &pb.BarisPerbandingan{Atribut: kunci, Label: label, Nilai: make([]string, n), IndeksTerbaik: -1}
+		for i, m := range daftar {
+			b.Nilai[i] = nilai(m)
+		}
+		b.Berbeda = berbeda(b.Nilai)
+		return b
+	}
+
+	harga := baris("harga", "Harga", func(m *mobilBanding) string {
+		tampil, err := money.FromProto(m.Mobil.HargaTampilMoney)
+		if err != nil {
+			return m.Harga.Format()
+		}
+		return tampil.Format()
+	})
+	harga.IndeksTerbaik = indeksTerbaik(daftar, func(m *mobilBanding) (int64, bool) { return -m.Harga.Minor, m.Harga.Minor > 0 })
+
+	tahun := baris("tahun", "Tahun", func(m *mobilBanding) string { return strconv.Itoa(int(m.Mobil.Tahun)) })
+	tahun.IndeksTerbaik = indeksTerbaik(daftar, func(m *mobilBanding) (int64, bool) { return int64(m.Mobil.Tahun), true })
+
+	kilometer := baris("kilometer", "Kilometer", func(m *mobilBanding) string {
+		if m.Mobil.Kilometer == nil {
+			return ""
+		}
+		return strconv.Itoa(int(*m.Mobil.Kilometer)) + " km"
+	})
+	kilometer.IndeksTerbaik = indeksTerbaik(daftar, func(m *mobilBanding) (int64, bool) {
+		return -int64(m.Mobil.GetKilometer()), m.Mobil.Kilometer != nil
+	})
+
+	kursi := baris("jumlah_kursi", "Jumlah kursi", func(m *mobilBanding) string {
+		if m.Mobil.JumlahKursi == 0 {
+			return ""
+		}
+		return strconv.Itoa(int(m.Mobil.JumlahKursi))
+	})
+
+	pasar := baris("posisi_harga_pasar", "Posisi harga pasar", func(m *mobilBanding) string {
+		acuan, jenis, jumlah := m.posisiPasar()
+		if jenis == "" {
+			return ""
+		}
+		teks := fmt.Sprintf("%+.1f%% dari median %s (%d data)", hargapasar.SelisihPersen(m.Harga, acuan), jenis, jumlah)
+		if hargapasar.DiAtasPasar(m.Harga, acuan) {
+			teks += ", di atas pasar"
+		}
+		return teks
+	})
+	pasar.IndeksTerbaik = indeksTerbaik(daftar, func(m *mobilBanding) (int64, bool) {
+		acuan, jenis, _ := m.posisiPasar()
+		return -int64(hargapasar.SelisihPersen(m.Harga, acuan) * 10), jenis != ""
+	})
+
+	rating := baris("rating_penjual", "Rating penjual", func(m *mobilBanding) string {
+		if m.Mobil.OwnerJumlahUlasan == 0 {
+			return ""
+		}
+		return fmt.Sprintf("%.1f (%d ulasan)", m.Mobil.OwnerRating, m.Mobil.OwnerJumlahUlasan)
+	})
+	rating.IndeksTerbaik = indeksTerbaik(daftar, func(m *mobilBanding) (int64, bool) {
+		return int64(m.Mobil.OwnerRating * 100), m.Mobil.OwnerJumlahUlasan > 0
+	})
+
+	// Atribut listing diutamakan; jika kosong dilengkapi dari hasil decode VIN
+	atauVIN := func(dariListing func(m *mobilBanding) string, dariVIN func(v *nhtsa.NhtsaVin) string) func(m *mobilBanding) string {
+		return func(m *mobilBanding) string {
+			if nilai := dariListing(m); nilai != "" || m.Spesifikasi == nil {
+				return nilai
+			}
+			return dariVIN(m.Spesifikasi)
+		}
+	}
+	tipeBodi := atauVIN(func(m *mobilBanding) string { return m.Mobil.TipeBodi },
+		func(v *nhtsa.NhtsaVin) string { return atribut.TipeBodiLonggar(v.BodyClass) })
+	bahanBakar := atauVIN(func(m *mobilBanding) string { return m.Mobil.BahanBakar },
+		func(v *nhtsa.NhtsaVin) string {
+			nilai, _ := atribut.BahanBakar(v.FuelTypePrimary)
+			return nilai
+		})
+
+	return []*pb.BarisPerbandingan{
+		baris("merk_model", "Merk & model", func(m *mobilBanding) string { return m.Mobil.Merk + " " + m.Mobil.Model }),
+		harga,
+		tahun,
+		baris("kondisi", "Kondisi", func(m *mobilBanding) string { return strings.ToLower(strings.TrimSpace(m.Mobil.Kondisi)) }),
+		kilometer,
+		baris("lokasi", "Lokasi", func(m *mobilBanding) string { return strings.TrimSpace(m.Mobil.Lokasi) }),
+		baris("tipe_bodi", "Tipe bodi", tipeBodi),
+		baris("transmisi", "Transmisi", func(m *mobilBanding) string { return m.Mobil.Transmisi }),
+		baris("bahan_bakar", "Bahan bakar", bahanBakar),
+		kursi,
+		baris("warna", "Warna", func(m *mobilBanding) string { return m.Mobil.Warna }),
+		baris("plat_region", "Plat nomor", func(m *mobilBanding) string { return m.Mobil.PlatRegion }),
+		pasar,
+		rating,
+	}
+}
+
+// berbeda: TRUE jika nilai yang tersedia (tidak kosong) tidak semuanya sama
+func berbeda(nilai []string) bool {
+	pertama := ""
+	for _, v := range nilai {
+		if v == "" {
+			continue
+		}
+		if pertama == "" {
+			pertama = v
+		} else if !samaTeks(pertama, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// indeksTerbaik mengembalikan index mobil dengan skor tertinggi. -1 jika kurang dari dua mobil
+// yang punya nilai atau semua nilainya sama (tidak ada yang lebih unggul).
+func indeksTerbaik(daftar []*mobilBanding, skor func(m *mobilBanding) (int64, bool)) int32 {
+	terbaik, jumlah := int32(-1), 0
+	var skorTerbaik int64
+	seri := false
+	for i, m := range daftar {
+		nilai, ada := skor(m)
+		if !ada {
+			continue
+		}
+		jumlah++
+		switch {
+		case terbaik == -1 || nilai > skorTerbaik:
+			terbaik, skorTerbaik, seri = int32(i), nilai, false
+		case nilai == skorTerbaik:
+			seri = true
+		}
+	}
+	if jumlah < 2 || seri {
+		return -1
+	}
+	return terbaik
+}
+
+// PENJELASAN FILE mobil_banding.go:
+// File ini berisi perbandingan mobil berdampingan (CompareMobil, RPC publik)
+//
+// Fungsi CompareMobil:
+// - 2-4 mobil_id unik, urutan kolom mengikuti request; mobil belum publik/tidak ada -> NotFound
+// - Satu query untuk semua mobil: kolomListMobil + rating owner + median pasar (LATERAL)
+//   per bucket merk/model/tahun: harga terjual 12 bulan terakhir & harga minta listing tersedia
+// - harga_tampil_money mengikuti display_currency seperti ListMobil
+//
+// Tabel perbandingan (susunBarisBanding):
+// - Satu baris per atribut, nilai "" = tidak tersedia, berbeda = nilai yang ada tidak sama
+// - indeks_terbaik: harga termurah, tahun terbaru, kilometer terendah, posisi pasar termurah,
+//   rating penjual tertinggi (-1 jika seri atau kurang dari dua data)
+// - Atribut terstruktur dari kolom mobils; tipe bodi & bahan bakar yang kosong dilengkapi dari decode VIN
+// - Posisi pasar memakai aturan acuan GetMarketPrice (median terjual jika >= 3 data, lalu minta)
+//
+// Fungsi decodeSpesifikasi:
+// - VIN dari deskripsi di-decode paralel lewat NHTSA, maksimal 5 detik; gagal = spesifikasi kosong
+// - Hanya dipakai untuk melengkapi atribut yang belum diisi owner
@@ -0,0 +1,473 @@
+package mobil
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"carapp.com/m/internal/atribut"
+	"carapp.com/m/internal/auth"
+	"carapp.com/m/internal/hargapasar"
+	"carapp.com/m/internal/kurs"
+	"carapp.com/m/internal/moderasi"
+	"carapp.com/m/internal/money"
+	"carapp.com/m/internal/notifikasi"
+	"carapp.com/m/internal/pencarian"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ModerasiAktif membaca env LISTING_MODERASI ("true"/"1"): listing yang dipublikasikan
+// masuk antrean moderasi dan baru tayang setelah disetujui staf
+func ModerasiAktif() bool {
+	aktif, _ := strconv.ParseBool(os.Getenv("LISTING_MODERASI"))
+	return aktif
+}
+
+// statusPublikasi adalah status listing setelah dipublikasikan owner
+func statusPublikasi() string {
+	if ModerasiAktif() {
+		return pencarian.StatusMenungguModerasi
+	}
+	return StatusTersedia
+}
+
+// dataListing adalah isi CreateMobilRequest yang sudah divalidasi dan dinormalkan
+type dataListing struct {
+	HargaJual money.Money // IDR
+	HargaAsli money.Money
+	AdaHarga  bool // false untuk draft yang belum diberi harga
+	Spek      atribut.Atribut
+	Koordinat titikListing
+}
+
+// kolomHarga mengembalikan nilai harga_jual dan harga_asli (NULL jika draft belum berharga)
+func (d dataListing) kolomHarga() (interface{}, interface{}) {
+	if !d.AdaHarga {
+		return nil, nil
+	}
+	return d.HargaJual, d.HargaAsli
+}
+
+// siapkanListing memvalidasi request listing. Draft cukup berisi merk & model (field lain
+// boleh kosong tapi harus valid jika diisi); listing yang langsung dipublikasikan harus lengkap.
+func (s *MobilServiceServer) siapkanListing(ctx context.Context, req *pb.CreateMobilRequest, draft bool) (dataListing, error) {
+	var data dataListing
+	hargaAsli, err := hargaDariRequest(req)
+	if err != nil {
+		return data, err
+	}
+	if draft {
+		if strings.TrimSpace(req.Merk) == "" || strings.TrimSpace(req.Model) == "" {
+			return data, status.Errorf(codes.InvalidArgument, "Draft minimal berisi Merk dan Model")
+		}
+		if req.Tahun != 0 && req.Tahun <= 1900 {
+			return data, status.Errorf(codes.InvalidArgument, "Tahun mobil tidak valid")
+		}
+		if hargaAsli.Minor < 0 {
+			return data, status.Errorf(codes.InvalidArgument, "Harga jual tidak valid")
+		}
+	} else {
+		if req.Merk == "" || req.Model == "" || req.Tahun <= 1900 || !hargaAsli.IsPositive() {
+			return data, status.Errorf(codes.InvalidArgument, "Data mobil tidak valid (Merk, Model, Tahun, Harga Jual)")
+		}
+		if req.Deskripsi == "" {
+			return data, status.Errorf(codes.InvalidArgument, "Deskripsi harus diisi")
+		}
+		// Validasi foto URL harus ada (user wajib upload foto dulu)
+		if req.FotoUrl == "" {
+			return data, status.Errorf(codes.InvalidArgument, "Foto mobil harus diupload terlebih dahulu")
+		}
+	}
+
+	// Atribut terstruktur opsional, disimpan dalam nilai kanonik
+	data.Spek, err = atribut.Normalisasi(atribut.Atribut{
+		Kilometer:   req.Kilometer,
+		Transmisi:   req.Transmisi,
+		BahanBakar:  req.BahanBakar,
+		Warna:       req.Warna,
+		TipeBodi:    req.TipeBodi,
+		JumlahKursi: req.JumlahKursi,
+		PlatRegion:  req.PlatRegion,
+		VIN:         vinListing(req),
+	})
+	if err != nil {
+		return data, status.Errorf(codes.InvalidArgument, "Atribut mobil tidak valid: %v", err)
+	}
+	data.Koordinat, err = koordinatListing(req)
+	if err != nil {
+		return data, err
+	}
+
+	// Harga dalam mata uang asing dikonversi ke IDR dengan kurs hari ini (harga_jual selalu IDR)
+	data.HargaAsli, data.HargaJual, data.AdaHarga = hargaAsli, hargaAsli, hargaAsli.IsPositive()
+	if data.AdaHarga && hargaAsli.Currency != money.DefaultCurrency {
+		data.HargaJual, err = kurs.NewKonverter(s.DB, time.Now()).Konversi(ctx, hargaAsli, money.DefaultCurrency)
+		if err != nil {
+			return data, errorKurs(err)
+		}
+	}
+	return data, nil
+}
+
+// vinListing mengambil VIN dari request, atau dari "VIN: ..." di deskripsi jika kosong
+func vinListing(req *pb.CreateMobilRequest) string {
+	if vin := strings.TrimSpace(req.Vin); vin != "" {
+		return vin
+	}
+	if cocok := polaVINDeskripsi.FindStringSubmatch(req.Deskripsi); cocok != nil {
+		return cocok[1]
+	}
+	return ""
+}
+
+// kekuranganListing mengembalikan field yang masih kosong sebelum listing boleh dipublikasikan
+func kekuranganListing(merk, model string, tahun int32, harga money.Money, deskripsi, fotoURL string) []string {
+	var kurang []string
+	if strings.TrimSpace(merk) == "" {
+		kurang = append(kurang, "merk")
+	}
+	if strings.TrimSpace(model) == "" {
+		kurang = append(kurang, "model")
+	}
+	if tahun <= 1900 {
+		kurang = append(kurang, "tahun")
+	}
+	if !harga.IsPositive() {
+		kurang = append(kurang, "harga")
+	}
+	if strings.TrimSpace(deskripsi) == "" {
+		kurang = append(kurang, "deskripsi")
+	}
+	if strings.TrimSpace(fotoURL) == "" {
+		kurang = append(kurang, "foto")
+	}
+	return kurang
+}
+
+// terbitkan menayangkan listing: status 'tersedia' dengan masa tayang penuh sejak sekarang.
+// dipublikasikan_pada hanya diisi saat tayang pertama, agar listing yang ditolak lalu diajukan
+// ulang tidak melompat ke atas urutan "terbaru".
+func terbitkan(ctx context.Context, tx *sql.Tx, mobilID string) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE mobils SET status = $1, dipublikasikan_pada = COALESCE(dipublikasikan_pada, NOW()),
+		       berlaku_sampai = NOW() + make_interval(days => $2),
+		       peringatan_kedaluwarsa_dikirim = NULL, alasan_penolakan = NULL, updated_at = NOW()
+		WHERE id = $3
+	`, StatusTersedia, MasaBerlaku(), mobilID)
+	return err
+}
+
+// UpdateDraftMobil menimpa isi listing draft (atau yang ditolak moderasi) milik user
+func (s *MobilServiceServer) UpdateDraftMobil(ctx context.Context, req *pb.UpdateDraftMobilRequest) (*pb.Mobil, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.MobilId == "" || req.Data == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "MobilID dan data mobil harus diisi")
+	}
+	data, err := s.siapkanListing(ctx, req.Data, true)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal memulai transaksi DB")
+	}
+	defer tx.Rollback()
+
+	statusMobil, hargaLama, err := lockListing(ctx, tx, req.MobilId, userID)
+	if err != nil {
+		return nil, err
+	}
+	if statusMobil != pencarian.StatusDraft && statusMobil != pencarian.StatusDitolak {
+		return nil, status.Errorf(codes.FailedPrecondition, "Mobil berstatus '%s' bukan draft", statusMobil)
+	}
+
+	hargaJual, hargaAsli := data.kolomHarga()
+	spek := data.Spek
+	_, err = tx.ExecContext(ctx, `
+		UPDATE mobils SET merk = $1, model = $2, tahun = $3, kondisi = $4, deskripsi = $5,
+		       harga_jual = $6, foto_url = $7, lokasi = $8, harga_asli = $9, mata_uang_asli = $10,
+		       kilometer = $11, transmisi = NULLIF($12, ''), bahan_bakar = NULLIF($13, ''), warna = NULLIF($14, ''),
+		       tipe_bodi = NULLIF($15, ''), jumlah_kursi = NULLIF($16, 0), plat_region = NULLIF($17, ''),
+		       latitude = $18, longitude = $19, vin = NULLIF($20, ''),
+		       foto_phash = CASE WHEN foto_url IS DISTINCT FROM $7 THEN NULL ELSE foto_phash END, updated_at = NOW()
+		WHERE id = $21
+	`, strings.TrimSpace(req.Data.Merk), strings.TrimSpace(req.Data.Model), req.Data.Tahun, req.Data.Kondisi,
+		req.Data.Deskripsi, hargaJual, req.Data.FotoUrl, req.Data.Lokasi, hargaAsli, data.HargaAsli.Currency,
+		spek.Kilometer, spek.Transmisi, spek.BahanBakar, spek.Warna, spek.TipeBodi, spek.JumlahKursi, spek.PlatRegion,
+		data.Koordinat.lat, data.Koordinat.lng, spek.VIN, req.MobilId)
+	if err != nil {
+		log.Printf("Gagal update draft mobil %s: %v", req.MobilId, err)
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan draft mobil")
+	}
+
+	// Harga baru / berubah masuk riwayat harga
+	if data.AdaHarga && data.HargaJual.Minor != hargaLama.Minor {
+		jenis := hargapasar.JenisPerubahan
+		if !hargaLama.IsPositive() {
+			jenis = hargapasar.JenisListing
+		}
+		if err := hargapasar.Catat(ctx, tx, req.MobilId, jenis, data.HargaJual, &data.HargaAsli, ""); err != nil {
+			log.Printf("%v", err)
+			return nil, status.Errorf(codes.Internal, "Gagal mencatat riwayat harga")
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan draft mobil")
+	}
+	log.Printf("Draft mobil %s diubah oleh owner %s", req.MobilId, userID)
+
+	return s.GetMobil(ctx, &pb.GetMobilRequest{MobilId: req.MobilId})
+}
+
+// PublishMobil memvalidasi kelengkapan draft lalu menayangkannya, atau memasukkannya ke
+// antrean moderasi jika LISTING_MODERASI aktif
+func (s *MobilServiceServer) PublishMobil(ctx context.Context, req *pb.PublishMobilRequest) (*pb.Mobil, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.MobilId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "MobilID tidak boleh kosong")
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal memulai transaksi DB")
+	}
+	defer tx.Rollback()
+
+	statusMobil, harga, err := lockListing(ctx, tx, req.MobilId, userID)
+	if err != nil {
+		return nil, err
+	}
+	if statusMobil != pencarian.StatusDraft && statusMobil != pencarian.StatusDitolak {
+		return nil, status.Errorf(codes.FailedPrecondition, "Mobil berstatus '%s' tidak bisa dipublikasikan", statusMobil)
+	}
+
+	// 1. Validasi kelengkapan seperti CreateMobil
+	var merk, model, deskripsi, vin string
+	var tahun int32
+	var fotoURL sql.NullString
+	err = tx.QueryRowContext(ctx, `
+		SELECT COALESCE(merk, ''), COALESCE(model, ''), COALESCE(tahun, 0), COALESCE(deskripsi, ''), foto_url,
+		       COALESCE(vin, '')
+		FROM mobils WHERE id = $1
+	`, req.MobilId).Scan(&merk, &model, &tahun, &deskripsi, &fotoURL, &vin)
+	if err != nil {
+		log.Printf("Gagal membaca draft mobil %s: %v", req.MobilId, err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil data mobil")
+	}
+	if kurang := kekuranganListing(merk, model, tahun, harga, deskripsi, fotoURL.String); len(kurang) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Listing belum lengkap: %s", strings.Join(kurang, ", "))
+	}
+
+	// 2. Penilaian risiko penipuan: skor tinggi ditahan ke antrean moderasi
+	statusBaru := statusPublikasi()
+	penilaian, err := moderasi.Nilai(ctx, tx, moderasi.Listing{
+		ID: req.MobilId, OwnerID: userID, Merk: merk, Model: model, Tahun: tahun,
+		Harga: harga, FotoURL: fotoURL.String, VIN: vin,
+	})
+	if err != nil {
+		log.Printf("Gagal menilai risiko mobil %s: %v", req.MobilId, err)
+		return nil, status.Errorf(codes.Internal, "Gagal mempublikasikan listing")
+	}
+	if penilaian.Ditahan {
+		statusBaru = pencarian.StatusMenungguModerasi
+	}
+	if err := moderasi.Simpan(ctx, tx, req.MobilId, penilaian); err != nil {
+		log.Printf("%v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mempublikasikan listing")
+	}
+
+	// 3. Tayang langsung atau masuk antrean moderasi
+	if statusBaru == StatusTersedia {
+		err = terbitkan(ctx, tx, req.MobilId)
+	} else {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE mobils SET status = $1, alasan_penolakan = NULL, updated_at = NOW() WHERE id = $2
+		`, statusBaru, req.MobilId)
+	}
+	if err != nil {
+		log.Printf("Gagal mempublikasikan mobil %s: %v", req.MobilId, err)
+		return nil, status.Errorf(codes.Internal, "Gagal mempublikasikan listing")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan status listing")
+	}
+	log.Printf("Mobil %s dipublikasikan oleh owner %s (status '%s')", req.MobilId, userID, statusBaru)
+
+	judul := fmt.Sprintf("%d %s %s", tahun, merk, model)
+	pesan := fmt.Sprintf("Iklan mobil %s sudah tayang.", judul)
+	if statusBaru == pencarian.StatusMenungguModerasi {
+		pesan = fmt.Sprintf("Iklan mobil %s sedang ditinjau staf dan akan tayang setelah disetujui.", judul)
+	}
+	go notifikasi.CreateNotification(s.DB, context.Background(), userID, TipeNotifikasiListing, pesan)
+
+	return s.GetMobil(ctx, &pb.GetMobilRequest{MobilId: req.MobilId})
+}
+
+// ListModerasiMobil menampilkan antrean moderasi untuk admin, pengajuan paling lama di depan
+func (s *MobilServiceServer) ListModerasiMobil(ctx context.Context, req *pb.ListModerasiMobilRequest) (*pb.ListMobilResponse, error) {
+	if _, ok := ctx.Value(auth.UserIDKey).(string); !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if !auth.IsAdmin(ctx) {
+		return nil, status.Errorf(codes.PermissionDenied, "Hanya admin yang dapat melihat antrean moderasi")
+	}
+
+	limit := 50
+	if req.Limit > 0 && req.Limit <= 100 {
+		limit = int(req.Limit)
+	}
+	offset := 0
+	if req.Page > 1 {
+		offset = (int(req.Page) - 1) * limit
+	}
+
+	rows, err := s.DB.QueryContext(ctx, `
+		SELECT `+kolomListMobil+`
+		FROM mobils
+		`+joinRatingOwner+`
+		WHERE status = $1
+		ORDER BY updated_at ASC, id
+		LIMIT $2 OFFSET $3
+	`, pencarian.StatusMenungguModerasi, limit, offset)
+	if err != nil {
+		log.Printf("Gagal query antrean moderasi: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil antrean moderasi")
+	}
+	defer rows.Close()
+
+	var mobils []*pb.Mobil
+	for rows.Next() {
+		baris, err := scanMobilList(rows)
+		if err != nil {
+			log.Printf("Gagal scan row mobil: %v", err)
+			continue
+		}
+		baris.Mobil.HargaTampilMoney = baris.HargaAsli.ToProto()
+		mobils = append(mobils, baris.Mobil)
+	}
+
+	var total int32
+	s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM mobils WHERE status = $1", pencarian.StatusMenungguModerasi).Scan(&total)
+
+	return &pb.ListMobilResponse{
+		Mobils: mobils,
+		Total:  total,
+	}, nil
+}
+
+// ModerasiMobil menyetujui (tayang) atau menolak (dengan alasan) listing di antrean moderasi
+func (s *MobilServiceServer) ModerasiMobil(ctx context.Context, req *pb.ModerasiMobilRequest) (*pb.Mobil, error) {
+	adminID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if !auth.IsAdmin(ctx) {
+		return nil, status.Errorf(codes.PermissionDenied, "Hanya admin yang dapat memoderasi listing")
+	}
+	alasan := strings.TrimSpace(req.Alasan)
+	if req.MobilId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "MobilID tidak boleh kosong")
+	}
+	if !req.Setujui && alasan == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Alasan penolakan harus diisi")
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal memulai transaksi DB")
+	}
+	defer tx.Rollback()
+
+	var ownerID, statusMobil, merk, model string
+	var tahun int32
+	err = tx.QueryRowContext(ctx, `
+		SELECT owner_id, status, COALESCE(merk, ''), COALESCE(model, ''), COALESCE(tahun, 0)
+		FROM mobils WHERE id = $1 FOR UPDATE
+	`, req.MobilId).Scan(&ownerID, &statusMobil, &merk, &model, &tahun)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "Mobil tidak ditemukan")
+	}
+	if err != nil {
+		log.Printf("Gagal lock mobil %s untuk moderasi: %v", req.MobilId, err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil data mobil")
+	}
+	if statusMobil != pencarian.StatusMenungguModerasi {
+		return nil, status.Errorf(codes.FailedPrecondition, "Mobil berstatus '%s' tidak sedang menunggu moderasi", statusMobil)
+	}
+
+	judul := fmt.Sprintf("%d %s %s", tahun, merk, model)
+	var pesan string
+	// Laporan terbuka ikut ditutup agar tidak langsung menahan ulang listing yang sudah ditinjau
+	if req.Setujui {
+		err = terbitkan(ctx, tx, req.MobilId)
+		if err == nil {
+			err = moderasi.TutupLaporan(ctx, tx, req.MobilId, moderasi.LaporanDiabaikan, "Listing disetujui moderasi", adminID)
+		}
+		pesan = fmt.Sprintf("Iklan mobil %s sudah disetujui dan kini tayang.", judul)
+	} else {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE mobils SET status = $1, alasan_penolakan = $2, updated_at = NOW() WHERE id = $3
+		`, pencarian.StatusDitolak, alasan, req.MobilId)
+		if err == nil {
+			err = moderasi.TutupLaporan(ctx, tx, req.MobilId, moderasi.LaporanDitindak, alasan, adminID)
+		}
+		pesan = fmt.Sprintf("Iklan mobil %s ditolak: %s. Perbaiki iklan lalu publikasikan ulang.", judul, alasan)
+	}
+	if err == nil {
+		_, err = tx.ExecContext(ctx, `UPDATE mobils SET dimoderasi_oleh = $1, dimoderasi_pada = NOW() WHERE id = $2`,
+			adminID, req.MobilId)
+	}
+	if err != nil {
+		log.Printf("Gagal memoderasi mobil %s: %v", req.MobilId, err)
+		return nil, status.Errorf(codes.Internal, "Gagal memoderasi listing")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan hasil moderasi")
+	}
+	log.Printf("Mobil %s dimoderasi oleh admin %s (disetujui: %t)", req.MobilId, adminID, req.Setujui)
+
+	go notifikasi.CreateNotification(s.DB, context.Background(), ownerID, TipeNotifikasiListing, pesan)
+
+	return s.GetMobil(ctx, &pb.GetMobilRequest{MobilId: req.MobilId})
+}
+
+// PENJELASAN FILE mobil_draft.go:
+// File ini berisi draft listing, publikasi, dan antrean moderasi
+//
+// Konfigurasi (env):
+// - LISTING_MODERASI: "true" = listing yang dipublikasikan menunggu persetujuan staf (default mati)
+//
+// Fungsi siapkanListing (dipakai CreateMobil dan UpdateDraftMobil):
+// - Draft: cukup merk & model; tahun/harga/atribut/koordinat divalidasi jika diisi
+// - Publikasi langsung: validasi lengkap termasuk deskripsi dan foto
+//
+// Fungsi UpdateDraftMobil:
+// - Hanya owner, status 'draft' atau 'ditolak'; semua field ditimpa isi data
+// - Hash foto (foto_phash) dikosongkan jika foto berganti, dihitung ulang saat publikasi
+// - Harga baru/berubah dicatat ke riwayat_harga
+//
+// Fungsi PublishMobil:
+// - Hanya owner, status 'draft' atau 'ditolak'; field yang kurang dilaporkan sekaligus
+// - Dinilai moderasi.Nilai seperti CreateMobil; skor risiko tinggi ditahan ke antrean moderasi
+// - Moderasi mati -> 'tersedia' (masa tayang mulai sekarang); aktif -> 'menunggu_moderasi'
+//
+// Fungsi ListModerasiMobil / ModerasiMobil (admin):
+// - Antrean diurutkan dari pengajuan paling lama
+// - Setujui -> 'tersedia'; tolak -> 'ditolak' dengan alasan_penolakan, owner diberi tahu
+// - terbitkan tidak menimpa dipublikasikan_pada yang sudah terisi (urutan terbaru tetap)
+// - Laporan terbuka listing ditutup (setujui -> 'diabaikan', tolak -> 'ditindak')
+// - dimoderasi_oleh / dimoderasi_pada dicatat untuk audit
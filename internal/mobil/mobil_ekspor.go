@@ -0,0 +1,91 @@
+package mobil
+
+import (
+	"bufio"
+	"log"
+	"strings"
+
+	"carapp.com/m/internal/auth"
+	"carapp.com/m/internal/inventaris"
+	"carapp.com/m/internal/pencarian"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const ukuranChunkEkspor = 32 << 10 // 32 KB per pesan stream
+
+// penulisStream meneruskan potongan file ekspor sebagai pesan stream
+type penulisStream struct {
+	stream pb.MobilService_ExportInventoryServer
+}
+
+func (w penulisStream) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&pb.ExportInventoryChunk{Data: append([]byte(nil), p...)}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// ExportInventory mengekspor mobil milik user (admin: semua, atau satu owner) sebagai CSV / JSON Lines.
+// File dikirim bertahap per chunk sambil rows dibaca dari DB.
+func (s *MobilServiceServer) ExportInventory(req *pb.ExportInventoryRequest, stream pb.MobilService_ExportInventoryServer) error {
+	ctx := stream.Context()
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+
+	// 1. Owner: user biasa hanya mobilnya sendiri
+	ownerID := userID
+	if auth.IsAdmin(ctx) {
+		ownerID = strings.TrimSpace(req.OwnerId)
+	} else if req.OwnerId != "" && req.OwnerId != userID {
+		return status.Errorf(codes.PermissionDenied, "Hanya admin yang dapat mengekspor inventaris user lain")
+	}
+
+	// 2. Validasi filter & format
+	filter, err := pencarian.ParseFilter(req.Filter)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Filter tidak valid: %v", err)
+	}
+	f := inventaris.FilterEkspor{OwnerID: ownerID, Filter: filter}
+	for _, st := range req.Status {
+		f.Status = append(f.Status, strings.ToLower(strings.TrimSpace(st)))
+	}
+	if err := f.Validasi(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	format := strings.ToLower(strings.TrimSpace(req.Format))
+	if format == "" {
+		format = inventaris.FormatEksporCSV
+	}
+	buf := bufio.NewWriterSize(penulisStream{stream}, ukuranChunkEkspor)
+	penulis, err := inventaris.NewPenulis(buf, format)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// 3. Tulis bertahap; stream yang ditutup client menghentikan query lewat ctx
+	jumlah, err := inventaris.Ekspor(ctx, s.DB, f, penulis)
+	if err == nil {
+		err = buf.Flush()
+	}
+	if err != nil {
+		log.Printf("Gagal ekspor inventaris user %s: %v", userID, err)
+		return status.Errorf(codes.Internal, "Gagal mengekspor inventaris")
+	}
+	log.Printf("Inventaris diekspor oleh user %s: %d mobil (%s)", userID, jumlah, format)
+	return nil
+}
+
+// PENJELASAN FILE mobil_ekspor.go:
+// File ini berisi RPC ExportInventory (server streaming) untuk rekonsiliasi stok dealer
+//
+// Fungsi ExportInventory:
+// - Butuh login; user biasa hanya mengekspor mobilnya sendiri, admin semua (atau owner_id tertentu)
+// - Filter: status (kosong = semua, termasuk draft & terjual) dan FilterMobil seperti ListMobil
+// - Format csv (default) atau jsonl, ditulis oleh inventaris.Ekspor baris per baris
+// - Output dikirim sebagai ExportInventoryChunk berukuran <= 32 KB; client menggabungkan semua chunk
+// - Mobil terjual membawa status_transaksi, harga_terjual, dan terjual_pada
+// - CLI dengan logika yang sama: `go run ./cmd/inventaris ekspor`
@@ -0,0 +1,85 @@
+package mobil
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"strings"
+
+	"carapp.com/m/internal/auth"
+	"carapp.com/m/internal/inventaris"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maksUkuranImpor = 5 << 20 // 5 MB
+
+// BulkImportMobil mengimpor stok dealer dari CSV / JSON ke listing milik user.
+// Mobil dicocokkan per nomor stok lalu VIN (upsert); file yang punya baris salah tidak disimpan.
+func (s *MobilServiceServer) BulkImportMobil(ctx context.Context, req *pb.BulkImportMobilRequest) (*pb.BulkImportMobilResponse, error) {
+	log.Println("MobilService: BulkImportMobil dipanggil")
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+
+	// 1. Validasi ukuran dan isi file
+	if len(req.Data) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "File impor kosong")
+	}
+	if len(req.Data) > maksUkuranImpor {
+		return nil, status.Errorf(codes.InvalidArgument, "File impor terlalu besar (maksimal 5 MB)")
+	}
+	daftar, gagal, err := inventaris.Parse(bytes.NewReader(req.Data), strings.ToLower(strings.TrimSpace(req.Format)))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// 2. Upsert dalam satu transaksi (rollback jika dry run atau ada baris gagal)
+	laporan, err := inventaris.Terapkan(ctx, s.DB, userID, daftar, gagal, inventaris.Opsi{
+		DryRun:          req.DryRun,
+		StatusBaru:      statusPublikasi(),
+		MasaBerlakuHari: MasaBerlaku(),
+	})
+	if err != nil {
+		log.Printf("Gagal impor stok user %s: %v", userID, err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengimpor stok")
+	}
+	log.Printf("Impor stok user %s: %d dibuat, %d diperbarui, %d gagal (disimpan: %t)",
+		userID, laporan.Dibuat, laporan.Diperbarui, laporan.Gagal, laporan.Disimpan)
+
+	if laporan.Disimpan {
+		go laporan.KirimNotifikasi(s.DB)
+	}
+
+	resp := &pb.BulkImportMobilResponse{
+		Dibuat:     int32(laporan.Dibuat),
+		Diperbarui: int32(laporan.Diperbarui),
+		Gagal:      int32(laporan.Gagal),
+		Disimpan:   laporan.Disimpan,
+	}
+	for _, h := range laporan.Hasil {
+		resp.Hasil = append(resp.Hasil, &pb.HasilImportBaris{
+			Baris:     int32(h.Baris),
+			NomorStok: h.NomorStok,
+			Vin:       h.VIN,
+			Aksi:      h.Aksi,
+			MobilId:   h.MobilID,
+			Status:    h.Status,
+			Errors:    h.Errors,
+		})
+	}
+	return resp, nil
+}
+
+// PENJELASAN FILE mobil_impor.go:
+// File ini berisi RPC BulkImportMobil (impor stok dealer, pengganti seeder hapus-lalu-insert)
+//
+// Fungsi BulkImportMobil:
+// - Butuh login; mobil diimpor sebagai milik pemanggil
+// - File CSV / JSON maksimal 5 MB, parsing & validasi per baris di inventaris.Parse
+// - Upsert per nomor_stok lalu VIN (inventaris.Terapkan); listing baru mengikuti LISTING_MODERASI
+//   dan penilaian risiko seperti CreateMobil
+// - dry_run atau ada baris gagal -> tidak ada yang disimpan, hasil per baris tetap dikembalikan
+// - CLI dengan logika yang sama: `go run ./cmd/inventaris impor`
@@ -0,0 +1,196 @@
+package mobil
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"carapp.com/m/internal/auth"
+	"carapp.com/m/internal/notifikasi"
+	"carapp.com/m/internal/watchlist"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultMasaBerlakuHari = 30
+	defaultHariPeringatan  = 3
+	// TipeNotifikasiListing adalah tipe notifikasi masa tayang listing untuk owner
+	TipeNotifikasiListing = "listing"
+)
+
+// MasaBerlaku membaca masa tayang listing dari env LISTING_MASA_BERLAKU_HARI (default 30 hari)
+func MasaBerlaku() int {
+	if hari, err := strconv.Atoi(os.Getenv("LISTING_MASA_BERLAKU_HARI")); err == nil && hari > 0 {
+		return hari
+	}
+	return defaultMasaBerlakuHari
+}
+
+// HariPeringatan membaca berapa hari sebelum kedaluwarsa owner diberi peringatan
+// dari env LISTING_PERINGATAN_HARI (default 3 hari)
+func HariPeringatan() int {
+	if hari, err := strconv.Atoi(os.Getenv("LISTING_PERINGATAN_HARI")); err == nil && hari > 0 {
+		return hari
+	}
+	return defaultHariPeringatan
+}
+
+// listingJob adalah satu listing yang diproses job (untuk isi notifikasi)
+type listingJob struct {
+	ID, OwnerID string
+	Judul       string
+	Sampai      time.Time
+}
+
+// StartKedaluwarsaJob menjalankan job berkala untuk peringatan & kedaluwarsa listing.
+// Dipanggil sebagai goroutine dari main.go.
+func StartKedaluwarsaJob(db *sql.DB, interval time.Duration) {
+	log.Printf("Job kedaluwarsa listing: berjalan setiap %s (masa tayang %d hari, peringatan H-%d)",
+		interval, MasaBerlaku(), HariPeringatan())
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		peringatan, kedaluwarsa, err := ProsesKedaluwarsa(context.Background(), db)
+		if err != nil {
+			log.Printf("Job kedaluwarsa listing: gagal memproses: %v", err)
+			continue
+		}
+		if peringatan > 0 || kedaluwarsa > 0 {
+			log.Printf("Job kedaluwarsa listing: %d peringatan dikirim, %d listing kedaluwarsa", peringatan, kedaluwarsa)
+		}
+	}
+}
+
+// ProsesKedaluwarsa mengisi masa tayang yang kosong, mengirim peringatan H-n, lalu memindahkan
+// listing 'tersedia' yang lewat berlaku_sampai ke 'kedaluwarsa'. Mengembalikan jumlah peringatan
+// dan jumlah listing yang kedaluwarsa.
+func ProsesKedaluwarsa(ctx context.Context, db *sql.DB) (int, int, error) {
+	// 1. Listing tanpa masa tayang (seeder, listing lama, draft yang baru dipublikasikan)
+	_, err := db.ExecContext(ctx, `
+		UPDATE mobils SET berlaku_sampai = NOW() + make_interval(days => $1)
+		WHERE status = $2 AND berlaku_sampai IS NULL
+	`, MasaBerlaku(), StatusTersedia)
+	if err != nil {
+		return 0, 0, fmt.Errorf("gagal mengisi masa tayang: %w", err)
+	}
+
+	// 2. Peringatan: satu kali per masa tayang (kolom di-reset saat RenewMobil)
+	peringatan, err := ambilListingJob(ctx, db, `
+		UPDATE mobils SET peringatan_kedaluwarsa_dikirim = NOW()
+		WHERE status = $1 AND peringatan_kedaluwarsa_dikirim IS NULL
+		  AND berlaku_sampai > NOW() AND berlaku_sampai <= NOW() + make_interval(days => $2)
+		RETURNING id, owner_id, tahun, merk, model, berlaku_sampai
+	`, StatusTersedia, HariPeringatan())
+	if err != nil {
+		return 0, 0, fmt.Errorf("gagal menandai peringatan: %w", err)
+	}
+	for _, l := range peringatan {
+		go notifikasi.CreateNotification(db, context.Background(), l.OwnerID, TipeNotifikasiListing,
+			fmt.Sprintf("Iklan mobil %s akan berakhir pada %s. Perpanjang iklan agar tetap tayang.",
+				l.Judul, l.Sampai.Format("02 Jan 2006 15:04")))
+	}
+
+	// 3. Kedaluwarsa: satu UPDATE sehingga tidak balapan dengan BuyMobil (hanya baris 'tersedia')
+	kedaluwarsa, err := ambilListingJob(ctx, db, `
+		UPDATE mobils SET status = $1, updated_at = NOW()
+		WHERE status = $2 AND berlaku_sampai <= NOW()
+		RETURNING id, owner_id, tahun, merk, model, berlaku_sampai
+	`, StatusKedaluwarsa, StatusTersedia)
+	if err != nil {
+		return len(peringatan), 0, fmt.Errorf("gagal memproses listing kedaluwarsa: %w", err)
+	}
+	for _, l := range kedaluwarsa {
+		go notifikasi.CreateNotification(db, context.Background(), l.OwnerID, TipeNotifikasiListing,
+			fmt.Sprintf("Iklan mobil %s sudah berakhir dan tidak lagi tayang. Perpanjang iklan untuk menayangkannya kembali.", l.Judul))
+		go watchlist.NotifyKedaluwarsa(db, l.ID)
+	}
+	return len(peringatan), len(kedaluwarsa), nil
+}
+
+// ambilListingJob menjalankan UPDATE ... RETURNING id, owner_id, tahun, merk, model, berlaku_sampai
+func ambilListingJob(ctx context.Context, db *sql.DB, query string, args ...interface{}) ([]listingJob, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var daftar []listingJob
+	for rows.Next() {
+		var l listingJob
+		var tahun int
+		var merk, model string
+		if err := rows.Scan(&l.ID, &l.OwnerID, &tahun, &merk, &model, &l.Sampai); err != nil {
+			return nil, err
+		}
+		l.Judul = fmt.Sprintf("%d %s %s", tahun, merk, model)
+		daftar = append(daftar, l)
+	}
+	return daftar, rows.Err()
+}
+
+// RenewMobil memperpanjang masa tayang listing milik user. Listing 'kedaluwarsa' ditayangkan ulang.
+func (s *MobilServiceServer) RenewMobil(ctx context.Context, req *pb.RenewMobilRequest) (*pb.Mobil, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.MobilId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "MobilID tidak boleh kosong")
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal memulai transaksi DB")
+	}
+	defer tx.Rollback()
+
+	statusMobil, _, err := lockListing(ctx, tx, req.MobilId, userID)
+	if err != nil {
+		return nil, err
+	}
+	if statusMobil != StatusTersedia && statusMobil != StatusKedaluwarsa {
+		return nil, status.Errorf(codes.FailedPrecondition, "Mobil berstatus '%s' tidak bisa diperpanjang", statusMobil)
+	}
+
+	// Masa tayang baru dihitung dari sekarang (tidak ditumpuk)
+	_, err = tx.ExecContext(ctx, `
+		UPDATE mobils SET status = $1, berlaku_sampai = NOW() + make_interval(days => $2),
+		       peringatan_kedaluwarsa_dikirim = NULL, updated_at = NOW()
+		WHERE id = $3
+	`, StatusTersedia, MasaBerlaku(), req.MobilId)
+	if err != nil {
+		log.Printf("Gagal memperpanjang mobil %s: %v", req.MobilId, err)
+		return nil, status.Errorf(codes.Internal, "Gagal memperpanjang listing")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan masa tayang listing")
+	}
+	log.Printf("Mobil %s diperpanjang oleh owner %s (sebelumnya '%s')", req.MobilId, userID, statusMobil)
+
+	return s.GetMobil(ctx, &pb.GetMobilRequest{MobilId: req.MobilId})
+}
+
+// PENJELASAN FILE mobil_kedaluwarsa.go:
+// File ini berisi masa tayang listing: job kedaluwarsa dan RenewMobil
+//
+// Konfigurasi (env):
+// - LISTING_MASA_BERLAKU_HARI: masa tayang listing baru / perpanjangan (default 30 hari)
+// - LISTING_PERINGATAN_HARI: peringatan dikirim H-n sebelum berakhir (default 3 hari)
+//
+// Fungsi ProsesKedaluwarsa (dijalankan StartKedaluwarsaJob):
+// - Listing 'tersedia' tanpa berlaku_sampai diberi masa tayang penuh
+// - Peringatan ke owner sekali per masa tayang (peringatan_kedaluwarsa_dikirim)
+// - Lewat berlaku_sampai -> status 'kedaluwarsa', owner dan watcher diberi tahu
+// - Hanya baris 'tersedia' yang diubah, mobil yang sedang dipesan tidak tersentuh
+//
+// Fungsi RenewMobil:
+// - Hanya owner, listing 'tersedia' atau 'kedaluwarsa'
+// - berlaku_sampai = sekarang + masa tayang, peringatan di-reset, status kembali 'tersedia'
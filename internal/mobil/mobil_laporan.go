@@ -0,0 +1,82 @@
+package mobil
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"strings"
+
+	"carapp.com/m/internal/auth"
+	"carapp.com/m/internal/moderasi"
+	"carapp.com/m/internal/pencarian"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maksPanjangKeteranganLaporan = 1000
+
+// ReportMobil menyimpan laporan user atas listing yang dicurigai penipuan / tidak wajar.
+// Listing yang dilaporkan banyak user (akun lama) ditahan otomatis ke antrean moderasi.
+func (s *MobilServiceServer) ReportMobil(ctx context.Context, req *pb.ReportMobilRequest) (*pb.LaporanListing, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	alasan := strings.ToLower(strings.TrimSpace(req.Alasan))
+	keterangan := strings.TrimSpace(req.Keterangan)
+	if req.MobilId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "MobilID tidak boleh kosong")
+	}
+	if !moderasi.AlasanValid(alasan) {
+		return nil, status.Errorf(codes.InvalidArgument, "Alasan laporan tidak valid")
+	}
+	if alasan == moderasi.AlasanLainnya && keterangan == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Keterangan harus diisi untuk alasan 'lainnya'")
+	}
+	if len(keterangan) > maksPanjangKeteranganLaporan {
+		return nil, status.Errorf(codes.InvalidArgument, "Keterangan maksimal %d karakter", maksPanjangKeteranganLaporan)
+	}
+
+	// 1. Hanya listing publik milik orang lain yang bisa dilaporkan
+	var ownerID, statusMobil string
+	err := s.DB.QueryRowContext(ctx, `SELECT owner_id, status FROM mobils WHERE id = $1`, req.MobilId).
+		Scan(&ownerID, &statusMobil)
+	if err == sql.ErrNoRows || (err == nil && pencarian.StatusPrivat(statusMobil)) {
+		return nil, status.Errorf(codes.NotFound, "Mobil tidak ditemukan")
+	}
+	if err != nil {
+		log.Printf("Gagal mengambil mobil %s untuk laporan: %v", req.MobilId, err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil data mobil")
+	}
+	if ownerID == userID {
+		return nil, status.Errorf(codes.FailedPrecondition, "Tidak bisa melaporkan listing sendiri")
+	}
+
+	// 2. Simpan laporan (dan tahan listing jika pelapor sudah mencapai batas)
+	lap, ditahan, err := moderasi.Laporkan(ctx, s.DB, req.MobilId, userID, alasan, keterangan)
+	if errors.Is(err, moderasi.ErrSudahDilaporkan) {
+		return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+	}
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan laporan")
+	}
+	log.Printf("Mobil %s dilaporkan oleh user %s (%s)", req.MobilId, userID, alasan)
+
+	if ditahan {
+		go moderasi.NotifyDitahan(s.DB, req.MobilId)
+	}
+	return lap, nil
+}
+
+// PENJELASAN FILE mobil_laporan.go:
+// File ini berisi RPC ReportMobil (laporan penyalahgunaan listing dari user)
+//
+// Fungsi ReportMobil:
+// - Butuh login; listing privat (draft / antrean moderasi / ditolak) dianggap tidak ada
+// - Owner tidak bisa melaporkan listing sendiri
+// - Alasan: penipuan, harga_tidak_wajar, foto_palsu, data_salah, duplikat, lainnya (wajib keterangan)
+// - Satu laporan terbuka per user per listing (AlreadyExists)
+// - Penyimpanan & penahanan otomatis di moderasi.Laporkan; antrean laporan di AdminService
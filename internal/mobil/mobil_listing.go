@@ -0,0 +1,204 @@
+package mobil
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"strings"
+	"time"
+
+	"carapp.com/m/internal/auth"
+	"carapp.com/m/internal/hargapasar"
+	"carapp.com/m/internal/kurs"
+	"carapp.com/m/internal/moderasi"
+	"carapp.com/m/internal/money"
+	"carapp.com/m/internal/pencarian"
+	"carapp.com/m/internal/watchlist"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Status listing yang diubah langsung oleh owner
+const (
+	StatusTersedia    = "tersedia"
+	StatusDitarik     = "ditarik"     // Owner menarik listing dari penjualan
+	StatusKedaluwarsa = "kedaluwarsa" // Masa tayang habis (job kedaluwarsa), bisa diperpanjang owner
+)
+
+// UpdateHargaMobil mengubah harga listing milik user. Watcher diberi tahu jika harga turun.
+// Listing yang sedang tayang dinilai ulang moderasi.Nilai dan ditahan jika skornya tinggi.
+func (s *MobilServiceServer) UpdateHargaMobil(ctx context.Context, req *pb.UpdateHargaMobilRequest) (*pb.Mobil, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.MobilId == "" || req.HargaJualMoney == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "MobilID dan harga harus diisi")
+	}
+	hargaAsli, err := money.FromProto(req.HargaJualMoney)
+	if err != nil || !hargaAsli.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "Harga jual tidak valid")
+	}
+
+	// 1. Konversi ke IDR seperti CreateMobil
+	hargaBaru := hargaAsli
+	if hargaAsli.Currency != money.DefaultCurrency {
+		hargaBaru, err = kurs.NewKonverter(s.DB, time.Now()).Konversi(ctx, hargaAsli, money.DefaultCurrency)
+		if err != nil {
+			return nil, errorKurs(err)
+		}
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal memulai transaksi DB")
+	}
+	defer tx.Rollback()
+
+	// 2. Lock listing, hanya owner dan hanya saat tidak sedang dipesan
+	statusMobil, hargaLama, err := lockListing(ctx, tx, req.MobilId, userID)
+	if err != nil {
+		return nil, err
+	}
+	if statusMobil != StatusTersedia && statusMobil != pencarian.StatusDraft && statusMobil != pencarian.StatusDitolak {
+		return nil, status.Errorf(codes.FailedPrecondition, "Harga mobil berstatus '%s' tidak bisa diubah", statusMobil)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE mobils SET harga_jual = $1, harga_asli = $2, mata_uang_asli = $3, updated_at = NOW()
+		WHERE id = $4
+	`, hargaBaru, hargaAsli, hargaAsli.Currency, req.MobilId)
+	if err != nil {
+		log.Printf("Gagal update harga mobil %s: %v", req.MobilId, err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengubah harga mobil")
+	}
+	if err := hargapasar.Catat(ctx, tx, req.MobilId, hargapasar.JenisPerubahan, hargaBaru, &hargaAsli, ""); err != nil {
+		log.Printf("%v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mencatat riwayat harga")
+	}
+
+	// 3. Listing tayang dinilai ulang seperti CreateMobil, agar harga tidak bisa diturunkan
+	// jauh di bawah pasar setelah lolos penilaian awal (draft dinilai saat PublishMobil)
+	ditahan := false
+	if statusMobil == StatusTersedia {
+		if ditahan, err = nilaiUlangListing(ctx, tx, req.MobilId, userID, hargaBaru); err != nil {
+			log.Printf("Gagal menilai ulang risiko mobil %s: %v", req.MobilId, err)
+			return nil, status.Errorf(codes.Internal, "Gagal mengubah harga mobil")
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan harga mobil")
+	}
+	log.Printf("Harga mobil %s diubah dari %s menjadi %s", req.MobilId, hargaLama.Format(), hargaBaru.Format())
+
+	// 4. Ditahan -> owner diberi tahu; harga turun -> beri tahu watcher (listing draft belum terlihat publik)
+	switch {
+	case ditahan:
+		log.Printf("Mobil %s ditahan ke antrean moderasi setelah perubahan harga", req.MobilId)
+		go moderasi.NotifyDitahan(s.DB, req.MobilId)
+	case statusMobil == StatusTersedia && hargaBaru.Minor < hargaLama.Minor:
+		go watchlist.NotifyHargaTurun(s.DB, req.MobilId, hargaLama, hargaBaru)
+	}
+
+	return s.GetMobil(ctx, &pb.GetMobilRequest{MobilId: req.MobilId})
+}
+
+// WithdrawMobil menarik listing milik user dari penjualan. Watcher diberi tahu.
+func (s *MobilServiceServer) WithdrawMobil(ctx context.Context, req *pb.WithdrawMobilRequest) (*pb.Mobil, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.MobilId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "MobilID tidak boleh kosong")
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal memulai transaksi DB")
+	}
+	defer tx.Rollback()
+
+	// Mobil yang sedang dipesan harus menunggu transaksinya selesai/batal
+	statusMobil, _, err := lockListing(ctx, tx, req.MobilId, userID)
+	if err != nil {
+		return nil, err
+	}
+	if statusMobil != StatusTersedia {
+		return nil, status.Errorf(codes.FailedPrecondition, "Mobil berstatus '%s' tidak bisa ditarik", statusMobil)
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE mobils SET status = $1, updated_at = NOW() WHERE id = $2`, StatusDitarik, req.MobilId)
+	if err != nil {
+		log.Printf("Gagal menarik mobil %s: %v", req.MobilId, err)
+		return nil, status.Errorf(codes.Internal, "Gagal menarik mobil")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan status mobil")
+	}
+	log.Printf("Mobil %s ditarik oleh owner %s: %s", req.MobilId, userID, strings.TrimSpace(req.Alasan))
+
+	go watchlist.NotifyDitarik(s.DB, req.MobilId)
+
+	return s.GetMobil(ctx, &pb.GetMobilRequest{MobilId: req.MobilId})
+}
+
+// nilaiUlangListing menjalankan moderasi.Nilai dengan harga baru di dalam tx pemanggil.
+// Skor tinggi -> listing ditahan ke antrean moderasi (ditahan = true).
+func nilaiUlangListing(ctx context.Context, tx *sql.Tx, mobilID, ownerID string, harga money.Money) (bool, error) {
+	l := moderasi.Listing{ID: mobilID, OwnerID: ownerID, Harga: harga}
+	err := tx.QueryRowContext(ctx, `
+		SELECT COALESCE(merk, ''), COALESCE(model, ''), COALESCE(tahun, 0), COALESCE(foto_url, ''), COALESCE(vin, '')
+		FROM mobils WHERE id = $1
+	`, mobilID).Scan(&l.Merk, &l.Model, &l.Tahun, &l.FotoURL, &l.VIN)
+	if err != nil {
+		return false, err
+	}
+	penilaian, err := moderasi.Nilai(ctx, tx, l)
+	if err != nil {
+		return false, err
+	}
+	if err := moderasi.Simpan(ctx, tx, mobilID, penilaian); err != nil {
+		return false, err
+	}
+	if !penilaian.Ditahan {
+		return false, nil
+	}
+	return moderasi.Tahan(ctx, tx, mobilID)
+}
+
+// lockListing mengunci baris mobil FOR UPDATE dan memastikan user adalah owner-nya.
+// Mengembalikan status dan harga_jual saat ini.
+func lockListing(ctx context.Context, tx *sql.Tx, mobilID, userID string) (string, money.Money, error) {
+	var ownerID, statusMobil string
+	var harga money.Money
+	err := tx.QueryRowContext(ctx, `SELECT owner_id, status, harga_jual FROM mobils WHERE id = $1 FOR UPDATE`, mobilID).
+		Scan(&ownerID, &statusMobil, &harga)
+	if err == sql.ErrNoRows || (err == nil && ownerID != userID) {
+		return "", money.Money{}, status.Errorf(codes.NotFound, "Mobil tidak ditemukan")
+	}
+	if err != nil {
+		log.Printf("Gagal lock mobil %s: %v", mobilID, err)
+		return "", money.Money{}, status.Errorf(codes.Internal, "Gagal mengambil data mobil")
+	}
+	return statusMobil, harga, nil
+}
+
+// PENJELASAN FILE mobil_listing.go:
+// File ini berisi RPC owner untuk mengelola listing yang sudah dipasang
+//
+// Fungsi UpdateHargaMobil:
+// - Hanya owner, listing 'tersedia', 'draft', atau 'ditolak' (bukan saat dipesan/terjual/dimoderasi)
+// - Harga mata uang asing dikonversi ke IDR seperti CreateMobil (harga_asli tetap disimpan)
+// - Perubahan dicatat ke riwayat_harga di transaksi DB yang sama
+// - Listing 'tersedia' dinilai ulang moderasi.Nilai dengan harga baru (nilaiUlangListing);
+//   skor >= RISIKO_BATAS_TAHAN (misal harga jauh di bawah pasar) -> 'menunggu_moderasi', owner diberi tahu
+// - Jika harga_jual turun dan listing tetap tersedia -> watchlist.NotifyHargaTurun
+//
+// Fungsi WithdrawMobil:
+// - Hanya owner, hanya listing 'tersedia' -> status 'ditarik'
+// - Listing ditarik tidak muncul di ListMobil default, watcher diberi tahu (watchlist.NotifyDitarik)
+//
+// Helper lockListing:
+// - SELECT ... FOR UPDATE agar tidak balapan dengan BuyMobil yang mengubah status ke 'dipesan'
@@ -0,0 +1,260 @@
+package mobil
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"carapp.com/m/internal/auth"
+	"carapp.com/m/internal/kurs"
+	"carapp.com/m/internal/money"
+	"carapp.com/m/internal/pencarian"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultLimitMirip = 6
+	maksLimitMirip    = 20
+	// maksKandidatMirip membatasi jumlah baris yang diskor per request
+	maksKandidatMirip = 200
+)
+
+// ciriMobil adalah atribut yang dipakai untuk menghitung kemiripan dua mobil
+type ciriMobil struct {
+	ID       string
+	Merk     string
+	Model    string
+	Tahun    int32
+	Harga    money.Money // harga_jual (IDR)
+	Lokasi   string
+	Kondisi  string
+	TipeBodi string
+}
+
+// skorKemiripan menghitung skor 0-100 kandidat terhadap mobil acuan beserta alasannya.
+// Fungsi murni (tanpa DB) sehingga hasilnya deterministik untuk input yang sama.
+//
+// Bobot: merk+model 40 (merk saja 15), tahun 20, harga 20, lokasi 10, kondisi 5, tipe bodi 5.
+func skorKemiripan(acuan, kandidat ciriMobil) (int32, []string) {
+	var skor int32
+	var alasan []string
+
+	// 1. Merk & model
+	switch {
+	case samaTeks(acuan.Merk, kandidat.Merk) && samaTeks(acuan.Model, kandidat.Model):
+		skor += 40
+		alasan = append(alasan, "merk & model sama")
+	case samaTeks(acuan.Merk, kandidat.Merk):
+		skor += 15
+		alasan = append(alasan, "merk sama")
+	}
+
+	// 2. Tahun: makin dekat makin tinggi (selisih > 3 tahun = 0)
+	selisihTahun := acuan.Tahun - kandidat.Tahun
+	if selisihTahun < 0 {
+		selisihTahun = -selisihTahun
+	}
+	if selisihTahun <= 3 {
+		skor += 20 - 5*selisihTahun
+		if selisihTahun == 0 {
+			alasan = append(alasan, "tahun sama")
+		} else {
+			alasan = append(alasan, "tahun +-"+strconv.Itoa(int(selisihTahun)))
+		}
+	}
+
+	// 3. Harga: selisih relatif terhadap harga acuan (dihitung di integer minor unit)
+	if acuan.Harga.Minor > 0 && acuan.Harga.Currency == kandidat.Harga.Currency {
+		selisih := kandidat.Harga.Minor - acuan.Harga.Minor
+		if selisih < 0 {
+			selisih = -selisih
+		}
+		switch persen := selisih * 100; {
+		case persen <= acuan.Harga.Minor*10:
+			skor += 20
+			alasan = append(alasan, "harga +-10%")
+		case persen <= acuan.Harga.Minor*20:
+			skor += 12
+			alasan = append(alasan, "harga +-20%")
+		case persen <= acuan.Harga.Minor*30:
+			skor += 5
+			alasan = append(alasan, "harga +-30%")
+		}
+	}
+
+	// 4. Lokasi: kota yang sama (bagian sebelum koma pertama)
+	if kota := kotaDari(acuan.Lokasi); kota != "" && kota == kotaDari(kandidat.Lokasi) {
+		skor += 10
+		alasan = append(alasan, "lokasi sama")
+	}
+
+	// 5. Kondisi baru/bekas
+	if acuan.Kondisi != "" && samaTeks(acuan.Kondisi, kandidat.Kondisi) {
+		skor += 5
+		alasan = append(alasan, "kondisi sama")
+	}
+
+	// 6. Tipe bodi (hanya jika keduanya diketahui)
+	if acuan.TipeBodi != "" && acuan.TipeBodi == kandidat.TipeBodi {
+		skor += 5
+		alasan = append(alasan, "tipe bodi sama")
+	}
+
+	return skor, alasan
+}
+
+// urutkanMirip: skor tertinggi dulu, lalu selisih harga terkecil, lalu ID (agar urutan stabil)
+func urutkanMirip(acuan ciriMobil, daftar []ciriMobil, skor map[string]int32) {
+	selisih := func(c ciriMobil) int64 {
+		d := c.Harga.Minor - acuan.Harga.Minor
+		if d < 0 {
+			return -d
+		}
+		return d
+	}
+	sort.Slice(daftar, func(i, j int) bool {
+		a, b := daftar[i], daftar[j]
+		if skor[a.ID] != skor[b.ID] {
+			return skor[a.ID] > skor[b.ID]
+		}
+		if selisih(a) != selisih(b) {
+			return selisih(a) < selisih(b)
+		}
+		return a.ID < b.ID
+	})
+}
+
+// GetSimilarMobil menampilkan mobil tersedia yang paling mirip dengan mobil_id.
+// Mobil itu sendiri dan listing milik pemanggil (jika login) tidak ikut.
+func (s *MobilServiceServer) GetSimilarMobil(ctx context.Context, req *pb.GetSimilarMobilRequest) (*pb.GetSimilarMobilResponse, error) {
+	if req.MobilId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "MobilID tidak boleh kosong")
+	}
+	displayCurrency, err := validasiDisplayCurrency(req.DisplayCurrency)
+	if err != nil {
+		return nil, err
+	}
+	limit := defaultLimitMirip
+	if req.Limit > 0 && req.Limit <= maksLimitMirip {
+		limit = int(req.Limit)
+	}
+	// RPC publik: user ID hanya ada jika token dikirim
+	userID, _ := ctx.Value(auth.UserIDKey).(string)
+
+	// 1. Mobil acuan
+	var acuan ciriMobil
+	var statusAcuan string
+	err = s.DB.QueryRowContext(ctx, `
+		SELECT id, merk, model, tahun, harga_jual, COALESCE(lokasi, ''), COALESCE(kondisi, ''), COALESCE(tipe_bodi, ''), status
+		FROM mobils WHERE id = $1
+	`, req.MobilId).Scan(&acuan.ID, &acuan.Merk, &acuan.Model, &acuan.Tahun, &acuan.Harga, &acuan.Lokasi, &acuan.Kondisi,
+		&acuan.TipeBodi, &statusAcuan)
+	if err == sql.ErrNoRows || (err == nil && pencarian.StatusPrivat(statusAcuan)) {
+		return nil, status.Errorf(codes.NotFound, "Mobil tidak ditemukan")
+	}
+	if err != nil {
+		log.Printf("Gagal query mobil acuan: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil data mobil")
+	}
+
+	// 2. Kandidat: merk sama atau harga dalam +-30%, dibatasi agar skor dihitung di memori.
+	// Sebelum LIMIT diurutkan dengan skor kasar di SQL (model, selisih tahun, rentang harga)
+	// agar 200 baris yang diambil adalah yang paling mirip, bukan sekadar yang terbaru.
+	args := []interface{}{acuan.ID, acuan.Merk,
+		money.New(acuan.Harga.Minor*7/10, acuan.Harga.Currency),
+		money.New(acuan.Harga.Minor*13/10, acuan.Harga.Currency),
+		maksKandidatMirip, acuan.Model, acuan.Tahun, acuan.Harga}
+	kecualiOwner := ""
+	if userID != "" {
+		args = append(args, userID)
+		kecualiOwner = ` AND owner_id <> $9`
+	}
+	rows, err := s.DB.QueryContext(ctx, `
+		SELECT `+kolomListMobil+`
+		FROM mobils
+		`+joinRatingOwner+`
+		WHERE status = 'tersedia' AND (berlaku_sampai IS NULL OR berlaku_sampai > NOW()) AND id <> $1`+kecualiOwner+`
+		  AND (LOWER(merk) = LOWER($2) OR harga_jual BETWEEN $3 AND $4)
+		ORDER BY (CASE WHEN LOWER(merk) = LOWER($2) AND LOWER(model) = LOWER($6) THEN 40
+		               WHEN LOWER(merk) = LOWER($2) THEN 15 ELSE 0 END
+		        + GREATEST(0, 20 - 5 * ABS(tahun - $7))
+		        + CASE WHEN harga_jual BETWEEN $3 AND $4 THEN 20 ELSE 0 END) DESC,
+		         ABS(harga_jual - $8::numeric), id
+		LIMIT $5
+	`, args...)
+	if err != nil {
+		log.Printf("Gagal query kandidat mobil mirip: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil mobil serupa")
+	}
+	defer rows.Close()
+
+	// 3. Skor setiap kandidat lalu urutkan
+	var kandidat []ciriMobil
+	skor := map[string]int32{}
+	alasan := map[string][]string{}
+	baris := map[string]barisMobil{}
+	for rows.Next() {
+		b, err := scanMobilList(rows)
+		if err != nil {
+			log.Printf("Gagal scan kandidat mobil mirip: %v", err)
+			continue
+		}
+		c := ciriMobil{ID: b.Mobil.Id, Merk: b.Mobil.Merk, Model: b.Mobil.Model, Tahun: b.Mobil.Tahun,
+			Harga: b.Harga, Lokasi: b.Mobil.Lokasi, Kondisi: b.Mobil.Kondisi, TipeBodi: b.Mobil.TipeBodi}
+		nilai, sebab := skorKemiripan(acuan, c)
+		if nilai == 0 {
+			continue
+		}
+		kandidat = append(kandidat, c)
+		skor[c.ID], alasan[c.ID], baris[c.ID] = nilai, sebab, b
+	}
+	urutkanMirip(acuan, kandidat, skor)
+	if len(kandidat) > limit {
+		kandidat = kandidat[:limit]
+	}
+
+	konverter := kurs.NewKonverter(s.DB, time.Now())
+	hasil := make([]*pb.MobilMirip, 0, len(kandidat))
+	for _, c := range kandidat {
+		b := baris[c.ID]
+		if err := setHargaTampil(ctx, konverter, b.Mobil, b.HargaAsli, displayCurrency); err != nil {
+			return nil, err
+		}
+		hasil = append(hasil, &pb.MobilMirip{Mobil: b.Mobil, Skor: skor[c.ID], Alasan: alasan[c.ID]})
+	}
+	return &pb.GetSimilarMobilResponse{Mobils: hasil}, nil
+}
+
+func samaTeks(a, b string) bool {
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
+
+// kotaDari mengambil nama kota dari lokasi "Kota, Provinsi" (huruf kecil)
+func kotaDari(lokasi string) string {
+	kota, _, _ := strings.Cut(lokasi, ",")
+	return strings.ToLower(strings.TrimSpace(kota))
+}
+
+// PENJELASAN FILE mobil_mirip.go:
+// File ini berisi rekomendasi mobil serupa untuk halaman detail (GetSimilarMobil)
+//
+// Fungsi skorKemiripan (murni, deterministik):
+// - Merk & model sama 40 poin (merk saja 15)
+// - Tahun: selisih 0/1/2/3 tahun = 20/15/10/5 poin
+// - Harga: selisih <= 10%/20%/30% dari harga acuan = 20/12/5 poin
+// - Lokasi: kota sama 10 poin; kondisi sama 5 poin; tipe bodi sama 5 poin (total maksimal 100)
+// - Urutan akhir: skor, lalu selisih harga terkecil, lalu ID (urutkanMirip)
+//
+// Fungsi GetSimilarMobil:
+// - RPC publik; listing milik pemanggil dikecualikan jika token dikirim
+// - Kandidat dari DB: status 'tersedia', bukan mobil acuan, merk sama atau harga +-30%
+//   (maksimal 200 baris), lalu diskor di memori
+// - Sebelum LIMIT kandidat diurutkan dengan skor kasar di SQL (merk/model, selisih tahun,
+//   rentang harga, lalu selisih harga) agar mobil yang paling mirip tidak terpotong
+// - Default 6 hasil (maksimal 20), harga_tampil_money mengikuti display_currency
@@ -0,0 +1,97 @@
+package mobil
+
+import (
+	"reflect"
+	"testing"
+
+	"carapp.com/m/internal/money"
+)
+
+func TestSkorKemiripan(t *testing.T) {
+	acuan := ciriMobil{
+		ID: "acuan", Merk: "Toyota", Model: "Avanza", Tahun: 2020,
+		Harga: money.New(200_000_000_00, "IDR"), Lokasi: "Bandung, Jawa Barat", Kondisi: "bekas", TipeBodi: "mpv",
+	}
+
+	tests := []struct {
+		nama       string
+		kandidat   ciriMobil
+		wantSkor   int32
+		wantAlasan []string
+	}{
+		{
+			nama:     "identik",
+			kandidat: acuan,
+			wantSkor: 100,
+			wantAlasan: []string{"merk & model sama", "tahun sama", "harga +-10%", "lokasi sama",
+				"kondisi sama", "tipe bodi sama"},
+		},
+		{
+			nama: "merk & model beda huruf besar dan spasi",
+			kandidat: ciriMobil{Merk: " toyota ", Model: "AVANZA", Tahun: 2020,
+				Harga: money.New(200_000_000_00, "IDR")},
+			wantSkor:   80,
+			wantAlasan: []string{"merk & model sama", "tahun sama", "harga +-10%"},
+		},
+		{
+			nama:       "merk saja, tahun +-2, harga +-20%",
+			kandidat:   ciriMobil{Merk: "Toyota", Model: "Rush", Tahun: 2018, Harga: money.New(236_000_000_00, "IDR")},
+			wantSkor:   15 + 10 + 12,
+			wantAlasan: []string{"merk sama", "tahun +-2", "harga +-20%"},
+		},
+		{
+			nama:       "tahun +-3 dan harga tepat 30%",
+			kandidat:   ciriMobil{Merk: "Honda", Tahun: 2023, Harga: money.New(140_000_000_00, "IDR")},
+			wantSkor:   5 + 5,
+			wantAlasan: []string{"tahun +-3", "harga +-30%"},
+		},
+		{
+			nama:     "tahun > 3 dan harga > 30% tidak dapat poin",
+			kandidat: ciriMobil{Merk: "Honda", Tahun: 2015, Harga: money.New(300_000_000_00, "IDR")},
+			wantSkor: 0,
+		},
+		{
+			nama:     "mata uang beda tidak dibandingkan harganya",
+			kandidat: ciriMobil{Merk: "Honda", Tahun: 2010, Harga: money.New(200_000_000_00, "USD")},
+			wantSkor: 0,
+		},
+		{
+			nama:       "kota sama walau provinsi beda tulisan",
+			kandidat:   ciriMobil{Merk: "Honda", Tahun: 2010, Lokasi: "bandung , Jabar"},
+			wantSkor:   10,
+			wantAlasan: []string{"lokasi sama"},
+		},
+		{
+			nama:       "kondisi dan tipe bodi sama",
+			kandidat:   ciriMobil{Merk: "Honda", Tahun: 2010, Kondisi: "Bekas", TipeBodi: "mpv"},
+			wantSkor:   10,
+			wantAlasan: []string{"kondisi sama", "tipe bodi sama"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.nama, func(t *testing.T) {
+			skor, alasan := skorKemiripan(acuan, tt.kandidat)
+			if skor != tt.wantSkor {
+				t.Errorf("skor = %d, ingin %d (alasan %v)", skor, tt.wantSkor, alasan)
+			}
+			if !reflect.DeepEqual(alasan, tt.wantAlasan) {
+				t.Errorf("alasan = %v, ingin %v", alasan, tt.wantAlasan)
+			}
+		})
+	}
+}
+
+func TestSkorKemiripanAcuanTanpaAtributOpsional(t *testing.T) {
+	// Lokasi, kondisi, dan tipe bodi kosong di acuan tidak boleh dianggap "sama"
+	acuan := ciriMobil{Merk: "Toyota", Model: "Avanza", Tahun: 2020}
+	kandidat := ciriMobil{Merk: "Daihatsu", Tahun: 2010}
+	if skor, alasan := skorKemiripan(acuan, kandidat); skor != 0 || alasan != nil {
+		t.Errorf("skor = %d, alasan = %v, ingin 0 tanpa alasan", skor, alasan)
+	}
+}
+
+// PENJELASAN FILE mobil_mirip_test.go:
+// Test table-driven untuk skorKemiripan (fungsi murni, tanpa DB)
+// - Setiap bobot (merk/model, tahun, harga, lokasi, kondisi, tipe bodi) dan batasnya
+// - Perbandingan teks tidak peka huruf besar / spasi, harga beda mata uang diabaikan
@@ -4,17 +4,25 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
-	"math"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"carapp.com/m/internal/auth"
+	"carapp.com/m/internal/geo"
+	"carapp.com/m/internal/hargapasar"
+	"carapp.com/m/internal/kurs"
+	"carapp.com/m/internal/moderasi"
+	"carapp.com/m/internal/money"
 	"carapp.com/m/internal/nhtsa"
 	"carapp.com/m/internal/notifikasi"
+	"carapp.com/m/internal/pencarian"
+	"carapp.com/m/internal/ulasan"
 	pb "carapp.com/m/proto"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -76,48 +84,81 @@ func (s *MobilServiceServer) CreateMobil(ctx context.Context, req *pb.CreateMobi
 		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
 	}
 
-	// 2. Validasi input
-	if req.Merk == "" || req.Model == "" || req.Tahun <= 1900 || req.HargaJual <= 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "Data mobil tidak valid (Merk, Model, Tahun, Harga Jual)")
+	// 2. Validasi input (draft cukup merk & model, dilengkapi lewat UpdateDraftMobil)
+	data, err := s.siapkanListing(ctx, req, req.SimpanDraft)
+	if err != nil {
+		return nil, err
 	}
-
-	if req.Deskripsi == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "Deskripsi harus diisi")
+	statusAwal := statusPublikasi()
+	if req.SimpanDraft {
+		statusAwal = pencarian.StatusDraft
 	}
+	hargaAsli, spek, koordinat := data.HargaAsli, data.Spek, data.Koordinat
+	hargaJual, hargaAsliKolom := data.kolomHarga()
 
-	// Validasi foto URL harus ada (user wajib upload foto dulu)
-	if req.FotoUrl == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "Foto mobil harus diupload terlebih dahulu")
+	// Penilaian risiko penipuan (draft dinilai saat PublishMobil). Skor tinggi ditahan
+	// ke antrean moderasi; penilaian yang gagal tidak membatalkan listing.
+	var penilaian *moderasi.Penilaian
+	if !req.SimpanDraft {
+		p, err := moderasi.Nilai(ctx, s.DB, moderasi.Listing{
+			OwnerID: userID, Merk: req.Merk, Model: req.Model, Tahun: req.Tahun,
+			Harga: data.HargaJual, FotoURL: req.FotoUrl, VIN: spek.VIN,
+		})
+		if err != nil {
+			log.Printf("Gagal menilai risiko listing baru user %s: %v", userID, err)
+		} else {
+			penilaian = &p
+			if p.Ditahan {
+				statusAwal = pencarian.StatusMenungguModerasi
+			}
+		}
 	}
 
-	// Bulatkan harga untuk menghindari floating-point precision issue
-	hargaJualBulat := math.Round(req.HargaJual)
-
 	// 3. Simpan ke database
 	query := `
 		INSERT INTO mobils (
 			owner_id, merk, model, tahun, kondisi, deskripsi, 
-			harga_jual, foto_url, lokasi, status
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			harga_jual, foto_url, lokasi, status, harga_asli, mata_uang_asli,
+			kilometer, transmisi, bahan_bakar, warna, tipe_bodi, jumlah_kursi, plat_region,
+			latitude, longitude, berlaku_sampai, dipublikasikan_pada, vin
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12,
+		          $13, NULLIF($14, ''), NULLIF($15, ''), NULLIF($16, ''), NULLIF($17, ''), NULLIF($18, 0), NULLIF($19, ''),
+		          $20, $21, CASE WHEN $23 THEN NOW() + make_interval(days => $22) END, CASE WHEN $23 THEN NOW() END,
+		          NULLIF($24, ''))
 		RETURNING id, owner_id, merk, model, tahun, kondisi, deskripsi, 
-		          harga_jual, foto_url, lokasi, status, created_at
+		          harga_jual, foto_url, lokasi, status, created_at, ` + kolomAtribut + `
 	`
 
 	var mobil pb.Mobil
 	var createdAt time.Time
+	var harga money.Money
 
-	err := s.DB.QueryRowContext(ctx, query,
+	err = s.DB.QueryRowContext(ctx, query,
 		userID,
 		req.Merk,
 		req.Model,
 		req.Tahun,
 		req.Kondisi,
 		req.Deskripsi,
-		hargaJualBulat,
+		hargaJual,   // Ditulis sebagai teks desimal ke NUMERIC (tanpa float)
 		req.FotoUrl, // Simpan foto_url dari request
 		req.Lokasi,
-		"tersedia",
-	).Scan(
+		statusAwal,
+		hargaAsliKolom,
+		hargaAsli.Currency,
+		spek.Kilometer,
+		spek.Transmisi,
+		spek.BahanBakar,
+		spek.Warna,
+		spek.TipeBodi,
+		spek.JumlahKursi,
+		spek.PlatRegion,
+		koordinat.lat,
+		koordinat.lng,
+		MasaBerlaku(),
+		statusAwal == StatusTersedia, // Draft & antrean moderasi belum punya masa tayang
+		spek.VIN,
+	).Scan(append([]interface{}{
 		&mobil.Id,
 		&mobil.OwnerId,
 		&mobil.Merk,
@@ -125,26 +166,49 @@ func (s *MobilServiceServer) CreateMobil(ctx context.Context, req *pb.CreateMobi
 		&mobil.Tahun,
 		&mobil.Kondisi,
 		&mobil.Deskripsi,
-		&mobil.HargaJual,
+		&harga,
 		&mobil.FotoUrl, // Scan langsung ke FotoUrl
 		&mobil.Lokasi,
 		&mobil.Status,
 		&createdAt,
-	)
+	}, tujuanAtribut(&mobil)...)...)
 
 	if err != nil {
 		log.Printf("Gagal menyimpan mobil ke DB: %v", err)
 		return nil, status.Errorf(codes.Internal, "Gagal menyimpan mobil")
 	}
 
+	setHarga(&mobil, harga, hargaAsli)
+	mobil.HargaTampilMoney = hargaAsli.ToProto()
 	mobil.CreatedAt = timestamppb.New(createdAt)
 	log.Printf("Mobil baru berhasil dibuat oleh UserID %s (MobilID: %s)", userID, mobil.Id)
 
-	// Buat notifikasi untuk penjual
+	// Harga awal masuk riwayat harga (gagal dicatat tidak membatalkan listing)
+	if data.AdaHarga {
+		if err := hargapasar.Catat(ctx, s.DB, mobil.Id, hargapasar.JenisListing, harga, &hargaAsli, ""); err != nil {
+			log.Printf("%v", err)
+		}
+	}
+	if penilaian != nil {
+		if err := moderasi.Simpan(ctx, s.DB, mobil.Id, *penilaian); err != nil {
+			log.Printf("%v", err)
+		}
+		if penilaian.Ditahan {
+			log.Printf("Mobil %s ditahan otomatis: skor risiko %d", mobil.Id, penilaian.Skor)
+		}
+	}
+
+	// Buat notifikasi untuk penjual (draft belum perlu notifikasi)
 	pesanMobil := fmt.Sprintf("%d %s %s", mobil.Tahun, mobil.Merk, mobil.Model)
-	go notifikasi.CreateNotification(s.DB, context.Background(), userID, "jual",
-		fmt.Sprintf("Anda berhasil memasang iklan jual mobil %s dengan harga Rp %.0f pada tanggal %s.",
-			pesanMobil, mobil.HargaJual, createdAt.Format("02 Jan 2006")))
+	switch statusAwal {
+	case StatusTersedia:
+		go notifikasi.CreateNotification(s.DB, context.Background(), userID, "jual",
+			fmt.Sprintf("Anda berhasil memasang iklan jual mobil %s dengan harga %s pada tanggal %s.",
+				pesanMobil, hargaAsli.Format(), createdAt.Format("02 Jan 2006")))
+	case pencarian.StatusMenungguModerasi:
+		go notifikasi.CreateNotification(s.DB, context.Background(), userID, TipeNotifikasiListing,
+			fmt.Sprintf("Iklan mobil %s sedang ditinjau staf dan akan tayang setelah disetujui.", pesanMobil))
+	}
 
 	return &mobil, nil
 }
@@ -159,6 +223,48 @@ func (s *MobilServiceServer) ListMobil(ctx context.Context, req *pb.ListMobilReq
 		filterStatus = *req.FilterStatus
 	}
 
+	displayCurrency, err := validasiDisplayCurrency(req.DisplayCurrency)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := pencarian.ParseFilter(req.Filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Filter tidak valid: %v", err)
+	}
+	where, args := filter.Klausa("", []interface{}{filterStatus})
+	where = "WHERE status = $1" + where
+	if pencarian.StatusPrivat(filterStatus) {
+		// Draft / antrean moderasi / ditolak hanya bisa dilihat owner-nya (admin melihat semua)
+		userID, ok := ctx.Value(auth.UserIDKey).(string)
+		if !ok {
+			return nil, status.Errorf(codes.Unauthenticated, "Login diperlukan untuk melihat listing berstatus '%s'", filterStatus)
+		}
+		if !auth.IsAdmin(ctx) {
+			args = append(args, userID)
+			where += fmt.Sprintf(" AND owner_id = $%d", len(args))
+		}
+	}
+	if filterStatus == StatusTersedia {
+		// Listing yang masa tayangnya habis tapi belum diproses job tidak ikut tampil
+		where += " AND (berlaku_sampai IS NULL OR berlaku_sampai > NOW())"
+	}
+
+	// Urutan: terbaru (default, draft yang baru dipublikasikan ikut dianggap baru) atau jarak dari titik asal filter
+	urutan := waktuTayang + " DESC"
+	argsList := args
+	switch strings.ToLower(strings.TrimSpace(req.Urutkan)) {
+	case "", urutTerbaru:
+	case urutJarak:
+		if filter.Asal == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Urutan jarak membutuhkan titik asal (latitude/longitude atau dekat_kota)")
+		}
+		var jarak string
+		jarak, argsList = filter.EkspresiJarak("", append([]interface{}{}, args...))
+		urutan = jarak + " ASC NULLS LAST, " + waktuTayang + " DESC"
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Urutan harus %s atau %s", urutTerbaru, urutJarak)
+	}
+
 	// Logika paginasi sederhana
 	limit := 50 // Sesuai permintaan Anda "50 mobil"
 	if req.Limit > 0 {
@@ -171,47 +277,38 @@ func (s *MobilServiceServer) ListMobil(ctx context.Context, req *pb.ListMobilReq
 
 	// Query untuk mengambil mobil
 	query := `
-		SELECT id, owner_id, merk, model, tahun, kondisi, deskripsi, 
-		       harga_jual, foto_url, lokasi, status, created_at
+		SELECT ` + kolomListMobil + `
 		FROM mobils
-		WHERE status = $1
-		ORDER BY created_at DESC
-		LIMIT $2 OFFSET $3
-	`
-	rows, err := s.DB.QueryContext(ctx, query, filterStatus, limit, offset)
+		` + joinRatingOwner + `
+		` + where + fmt.Sprintf(`
+		ORDER BY %s, id
+		LIMIT $%d OFFSET $%d
+	`, urutan, len(argsList)+1, len(argsList)+2)
+	rows, err := s.DB.QueryContext(ctx, query, append(argsList, limit, offset)...)
 	if err != nil {
 		log.Printf("Gagal query ListMobil: %v", err)
 		return nil, status.Errorf(codes.Internal, "Gagal mengambil data mobil")
 	}
 	defer rows.Close()
 
+	konverter := kurs.NewKonverter(s.DB, time.Now())
 	var mobils []*pb.Mobil
 	for rows.Next() {
-		var mobil pb.Mobil
-		var createdAt time.Time
-		var fotoUrl sql.NullString
-
-		err := rows.Scan(
-			&mobil.Id, &mobil.OwnerId, &mobil.Merk, &mobil.Model, &mobil.Tahun,
-			&mobil.Kondisi, &mobil.Deskripsi, &mobil.HargaJual, &fotoUrl,
-			&mobil.Lokasi, &mobil.Status, &createdAt,
-		)
+		baris, err := scanMobilList(rows)
 		if err != nil {
 			log.Printf("Gagal scan row mobil: %v", err)
 			continue
 		}
-
-		// Set foto_url jika ada
-		if fotoUrl.Valid {
-			mobil.FotoUrl = fotoUrl.String
+		if err := setHargaTampil(ctx, konverter, baris.Mobil, baris.HargaAsli, displayCurrency); err != nil {
+			return nil, err
 		}
-		mobil.CreatedAt = timestamppb.New(createdAt)
-		mobils = append(mobils, &mobil)
+		setJarak(baris.Mobil, filter.Asal)
+		mobils = append(mobils, baris.Mobil)
 	}
 
 	// Query untuk total (untuk paginasi)
 	var total int32
-	s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM mobils WHERE status = $1", filterStatus).Scan(&total)
+	s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM mobils "+where, args...).Scan(&total)
 
 	return &pb.ListMobilResponse{
 		Mobils: mobils,
@@ -226,24 +323,33 @@ func (s *MobilServiceServer) GetMobil(ctx context.Context, req *pb.GetMobilReque
 	if req.MobilId == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "MobilID tidak boleh kosong")
 	}
+	displayCurrency, err := validasiDisplayCurrency(req.DisplayCurrency)
+	if err != nil {
+		return nil, err
+	}
 
 	query := `
 		SELECT m.id, m.owner_id, u.name as owner_name, m.merk, m.model, m.tahun, m.kondisi, m.deskripsi, 
-		       m.harga_jual, m.foto_url, m.lokasi, m.status, m.created_at
+		       m.harga_jual, m.foto_url, m.lokasi, m.status, m.created_at, m.harga_asli, m.mata_uang_asli,
+		       COALESCE(r.total, 0), COALESCE(r.jumlah, 0), ` + kolomAtribut + `
 		FROM mobils m
 		LEFT JOIN users u ON m.owner_id = u.id
+		` + joinRatingOwner + `
 		WHERE m.id = $1
 	`
 	var mobil pb.Mobil
 	var createdAt time.Time
-	var fotoUrl sql.NullString
-	var ownerName string
+	var fotoUrl, hargaAsliText sql.NullString
+	var ownerName, mataUangAsli string
+	var harga money.Money
+	var totalBintang, jumlahUlasan int32
 
-	err := s.DB.QueryRowContext(ctx, query, req.MobilId).Scan(
+	err = s.DB.QueryRowContext(ctx, query, req.MobilId).Scan(append([]interface{}{
 		&mobil.Id, &mobil.OwnerId, &ownerName, &mobil.Merk, &mobil.Model, &mobil.Tahun,
-		&mobil.Kondisi, &mobil.Deskripsi, &mobil.HargaJual, &fotoUrl,
-		&mobil.Lokasi, &mobil.Status, &createdAt,
-	)
+		&mobil.Kondisi, &mobil.Deskripsi, &harga, &fotoUrl,
+		&mobil.Lokasi, &mobil.Status, &createdAt, &hargaAsliText, &mataUangAsli,
+		&totalBintang, &jumlahUlasan,
+	}, tujuanAtribut(&mobil)...)...)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -253,6 +359,14 @@ func (s *MobilServiceServer) GetMobil(ctx context.Context, req *pb.GetMobilReque
 		return nil, status.Errorf(codes.Internal, "Gagal mengambil data mobil")
 	}
 
+	// Listing yang belum publik hanya untuk owner dan admin (RPC publik, token opsional)
+	if pencarian.StatusPrivat(mobil.Status) {
+		userID, _ := ctx.Value(auth.UserIDKey).(string)
+		if userID != mobil.OwnerId && !auth.IsAdmin(ctx) {
+			return nil, status.Errorf(codes.NotFound, "Mobil tidak ditemukan")
+		}
+	}
+
 	// Set owner name
 	mobil.OwnerName = ownerName
 	log.Printf("Owner name: %s", ownerName)
@@ -261,11 +375,250 @@ func (s *MobilServiceServer) GetMobil(ctx context.Context, req *pb.GetMobilReque
 	if fotoUrl.Valid {
 		mobil.FotoUrl = fotoUrl.String
 	}
+	hargaAsli := parseHargaAsli(hargaAsliText, mataUangAsli, harga)
+	setHarga(&mobil, harga, hargaAsli)
+	if err := setHargaTampil(ctx, kurs.NewKonverter(s.DB, time.Now()), &mobil, hargaAsli, displayCurrency); err != nil {
+		return nil, err
+	}
+	setRatingOwner(&mobil, totalBintang, jumlahUlasan)
 	mobil.CreatedAt = timestamppb.New(createdAt)
 
 	return &mobil, nil
 }
 
+// hargaDariRequest membaca harga_jual_money, atau harga_jual (deprecated) untuk client lama
+func hargaDariRequest(req *pb.CreateMobilRequest) (money.Money, error) {
+	if req.HargaJualMoney == nil {
+		return money.FromFloat(req.HargaJual, money.DefaultCurrency), nil
+	}
+	harga, err := money.FromProto(req.HargaJualMoney)
+	if err != nil {
+		return money.Money{}, status.Errorf(codes.InvalidArgument, "Harga jual tidak valid: %v", err)
+	}
+	return harga, nil
+}
+
+// kolomListMobil adalah kolom SELECT untuk daftar mobil (dibaca dengan scanMobilList).
+// Query harus memakai joinRatingOwner.
+const kolomListMobil = `id, owner_id, merk, model, tahun, kondisi, deskripsi,
+		       harga_jual, foto_url, lokasi, status, created_at, harga_asli, mata_uang_asli,
+		       COALESCE(r.total, 0), COALESCE(r.jumlah, 0), ` + kolomAtribut
+
+// kolomAtribut adalah atribut terstruktur mobil (nama kolom unik, aman dipakai bersama join users/ulasan),
+// dibaca dengan tujuanAtribut
+const kolomAtribut = `kilometer, COALESCE(transmisi, ''), COALESCE(bahan_bakar, ''),
+		       COALESCE(warna, ''), COALESCE(tipe_bodi, ''), COALESCE(jumlah_kursi, 0), COALESCE(plat_region, ''),
+		       latitude, longitude, berlaku_sampai, COALESCE(alasan_penolakan, ''), COALESCE(vin, ''),
+		       COALESCE(nomor_stok, '')`
+
+// Nilai ListMobilRequest.urutkan
+const (
+	urutTerbaru = "terbaru"
+	urutJarak   = "jarak"
+)
+
+// waktuTayang adalah waktu listing mulai tayang (created_at untuk draft dan listing lama)
+const waktuTayang = "COALESCE(dipublikasikan_pada, created_at)"
+
+// kilometerScan mengisi pb.Mobil.Kilometer (optional) dari kolom yang boleh NULL
+type kilometerScan struct{ mobil *pb.Mobil }
+
+func (k kilometerScan) Scan(src interface{}) error {
+	var km sql.NullInt32
+	if err := km.Scan(src); err != nil {
+		return err
+	}
+	k.mobil.Kilometer = nil
+	if km.Valid {
+		k.mobil.Kilometer = &km.Int32
+	}
+	return nil
+}
+
+// floatOpsional mengisi field optional double (latitude/longitude) dari kolom yang boleh NULL
+type floatOpsional struct{ tujuan **float64 }
+
+func (f floatOpsional) Scan(src interface{}) error {
+	var nilai sql.NullFloat64
+	if err := nilai.Scan(src); err != nil {
+		return err
+	}
+	*f.tujuan = nil
+	if nilai.Valid {
+		*f.tujuan = &nilai.Float64
+	}
+	return nil
+}
+
+// tujuanAtribut mengembalikan tujuan Scan untuk kolomAtribut
+func tujuanAtribut(mobil *pb.Mobil) []interface{} {
+	return []interface{}{
+		kilometerScan{mobil}, &mobil.Transmisi, &mobil.BahanBakar,
+		&mobil.Warna, &mobil.TipeBodi, &mobil.JumlahKursi, &mobil.PlatRegion,
+		floatOpsional{&mobil.Latitude}, floatOpsional{&mobil.Longitude}, waktuOpsional{&mobil.BerlakuSampai},
+		&mobil.AlasanPenolakan, &mobil.Vin, &mobil.NomorStok,
+	}
+}
+
+// waktuOpsional mengisi field Timestamp dari kolom TIMESTAMP yang boleh NULL
+type waktuOpsional struct{ tujuan **timestamppb.Timestamp }
+
+func (w waktuOpsional) Scan(src interface{}) error {
+	var nilai sql.NullTime
+	if err := nilai.Scan(src); err != nil {
+		return err
+	}
+	*w.tujuan = nil
+	if nilai.Valid {
+		*w.tujuan = timestamppb.New(nilai.Time)
+	}
+	return nil
+}
+
+// titikListing adalah koordinat yang disimpan saat CreateMobil (nil = tidak diketahui)
+type titikListing struct {
+	lat, lng *float64
+}
+
+// koordinatListing memakai latitude/longitude dari request, atau geocode lokasi lewat gazetteer
+func koordinatListing(req *pb.CreateMobilRequest) (titikListing, error) {
+	if req.Latitude != nil || req.Longitude != nil {
+		if req.Latitude == nil || req.Longitude == nil {
+			return titikListing{}, status.Errorf(codes.InvalidArgument, "Latitude dan longitude harus diisi bersamaan")
+		}
+		if !(geo.Koordinat{Lat: *req.Latitude, Lng: *req.Longitude}).Valid() {
+			return titikListing{}, status.Errorf(codes.InvalidArgument, "Koordinat lokasi tidak valid")
+		}
+		return titikListing{lat: req.Latitude, lng: req.Longitude}, nil
+	}
+	if k, _, ok := geo.Geocode(req.Lokasi); ok {
+		return titikListing{lat: &k.Lat, lng: &k.Lng}, nil
+	}
+	return titikListing{}, nil
+}
+
+// setJarak mengisi jarak_km dari titik asal filter (listing tanpa koordinat dibiarkan kosong)
+func setJarak(mobil *pb.Mobil, asal *geo.Koordinat) {
+	if asal == nil || mobil.Latitude == nil || mobil.Longitude == nil {
+		return
+	}
+	jarak := geo.BulatkanKm(geo.Haversine(*asal, geo.Koordinat{Lat: *mobil.Latitude, Lng: *mobil.Longitude}))
+	mobil.JarakKm = &jarak
+}
+
+// rowScanner bisa *sql.Row atau *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// barisMobil adalah hasil scanMobilList beserta harga dalam bentuk money.Money
+type barisMobil struct {
+	Mobil     *pb.Mobil
+	Harga     money.Money // harga_jual (IDR)
+	HargaAsli money.Money
+}
+
+// scanMobilList membaca satu baris kolomListMobil (tanpa harga_tampil_money)
+func scanMobilList(row rowScanner) (barisMobil, error) {
+	var mobil pb.Mobil
+	var createdAt time.Time
+	var fotoUrl, hargaAsliText sql.NullString
+	var mataUangAsli string
+	var harga money.Money
+	var totalBintang, jumlahUlasan int32
+
+	err := row.Scan(append([]interface{}{
+		&mobil.Id, &mobil.OwnerId, &mobil.Merk, &mobil.Model, &mobil.Tahun,
+		&mobil.Kondisi, &mobil.Deskripsi, &harga, &fotoUrl,
+		&mobil.Lokasi, &mobil.Status, &createdAt, &hargaAsliText, &mataUangAsli,
+		&totalBintang, &jumlahUlasan,
+	}, tujuanAtribut(&mobil)...)...)
+	if err != nil {
+		return barisMobil{}, err
+	}
+
+	// Set foto_url jika ada
+	if fotoUrl.Valid {
+		mobil.FotoUrl = fotoUrl.String
+	}
+	hargaAsli := parseHargaAsli(hargaAsliText, mataUangAsli, harga)
+	setHarga(&mobil, harga, hargaAsli)
+	setRatingOwner(&mobil, totalBintang, jumlahUlasan)
+	mobil.CreatedAt = timestamppb.New(createdAt)
+	return barisMobil{Mobil: &mobil, Harga: harga, HargaAsli: hargaAsli}, nil
+}
+
+// joinRatingOwner menggabungkan total bintang & jumlah ulasan (dari pembeli, tidak disembunyikan)
+// milik owner listing. Alias r, dipakai di ListMobil dan GetMobil.
+const joinRatingOwner = `LEFT JOIN (
+			SELECT target_id, SUM(rating) AS total, COUNT(*) AS jumlah
+			FROM ulasan
+			WHERE peran_penulis = 'pembeli' AND NOT disembunyikan
+			GROUP BY target_id
+		) r ON r.target_id = owner_id`
+
+// setRatingOwner mengisi rating rata-rata penjual (1 desimal) dan jumlah ulasannya
+func setRatingOwner(mobil *pb.Mobil, totalBintang, jumlah int32) {
+	mobil.OwnerRating = ulasan.RataRata(totalBintang, jumlah)
+	mobil.OwnerJumlahUlasan = jumlah
+}
+
+// setHarga mengisi harga_jual_money (IDR), field harga_jual lama (deprecated), dan harga asli
+func setHarga(mobil *pb.Mobil, harga, hargaAsli money.Money) {
+	mobil.HargaJualMoney = harga.ToProto()
+	mobil.HargaJual = harga.Float64()
+	mobil.HargaAsliMoney = hargaAsli.ToProto()
+}
+
+// parseHargaAsli membaca harga_asli + mata_uang_asli, fallback ke harga_jual (IDR)
+// untuk baris yang belum punya harga asli
+func parseHargaAsli(nilai sql.NullString, mataUang string, hargaJual money.Money) money.Money {
+	if !nilai.Valid || mataUang == "" {
+		return hargaJual
+	}
+	harga, err := money.Parse(nilai.String, mataUang)
+	if err != nil {
+		log.Printf("harga_asli %q (%s) tidak valid: %v", nilai.String, mataUang, err)
+		return hargaJual
+	}
+	return harga
+}
+
+// validasiDisplayCurrency menormalkan display_currency (kosong = tampilkan mata uang asli)
+func validasiDisplayCurrency(displayCurrency *string) (string, error) {
+	if displayCurrency == nil || strings.TrimSpace(*displayCurrency) == "" {
+		return "", nil
+	}
+	currency := strings.ToUpper(strings.TrimSpace(*displayCurrency))
+	if !money.IsSupported(currency) {
+		return "", status.Errorf(codes.InvalidArgument, "display_currency %q tidak didukung", *displayCurrency)
+	}
+	return currency, nil
+}
+
+// setHargaTampil mengisi harga_tampil_money dari harga asli dengan kurs yang berlaku hari ini
+func setHargaTampil(ctx context.Context, konverter *kurs.Konverter, mobil *pb.Mobil, hargaAsli money.Money, displayCurrency string) error {
+	if displayCurrency == "" {
+		mobil.HargaTampilMoney = hargaAsli.ToProto()
+		return nil
+	}
+	tampil, err := konverter.Konversi(ctx, hargaAsli, displayCurrency)
+	if err != nil {
+		return errorKurs(err)
+	}
+	mobil.HargaTampilMoney = tampil.ToProto()
+	return nil
+}
+
+// errorKurs mengubah error konversi kurs ke status gRPC
+func errorKurs(err error) error {
+	if errors.Is(err, kurs.ErrKursTidakAda) {
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	log.Printf("Gagal konversi kurs: %v", err)
+	return status.Errorf(codes.Internal, "Gagal konversi mata uang")
+}
+
 // getMakesFromCache helper untuk cek cache DB
 func (s *MobilServiceServer) getMakesFromCache(ctx context.Context) ([]*pb.Make, error) {
 	query := `SELECT brand_id, name, updated_at FROM brand_cache`
@@ -506,23 +859,40 @@ func (s *MobilServiceServer) UploadFoto(ctx context.Context, req *pb.UploadFotoR
 //
 // Fungsi CreateMobil:
 // - Ambil user_id dari context (owner mobil)
-// - Validasi input (merk, model, tahun, harga_jual harus valid)
-// - Bulatkan harga untuk menghindari floating-point precision issue
-// - Insert mobil baru ke database dengan status 'tersedia'
+// - Validasi input (merk, model, tahun, harga_jual harus valid); simpan_draft cukup merk & model
+// - Harga dari harga_jual_money (Money, minor unit) atau harga_jual lama (deprecated)
+// - Harga boleh dalam mata uang asing: disimpan di harga_asli, harga_jual dikonversi ke IDR (tabel kurs)
+// - Atribut terstruktur opsional (kilometer, transmisi, bahan bakar, warna, tipe bodi, jumlah kursi,
+//   plat region, VIN) divalidasi & dinormalkan lewat atribut.Normalisasi
+// - Koordinat dari latitude/longitude request, jika kosong dari lokasi lewat gazetteer (geo.Geocode)
+// - Listing non-draft dinilai moderasi.Nilai (harga jauh di bawah pasar, foto duplikat, akun baru,
+//   VIN duplikat); skor >= RISIKO_BATAS_TAHAN langsung masuk antrean moderasi
+// - Insert mobil baru ke database dengan status 'tersedia', tayang selama MasaBerlaku() hari
+//   ('menunggu_moderasi' jika LISTING_MODERASI aktif atau ditahan, 'draft' jika simpan_draft)
+// - Catat harga awal ke riwayat_harga (hargapasar.Catat)
 // - Buat notifikasi untuk penjual (goroutine background)
 // - Return data mobil yang baru dibuat
 //
 // Fungsi ListMobil:
 // - Query daftar mobil dengan paginasi (default 50 mobil per page)
-// - Filter berdasarkan status (default: 'tersedia')
+// - Filter berdasarkan status (default: 'tersedia'; listing lewat berlaku_sampai tidak ikut)
+// - filter (FilterMobil): merk, model, rentang tahun & harga, lokasi, kondisi, dan atribut
+//   terstruktur (pencarian.Filter, sama dengan yang dipakai saved search)
+// - Pencarian lokasi: radius_km dari titik asal (koordinat atau dekat_kota), urutkan "jarak"
+//   mengurutkan dengan haversine (listing tanpa koordinat di akhir), jarak_km diisi per mobil
 // - Support limit dan offset untuk pagination
-// - Order by created_at DESC (mobil terbaru di atas)
+// - Order by waktu tayang DESC (dipublikasikan_pada, fallback created_at; mobil terbaru di atas)
+// - Status privat (draft/menunggu_moderasi/ditolak) butuh login dan hanya milik sendiri (admin: semua)
 // - Handle harga_rental NULL dengan sql.NullFloat64
+// - Harga di-scan lossless dari NUMERIC ke money.Money (harga_jual_money + harga_jual lama)
+// - display_currency: harga_tampil_money = harga asli dikonversi dengan kurs yang berlaku hari ini
+// - owner_rating & owner_jumlah_ulasan: rating penjual dari tabel ulasan (joinRatingOwner)
 // - Return list mobil + total count
 //
 // Fungsi GetMobil:
 // - Query detail satu mobil berdasarkan mobil_id
-// - Return 404 NotFound jika mobil tidak ada
+// - Return 404 NotFound jika mobil tidak ada, atau belum publik dan bukan milik user (kecuali admin)
+// - display_currency dan rating penjual sama seperti ListMobil
 // - Support untuk public access (tidak perlu login)
 //
 // Fungsi GetMakes:
@@ -553,23 +923,40 @@ func (s *MobilServiceServer) UploadFoto(ctx context.Context, req *pb.UploadFotoR
 //
 // Fungsi CreateMobil:
 // - Ambil user_id dari context (owner mobil)
-// - Validasi input (merk, model, tahun, harga_jual harus valid)
-// - Bulatkan harga untuk menghindari floating-point precision issue
-// - Insert mobil baru ke database dengan status 'tersedia'
+// - Validasi input (merk, model, tahun, harga_jual harus valid); simpan_draft cukup merk & model
+// - Harga dari harga_jual_money (Money, minor unit) atau harga_jual lama (deprecated)
+// - Harga boleh dalam mata uang asing: disimpan di harga_asli, harga_jual dikonversi ke IDR (tabel kurs)
+// - Atribut terstruktur opsional (kilometer, transmisi, bahan bakar, warna, tipe bodi, jumlah kursi,
+//   plat region, VIN) divalidasi & dinormalkan lewat atribut.Normalisasi
+// - Koordinat dari latitude/longitude request, jika kosong dari lokasi lewat gazetteer (geo.Geocode)
+// - Listing non-draft dinilai moderasi.Nilai (harga jauh di bawah pasar, foto duplikat, akun baru,
+//   VIN duplikat); skor >= RISIKO_BATAS_TAHAN langsung masuk antrean moderasi
+// - Insert mobil baru ke database dengan status 'tersedia', tayang selama MasaBerlaku() hari
+//   ('menunggu_moderasi' jika LISTING_MODERASI aktif atau ditahan, 'draft' jika simpan_draft)
+// - Catat harga awal ke riwayat_harga (hargapasar.Catat)
 // - Buat notifikasi untuk penjual (goroutine background)
 // - Return data mobil yang baru dibuat
 //
 // Fungsi ListMobil:
 // - Query daftar mobil dengan paginasi (default 50 mobil per page)
-// - Filter berdasarkan status (default: 'tersedia')
+// - Filter berdasarkan status (default: 'tersedia'; listing lewat berlaku_sampai tidak ikut)
+// - filter (FilterMobil): merk, model, rentang tahun & harga, lokasi, kondisi, dan atribut
+//   terstruktur (pencarian.Filter, sama dengan yang dipakai saved search)
+// - Pencarian lokasi: radius_km dari titik asal (koordinat atau dekat_kota), urutkan "jarak"
+//   mengurutkan dengan haversine (listing tanpa koordinat di akhir), jarak_km diisi per mobil
 // - Support limit dan offset untuk pagination
-// - Order by created_at DESC (mobil terbaru di atas)
+// - Order by waktu tayang DESC (dipublikasikan_pada, fallback created_at; mobil terbaru di atas)
+// - Status privat (draft/menunggu_moderasi/ditolak) butuh login dan hanya milik sendiri (admin: semua)
 // - Handle harga_rental NULL dengan sql.NullFloat64
+// - Harga di-scan lossless dari NUMERIC ke money.Money (harga_jual_money + harga_jual lama)
+// - display_currency: harga_tampil_money = harga asli dikonversi dengan kurs yang berlaku hari ini
+// - owner_rating & owner_jumlah_ulasan: rating penjual dari tabel ulasan (joinRatingOwner)
 // - Return list mobil + total count
 //
 // Fungsi GetMobil:
 // - Query detail satu mobil berdasarkan mobil_id
-// - Return 404 NotFound jika mobil tidak ada
+// - Return 404 NotFound jika mobil tidak ada, atau belum publik dan bukan milik user (kecuali admin)
+// - display_currency dan rating penjual sama seperti ListMobil
 // - Support untuk public access (tidak perlu login)
 //
 // Fungsi GetMakes:
@@ -0,0 +1,338 @@
+package moderasi
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"strings"
+
+	"carapp.com/m/internal/auth"
+	"carapp.com/m/internal/pencarian"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Tindakan admin atas laporan
+const (
+	TindakanTahan   = "tahan"
+	TindakanAbaikan = "abaikan"
+)
+
+const maksPanjangCatatan = 1000
+
+// AdminServiceServer adalah implementasi dari pb.AdminServiceServer
+type AdminServiceServer struct {
+	pb.UnimplementedAdminServiceServer
+	DB *sql.DB
+}
+
+// NewAdminService membuat instance baru
+func NewAdminService(db *sql.DB) *AdminServiceServer {
+	return &AdminServiceServer{DB: db}
+}
+
+// cekAdmin memastikan pemanggil sudah login dan ber-role admin
+func cekAdmin(ctx context.Context) (string, error) {
+	adminID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return "", status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if !auth.IsAdmin(ctx) {
+		return "", status.Errorf(codes.PermissionDenied, "Hanya admin yang dapat mengakses layanan ini")
+	}
+	return adminID, nil
+}
+
+// ListLaporan menampilkan antrean laporan: listing dengan laporan terbanyak di depan,
+// lalu laporan paling lama
+func (s *AdminServiceServer) ListLaporan(ctx context.Context, req *pb.ListLaporanRequest) (*pb.ListLaporanResponse, error) {
+	if _, err := cekAdmin(ctx); err != nil {
+		return nil, err
+	}
+	statusLaporan := strings.TrimSpace(req.Status)
+	if statusLaporan == "" {
+		statusLaporan = LaporanTerbuka
+	}
+	if statusLaporan != LaporanTerbuka && statusLaporan != LaporanDitindak && statusLaporan != LaporanDiabaikan {
+		return nil, status.Errorf(codes.InvalidArgument, "Status laporan harus %s, %s, atau %s",
+			LaporanTerbuka, LaporanDitindak, LaporanDiabaikan)
+	}
+
+	limit := 50
+	if req.Limit > 0 && req.Limit <= 100 {
+		limit = int(req.Limit)
+	}
+	offset := 0
+	if req.Page > 1 {
+		offset = (int(req.Page) - 1) * limit
+	}
+
+	rows, err := s.DB.QueryContext(ctx, `SELECT `+kolomLaporan+fromLaporan+`
+		WHERE l.status = $1
+		ORDER BY jumlah_terbuka DESC, l.created_at ASC, l.id
+		LIMIT $2 OFFSET $3
+	`, statusLaporan, limit, offset)
+	if err != nil {
+		log.Printf("Gagal query antrean laporan: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil laporan")
+	}
+	defer rows.Close()
+
+	var daftar []*pb.LaporanListing
+	for rows.Next() {
+		lap, err := scanLaporan(rows)
+		if err != nil {
+			log.Printf("Gagal scan laporan: %v", err)
+			continue
+		}
+		daftar = append(daftar, lap)
+	}
+
+	var total int32
+	s.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM laporan_listing WHERE status = $1`, statusLaporan).Scan(&total)
+
+	return &pb.ListLaporanResponse{Laporan: daftar, Total: total}, nil
+}
+
+// TanganiLaporan menindaklanjuti laporan terbuka. 'tahan' memindahkan listing ke antrean
+// moderasi dan menutup semua laporan terbuka listing tsb 'ditindak'; 'abaikan' menutup semua
+// laporan terbuka listing tsb 'diabaikan'.
+func (s *AdminServiceServer) TanganiLaporan(ctx context.Context, req *pb.TanganiLaporanRequest) (*pb.LaporanListing, error) {
+	adminID, err := cekAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tindakan := strings.ToLower(strings.TrimSpace(req.Tindakan))
+	catatan := strings.TrimSpace(req.Catatan)
+	if req.LaporanId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "LaporanID tidak boleh kosong")
+	}
+	if tindakan != TindakanTahan && tindakan != TindakanAbaikan {
+		return nil, status.Errorf(codes.InvalidArgument, "Tindakan harus %s atau %s", TindakanTahan, TindakanAbaikan)
+	}
+	if len(catatan) > maksPanjangCatatan {
+		return nil, status.Errorf(codes.InvalidArgument, "Catatan maksimal %d karakter", maksPanjangCatatan)
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal memulai transaksi DB")
+	}
+	defer tx.Rollback()
+
+	// 1. Lock laporan dan mobilnya
+	var mobilID, statusLaporan, statusMobil string
+	err = tx.QueryRowContext(ctx, `
+		SELECT l.mobil_id, l.status, m.status
+		FROM laporan_listing l
+		JOIN mobils m ON m.id = l.mobil_id
+		WHERE l.id = $1
+		FOR UPDATE
+	`, req.LaporanId).Scan(&mobilID, &statusLaporan, &statusMobil)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "Laporan tidak ditemukan")
+	}
+	if err != nil {
+		log.Printf("Gagal lock laporan %s: %v", req.LaporanId, err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil laporan")
+	}
+	if statusLaporan != LaporanTerbuka {
+		return nil, status.Errorf(codes.FailedPrecondition, "Laporan sudah %s", statusLaporan)
+	}
+
+	// 2. Tindakan
+	ditahan := false
+	if tindakan == TindakanAbaikan {
+		err = TutupLaporan(ctx, tx, mobilID, LaporanDiabaikan, catatan, adminID)
+	} else {
+		if statusMobil != "tersedia" && statusMobil != pencarian.StatusMenungguModerasi {
+			return nil, status.Errorf(codes.FailedPrecondition, "Mobil berstatus '%s' tidak bisa ditahan", statusMobil)
+		}
+		if ditahan, err = Tahan(ctx, tx, mobilID); err == nil {
+			err = catatSinyalLaporan(ctx, tx, mobilID, "Ditahan admin dari laporan user")
+		}
+		if err == nil {
+			err = TutupLaporan(ctx, tx, mobilID, LaporanDitindak, catatan, adminID)
+		}
+	}
+	if err != nil {
+		log.Printf("Gagal menangani laporan %s: %v", req.LaporanId, err)
+		return nil, status.Errorf(codes.Internal, "Gagal menangani laporan")
+	}
+
+	lap, err := ambilLaporan(ctx, tx, req.LaporanId)
+	if err != nil {
+		log.Printf("Gagal membaca laporan %s: %v", req.LaporanId, err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil laporan")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan penanganan laporan")
+	}
+	log.Printf("Laporan %s ditangani admin %s: %s (mobil %s)", req.LaporanId, adminID, tindakan, mobilID)
+
+	if ditahan {
+		go NotifyDitahan(s.DB, mobilID)
+	}
+	return lap, nil
+}
+
+// ListLaporanUser menampilkan antrean laporan user dari chat: user dengan laporan aktif
+// terbanyak di depan, lalu laporan paling lama
+func (s *AdminServiceServer) ListLaporanUser(ctx context.Context, req *pb.ListLaporanUserRequest) (*pb.ListLaporanUserResponse, error) {
+	if _, err := cekAdmin(ctx); err != nil {
+		return nil, err
+	}
+	statusLaporan := strings.TrimSpace(req.Status)
+	if statusLaporan == "" {
+		statusLaporan = LaporanUserBaru
+	}
+	if statusLaporan != LaporanUserBaru && statusLaporan != LaporanUserDitinjau && statusLaporan != LaporanUserDitutup {
+		return nil, status.Errorf(codes.InvalidArgument, "Status laporan harus %s, %s, atau %s",
+			LaporanUserBaru, LaporanUserDitinjau, LaporanUserDitutup)
+	}
+
+	limit := 50
+	if req.Limit > 0 && req.Limit <= 100 {
+		limit = int(req.Limit)
+	}
+	offset := 0
+	if req.Page > 1 {
+		offset = (int(req.Page) - 1) * limit
+	}
+
+	rows, err := s.DB.QueryContext(ctx, `SELECT `+kolomLaporanUser+fromLaporanUser+`
+		WHERE l.status = $1
+		ORDER BY jumlah_aktif DESC, l.created_at ASC, l.id
+		LIMIT $2 OFFSET $3
+	`, statusLaporan, limit, offset)
+	if err != nil {
+		log.Printf("Gagal query antrean laporan user: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil laporan user")
+	}
+	defer rows.Close()
+
+	var daftar []*pb.LaporanUser
+	for rows.Next() {
+		lap, err := scanLaporanUser(rows)
+		if err != nil {
+			log.Printf("Gagal scan laporan user: %v", err)
+			continue
+		}
+		daftar = append(daftar, lap)
+	}
+
+	var total int32
+	s.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM laporan_user WHERE status = $1`, statusLaporan).Scan(&total)
+
+	return &pb.ListLaporanUserResponse{Laporan: daftar, Total: total}, nil
+}
+
+// TanganiLaporanUser menindaklanjuti satu laporan user: 'tinjau' menandai laporan baru sedang
+// ditinjau, 'tutup' menyelesaikan laporan baru / ditinjau dengan catatan hasil peninjauan
+func (s *AdminServiceServer) TanganiLaporanUser(ctx context.Context, req *pb.TanganiLaporanUserRequest) (*pb.LaporanUser, error) {
+	adminID, err := cekAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tindakan := strings.ToLower(strings.TrimSpace(req.Tindakan))
+	catatan := strings.TrimSpace(req.Catatan)
+	if req.LaporanId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "LaporanID tidak boleh kosong")
+	}
+	if tindakan != TindakanTinjau && tindakan != TindakanTutup {
+		return nil, status.Errorf(codes.InvalidArgument, "Tindakan harus %s atau %s", TindakanTinjau, TindakanTutup)
+	}
+	if tindakan == TindakanTutup && catatan == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Catatan hasil peninjauan harus diisi saat menutup laporan")
+	}
+	if len(catatan) > maksPanjangCatatan {
+		return nil, status.Errorf(codes.InvalidArgument, "Catatan maksimal %d karakter", maksPanjangCatatan)
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal memulai transaksi DB")
+	}
+	defer tx.Rollback()
+
+	statusLama, err := tanganiLaporanUser(ctx, tx, req.LaporanId, tindakan, catatan, adminID)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "Laporan tidak ditemukan")
+	}
+	if err == errTindakanTidakBerlaku {
+		return nil, status.Errorf(codes.FailedPrecondition, "Laporan berstatus '%s' tidak bisa di-%s", statusLama, tindakan)
+	}
+	if err != nil {
+		log.Printf("Gagal menangani laporan user %s: %v", req.LaporanId, err)
+		return nil, status.Errorf(codes.Internal, "Gagal menangani laporan user")
+	}
+
+	lap, err := ambilLaporanUser(ctx, tx, req.LaporanId)
+	if err != nil {
+		log.Printf("Gagal membaca laporan user %s: %v", req.LaporanId, err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil laporan user")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan penanganan laporan user")
+	}
+	log.Printf("Laporan user %s ditangani admin %s: %s (user %s)", req.LaporanId, adminID, tindakan, lap.DilaporkanId)
+	return lap, nil
+}
+
+// GetRisikoMobil menampilkan penilaian risiko terakhir sebuah listing
+func (s *AdminServiceServer) GetRisikoMobil(ctx context.Context, req *pb.GetRisikoMobilRequest) (*pb.PenilaianRisiko, error) {
+	if _, err := cekAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.MobilId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "MobilID tidak boleh kosong")
+	}
+
+	p, ada, err := Muat(ctx, s.DB, req.MobilId)
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil penilaian risiko")
+	}
+	if !ada {
+		return nil, status.Errorf(codes.NotFound, "Listing belum pernah dinilai")
+	}
+
+	resp := &pb.PenilaianRisiko{
+		MobilId:     req.MobilId,
+		Skor:        int32(p.Skor),
+		Ditahan:     p.Ditahan,
+		DinilaiPada: timestamppb.New(p.DinilaiPada),
+	}
+	for _, sinyal := range p.Sinyal {
+		resp.Sinyal = append(resp.Sinyal, &pb.SinyalRisiko{
+			Aturan:     sinyal.Aturan,
+			Skor:       int32(sinyal.Skor),
+			Keterangan: sinyal.Keterangan,
+		})
+	}
+	return resp, nil
+}
+
+// PENJELASAN FILE admin_service.go:
+// File ini berisi AdminService: antrean laporan listing, laporan user dari chat, dan penilaian
+// risiko penipuan
+// (semua RPC hanya untuk role admin)
+//
+// Fungsi ListLaporan:
+// - Default laporan 'terbuka'; listing dengan laporan terbanyak di depan, lalu yang paling lama
+// - Setiap laporan membawa ringkasan listing dan skor risiko terakhir
+//
+// Fungsi TanganiLaporan:
+// - abaikan: semua laporan terbuka listing tsb ditutup 'diabaikan'
+// - tahan: listing 'tersedia' -> 'menunggu_moderasi', semua laporan terbuka listing tsb 'ditindak',
+//   owner diberi tahu; keputusan akhir lewat MobilService.ModerasiMobil (setujui / tolak)
+//
+// Fungsi ListLaporanUser / TanganiLaporanUser:
+// - Laporan dari ChatService.ReportUser; default 'baru', user dengan laporan aktif terbanyak di depan
+// - tinjau: 'baru' -> 'ditinjau'; tutup: 'baru'/'ditinjau' -> 'ditutup' dengan catatan wajib
+// - Tindakan terhadap akun yang dilaporkan di luar RPC ini; catatan_admin menyimpan hasilnya
+//
+// Fungsi GetRisikoMobil:
+// - Skor dan sinyal aturan dari penilaian terakhir (risiko.go), untuk meninjau listing yang ditahan
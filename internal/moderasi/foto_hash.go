@@ -0,0 +1,111 @@
+package moderasi
+
+import (
+	"fmt"
+	"image"
+	_ "image/jpeg" // Registrasi decoder JPEG untuk image.Decode
+	_ "image/png"  // Registrasi decoder PNG untuk image.Decode
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// prefixUpload adalah awalan URL foto hasil UploadFoto (file disimpan di folder uploads)
+const prefixUpload = "/uploads/"
+
+// Ukuran kotak dHash: 9 kolom x 8 baris menghasilkan 8x8 = 64 bit
+const (
+	lebarHash  = 9
+	tinggiHash = 8
+)
+
+// BatasJarakFoto: dua foto dianggap sama jika hash-nya berbeda paling banyak sekian bit (dari 64)
+const BatasJarakFoto = 6
+
+// HashFotoListing menghitung hash perseptual foto listing yang diupload lewat UploadFoto.
+// ok = false untuk foto eksternal (seeder), file yang tidak ada, atau format yang tidak bisa
+// didecode (WebP tidak ada di library standar).
+func HashFotoListing(fotoURL string) (uint64, bool) {
+	if !strings.HasPrefix(fotoURL, prefixUpload) {
+		return 0, false
+	}
+	hash, err := HashFoto(filepath.Join("uploads", filepath.Base(fotoURL)))
+	if err != nil {
+		return 0, false
+	}
+	return hash, true
+}
+
+// HashFoto menghitung difference hash (dHash) 64-bit dari file gambar
+func HashFoto(path string) (uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return 0, fmt.Errorf("gagal decode foto %s: %w", path, err)
+	}
+	if b := img.Bounds(); b.Dx() < lebarHash || b.Dy() < tinggiHash {
+		return 0, fmt.Errorf("foto %s terlalu kecil untuk di-hash", path)
+	}
+	return dHash(img), nil
+}
+
+// dHash: gambar diperkecil ke 9x8 kotak abu-abu, bit = 1 jika kotak lebih terang dari tetangga kanannya.
+// Tahan terhadap resize, kompresi ulang, dan perubahan kecerahan ringan.
+func dHash(img image.Image) uint64 {
+	const lebar, tinggi = lebarHash, tinggiHash
+	b := img.Bounds()
+
+	var abu [tinggi][lebar]float64
+	for y := 0; y < tinggi; y++ {
+		y0, y1 := b.Min.Y+y*b.Dy()/tinggi, b.Min.Y+(y+1)*b.Dy()/tinggi
+		for x := 0; x < lebar; x++ {
+			x0, x1 := b.Min.X+x*b.Dx()/lebar, b.Min.X+(x+1)*b.Dx()/lebar
+			abu[y][x] = rataKecerahan(img, x0, y0, x1, y1)
+		}
+	}
+
+	var hash uint64
+	for y := 0; y < tinggi; y++ {
+		for x := 0; x < lebar-1; x++ {
+			if abu[y][x] > abu[y][x+1] {
+				hash |= 1 << uint(y*(lebar-1)+x)
+			}
+		}
+	}
+	return hash
+}
+
+// rataKecerahan menghitung rata-rata luminans satu kotak (maksimal 16x16 sampel per kotak)
+func rataKecerahan(img image.Image, x0, y0, x1, y1 int) float64 {
+	langkahX, langkahY := max(1, (x1-x0)/16), max(1, (y1-y0)/16)
+	var total float64
+	var n int
+	for y := y0; y < y1; y += langkahY {
+		for x := x0; x < x1; x += langkahX {
+			r, g, bl, _ := img.At(x, y).RGBA()
+			total += 0.299*float64(r) + 0.587*float64(g) + 0.114*float64(bl)
+			n++
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+	return total / float64(n)
+}
+
+// PENJELASAN FILE foto_hash.go:
+// File ini berisi hash perseptual foto listing untuk mendeteksi foto yang dipakai ulang
+//
+// Fungsi HashFoto / dHash:
+// - Difference hash 64-bit: foto yang sama (walau di-resize/dikompres ulang) menghasilkan hash
+//   yang hampir sama, dibandingkan dengan jarak Hamming (BatasJarakFoto, dihitung di SQL)
+// - Hanya JPEG dan PNG (decoder library standar)
+//
+// Fungsi HashFotoListing:
+// - Hanya foto hasil UploadFoto (/uploads/...), foto URL eksternal dilewati
+// - Hash disimpan di mobils.foto_phash agar tidak dihitung ulang
@@ -0,0 +1,228 @@
+package moderasi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"carapp.com/m/internal/notifikasi"
+	pb "carapp.com/m/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Alasan laporan listing
+const (
+	AlasanPenipuan        = "penipuan"
+	AlasanHargaTidakWajar = "harga_tidak_wajar"
+	AlasanFotoPalsu       = "foto_palsu"
+	AlasanDataSalah       = "data_salah"
+	AlasanDuplikat        = "duplikat"
+	AlasanLainnya         = "lainnya"
+)
+
+// Status laporan listing
+const (
+	LaporanTerbuka   = "terbuka"
+	LaporanDitindak  = "ditindak"  // Listing ditahan ke antrean moderasi
+	LaporanDiabaikan = "diabaikan" // Laporan tidak terbukti
+)
+
+const (
+	defaultBatasLaporan = 3
+	skorLaporan         = 40
+	// tipeNotifikasiListing sama dengan mobil.TipeNotifikasiListing
+	tipeNotifikasiListing = "listing"
+)
+
+// ErrSudahDilaporkan: user masih punya laporan terbuka untuk listing yang sama
+var ErrSudahDilaporkan = errors.New("listing ini sudah Anda laporkan dan sedang ditinjau")
+
+// AlasanValid mengecek alasan laporan
+func AlasanValid(alasan string) bool {
+	switch alasan {
+	case AlasanPenipuan, AlasanHargaTidakWajar, AlasanFotoPalsu, AlasanDataSalah, AlasanDuplikat, AlasanLainnya:
+		return true
+	}
+	return false
+}
+
+// BatasLaporan membaca jumlah pelapor berbeda (laporan terbuka) agar listing ditahan otomatis
+// dari env RISIKO_BATAS_LAPORAN (default 3). Hanya akun berumur >= umurAkunBaruHari yang dihitung.
+func BatasLaporan() int {
+	if batas, err := strconv.Atoi(os.Getenv("RISIKO_BATAS_LAPORAN")); err == nil && batas > 0 {
+		return batas
+	}
+	return defaultBatasLaporan
+}
+
+// kolomLaporan adalah kolom SELECT laporan beserta ringkasan listing (dibaca scanLaporan).
+// Query harus memakai alias l (laporan_listing), m (mobils), dan pr (LEFT JOIN penilaian_risiko).
+const kolomLaporan = `l.id, l.mobil_id, l.pelapor_id, l.alasan, l.keterangan, l.status, l.catatan_admin,
+		       l.created_at, l.ditangani_pada, COALESCE(m.tahun, 0), COALESCE(m.merk, ''), COALESCE(m.model, ''),
+		       COALESCE(m.status, ''), COALESCE(pr.skor, 0),
+		       (SELECT COUNT(*) FROM laporan_listing l2 WHERE l2.mobil_id = l.mobil_id AND l2.status = 'terbuka') AS jumlah_terbuka`
+
+const fromLaporan = `
+		FROM laporan_listing l
+		JOIN mobils m ON m.id = l.mobil_id
+		LEFT JOIN penilaian_risiko pr ON pr.mobil_id = l.mobil_id`
+
+// rowScanner bisa *sql.Row atau *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanLaporan(row rowScanner) (*pb.LaporanListing, error) {
+	var lap pb.LaporanListing
+	var createdAt time.Time
+	var ditanganiPada sql.NullTime
+	var tahun int32
+	var merk, model string
+	err := row.Scan(&lap.Id, &lap.MobilId, &lap.PelaporId, &lap.Alasan, &lap.Keterangan, &lap.Status, &lap.CatatanAdmin,
+		&createdAt, &ditanganiPada, &tahun, &merk, &model, &lap.StatusMobil, &lap.SkorRisiko, &lap.JumlahLaporanTerbuka)
+	if err != nil {
+		return nil, err
+	}
+	lap.CreatedAt = timestamppb.New(createdAt)
+	if ditanganiPada.Valid {
+		lap.DitanganiPada = timestamppb.New(ditanganiPada.Time)
+	}
+	lap.JudulMobil = fmt.Sprintf("%d %s %s", tahun, merk, model)
+	return &lap, nil
+}
+
+// ambilLaporan membaca satu laporan beserta ringkasan listingnya
+func ambilLaporan(ctx context.Context, q queryer, laporanID string) (*pb.LaporanListing, error) {
+	return scanLaporan(q.QueryRowContext(ctx, `SELECT `+kolomLaporan+fromLaporan+` WHERE l.id = $1`, laporanID))
+}
+
+// Laporkan menyimpan laporan user. Jika pelapor berbeda dengan laporan terbuka mencapai
+// BatasLaporan(), listing 'tersedia' ditahan ke antrean moderasi (ditahan = true).
+// Laporan dari akun baru tetap disimpan untuk admin, tapi tidak dihitung untuk penahanan
+// otomatis agar beberapa akun sekali pakai tidak bisa menurunkan listing orang lain.
+// Validasi listing (ada, publik, bukan milik pelapor) dilakukan pemanggil.
+func Laporkan(ctx context.Context, db *sql.DB, mobilID, pelaporID, alasan, keterangan string) (*pb.LaporanListing, bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("gagal memulai transaksi DB: %w", err)
+	}
+	defer tx.Rollback()
+
+	var laporanID string
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO laporan_listing (mobil_id, pelapor_id, alasan, keterangan)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (mobil_id, pelapor_id) WHERE status = 'terbuka' DO NOTHING
+		RETURNING id
+	`, mobilID, pelaporID, alasan, keterangan).Scan(&laporanID)
+	if err == sql.ErrNoRows {
+		return nil, false, ErrSudahDilaporkan
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("gagal menyimpan laporan: %w", err)
+	}
+
+	// Lock baris mobil agar laporan yang bersamaan tidak menahan dua kali
+	if _, err := tx.ExecContext(ctx, `SELECT 1 FROM mobils WHERE id = $1 FOR UPDATE`, mobilID); err != nil {
+		return nil, false, fmt.Errorf("gagal lock mobil %s: %w", mobilID, err)
+	}
+	var jumlah int
+	err = tx.QueryRowContext(ctx, `
+		SELECT COUNT(DISTINCT l.pelapor_id)
+		FROM laporan_listing l
+		JOIN users u ON u.id = l.pelapor_id
+		WHERE l.mobil_id = $1 AND l.status = $2 AND u.created_at <= NOW() - make_interval(days => $3)
+	`, mobilID, LaporanTerbuka, umurAkunBaruHari).Scan(&jumlah)
+	if err != nil {
+		return nil, false, fmt.Errorf("gagal menghitung laporan: %w", err)
+	}
+
+	ditahan := false
+	if jumlah >= BatasLaporan() {
+		if ditahan, err = Tahan(ctx, tx, mobilID); err != nil {
+			return nil, false, err
+		}
+		if ditahan {
+			keteranganSinyal := fmt.Sprintf("%d user berbeda (akun >= %d hari) melaporkan listing ini", jumlah, umurAkunBaruHari)
+			if err := catatSinyalLaporan(ctx, tx, mobilID, keteranganSinyal); err != nil {
+				return nil, false, err
+			}
+		}
+	}
+
+	lap, err := ambilLaporan(ctx, tx, laporanID)
+	if err != nil {
+		return nil, false, fmt.Errorf("gagal membaca laporan: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, fmt.Errorf("gagal menyimpan laporan: %w", err)
+	}
+	if ditahan {
+		log.Printf("Mobil %s ditahan otomatis: %d pelapor terbuka dari akun lama", mobilID, jumlah)
+	}
+	return lap, ditahan, nil
+}
+
+// TutupLaporan menutup semua laporan terbuka sebuah listing dengan status ditindak / diabaikan
+// di dalam tx pemanggil (dipakai TanganiLaporan dan MobilService.ModerasiMobil)
+func TutupLaporan(ctx context.Context, q queryer, mobilID, statusBaru, catatan, adminID string) error {
+	_, err := q.ExecContext(ctx, `
+		UPDATE laporan_listing SET status = $1, catatan_admin = $2, ditangani_oleh = $3, ditangani_pada = NOW()
+		WHERE mobil_id = $4 AND status = $5
+	`, statusBaru, catatan, adminID, mobilID, LaporanTerbuka)
+	if err != nil {
+		return fmt.Errorf("gagal menutup laporan mobil %s: %w", mobilID, err)
+	}
+	return nil
+}
+
+// catatSinyalLaporan menambahkan sinyal laporan_pengguna ke penilaian terakhir dan menandainya ditahan
+func catatSinyalLaporan(ctx context.Context, q queryer, mobilID, keterangan string) error {
+	p, _, err := Muat(ctx, q, mobilID)
+	if err != nil {
+		return err
+	}
+	p.tambah(Sinyal{Aturan: AturanLaporanPengguna, Skor: skorLaporan, Keterangan: keterangan})
+	p.Ditahan = true
+	return Simpan(ctx, q, mobilID, p)
+}
+
+// NotifyDitahan memberi tahu owner bahwa listingnya ditahan untuk ditinjau staf.
+// Dipanggil sebagai goroutine setelah transaksi DB di-commit.
+func NotifyDitahan(db *sql.DB, mobilID string) {
+	var ownerID, merk, model string
+	var tahun int
+	err := db.QueryRow(`SELECT owner_id, COALESCE(merk, ''), COALESCE(model, ''), COALESCE(tahun, 0) FROM mobils WHERE id = $1`, mobilID).
+		Scan(&ownerID, &merk, &model, &tahun)
+	if err != nil {
+		log.Printf("Gagal mengambil owner mobil %s untuk notifikasi penahanan: %v", mobilID, err)
+		return
+	}
+	notifikasi.CreateNotification(db, context.Background(), ownerID, tipeNotifikasiListing,
+		fmt.Sprintf("Iklan mobil %d %s %s sedang ditinjau staf dan sementara tidak tayang.", tahun, merk, model))
+}
+
+// PENJELASAN FILE laporan.go:
+// File ini berisi laporan penyalahgunaan listing dari user
+//
+// Konfigurasi (env):
+// - RISIKO_BATAS_LAPORAN: jumlah pelapor berbeda agar listing ditahan otomatis (default 3)
+//
+// Fungsi Laporkan (dipanggil MobilService.ReportMobil):
+// - Satu laporan terbuka per pelapor per listing (ErrSudahDilaporkan)
+// - Laporan terbuka dari >= RISIKO_BATAS_LAPORAN pelapor -> listing 'tersedia' ditahan ke antrean
+//   moderasi dan sinyal laporan_pengguna ditambahkan ke penilaian risiko
+// - Hanya pelapor dengan akun berumur >= 7 hari yang dihitung (akun sekali pakai tidak cukup
+//   untuk menahan listing); laporan akun baru tetap masuk antrean admin
+//
+// Fungsi TutupLaporan:
+// - Semua laporan terbuka listing ditutup sekaligus (tahan / abaikan di TanganiLaporan,
+//   setujui / tolak di ModerasiMobil) agar laporan lama tidak menahan ulang listing yang sudah ditinjau
+//
+// Fungsi NotifyDitahan:
+// - Owner diberi tahu listingnya sedang ditinjau (alasan detail tidak diungkap ke owner)
@@ -0,0 +1,109 @@
+package moderasi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	pb "carapp.com/m/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Status laporan user (tabel laporan_user, diisi ChatService.ReportUser)
+const (
+	LaporanUserBaru     = "baru"
+	LaporanUserDitinjau = "ditinjau" // Sedang ditinjau admin
+	LaporanUserDitutup  = "ditutup"  // Selesai, hasil di catatan_admin
+)
+
+// Tindakan admin atas laporan user
+const (
+	TindakanTinjau = "tinjau"
+	TindakanTutup  = "tutup"
+)
+
+// errTindakanTidakBerlaku: tindakan tidak cocok dengan status laporan sekarang
+var errTindakanTidakBerlaku = errors.New("tindakan tidak berlaku untuk status laporan")
+
+// kolomLaporanUser adalah kolom SELECT laporan user (dibaca scanLaporanUser).
+// Query harus memakai alias l (laporan_user), p (pelapor), dan d (dilaporkan).
+const kolomLaporanUser = `l.id, l.pelapor_id, p.name, l.dilaporkan_id, d.name, COALESCE(l.percakapan_id::text, ''),
+		       l.alasan, l.status, l.catatan_admin, l.created_at, l.ditangani_pada,
+		       (SELECT COUNT(*) FROM laporan_user l2 WHERE l2.dilaporkan_id = l.dilaporkan_id AND l2.status <> 'ditutup') AS jumlah_aktif`
+
+const fromLaporanUser = `
+		FROM laporan_user l
+		JOIN users p ON p.id = l.pelapor_id
+		JOIN users d ON d.id = l.dilaporkan_id`
+
+func scanLaporanUser(row rowScanner) (*pb.LaporanUser, error) {
+	var lap pb.LaporanUser
+	var createdAt sql.NullTime
+	var ditanganiPada sql.NullTime
+	err := row.Scan(&lap.Id, &lap.PelaporId, &lap.PelaporNama, &lap.DilaporkanId, &lap.DilaporkanNama, &lap.PercakapanId,
+		&lap.Alasan, &lap.Status, &lap.CatatanAdmin, &createdAt, &ditanganiPada, &lap.JumlahLaporanAktif)
+	if err != nil {
+		return nil, err
+	}
+	if createdAt.Valid {
+		lap.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+	if ditanganiPada.Valid {
+		lap.DitanganiPada = timestamppb.New(ditanganiPada.Time)
+	}
+	return &lap, nil
+}
+
+// ambilLaporanUser membaca satu laporan user beserta nama pelapor dan yang dilaporkan
+func ambilLaporanUser(ctx context.Context, q queryer, laporanID string) (*pb.LaporanUser, error) {
+	return scanLaporanUser(q.QueryRowContext(ctx, `SELECT `+kolomLaporanUser+fromLaporanUser+` WHERE l.id = $1`, laporanID))
+}
+
+// statusSetelah menentukan status baru laporan user dari tindakan admin.
+// ok = false jika tindakan tidak berlaku untuk status sekarang.
+func statusSetelah(statusLama, tindakan string) (string, bool) {
+	switch {
+	case tindakan == TindakanTinjau && statusLama == LaporanUserBaru:
+		return LaporanUserDitinjau, true
+	case tindakan == TindakanTutup && statusLama != LaporanUserDitutup:
+		return LaporanUserDitutup, true
+	}
+	return "", false
+}
+
+// tanganiLaporanUser mengunci laporan user dan memindahkan statusnya sesuai tindakan
+// di dalam tx pemanggil. Return sql.ErrNoRows jika laporan tidak ada.
+func tanganiLaporanUser(ctx context.Context, tx *sql.Tx, laporanID, tindakan, catatan, adminID string) (statusLama string, err error) {
+	err = tx.QueryRowContext(ctx, `SELECT status FROM laporan_user WHERE id = $1 FOR UPDATE`, laporanID).Scan(&statusLama)
+	if err != nil {
+		return "", err
+	}
+	statusBaru, ok := statusSetelah(statusLama, tindakan)
+	if !ok {
+		return statusLama, errTindakanTidakBerlaku
+	}
+	_, err = tx.ExecContext(ctx, `
+		UPDATE laporan_user
+		SET status = $1, catatan_admin = COALESCE(NULLIF($2::text, ''), catatan_admin),
+		    ditangani_oleh = $3, ditangani_pada = NOW()
+		WHERE id = $4
+	`, statusBaru, catatan, adminID, laporanID)
+	if err != nil {
+		return statusLama, fmt.Errorf("gagal update laporan user %s: %w", laporanID, err)
+	}
+	return statusLama, nil
+}
+
+// PENJELASAN FILE laporan_user.go:
+// File ini berisi penanganan laporan user dari chat (tabel laporan_user) oleh admin
+//
+// Alur status:
+// - baru: Laporan masuk dari ChatService.ReportUser
+// - ditinjau: Admin sedang meninjau (tindakan 'tinjau', hanya dari 'baru')
+// - ditutup: Selesai (tindakan 'tutup' dari 'baru' atau 'ditinjau'), hasil di catatan_admin
+//
+// Helper:
+// - kolomLaporanUser / scanLaporanUser: laporan beserta nama pelapor & yang dilaporkan, dan jumlah
+//   laporan aktif (baru/ditinjau) untuk user yang dilaporkan dari semua pelapor
+// - tanganiLaporanUser: lock laporan (FOR UPDATE), validasi tindakan, simpan status + catatan admin
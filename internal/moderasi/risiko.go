@@ -0,0 +1,324 @@
+package moderasi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"carapp.com/m/internal/hargapasar"
+	"carapp.com/m/internal/money"
+	"carapp.com/m/internal/pencarian"
+)
+
+// Aturan deteksi penipuan (nilai SinyalRisiko.aturan)
+const (
+	AturanHargaDiBawahPasar     = "harga_di_bawah_pasar"
+	AturanFotoDuplikat          = "foto_duplikat"
+	AturanAkunBaruBanyakListing = "akun_baru_banyak_listing"
+	AturanVINDuplikat           = "vin_duplikat"
+	AturanLaporanPengguna       = "laporan_pengguna"
+)
+
+const (
+	maksSkor          = 100
+	defaultBatasTahan = 60
+	// Akun berumur kurang dari umurAkunBaruHari yang memasang >= batasListingAkunBaru listing
+	umurAkunBaruHari     = 7
+	batasListingAkunBaru = 5
+)
+
+// statusAktif adalah listing yang dianggap aktif untuk pengecekan duplikat (VIN / foto)
+const statusAktif = "status IN ('tersedia', 'dipesan', '" + pencarian.StatusMenungguModerasi + "')"
+
+// BatasTahan membaca skor minimal agar listing ditahan otomatis dari env RISIKO_BATAS_TAHAN (default 60)
+func BatasTahan() int {
+	if batas, err := strconv.Atoi(os.Getenv("RISIKO_BATAS_TAHAN")); err == nil && batas > 0 {
+		return batas
+	}
+	return defaultBatasTahan
+}
+
+// queryer bisa *sql.DB atau *sql.Tx
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// Listing adalah data listing yang dinilai (ID kosong untuk listing yang belum disimpan)
+type Listing struct {
+	ID      string
+	OwnerID string
+	Merk    string
+	Model   string
+	Tahun   int32
+	Harga   money.Money // harga_jual (IDR)
+	FotoURL string
+	VIN     string
+}
+
+// Sinyal adalah satu aturan yang terpicu beserta kontribusi skornya
+type Sinyal struct {
+	Aturan     string `json:"aturan"`
+	Skor       int    `json:"skor"`
+	Keterangan string `json:"keterangan"`
+}
+
+// Penilaian adalah hasil penilaian risiko satu listing
+type Penilaian struct {
+	Skor        int // 0-100, jumlah skor sinyal
+	Sinyal      []Sinyal
+	Ditahan     bool
+	FotoHash    *int64 // Hash foto untuk mobils.foto_phash (nil jika tidak bisa dihitung)
+	DinilaiPada time.Time
+}
+
+// tambah menambahkan sinyal (sinyal lama dengan aturan yang sama diganti)
+func (p *Penilaian) tambah(s Sinyal) {
+	sinyal := p.Sinyal[:0:0]
+	for _, lama := range p.Sinyal {
+		if lama.Aturan != s.Aturan {
+			sinyal = append(sinyal, lama)
+		}
+	}
+	p.Sinyal = append(sinyal, s)
+	p.Skor = 0
+	for _, s := range p.Sinyal {
+		p.Skor += s.Skor
+	}
+	p.Skor = min(p.Skor, maksSkor)
+}
+
+// aturan memeriksa satu heuristik; nil = tidak terpicu
+type aturan func(ctx context.Context, q queryer, l Listing, p *Penilaian) (*Sinyal, error)
+
+// daftarAturan dijalankan berurutan oleh Nilai. Aturan baru cukup ditambahkan di sini.
+var daftarAturan = []aturan{aturanHarga, aturanFoto, aturanAkunBaru, aturanVIN}
+
+// Nilai menjalankan semua aturan terhadap listing. Listing dengan skor >= BatasTahan()
+// ditandai Ditahan (pemanggil memindahkannya ke antrean moderasi).
+func Nilai(ctx context.Context, q queryer, l Listing) (Penilaian, error) {
+	var p Penilaian
+	if hash, ok := HashFotoListing(l.FotoURL); ok {
+		h := int64(hash) // Disimpan bit-per-bit di kolom BIGINT
+		p.FotoHash = &h
+	}
+	for _, periksa := range daftarAturan {
+		s, err := periksa(ctx, q, l, &p)
+		if err != nil {
+			return Penilaian{}, err
+		}
+		if s != nil {
+			p.tambah(*s)
+		}
+	}
+	p.Ditahan = p.Skor >= BatasTahan()
+	p.DinilaiPada = time.Now()
+	return p, nil
+}
+
+// aturanHarga: harga jauh di bawah median pasar bucket merk/model/tahun
+// (misal Fortuner 2023 seharga Rp 50 juta)
+func aturanHarga(ctx context.Context, q queryer, l Listing, _ *Penilaian) (*Sinyal, error) {
+	if !l.Harga.IsPositive() || l.Tahun <= 1900 {
+		return nil, nil
+	}
+	acuan, ok, err := hargapasar.AcuanPasar(ctx, q, l.Merk, l.Model, l.Tahun, l.ID)
+	if err != nil || !ok || !acuan.IsPositive() {
+		return nil, err
+	}
+
+	persen := l.Harga.Minor * 100 / acuan.Minor
+	var skor int
+	switch {
+	case persen <= 30:
+		skor = 70
+	case persen <= 50:
+		skor = 45
+	case persen <= 70:
+		skor = 20
+	default:
+		return nil, nil
+	}
+	return &Sinyal{
+		Aturan:     AturanHargaDiBawahPasar,
+		Skor:       skor,
+		Keterangan: fmt.Sprintf("Harga %s hanya %d%% dari median pasar %s", l.Harga.Format(), persen, acuan.Format()),
+	}, nil
+}
+
+// aturanFoto: foto sama (URL identik atau hash perseptual mirip) dengan listing aktif milik user lain
+func aturanFoto(ctx context.Context, q queryer, l Listing, p *Penilaian) (*Sinyal, error) {
+	if strings.TrimSpace(l.FotoURL) == "" {
+		return nil, nil
+	}
+	var hash interface{}
+	if p.FotoHash != nil {
+		hash = *p.FotoHash
+	}
+	var jumlah int
+	err := q.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM mobils
+		WHERE id::text <> $1 AND owner_id::text <> $2 AND `+statusAktif+`
+		  AND (foto_url = $3 OR length(replace((foto_phash # $4::bigint)::bit(64)::text, '0', '')) <= $5)
+	`, l.ID, l.OwnerID, l.FotoURL, hash, BatasJarakFoto).Scan(&jumlah)
+	if err != nil {
+		return nil, fmt.Errorf("gagal memeriksa foto duplikat: %w", err)
+	}
+	if jumlah == 0 {
+		return nil, nil
+	}
+	return &Sinyal{
+		Aturan:     AturanFotoDuplikat,
+		Skor:       40,
+		Keterangan: fmt.Sprintf("Foto sama/mirip dengan %d listing aktif milik user lain", jumlah),
+	}, nil
+}
+
+// aturanAkunBaru: akun yang baru dibuat langsung memasang banyak listing
+func aturanAkunBaru(ctx context.Context, q queryer, l Listing, _ *Penilaian) (*Sinyal, error) {
+	var dibuat time.Time
+	var jumlah int
+	err := q.QueryRowContext(ctx, `
+		SELECT u.created_at, COUNT(m.id)
+		FROM users u
+		LEFT JOIN mobils m ON m.owner_id = u.id AND m.id::text <> $2
+		     AND m.created_at >= NOW() - make_interval(days => $3)
+		WHERE u.id = $1
+		GROUP BY u.created_at
+	`, l.OwnerID, l.ID, umurAkunBaruHari).Scan(&dibuat, &jumlah)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("gagal memeriksa umur akun: %w", err)
+	}
+
+	umurHari := int(time.Since(dibuat).Hours() / 24)
+	jumlah++ // Termasuk listing yang sedang dinilai
+	if umurHari >= umurAkunBaruHari || jumlah < batasListingAkunBaru {
+		return nil, nil
+	}
+	return &Sinyal{
+		Aturan:     AturanAkunBaruBanyakListing,
+		Skor:       30,
+		Keterangan: fmt.Sprintf("Akun berumur %d hari sudah memasang %d listing", umurHari, jumlah),
+	}, nil
+}
+
+// aturanVIN: VIN yang sama sedang dipasang di listing aktif lain
+func aturanVIN(ctx context.Context, q queryer, l Listing, _ *Penilaian) (*Sinyal, error) {
+	if l.VIN == "" {
+		return nil, nil
+	}
+	var milikLain, milikSendiri int
+	err := q.QueryRowContext(ctx, `
+		SELECT COUNT(*) FILTER (WHERE owner_id::text <> $3), COUNT(*) FILTER (WHERE owner_id::text = $3)
+		FROM mobils
+		WHERE vin = $1 AND id::text <> $2 AND `+statusAktif+`
+	`, l.VIN, l.ID, l.OwnerID).Scan(&milikLain, &milikSendiri)
+	if err != nil {
+		return nil, fmt.Errorf("gagal memeriksa VIN duplikat: %w", err)
+	}
+	switch {
+	case milikLain > 0:
+		return &Sinyal{
+			Aturan:     AturanVINDuplikat,
+			Skor:       50,
+			Keterangan: fmt.Sprintf("VIN %s sudah dipasang di %d listing aktif milik user lain", l.VIN, milikLain),
+		}, nil
+	case milikSendiri > 0:
+		return &Sinyal{
+			Aturan:     AturanVINDuplikat,
+			Skor:       15,
+			Keterangan: fmt.Sprintf("VIN %s sudah dipasang di %d listing aktif lain milik sendiri", l.VIN, milikSendiri),
+		}, nil
+	}
+	return nil, nil
+}
+
+// Simpan menyimpan (menimpa) penilaian terakhir listing dan hash fotonya
+func Simpan(ctx context.Context, q queryer, mobilID string, p Penilaian) error {
+	sinyal := p.Sinyal
+	if sinyal == nil {
+		sinyal = []Sinyal{}
+	}
+	sinyalJSON, err := json.Marshal(sinyal)
+	if err != nil {
+		return fmt.Errorf("gagal encode sinyal risiko: %w", err)
+	}
+	_, err = q.ExecContext(ctx, `
+		INSERT INTO penilaian_risiko (mobil_id, skor, sinyal, ditahan, dinilai_pada)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (mobil_id) DO UPDATE
+		SET skor = EXCLUDED.skor, sinyal = EXCLUDED.sinyal, ditahan = EXCLUDED.ditahan, dinilai_pada = NOW()
+	`, mobilID, p.Skor, string(sinyalJSON), p.Ditahan)
+	if err != nil {
+		return fmt.Errorf("gagal menyimpan penilaian risiko mobil %s: %w", mobilID, err)
+	}
+	if p.FotoHash != nil {
+		if _, err := q.ExecContext(ctx, `UPDATE mobils SET foto_phash = $1 WHERE id = $2`, *p.FotoHash, mobilID); err != nil {
+			return fmt.Errorf("gagal menyimpan hash foto mobil %s: %w", mobilID, err)
+		}
+	}
+	return nil
+}
+
+// Muat membaca penilaian terakhir listing. ada = false jika listing belum pernah dinilai.
+func Muat(ctx context.Context, q queryer, mobilID string) (Penilaian, bool, error) {
+	var p Penilaian
+	var sinyalJSON []byte
+	err := q.QueryRowContext(ctx, `
+		SELECT skor, sinyal, ditahan, dinilai_pada FROM penilaian_risiko WHERE mobil_id = $1
+	`, mobilID).Scan(&p.Skor, &sinyalJSON, &p.Ditahan, &p.DinilaiPada)
+	if err == sql.ErrNoRows {
+		return Penilaian{}, false, nil
+	}
+	if err != nil {
+		return Penilaian{}, false, fmt.Errorf("gagal membaca penilaian risiko mobil %s: %w", mobilID, err)
+	}
+	if err := json.Unmarshal(sinyalJSON, &p.Sinyal); err != nil {
+		return Penilaian{}, false, fmt.Errorf("sinyal risiko mobil %s tidak valid: %w", mobilID, err)
+	}
+	return p, true, nil
+}
+
+// Tahan memindahkan listing 'tersedia' ke antrean moderasi. ditahan = false jika listing
+// tidak sedang tersedia (misal sedang dipesan atau sudah di antrean).
+func Tahan(ctx context.Context, q queryer, mobilID string) (bool, error) {
+	res, err := q.ExecContext(ctx, `
+		UPDATE mobils SET status = $1, updated_at = NOW() WHERE id = $2 AND status = 'tersedia'
+	`, pencarian.StatusMenungguModerasi, mobilID)
+	if err != nil {
+		return false, fmt.Errorf("gagal menahan mobil %s: %w", mobilID, err)
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// PENJELASAN FILE risiko.go:
+// File ini berisi mesin aturan deteksi penipuan listing
+//
+// Konfigurasi (env):
+// - RISIKO_BATAS_TAHAN: skor minimal (0-100) agar listing ditahan otomatis (default 60)
+//
+// Fungsi Nilai (dipanggil CreateMobil dan PublishMobil sebelum listing tayang):
+// - Menjalankan daftarAturan, skor = jumlah skor sinyal (maksimal 100)
+// - harga_di_bawah_pasar: <= 30% median pasar = 70, <= 50% = 45, <= 70% = 20
+//   (median dari hargapasar.AcuanPasar, dilewati jika data pasar belum cukup)
+// - foto_duplikat (40): URL foto sama atau hash perseptual mirip dengan listing aktif user lain
+// - akun_baru_banyak_listing (30): akun < 7 hari dengan >= 5 listing dalam 7 hari terakhir
+// - vin_duplikat: VIN sama dengan listing aktif user lain (50) atau milik sendiri (15)
+// - Skor >= RISIKO_BATAS_TAHAN -> Ditahan, listing masuk antrean moderasi (ModerasiMobil)
+//
+// Fungsi Simpan / Muat:
+// - Penilaian terakhir per listing di tabel penilaian_risiko (sinyal dalam JSONB)
+// - Hash foto disimpan ke mobils.foto_phash untuk pembanding listing berikutnya
+//
+// Fungsi Tahan:
+// - Listing 'tersedia' -> 'menunggu_moderasi' (dipakai laporan user dan tindakan admin)
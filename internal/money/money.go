@@ -0,0 +1,319 @@
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	pb "carapp.com/m/proto"
+)
+
+// DefaultCurrency adalah mata uang semua harga yang tersimpan di database
+const DefaultCurrency = "IDR"
+
+// exponents adalah jumlah digit desimal (minor unit) per mata uang ISO 4217.
+// Mata uang yang tidak terdaftar dianggap tidak didukung.
+var exponents = map[string]int{
+	"IDR": 2,
+	"USD": 2,
+	"EUR": 2,
+	"SGD": 2,
+	"MYR": 2,
+	"AUD": 2,
+	"GBP": 2,
+	"JPY": 0,
+	"KRW": 0,
+}
+
+var (
+	ErrCurrencyTidakDikenal = errors.New("mata uang tidak dikenal")
+	ErrFormatTidakValid     = errors.New("format angka tidak valid")
+	ErrPresisiBerlebih      = errors.New("jumlah desimal melebihi minor unit mata uang")
+	ErrOverflow             = errors.New("nilai terlalu besar")
+)
+
+// Money adalah nilai uang dalam satuan terkecil (minor unit) + kode mata uang ISO 4217.
+// Contoh: Rp 150.000.000 = Money{Minor: 15000000000, Currency: "IDR"}.
+type Money struct {
+	Minor    int64
+	Currency string
+}
+
+// New membuat Money dari nilai minor unit
+func New(minor int64, currency string) Money {
+	return Money{Minor: minor, Currency: currency}
+}
+
+// IsSupported mengecek apakah kode mata uang dikenal
+func IsSupported(currency string) bool {
+	_, ok := exponents[currency]
+	return ok
+}
+
+// Exponent mengembalikan jumlah digit desimal mata uang (default 2)
+func Exponent(currency string) int {
+	if exp, ok := exponents[currency]; ok {
+		return exp
+	}
+	return 2
+}
+
+// Parse membaca angka desimal (contoh "150000000.00" dari kolom NUMERIC) tanpa melewati float64
+func Parse(s, currency string) (Money, error) {
+	s = strings.TrimSpace(s)
+	negatif := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+
+	bulat, pecahan, _ := strings.Cut(s, ".")
+	if bulat == "" && pecahan == "" {
+		return Money{}, ErrFormatTidakValid
+	}
+	if bulat == "" {
+		bulat = "0"
+	}
+
+	// Digit desimal di luar minor unit hanya boleh nol (contoh NUMERIC "1000.000")
+	exp := Exponent(currency)
+	if len(pecahan) > exp {
+		if strings.Trim(pecahan[exp:], "0") != "" {
+			return Money{}, ErrPresisiBerlebih
+		}
+		pecahan = pecahan[:exp]
+	}
+	pecahan += strings.Repeat("0", exp-len(pecahan))
+
+	digits := bulat + pecahan
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return Money{}, ErrFormatTidakValid
+		}
+	}
+	minor, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return Money{}, ErrOverflow
+	}
+	if negatif {
+		minor = -minor
+	}
+	return Money{Minor: minor, Currency: currency}, nil
+}
+
+// FromFloat mengubah nilai float (field lama yang deprecated) ke Money, dibulatkan ke minor unit
+func FromFloat(nilai float64, currency string) Money {
+	return Money{Minor: int64(math.Round(nilai * math.Pow10(Exponent(currency)))), Currency: currency}
+}
+
+// FromProto memvalidasi dan mengubah pb.Money (nil -> error)
+func FromProto(m *pb.Money) (Money, error) {
+	if m == nil {
+		return Money{}, ErrFormatTidakValid
+	}
+	currency := strings.ToUpper(strings.TrimSpace(m.CurrencyCode))
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+	if !IsSupported(currency) {
+		return Money{}, fmt.Errorf("%w: %s", ErrCurrencyTidakDikenal, m.CurrencyCode)
+	}
+	return Money{Minor: m.MinorUnits, Currency: currency}, nil
+}
+
+// ToProto mengubah Money ke pb.Money
+func (m Money) ToProto() *pb.Money {
+	return &pb.Money{MinorUnits: m.Minor, CurrencyCode: m.currency()}
+}
+
+// Float64 hanya untuk mengisi field double yang deprecated
+func (m Money) Float64() float64 {
+	return float64(m.Minor) / math.Pow10(Exponent(m.currency()))
+}
+
+// IsPositive mengecek nilai > 0
+func (m Money) IsPositive() bool {
+	return m.Minor > 0
+}
+
+// String mengembalikan angka desimal persis (contoh "150000000.00"), format yang sama dengan NUMERIC
+func (m Money) String() string {
+	exp := Exponent(m.currency())
+	minor := m.Minor
+	tanda := ""
+	if minor < 0 {
+		tanda = "-"
+		minor = -minor
+	}
+	s := strconv.FormatInt(minor, 10)
+	if exp == 0 {
+		return tanda + s
+	}
+	if len(s) <= exp {
+		s = strings.Repeat("0", exp-len(s)+1) + s
+	}
+	return tanda + s[:len(s)-exp] + "." + s[len(s)-exp:]
+}
+
+// Format mengembalikan teks untuk ditampilkan (format Indonesia), contoh "Rp 150.000.000"
+// atau "USD 31.499,50". Desimal nol tidak ditampilkan.
+func (m Money) Format() string {
+	desimal := m.String()
+	tanda := ""
+	if strings.HasPrefix(desimal, "-") {
+		tanda = "-"
+		desimal = desimal[1:]
+	}
+	bulat, pecahan, _ := strings.Cut(desimal, ".")
+
+	var b strings.Builder
+	for i, c := range bulat {
+		if i > 0 && (len(bulat)-i)%3 == 0 {
+			b.WriteByte('.')
+		}
+		b.WriteRune(c)
+	}
+	if strings.Trim(pecahan, "0") != "" {
+		b.WriteString("," + pecahan)
+	}
+
+	simbol := m.currency()
+	if simbol == "IDR" {
+		simbol = "Rp"
+	}
+	return simbol + " " + tanda + b.String()
+}
+
+// Convert mengubah Money ke mata uang lain dengan rate desimal (1 m.Currency = rate currency).
+// Dihitung dengan big.Rat lalu dibulatkan ke minor unit terdekat (half away from zero).
+func (m Money) Convert(rate *big.Rat, currency string) (Money, error) {
+	if !IsSupported(currency) {
+		return Money{}, fmt.Errorf("%w: %s", ErrCurrencyTidakDikenal, currency)
+	}
+	// minor_tujuan = minor_asal * rate * 10^(exp_tujuan - exp_asal)
+	hasil := new(big.Rat).Mul(new(big.Rat).SetInt64(m.Minor), rate)
+	selisih := Exponent(currency) - Exponent(m.currency())
+	skala := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(selisih))), nil))
+	if selisih >= 0 {
+		hasil.Mul(hasil, skala)
+	} else {
+		hasil.Quo(hasil, skala)
+	}
+
+	minor, err := bulatkan(hasil)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Minor: minor, Currency: currency}, nil
+}
+
+// FromRat membulatkan bilangan rasional (dalam satuan mata uang, bukan minor unit) ke Money
+func FromRat(r *big.Rat, currency string) (Money, error) {
+	skala := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(Exponent(currency))), nil)
+	minor, err := bulatkan(new(big.Rat).Mul(r, new(big.Rat).SetInt(skala)))
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Minor: minor, Currency: currency}, nil
+}
+
+// Rat mengembalikan nilai Money sebagai bilangan rasional dalam satuan mata uang
+func (m Money) Rat() *big.Rat {
+	skala := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(Exponent(m.currency()))), nil)
+	return new(big.Rat).SetFrac(big.NewInt(m.Minor), skala)
+}
+
+// ParseRate membaca rate kurs dalam bentuk desimal (contoh "15800" atau "0.0000633")
+func ParseRate(s string) (*big.Rat, error) {
+	rate, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok || rate.Sign() <= 0 {
+		return nil, ErrFormatTidakValid
+	}
+	return rate, nil
+}
+
+// bulatkan membulatkan bilangan rasional ke int64 terdekat (half away from zero)
+func bulatkan(r *big.Rat) (int64, error) {
+	num, den := new(big.Int).Set(r.Num()), r.Denom()
+	negatif := num.Sign() < 0
+	num.Abs(num)
+
+	// (2*num + den) / (2*den) = floor(num/den + 0.5)
+	num.Mul(num, big.NewInt(2)).Add(num, den)
+	q := num.Quo(num, new(big.Int).Mul(den, big.NewInt(2)))
+	if !q.IsInt64() {
+		return 0, ErrOverflow
+	}
+	if negatif {
+		return -q.Int64(), nil
+	}
+	return q.Int64(), nil
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// Scan membaca kolom NUMERIC (lib/pq mengirim []byte teks) secara lossless.
+// Currency diambil dari nilai Money sebelum Scan (default IDR).
+func (m *Money) Scan(src interface{}) error {
+	currency := m.currency()
+	var parsed Money
+	var err error
+
+	switch v := src.(type) {
+	case nil:
+		parsed = Money{Currency: currency}
+	case []byte:
+		parsed, err = Parse(string(v), currency)
+	case string:
+		parsed, err = Parse(v, currency)
+	case int64:
+		parsed, err = Parse(strconv.FormatInt(v, 10), currency)
+	case float64:
+		parsed, err = Parse(strconv.FormatFloat(v, 'f', -1, 64), currency)
+	default:
+		return fmt.Errorf("money: tipe %T tidak didukung", src)
+	}
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// Value menulis Money ke kolom NUMERIC sebagai teks desimal (tanpa float)
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+func (m Money) currency() string {
+	if m.Currency == "" {
+		return DefaultCurrency
+	}
+	return m.Currency
+}
+
+// PENJELASAN FILE money.go:
+// File ini berisi tipe Money untuk harga tanpa floating-point
+//
+// Masalah sebelumnya:
+// - harga_jual NUMERIC di Postgres, tapi double di proto dan float64 di Go
+// - CreateMobil harus math.Round untuk menutupi error presisi (lihat FIX_KONVERSI_HARGA.md)
+//
+// Solusi:
+// - Money = int64 minor unit + kode mata uang ISO 4217 (IDR punya 2 desimal: 1 rupiah = 100)
+// - Scan: baca NUMERIC sebagai teks lalu Parse -> tidak pernah lewat float64
+// - Value: tulis kembali sebagai teks desimal persis untuk kolom NUMERIC
+// - ToProto / FromProto: konversi ke pb.Money (message Money di carapp.proto)
+// - Float64 / FromFloat: hanya untuk field double lama yang ditandai deprecated
+// - Format: tampilan "Rp 150.000.000" untuk notifikasi dan invoice
+// - Convert / ParseRate: konversi mata uang dengan big.Rat (rate dari tabel kurs, lihat package kurs)
+// - Rat / FromRat: hitungan persentase dan bunga (simulasi kredit) tanpa float
+//
+// Catatan: harga_jual di database selalu dalam IDR (DefaultCurrency); harga asli listing
+// disimpan di harga_asli + mata_uang_asli
@@ -0,0 +1,121 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		nama     string
+		input    string
+		currency string
+		want     Money
+		wantErr  error
+	}{
+		{nama: "desimal satu digit", input: "1234.5", currency: "IDR", want: New(1234_50, "IDR")},
+		{nama: "NUMERIC dengan nol berlebih", input: "150000000.0000", currency: "IDR", want: New(150_000_000_00, "IDR")},
+		{nama: "tanpa desimal", input: "485000000", currency: "IDR", want: New(485_000_000_00, "IDR")},
+		{nama: "hanya pecahan", input: ".5", currency: "USD", want: New(50, "USD")},
+		{nama: "spasi dan tanda plus", input: " +10.25 ", currency: "USD", want: New(10_25, "USD")},
+		{nama: "negatif", input: "-0.01", currency: "IDR", want: New(-1, "IDR")},
+		{nama: "mata uang tanpa minor unit", input: "1500000.0", currency: "JPY", want: New(1_500_000, "JPY")},
+		{nama: "kosong", input: "", currency: "IDR", wantErr: ErrFormatTidakValid},
+		{nama: "tanda saja", input: "-", currency: "IDR", wantErr: ErrFormatTidakValid},
+		{nama: "huruf", input: "12a4", currency: "IDR", wantErr: ErrFormatTidakValid},
+		{nama: "koma desimal", input: "1234,5", currency: "IDR", wantErr: ErrFormatTidakValid},
+		{nama: "dengan simbol mata uang", input: "Rp 1000", currency: "IDR", wantErr: ErrFormatTidakValid},
+		{nama: "dua tanda", input: "--5", currency: "IDR", wantErr: ErrFormatTidakValid},
+		{nama: "lebih presisi dari minor unit", input: "1234.567", currency: "IDR", wantErr: ErrPresisiBerlebih},
+		{nama: "pecahan untuk JPY", input: "1234.5", currency: "JPY", wantErr: ErrPresisiBerlebih},
+		{nama: "melebihi int64", input: "99999999999999999999", currency: "IDR", wantErr: ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.nama, func(t *testing.T) {
+			got, err := Parse(tt.input, tt.currency)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Parse(%q) error = %v, ingin %v", tt.input, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) error tidak diharapkan: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %+v, ingin %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStringDanFormat(t *testing.T) {
+	tests := []struct {
+		m          Money
+		wantString string
+		wantFormat string
+	}{
+		{New(1234_50, "IDR"), "1234.50", "Rp 1.234,50"},
+		{New(150_000_000_00, "IDR"), "150000000.00", "Rp 150.000.000"},
+		{New(5, "IDR"), "0.05", "Rp 0,05"},
+		{New(-3_149_950, "USD"), "-31499.50", "USD -31.499,50"},
+		{New(1_500_000, "JPY"), "1500000", "JPY 1.500.000"},
+		{Money{Minor: 100}, "1.00", "Rp 1"}, // Currency kosong = IDR
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.wantString, func(t *testing.T) {
+			if got := tt.m.String(); got != tt.wantString {
+				t.Errorf("String() = %q, ingin %q", got, tt.wantString)
+			}
+			if got := tt.m.Format(); got != tt.wantFormat {
+				t.Errorf("Format() = %q, ingin %q", got, tt.wantFormat)
+			}
+			// String harus bisa dibaca ulang tanpa kehilangan nilai
+			if back, err := Parse(tt.m.String(), tt.m.currency()); err != nil || back.Minor != tt.m.Minor {
+				t.Errorf("Parse(String()) = %+v, %v; ingin Minor %d", back, err, tt.m.Minor)
+			}
+		})
+	}
+}
+
+func TestFromRat(t *testing.T) {
+	tests := []struct {
+		nama     string
+		r        *big.Rat
+		currency string
+		want     int64
+	}{
+		{"persis", big.NewRat(123450, 100), "IDR", 1234_50},
+		{"dibulatkan ke bawah", big.NewRat(100, 3), "IDR", 33_33},         // 33.333...
+		{"dibulatkan ke atas", big.NewRat(200, 3), "IDR", 66_67},          // 66.666...
+		{"setengah menjauhi nol", big.NewRat(1, 200), "IDR", 1},           // 0.005
+		{"setengah negatif menjauhi nol", big.NewRat(-1, 200), "IDR", -1}, // -0.005
+		{"JPY dibulatkan ke satuan", big.NewRat(5, 2), "JPY", 3},          // 2.5
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.nama, func(t *testing.T) {
+			got, err := FromRat(tt.r, tt.currency)
+			if err != nil {
+				t.Fatalf("FromRat(%s) error tidak diharapkan: %v", tt.r, err)
+			}
+			if got.Minor != tt.want || got.Currency != tt.currency {
+				t.Errorf("FromRat(%s) = %+v, ingin Minor %d %s", tt.r, got, tt.want, tt.currency)
+			}
+		})
+	}
+
+	if _, err := FromRat(new(big.Rat).SetFrac64(1<<62, 1), "IDR"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("FromRat nilai sangat besar error = %v, ingin ErrOverflow", err)
+	}
+}
+
+// PENJELASAN FILE money_test.go:
+// Test table-driven untuk parsing dan pembulatan Money (fungsi murni, tanpa DB)
+// - Parse: desimal NUMERIC, tanda, mata uang tanpa minor unit; format salah, presisi berlebih,
+//   dan overflow ditolak dengan error yang sesuai
+// - String / Format: teks desimal persis dan format tampilan Indonesia, bisa di-Parse ulang
+// - FromRat: pembulatan ke minor unit terdekat (half away from zero) dan overflow
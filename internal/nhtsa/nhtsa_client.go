@@ -135,6 +135,59 @@ func FetchModelsForMakeID(makeID string) ([]NhtsaModel, error) {
 	return nil, fmt.Errorf("failed after %d attempts: %v", maxRetries, lastErr)
 }
 
+// NhtsaVin adalah hasil DecodeVinValues (satu baris, semua nilai berupa string)
+type NhtsaVin struct {
+	Make            string `json:"Make"`
+	Model           string `json:"Model"`
+	ModelYear       string `json:"ModelYear"`
+	BodyClass       string `json:"BodyClass"`
+	FuelTypePrimary string `json:"FuelTypePrimary"`
+	ErrorCode       string `json:"ErrorCode"`
+	ErrorText       string `json:"ErrorText"`
+}
+type NhtsaVinResponse struct {
+	Results []NhtsaVin `json:"Results"`
+}
+
+// DecodeVIN mengambil merek, model, dan tahun dari VIN (17 karakter) lewat NHTSA
+func DecodeVIN(vin string) (*NhtsaVin, error) {
+	url := fmt.Sprintf("%s/DecodeVinValues/%s?format=json", nhtsaBaseURL, vin)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "CarApp/1.0")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("NHTSA API returned status: %s", resp.Status)
+	}
+
+	var apiResponse NhtsaVinResponse
+	if err := json.NewDecoder(resp.Body).Decode(&apiResponse); err != nil {
+		return nil, err
+	}
+	if len(apiResponse.Results) == 0 {
+		return nil, fmt.Errorf("NHTSA tidak mengembalikan hasil untuk VIN %s", vin)
+	}
+
+	log.Printf("Sukses decode VIN %s dari NHTSA API", vin)
+	return &apiResponse.Results[0], nil
+}
+
+// Valid mengecek apakah NHTSA berhasil mengenali VIN (ErrorCode "0" = tanpa error,
+// kode lain tetap dianggap valid selama merek dan tahun terbaca)
+func (v *NhtsaVin) Valid() bool {
+	return v.Make != "" && v.ModelYear != ""
+}
+
 // PENJELASAN FILE nhtsa_client.go:
 // File ini menangani komunikasi dengan NHTSA API eksternal
 //
@@ -157,6 +210,10 @@ func FetchModelsForMakeID(makeID string) ([]NhtsaModel, error) {
 // - Return semua model untuk merek tertentu
 // - Parse JSON response ke slice []NhtsaModel
 //
+// Fungsi DecodeVIN:
+// - Request GET ke /DecodeVinValues/{vin}?format=json
+// - Return merek, model, tahun, body class, bahan bakar (dipakai trade-in)
+//
 // Error handling:
 // - Cek HTTP status code (harus 200 OK)
 // - Decode JSON response
@@ -184,6 +241,10 @@ func FetchModelsForMakeID(makeID string) ([]NhtsaModel, error) {
 // - Return semua model untuk merek tertentu
 // - Parse JSON response ke slice []NhtsaModel
 //
+// Fungsi DecodeVIN:
+// - Request GET ke /DecodeVinValues/{vin}?format=json
+// - Return merek, model, tahun, body class, bahan bakar (dipakai trade-in)
+//
 // Error handling:
 // - Cek HTTP status code (harus 200 OK)
 // - Decode JSON response
@@ -211,6 +272,10 @@ func FetchModelsForMakeID(makeID string) ([]NhtsaModel, error) {
 // - Return semua model untuk merek tertentu
 // - Parse JSON response ke slice []NhtsaModel
 //
+// Fungsi DecodeVIN:
+// - Request GET ke /DecodeVinValues/{vin}?format=json
+// - Return merek, model, tahun, body class, bahan bakar (dipakai trade-in)
+//
 // Error handling:
 // - Cek HTTP status code (harus 200 OK)
 // - Decode JSON response
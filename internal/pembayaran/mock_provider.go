@@ -0,0 +1,180 @@
+package pembayaran
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+)
+
+// MockProvider adalah payment provider lokal untuk development.
+// Charge ID acak (tidak bisa ditebak dari transaksi ID), data disimpan di memori.
+type MockProvider struct {
+	baseURL string
+
+	mu      sync.Mutex
+	charges map[string]*Charge
+}
+
+// NewMockProvider membuat mock provider baru
+func NewMockProvider(baseURL string) *MockProvider {
+	if baseURL == "" {
+		baseURL = "http://localhost:9090"
+	}
+	return &MockProvider{
+		baseURL: baseURL,
+		charges: make(map[string]*Charge),
+	}
+}
+
+// Name mengembalikan nama provider
+func (m *MockProvider) Name() string {
+	return "mock"
+}
+
+// CreateCharge membuat tagihan dengan ID "mock_<128 bit acak>"
+func (m *MockProvider) CreateCharge(ctx context.Context, req ChargeRequest) (*Charge, error) {
+	if !req.Amount.IsPositive() {
+		return nil, fmt.Errorf("jumlah tagihan tidak valid: %s", req.Amount.Format())
+	}
+	acak := make([]byte, 16)
+	if _, err := rand.Read(acak); err != nil {
+		return nil, fmt.Errorf("gagal membuat charge ID: %w", err)
+	}
+	id := "mock_" + hex.EncodeToString(acak)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	charge := &Charge{
+		ID:          id,
+		TransaksiID: req.TransaksiID,
+		Amount:      req.Amount,
+		Status:      StatusPending,
+		PaymentURL:  fmt.Sprintf("%s/mock-pembayaran/bayar?charge_id=%s", m.baseURL, id),
+	}
+	m.charges[id] = charge
+
+	log.Printf("MockProvider: Tagihan %s dibuat (%s)", id, req.Amount.Format())
+	salinan := *charge
+	return &salinan, nil
+}
+
+// GetStatus mengembalikan status tagihan
+func (m *MockProvider) GetStatus(ctx context.Context, chargeID string) (*Charge, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	charge, ok := m.charges[chargeID]
+	if !ok {
+		return nil, ErrChargeNotFound
+	}
+	salinan := *charge
+	return &salinan, nil
+}
+
+// Refund menandai tagihan yang sudah dibayar sebagai refunded
+func (m *MockProvider) Refund(ctx context.Context, chargeID string) (*Charge, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	charge, ok := m.charges[chargeID]
+	if !ok {
+		return nil, ErrChargeNotFound
+	}
+	if charge.Status != StatusPaid {
+		return nil, fmt.Errorf("tagihan %s berstatus %s, tidak bisa di-refund", chargeID, charge.Status)
+	}
+	charge.Status = StatusRefunded
+
+	log.Printf("MockProvider: Tagihan %s di-refund", chargeID)
+	salinan := *charge
+	return &salinan, nil
+}
+
+// settle mengubah status tagihan dan membentuk event webhook-nya
+func (m *MockProvider) settle(chargeID, statusBaru string) (*WebhookEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	charge, ok := m.charges[chargeID]
+	if !ok {
+		return nil, ErrChargeNotFound
+	}
+	if charge.Status != StatusPending {
+		return nil, fmt.Errorf("tagihan %s sudah berstatus %s", chargeID, charge.Status)
+	}
+	charge.Status = statusBaru
+
+	return &WebhookEvent{
+		EventID:     fmt.Sprintf("evt_%s_%s", chargeID, statusBaru),
+		ChargeID:    chargeID,
+		Status:      statusBaru,
+		AmountMinor: charge.Amount.Minor,
+		Currency:    charge.Amount.Currency,
+	}, nil
+}
+
+// MockPayHandler mensimulasikan halaman pembayaran mock:
+// POST /mock-pembayaran/bayar?charge_id=...&status=paid|failed (atau sebagai form body)
+// lalu mengirim event bertanda tangan ke webhook handler.
+func MockPayHandler(m *MockProvider, secret []byte, webhook http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// GET bisa dipicu tanpa sengaja (prefetch, link di chat), jadi hanya POST
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "gunakan POST", http.StatusMethodNotAllowed)
+			return
+		}
+		chargeID := r.FormValue("charge_id")
+		statusBaru := r.FormValue("status")
+		if statusBaru == "" {
+			statusBaru = StatusPaid
+		}
+		if statusBaru != StatusPaid && statusBaru != StatusFailed {
+			http.Error(w, "status harus paid atau failed", http.StatusBadRequest)
+			return
+		}
+
+		event, err := m.settle(chargeID, statusBaru)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		body, err := json.Marshal(event)
+		if err != nil {
+			http.Error(w, "gagal membuat event", http.StatusInternalServerError)
+			return
+		}
+
+		req, err := http.NewRequestWithContext(r.Context(), http.MethodPost, "/webhooks/pembayaran", bytes.NewReader(body))
+		if err != nil {
+			http.Error(w, "gagal membuat request webhook", http.StatusInternalServerError)
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(SignatureHeader, "sha256="+Sign(secret, body))
+
+		webhook.ServeHTTP(w, req)
+	})
+}
+
+// PENJELASAN FILE mock_provider.go:
+// File ini berisi payment provider palsu untuk development lokal
+//
+// MockProvider:
+// - Charge ID = "mock_<hex acak 128 bit>" agar tidak bisa ditebak, event ID = "evt_<charge_id>_<status>"
+// - Data tagihan disimpan di memori (hilang saat server restart)
+// - Thread-safe dengan sync.Mutex
+//
+// MockPayHandler:
+// - Endpoint POST /mock-pembayaran/bayar?charge_id=...&status=paid|failed (GET ditolak 405)
+// - Mengubah status tagihan lalu memanggil webhook handler dengan body
+//   yang ditandatangani HMAC, sama seperti provider sungguhan
+// - Hanya didaftarkan di main.go jika PAYMENT_PROVIDER=mock (diset eksplisit)
@@ -0,0 +1,151 @@
+package pembayaran
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"carapp.com/m/internal/money"
+)
+
+// Status tagihan di payment provider
+const (
+	StatusPending  = "pending"
+	StatusPaid     = "paid"
+	StatusFailed   = "failed"
+	StatusRefunded = "refunded"
+	// StatusRefundPending hanya dipakai di database: dana harus dikembalikan tapi refund ke
+	// provider belum berhasil (dicoba ulang job refund). Tidak pernah dikirim provider.
+	StatusRefundPending = "refund_pending"
+)
+
+// SignatureHeader adalah header HTTP yang berisi HMAC-SHA256 dari body webhook
+const SignatureHeader = "X-Pembayaran-Signature"
+
+// ErrChargeNotFound dikembalikan jika charge ID tidak dikenal provider
+var ErrChargeNotFound = errors.New("charge tidak ditemukan")
+
+// ChargeRequest adalah data untuk membuat tagihan baru
+type ChargeRequest struct {
+	TransaksiID string
+	Amount      money.Money // Jumlah tagihan beserta mata uangnya (minor unit, tanpa float)
+	Deskripsi   string
+}
+
+// Charge adalah tagihan di payment provider
+type Charge struct {
+	ID          string
+	TransaksiID string
+	Amount      money.Money
+	Status      string
+	PaymentURL  string
+}
+
+// WebhookEvent adalah payload JSON yang dikirim provider ke endpoint webhook.
+// Jumlah dikirim dalam minor unit (integer) agar tidak ada pembulatan float.
+type WebhookEvent struct {
+	EventID     string `json:"event_id"`
+	ChargeID    string `json:"charge_id"`
+	Status      string `json:"status"`
+	AmountMinor int64  `json:"amount_minor"`
+	Currency    string `json:"currency"`
+}
+
+// Jumlah mengembalikan jumlah pembayaran di event sebagai money.Money
+func (e WebhookEvent) Jumlah() money.Money {
+	return money.New(e.AmountMinor, e.Currency)
+}
+
+// Provider adalah abstraksi payment gateway (Midtrans, Xendit, mock lokal, dll)
+type Provider interface {
+	// Name dipakai sebagai kolom 'provider' di database
+	Name() string
+	// CreateCharge membuat tagihan baru untuk transaksi
+	CreateCharge(ctx context.Context, req ChargeRequest) (*Charge, error)
+	// GetStatus mengambil status terbaru tagihan
+	GetStatus(ctx context.Context, chargeID string) (*Charge, error)
+	// Refund mengembalikan dana tagihan yang sudah dibayar
+	Refund(ctx context.Context, chargeID string) (*Charge, error)
+}
+
+// NewProviderFromEnv memilih provider berdasarkan env PAYMENT_PROVIDER.
+// Tidak ada default: mock menerima pembayaran palsu, jadi harus dipilih secara eksplisit.
+func NewProviderFromEnv() (Provider, error) {
+	name := strings.ToLower(strings.TrimSpace(os.Getenv("PAYMENT_PROVIDER")))
+	switch name {
+	case "mock":
+		log.Println("⚠️  PAYMENT_PROVIDER=mock: pembayaran hanya simulasi, jangan dipakai di production")
+		return NewMockProvider(os.Getenv("PAYMENT_MOCK_BASE_URL")), nil
+	case "":
+		return nil, errors.New("PAYMENT_PROVIDER belum diset (isi \"mock\" untuk development lokal)")
+	default:
+		return nil, fmt.Errorf("PAYMENT_PROVIDER '%s' belum didukung", name)
+	}
+}
+
+// WebhookSecret mengambil secret HMAC webhook dari env PAYMENT_WEBHOOK_SECRET.
+// Return nil jika belum diset (webhook akan menolak semua request).
+func WebhookSecret() []byte {
+	secret := os.Getenv("PAYMENT_WEBHOOK_SECRET")
+	if secret == "" {
+		log.Println("PAYMENT_WEBHOOK_SECRET tidak diset, webhook pembayaran dinonaktifkan")
+		return nil
+	}
+	return []byte(secret)
+}
+
+// IsValidStatus mengecek apakah status dari webhook dikenal
+func IsValidStatus(s string) bool {
+	switch s {
+	case StatusPending, StatusPaid, StatusFailed, StatusRefunded:
+		return true
+	}
+	return false
+}
+
+// Sign menghitung signature HMAC-SHA256 (hex) dari body webhook
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature membandingkan signature secara constant-time
+func VerifySignature(secret, body []byte, signature string) bool {
+	expected, err := hex.DecodeString(Sign(secret, body))
+	if err != nil {
+		return false
+	}
+	given, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(expected, given)
+}
+
+// PENJELASAN FILE provider.go:
+// File ini mendefinisikan abstraksi payment gateway
+//
+// Interface Provider:
+// - CreateCharge: Buat tagihan baru (return charge ID + URL pembayaran)
+// - GetStatus: Cek status tagihan (pending/paid/failed/refunded)
+// - Refund: Kembalikan dana jika transaksi dibatalkan setelah dibayar
+//
+// Webhook:
+// - Provider mengirim WebhookEvent (JSON) ke /webhooks/pembayaran, jumlah dalam minor unit
+//   (amount_minor + currency), sama dengan money.Money di ChargeRequest / Charge
+// - Body ditandatangani HMAC-SHA256 dengan PAYMENT_WEBHOOK_SECRET
+// - Signature dikirim di header X-Pembayaran-Signature
+// - VerifySignature memakai hmac.Equal (constant-time) untuk cegah timing attack
+//
+// Konfigurasi (.env):
+// - PAYMENT_PROVIDER: Nama provider, wajib diisi; "mock" harus dipilih eksplisit (server gagal start jika kosong)
+// - PAYMENT_WEBHOOK_SECRET: Secret untuk verifikasi webhook (jika kosong, webhook nonaktif;
+//   wajib untuk mock, server gagal start tanpa secret)
+// - PAYMENT_MOCK_BASE_URL: Base URL halaman bayar mock (opsional)
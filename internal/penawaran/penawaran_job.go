@@ -0,0 +1,75 @@
+package penawaran
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"carapp.com/m/internal/money"
+	"carapp.com/m/internal/notifikasi"
+)
+
+// StartExpiryJob menandai penawaran yang tidak direspon sebagai 'kedaluwarsa' secara berkala.
+// Dipanggil sebagai goroutine dari main.go.
+func StartExpiryJob(db *sql.DB, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := expirePenawaran(context.Background(), db); err != nil {
+			log.Printf("Job penawaran: gagal memproses penawaran kedaluwarsa: %v", err)
+		}
+	}
+}
+
+// expirePenawaran mengubah status semua penawaran lewat waktu dalam satu query
+func expirePenawaran(ctx context.Context, db *sql.DB) error {
+	rows, err := db.QueryContext(ctx, `
+		WITH expired AS (
+			UPDATE penawaran SET status = $1, updated_at = NOW()
+			WHERE status = $2 AND expires_at < NOW()
+			RETURNING id, mobil_id, pembeli_id, penjual_id, harga
+		), riwayat AS (
+			INSERT INTO penawaran_riwayat (penawaran_id, aksi, harga)
+			SELECT id, 'kedaluwarsa', harga FROM expired
+		)
+		SELECT e.pembeli_id, e.penjual_id, e.harga, m.merk, m.model
+		FROM expired e
+		JOIN mobils m ON m.id = e.mobil_id
+	`, StatusKedaluwarsa, StatusMenunggu)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	count := 0
+	for rows.Next() {
+		var pembeliID, penjualID, merk, model string
+		var harga money.Money
+		if err := rows.Scan(&pembeliID, &penjualID, &harga, &merk, &model); err != nil {
+			continue
+		}
+		count++
+		pesan := fmt.Sprintf("Penawaran %s untuk mobil %s %s kedaluwarsa karena tidak direspon", harga.Format(), merk, model)
+		go notifikasi.CreateNotification(db, context.Background(), pembeliID, "penawaran", pesan)
+		go notifikasi.CreateNotification(db, context.Background(), penjualID, "penawaran", pesan)
+	}
+
+	if count > 0 {
+		log.Printf("Job penawaran: %d penawaran kedaluwarsa", count)
+	}
+	return rows.Err()
+}
+
+// PENJELASAN FILE penawaran_job.go:
+// File ini berisi job terjadwal untuk penawaran yang tidak direspon
+//
+// Fungsi StartExpiryJob:
+// - Dijalankan sebagai goroutine dari main.go dengan time.Ticker
+//
+// Fungsi expirePenawaran:
+// - Satu query CTE: UPDATE status 'menunggu' -> 'kedaluwarsa' jika expires_at < NOW(),
+//   catat ke penawaran_riwayat, lalu ambil data mobil untuk pesan notifikasi
+// - Kirim notifikasi ke pembeli dan penjual
@@ -0,0 +1,499 @@
+package penawaran
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"carapp.com/m/internal/auth"
+	"carapp.com/m/internal/money"
+	"carapp.com/m/internal/notifikasi"
+	"carapp.com/m/internal/transaksi"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Status penawaran
+const (
+	StatusMenunggu    = "menunggu"
+	StatusDiterima    = "diterima"
+	StatusDitolak     = "ditolak"
+	StatusDibatalkan  = "dibatalkan"
+	StatusKedaluwarsa = "kedaluwarsa"
+)
+
+// Pihak yang mendapat giliran merespon
+const (
+	GiliranPembeli = "pembeli"
+	GiliranPenjual = "penjual"
+)
+
+// Aksi pada RespondPenawaran
+const (
+	AksiTerima     = "terima"
+	AksiTolak      = "tolak"
+	AksiTawarBalik = "tawar_balik"
+)
+
+const defaultDurasiPenawaran = 48 * time.Hour
+
+// kolomPenawaran dipakai di semua SELECT agar urutan scan selalu sama
+const kolomPenawaran = `
+	p.id, p.mobil_id, p.pembeli_id, p.penjual_id, p.harga, m.harga_jual, p.status, p.giliran,
+	p.pesan, p.transaksi_id, p.expires_at, p.created_at, p.updated_at, m.merk, m.model
+`
+
+// PenawaranServiceServer adalah implementasi dari pb.PenawaranServiceServer
+type PenawaranServiceServer struct {
+	pb.UnimplementedPenawaranServiceServer
+	DB *sql.DB
+}
+
+// NewPenawaranService membuat instance baru
+func NewPenawaranService(db *sql.DB) *PenawaranServiceServer {
+	return &PenawaranServiceServer{DB: db}
+}
+
+// CreatePenawaran membuat penawaran harga baru dari pembeli
+func (s *PenawaranServiceServer) CreatePenawaran(ctx context.Context, req *pb.CreatePenawaranRequest) (*pb.Penawaran, error) {
+	pembeliID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	harga, err := hargaDariRequest(req.HargaMoney, req.Harga)
+	if err != nil {
+		return nil, err
+	}
+	if req.MobilId == "" || !harga.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "MobilID dan harga tawaran harus diisi")
+	}
+
+	log.Printf("PenawaranService: CreatePenawaran oleh %s untuk mobil %s (%s)", pembeliID, req.MobilId, harga.Format())
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal memulai transaksi DB")
+	}
+	defer tx.Rollback()
+
+	// 1. Kunci mobil dan validasi
+	var penjualID, statusMobil string
+	var hargaJual money.Money
+	err = tx.QueryRowContext(ctx, `SELECT owner_id, harga_jual, status FROM mobils WHERE id = $1 FOR UPDATE`, req.MobilId).
+		Scan(&penjualID, &hargaJual, &statusMobil)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "Mobil tidak ditemukan")
+		}
+		return nil, status.Errorf(codes.Internal, "Gagal mengecek mobil")
+	}
+	if statusMobil != transaksi.MobilTersedia {
+		return nil, status.Errorf(codes.FailedPrecondition, "Mobil saat ini tidak tersedia")
+	}
+	if penjualID == pembeliID {
+		return nil, status.Errorf(codes.FailedPrecondition, "Anda tidak bisa menawar mobil Anda sendiri")
+	}
+	if harga.Minor > hargaJual.Minor {
+		return nil, status.Errorf(codes.InvalidArgument, "Harga tawaran tidak boleh melebihi harga jual (%s)", hargaJual.Format())
+	}
+
+	// 2. Simpan penawaran (giliran penjual untuk merespon)
+	var penawaranID string
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO penawaran (mobil_id, pembeli_id, penjual_id, harga, status, giliran, pesan, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), $8)
+		RETURNING id
+	`, req.MobilId, pembeliID, penjualID, harga, StatusMenunggu, GiliranPenjual, req.Pesan,
+		time.Now().Add(durasiPenawaran())).Scan(&penawaranID)
+	if err != nil {
+		if strings.Contains(err.Error(), "unique constraint") {
+			return nil, status.Errorf(codes.AlreadyExists, "Anda masih punya penawaran aktif untuk mobil ini")
+		}
+		log.Printf("Gagal menyimpan penawaran: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan penawaran")
+	}
+
+	if err := catatRiwayat(ctx, tx, penawaranID, pembeliID, "tawar", harga, req.Pesan); err != nil {
+		return nil, err
+	}
+
+	p, err := getPenawaran(ctx, tx, penawaranID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan penawaran")
+	}
+
+	// 3. Notifikasi penjual
+	go notifikasi.CreateNotification(s.DB, context.Background(), penjualID, "penawaran",
+		fmt.Sprintf("Ada penawaran %s untuk mobil %s %s Anda (harga jual %s)",
+			harga.Format(), p.Merk, p.Model, hargaJual.Format()))
+
+	return p, nil
+}
+
+// RespondPenawaran menerima, menolak, atau menawar balik (sesuai giliran)
+func (s *PenawaranServiceServer) RespondPenawaran(ctx context.Context, req *pb.RespondPenawaranRequest) (*pb.RespondPenawaranResponse, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.PenawaranId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "PenawaranID tidak boleh kosong")
+	}
+	if req.Aksi != AksiTerima && req.Aksi != AksiTolak && req.Aksi != AksiTawarBalik {
+		return nil, status.Errorf(codes.InvalidArgument, "Aksi harus terima, tolak, atau tawar_balik")
+	}
+
+	log.Printf("PenawaranService: RespondPenawaran %s oleh %s (aksi: %s)", req.PenawaranId, userID, req.Aksi)
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal memulai transaksi DB")
+	}
+	defer tx.Rollback()
+
+	// 1. Kunci penawaran dan cek giliran
+	p, err := getPenawaran(ctx, tx, req.PenawaranId, true)
+	if err != nil {
+		return nil, err
+	}
+	if userID != p.PembeliId && userID != p.PenjualId {
+		return nil, status.Errorf(codes.PermissionDenied, "Anda bukan bagian dari penawaran ini")
+	}
+	if p.Status != StatusMenunggu {
+		return nil, status.Errorf(codes.FailedPrecondition, "Penawaran sudah %s", p.Status)
+	}
+	if time.Now().After(p.ExpiresAt.AsTime()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Penawaran sudah kedaluwarsa")
+	}
+	if (p.Giliran == GiliranPenjual && userID != p.PenjualId) || (p.Giliran == GiliranPembeli && userID != p.PembeliId) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Bukan giliran Anda untuk merespon penawaran ini")
+	}
+
+	pihakLain := p.PembeliId
+	if userID == p.PembeliId {
+		pihakLain = p.PenjualId
+	}
+	namaMobil := fmt.Sprintf("%s %s", p.Merk, p.Model)
+	hargaLama, hargaJual := hargaPenawaran(p)
+
+	resp := &pb.RespondPenawaranResponse{}
+	var pesanPihakLain string
+	var pembeliDitolak []string
+	hargaRiwayat := hargaLama
+
+	// 2. Jalankan aksi
+	switch req.Aksi {
+	case AksiTolak:
+		if _, err := tx.ExecContext(ctx, `UPDATE penawaran SET status = $1, pesan = NULLIF($2, ''), updated_at = NOW() WHERE id = $3`,
+			StatusDitolak, req.Pesan, p.Id); err != nil {
+			return nil, status.Errorf(codes.Internal, "Gagal update penawaran")
+		}
+		pesanPihakLain = fmt.Sprintf("Penawaran %s untuk mobil %s ditolak", hargaLama.Format(), namaMobil)
+
+	case AksiTawarBalik:
+		hargaBaru, err := hargaDariRequest(req.HargaMoney, req.Harga)
+		if err != nil {
+			return nil, err
+		}
+		if !hargaBaru.IsPositive() || hargaBaru.Minor > hargaJual.Minor {
+			return nil, status.Errorf(codes.InvalidArgument, "Harga tawar balik harus antara 0 dan harga jual (%s)", hargaJual.Format())
+		}
+		if hargaBaru == hargaLama {
+			return nil, status.Errorf(codes.InvalidArgument, "Harga sama dengan tawaran sebelumnya, gunakan aksi terima")
+		}
+		giliranBaru := GiliranPembeli
+		if userID == p.PembeliId {
+			giliranBaru = GiliranPenjual
+		}
+		_, err = tx.ExecContext(ctx, `
+			UPDATE penawaran SET harga = $1, giliran = $2, pesan = NULLIF($3, ''), expires_at = $4, updated_at = NOW()
+			WHERE id = $5
+		`, hargaBaru, giliranBaru, req.Pesan, time.Now().Add(durasiPenawaran()), p.Id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Gagal update penawaran")
+		}
+		pesanPihakLain = fmt.Sprintf("Tawaran balik %s untuk mobil %s (sebelumnya %s)", hargaBaru.Format(), namaMobil, hargaLama.Format())
+		hargaRiwayat = hargaBaru
+
+	case AksiTerima:
+		// Buat transaksi dengan harga yang disepakati (mobil langsung dikunci)
+		trx, err := transaksi.ReserveMobil(ctx, tx, p.MobilId, p.PembeliId, hargaLama)
+		if err != nil {
+			return nil, err
+		}
+		_, err = tx.ExecContext(ctx, `UPDATE penawaran SET status = $1, transaksi_id = $2, updated_at = NOW() WHERE id = $3`,
+			StatusDiterima, trx.Id, p.Id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Gagal update penawaran")
+		}
+
+		// Penawaran lain untuk mobil yang sama otomatis ditolak
+		rows, err := tx.QueryContext(ctx, `
+			UPDATE penawaran SET status = $1, pesan = 'Mobil sudah dipesan pembeli lain', updated_at = NOW()
+			WHERE mobil_id = $2 AND status = $3 AND id <> $4
+			RETURNING pembeli_id
+		`, StatusDitolak, p.MobilId, StatusMenunggu, p.Id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Gagal menutup penawaran lain")
+		}
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err == nil {
+				pembeliDitolak = append(pembeliDitolak, id)
+			}
+		}
+		rows.Close()
+
+		resp.Transaksi = trx
+		pesanPihakLain = fmt.Sprintf("Penawaran %s untuk mobil %s diterima", hargaLama.Format(), namaMobil)
+	}
+
+	if err := catatRiwayat(ctx, tx, p.Id, userID, req.Aksi, hargaRiwayat, req.Pesan); err != nil {
+		return nil, err
+	}
+
+	resp.Penawaran, err = getPenawaran(ctx, tx, p.Id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan respon penawaran")
+	}
+
+	// 3. Notifikasi (setelah commit)
+	go notifikasi.CreateNotification(s.DB, context.Background(), pihakLain, "penawaran", pesanPihakLain)
+	for _, id := range pembeliDitolak {
+		go notifikasi.CreateNotification(s.DB, context.Background(), id, "penawaran",
+			fmt.Sprintf("Penawaran Anda untuk mobil %s ditutup karena mobil sudah dipesan pembeli lain", namaMobil))
+	}
+	if resp.Transaksi != nil {
+		transaksi.KirimNotifikasiReservasi(s.DB, resp.Transaksi, namaMobil)
+	}
+
+	return resp, nil
+}
+
+// CancelPenawaran menarik penawaran oleh pembeli
+func (s *PenawaranServiceServer) CancelPenawaran(ctx context.Context, req *pb.CancelPenawaranRequest) (*pb.Penawaran, error) {
+	pembeliID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.PenawaranId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "PenawaranID tidak boleh kosong")
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal memulai transaksi DB")
+	}
+	defer tx.Rollback()
+
+	p, err := getPenawaran(ctx, tx, req.PenawaranId, true)
+	if err != nil {
+		return nil, err
+	}
+	if p.PembeliId != pembeliID {
+		return nil, status.Errorf(codes.PermissionDenied, "Hanya pembeli yang bisa membatalkan penawaran ini")
+	}
+	if p.Status != StatusMenunggu {
+		return nil, status.Errorf(codes.FailedPrecondition, "Penawaran sudah %s", p.Status)
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE penawaran SET status = $1, updated_at = NOW() WHERE id = $2`,
+		StatusDibatalkan, p.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal membatalkan penawaran")
+	}
+	harga, _ := hargaPenawaran(p)
+	if err := catatRiwayat(ctx, tx, p.Id, pembeliID, "batal", harga, ""); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal membatalkan penawaran")
+	}
+
+	p.Status = StatusDibatalkan
+	go notifikasi.CreateNotification(s.DB, context.Background(), p.PenjualId, "penawaran",
+		fmt.Sprintf("Pembeli membatalkan penawaran %s untuk mobil %s %s", harga.Format(), p.Merk, p.Model))
+
+	return p, nil
+}
+
+// ListPenawaran menampilkan penawaran milik user sebagai pembeli atau penjual
+func (s *PenawaranServiceServer) ListPenawaran(ctx context.Context, req *pb.ListPenawaranRequest) (*pb.ListPenawaranResponse, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+
+	kolomUser := "p.pembeli_id"
+	switch req.Peran {
+	case "", GiliranPembeli:
+	case GiliranPenjual:
+		kolomUser = "p.penjual_id"
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Peran harus pembeli atau penjual")
+	}
+
+	query := `SELECT ` + kolomPenawaran + `
+		FROM penawaran p
+		JOIN mobils m ON m.id = p.mobil_id
+		WHERE ` + kolomUser + ` = $1`
+	args := []interface{}{userID}
+	if req.FilterStatus != nil {
+		args = append(args, *req.FilterStatus)
+		query += fmt.Sprintf(" AND p.status = $%d", len(args))
+	}
+	if req.MobilId != "" {
+		args = append(args, req.MobilId)
+		query += fmt.Sprintf(" AND p.mobil_id = $%d", len(args))
+	}
+	query += " ORDER BY p.updated_at DESC LIMIT 100"
+
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Printf("Gagal query ListPenawaran: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil data penawaran")
+	}
+	defer rows.Close()
+
+	var list []*pb.Penawaran
+	for rows.Next() {
+		p, err := scanPenawaran(rows)
+		if err != nil {
+			log.Printf("Gagal scan penawaran: %v", err)
+			continue
+		}
+		list = append(list, p)
+	}
+
+	return &pb.ListPenawaranResponse{Penawaran: list}, nil
+}
+
+// rowScanner adalah interface bersama *sql.Row dan *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanPenawaran membaca satu baris dengan urutan kolom kolomPenawaran
+func scanPenawaran(row rowScanner) (*pb.Penawaran, error) {
+	var p pb.Penawaran
+	var harga, hargaJual money.Money
+	var pesan, transaksiID sql.NullString
+	var expiresAt, createdAt, updatedAt time.Time
+
+	err := row.Scan(&p.Id, &p.MobilId, &p.PembeliId, &p.PenjualId, &harga, &hargaJual, &p.Status, &p.Giliran,
+		&pesan, &transaksiID, &expiresAt, &createdAt, &updatedAt, &p.Merk, &p.Model)
+	if err != nil {
+		return nil, err
+	}
+	p.HargaMoney = harga.ToProto()
+	p.HargaJualMoney = hargaJual.ToProto()
+	p.Harga = harga.Float64()
+	p.HargaJual = hargaJual.Float64()
+	p.Pesan = pesan.String
+	p.TransaksiId = transaksiID.String
+	p.ExpiresAt = timestamppb.New(expiresAt)
+	p.CreatedAt = timestamppb.New(createdAt)
+	p.UpdatedAt = timestamppb.New(updatedAt)
+	return &p, nil
+}
+
+// getPenawaran mengambil satu penawaran di dalam tx (forUpdate mengunci baris penawaran)
+func getPenawaran(ctx context.Context, tx *sql.Tx, penawaranID string, forUpdate bool) (*pb.Penawaran, error) {
+	query := `SELECT ` + kolomPenawaran + `
+		FROM penawaran p
+		JOIN mobils m ON m.id = p.mobil_id
+		WHERE p.id = $1`
+	if forUpdate {
+		query += " FOR UPDATE OF p"
+	}
+	p, err := scanPenawaran(tx.QueryRowContext(ctx, query, penawaranID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "Penawaran tidak ditemukan")
+		}
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil penawaran")
+	}
+	return p, nil
+}
+
+// hargaPenawaran mengembalikan harga tawaran terakhir dan harga jual dari hasil scanPenawaran
+func hargaPenawaran(p *pb.Penawaran) (harga, hargaJual money.Money) {
+	return money.New(p.HargaMoney.MinorUnits, p.HargaMoney.CurrencyCode),
+		money.New(p.HargaJualMoney.MinorUnits, p.HargaJualMoney.CurrencyCode)
+}
+
+// hargaDariRequest membaca harga_money, atau harga (deprecated) untuk client lama.
+// Penawaran selalu dalam IDR seperti harga_jual.
+func hargaDariRequest(hargaMoney *pb.Money, hargaLama float64) (money.Money, error) {
+	if hargaMoney == nil {
+		return money.FromFloat(hargaLama, money.DefaultCurrency), nil
+	}
+	harga, err := money.FromProto(hargaMoney)
+	if err != nil {
+		return money.Money{}, status.Errorf(codes.InvalidArgument, "Harga tawaran tidak valid: %v", err)
+	}
+	if harga.Currency != money.DefaultCurrency {
+		return money.Money{}, status.Errorf(codes.InvalidArgument, "Harga tawaran harus dalam %s", money.DefaultCurrency)
+	}
+	return harga, nil
+}
+
+// catatRiwayat menyimpan satu langkah negosiasi
+func catatRiwayat(ctx context.Context, tx *sql.Tx, penawaranID, userID, aksi string, harga money.Money, pesan string) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO penawaran_riwayat (penawaran_id, user_id, aksi, harga, pesan)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''))
+	`, penawaranID, userID, aksi, harga, pesan)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Gagal mencatat riwayat penawaran")
+	}
+	return nil
+}
+
+// durasiPenawaran membaca masa berlaku penawaran dari env PENAWARAN_DURASI_JAM (default 48 jam)
+func durasiPenawaran() time.Duration {
+	if jam, err := strconv.Atoi(os.Getenv("PENAWARAN_DURASI_JAM")); err == nil && jam > 0 {
+		return time.Duration(jam) * time.Hour
+	}
+	return defaultDurasiPenawaran
+}
+
+// PENJELASAN FILE penawaran_service.go:
+// File ini menangani negosiasi harga (tawar-menawar) antara pembeli dan penjual
+//
+// Model data:
+// - Satu baris 'penawaran' per pembeli per mobil (hanya satu yang boleh 'menunggu')
+// - Harga disimpan & dibandingkan sebagai money.Money (minor unit IDR, tanpa float);
+//   field double di proto hanya diisi untuk client lama
+// - Kolom harga & giliran selalu berisi tawaran terakhir dan siapa yang harus merespon
+// - Setiap langkah dicatat di 'penawaran_riwayat'
+//
+// Fungsi CreatePenawaran:
+// - Pembeli menawar (harga <= harga_jual), mobil harus 'tersedia' dan bukan milik sendiri
+// - Giliran awal: penjual
+//
+// Fungsi RespondPenawaran (hanya pihak yang sedang mendapat giliran):
+// - tolak: status 'ditolak'
+// - tawar_balik: harga baru, giliran pindah ke pihak lain, masa berlaku di-reset
+// - terima: transaksi.ReserveMobil dengan harga yang disepakati (mobil dikunci FOR UPDATE,
+//   status 'dipesan', transaksi 'menunggu_pembayaran'), penawaran lain untuk mobil itu ditolak
+//
+// Fungsi CancelPenawaran: pembeli menarik penawaran yang masih 'menunggu'
+// Fungsi ListPenawaran: daftar penawaran sebagai pembeli/penjual, filter status & mobil
+//
+// Notifikasi (tipe 'penawaran') dikirim ke pihak lain di setiap langkah.
+// Penawaran yang tidak direspon akan kedaluwarsa (lihat penawaran_job.go).
@@ -0,0 +1,198 @@
+package pencarian
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"carapp.com/m/internal/money"
+	"carapp.com/m/internal/notifikasi"
+	pb "carapp.com/m/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// TipeNotifikasi adalah tipe notifikasi untuk alert saved search
+const TipeNotifikasi = "saved_search"
+
+// defaultIntervalAlert adalah interval job jika SAVED_SEARCH_ALERT_MENIT tidak diisi
+const defaultIntervalAlert = 10 * time.Minute
+
+// maksContohListing adalah jumlah listing yang disebut di isi notifikasi
+const maksContohListing = 3
+
+// jedaAlert adalah jarak minimal antar notifikasi untuk tiap frekuensi
+var jedaAlert = map[string]time.Duration{
+	FrekuensiInstan:   0,
+	FrekuensiHarian:   24 * time.Hour,
+	FrekuensiMingguan: 7 * 24 * time.Hour,
+}
+
+// savedSearchAktif adalah satu baris saved_search yang alert-nya aktif
+type savedSearchAktif struct {
+	ID              string
+	UserID          string
+	Nama            string
+	FilterJSON      string
+	Frekuensi       string
+	TerakhirDicek   time.Time
+	TerakhirDikirim sql.NullTime
+}
+
+// StartAlertJob menjalankan job berkala yang mencocokkan listing baru dengan saved search.
+// Dipanggil sebagai goroutine dari main.go.
+func StartAlertJob(db *sql.DB, interval time.Duration) {
+	log.Printf("Job saved search: berjalan setiap %s", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		n, err := ProsesAlert(context.Background(), db)
+		if err != nil {
+			log.Printf("Job saved search: gagal memproses alert: %v", err)
+			continue
+		}
+		if n > 0 {
+			log.Printf("Job saved search: %d alert dikirim", n)
+		}
+	}
+}
+
+// IntervalAlert membaca interval job dari env SAVED_SEARCH_ALERT_MENIT (default 10 menit)
+func IntervalAlert() time.Duration {
+	if menit, err := strconv.Atoi(os.Getenv("SAVED_SEARCH_ALERT_MENIT")); err == nil && menit > 0 {
+		return time.Duration(menit) * time.Minute
+	}
+	return defaultIntervalAlert
+}
+
+// ProsesAlert mengecek semua saved search yang sudah waktunya (sesuai frekuensi) dan mengirim
+// satu notifikasi per pencarian jika ada listing baru yang cocok. Mengembalikan jumlah notifikasi.
+func ProsesAlert(ctx context.Context, db *sql.DB) (int, error) {
+	// 1. Waktu proses diambil dari jam database (kolom TIMESTAMP diisi NOW() oleh database)
+	var sekarang time.Time
+	if err := db.QueryRowContext(ctx, `SELECT LOCALTIMESTAMP`).Scan(&sekarang); err != nil {
+		return 0, err
+	}
+
+	// 2. Ambil semua saved search aktif (tanpa lock, job hanya berjalan di satu instance)
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, user_id, nama, filter, frekuensi_alert, terakhir_dicek, terakhir_dikirim
+		FROM saved_search
+		WHERE frekuensi_alert <> $1
+	`, FrekuensiNonaktif)
+	if err != nil {
+		return 0, err
+	}
+	var daftar []savedSearchAktif
+	for rows.Next() {
+		var s savedSearchAktif
+		if err := rows.Scan(&s.ID, &s.UserID, &s.Nama, &s.FilterJSON, &s.Frekuensi, &s.TerakhirDicek, &s.TerakhirDikirim); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		daftar = append(daftar, s)
+	}
+	rows.Close()
+
+	// 3. Proses satu per satu, satu kegagalan tidak menghentikan yang lain
+	count := 0
+	for _, s := range daftar {
+		jeda, ok := jedaAlert[s.Frekuensi]
+		if !ok {
+			continue
+		}
+		if s.TerakhirDikirim.Valid && sekarang.Sub(s.TerakhirDikirim.Time) < jeda {
+			continue // Belum waktunya, listing baru terkumpul untuk notifikasi berikutnya
+		}
+		terkirim, err := prosesSatu(ctx, db, s, sekarang)
+		if err != nil {
+			log.Printf("Job saved search: gagal memproses %s: %v", s.ID, err)
+			continue
+		}
+		if terkirim {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// prosesSatu mencari listing tersedia yang dibuat setelah terakhir_dicek dan cocok dengan filter
+func prosesSatu(ctx context.Context, db *sql.DB, s savedSearchAktif, sekarang time.Time) (bool, error) {
+	var filterPb pb.FilterMobil
+	if err := protojson.Unmarshal([]byte(s.FilterJSON), &filterPb); err != nil {
+		return false, fmt.Errorf("filter tidak bisa dibaca: %w", err)
+	}
+	filter, err := ParseFilter(&filterPb)
+	if err != nil {
+		return false, fmt.Errorf("filter tidak valid: %w", err)
+	}
+
+	args := []interface{}{s.TerakhirDicek, sekarang, s.UserID}
+	klausa, args := filter.Klausa("m.", args)
+	args = append(args, maksContohListing)
+	rows, err := db.QueryContext(ctx, `
+		SELECT m.tahun, m.merk, m.model, m.harga_jual, COUNT(*) OVER ()
+		FROM mobils m
+		WHERE m.status = 'tersedia' AND COALESCE(m.dipublikasikan_pada, m.created_at) > $1
+		  AND COALESCE(m.dipublikasikan_pada, m.created_at) <= $2 AND m.owner_id <> $3`+klausa+`
+		ORDER BY COALESCE(m.dipublikasikan_pada, m.created_at) DESC
+		LIMIT $`+fmt.Sprint(len(args)), args...)
+	if err != nil {
+		return false, err
+	}
+	var contoh []string
+	total := 0
+	for rows.Next() {
+		var tahun int
+		var merk, model string
+		var harga money.Money
+		if err := rows.Scan(&tahun, &merk, &model, &harga, &total); err != nil {
+			rows.Close()
+			return false, err
+		}
+		contoh = append(contoh, fmt.Sprintf("%d %s %s (%s)", tahun, merk, model, harga.Format()))
+	}
+	rows.Close()
+
+	// Geser terakhir_dicek agar listing yang sama tidak dikirim dua kali
+	kolomDikirim := ""
+	if total > 0 {
+		kolomDikirim = ", terakhir_dikirim = $2"
+	}
+	if _, err := db.ExecContext(ctx, `UPDATE saved_search SET terakhir_dicek = $2`+kolomDikirim+` WHERE id = $1`, s.ID, sekarang); err != nil {
+		return false, err
+	}
+	if total == 0 {
+		return false, nil
+	}
+
+	pesan := fmt.Sprintf("%d mobil baru cocok dengan pencarian \"%s\": %s", total, s.Nama, strings.Join(contoh, ", "))
+	if total > len(contoh) {
+		pesan += fmt.Sprintf(", dan %d lainnya", total-len(contoh))
+	}
+	pesan += ". Ubah frekuensi atau berhenti berlangganan alert di menu Pencarian Tersimpan."
+	notifikasi.CreateNotification(db, ctx, s.UserID, TipeNotifikasi, pesan)
+	return true, nil
+}
+
+// PENJELASAN FILE alert_job.go:
+// File ini berisi job berkala yang mengirim alert listing baru untuk saved search
+//
+// Fungsi StartAlertJob / ProsesAlert:
+// - Dijalankan dari main.go (interval SAVED_SEARCH_ALERT_MENIT, default 10 menit)
+// - Hanya saved search dengan frekuensi selain 'nonaktif'
+// - Waktu proses memakai jam database (LOCALTIMESTAMP) agar sejalan dengan created_at
+// - harian/mingguan: dilewati jika notifikasi terakhir belum lewat 1 hari / 7 hari,
+//   listing baru tetap terkumpul karena terakhir_dicek belum digeser
+//
+// Fungsi prosesSatu:
+// - Listing baru = status 'tersedia', waktu tayang (dipublikasikan_pada, atau created_at untuk
+//   listing lama) di antara terakhir_dicek dan sekarang,
+//   bukan milik user sendiri, dan cocok dengan filter (Filter.Klausa yang sama dengan ListMobil)
+// - Satu notifikasi ringkasan per pencarian (maksimal 3 contoh listing + jumlah sisanya)
+// - terakhir_dicek digeser ke waktu proses agar listing tidak dikirim dua kali
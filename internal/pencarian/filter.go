@@ -0,0 +1,286 @@
+package pencarian
+
+import (
+	"fmt"
+	"strings"
+
+	"carapp.com/m/internal/atribut"
+	"carapp.com/m/internal/geo"
+	"carapp.com/m/internal/money"
+	pb "carapp.com/m/proto"
+)
+
+// Filter adalah pb.FilterMobil yang sudah divalidasi (harga dalam IDR, teks sudah di-trim)
+type Filter struct {
+	Merk     string
+	Model    string
+	TahunMin int32
+	TahunMax int32
+	HargaMin *money.Money
+	HargaMax *money.Money
+	Lokasi   string
+	Kondisi  string
+	// Atribut terstruktur (nilai kanonik paket atribut)
+	KilometerMax   int32
+	Transmisi      string
+	BahanBakar     string
+	Warna          string
+	TipeBodi       string
+	JumlahKursiMin int32
+	PlatRegion     string
+	// Titik asal pembeli (nil = tanpa pencarian lokasi) dan radius dalam km (0 = tanpa batas)
+	Asal      *geo.Koordinat
+	DekatKota string // Nama kota dari request jika titik asal dari dekat_kota
+	RadiusKm  float64
+}
+
+// ParseFilter memvalidasi pb.FilterMobil. nil dianggap filter kosong (semua mobil).
+func ParseFilter(f *pb.FilterMobil) (Filter, error) {
+	if f == nil {
+		return Filter{}, nil
+	}
+	hasil := Filter{
+		Merk:     strings.TrimSpace(f.Merk),
+		Model:    strings.TrimSpace(f.Model),
+		TahunMin: f.TahunMin,
+		TahunMax: f.TahunMax,
+		Lokasi:   strings.TrimSpace(f.Lokasi),
+		Kondisi:  strings.ToLower(strings.TrimSpace(f.Kondisi)),
+
+		KilometerMax:   f.KilometerMax,
+		Warna:          atribut.Warna(f.Warna),
+		JumlahKursiMin: f.JumlahKursiMin,
+	}
+	if hasil.TahunMin < 0 || hasil.TahunMax < 0 || (hasil.TahunMax > 0 && hasil.TahunMin > hasil.TahunMax) {
+		return Filter{}, fmt.Errorf("rentang tahun tidak valid")
+	}
+	if hasil.KilometerMax < 0 || hasil.JumlahKursiMin < 0 {
+		return Filter{}, fmt.Errorf("kilometer maksimal dan jumlah kursi minimal tidak boleh negatif")
+	}
+
+	var ok bool
+	if hasil.Transmisi, ok = atribut.Transmisi(f.Transmisi); !ok {
+		return Filter{}, fmt.Errorf("transmisi %q tidak dikenal", f.Transmisi)
+	}
+	if hasil.BahanBakar, ok = atribut.BahanBakar(f.BahanBakar); !ok {
+		return Filter{}, fmt.Errorf("bahan bakar %q tidak dikenal", f.BahanBakar)
+	}
+	if hasil.TipeBodi, ok = atribut.TipeBodi(f.TipeBodi); !ok {
+		return Filter{}, fmt.Errorf("tipe bodi %q tidak dikenal", f.TipeBodi)
+	}
+	if hasil.PlatRegion, ok = atribut.PlatRegion(f.PlatRegion); !ok {
+		return Filter{}, fmt.Errorf("kode wilayah plat nomor %q tidak dikenal", f.PlatRegion)
+	}
+
+	// Titik asal: koordinat eksplisit diutamakan, jika tidak dari nama kota
+	switch {
+	case f.Latitude != nil || f.Longitude != nil:
+		if f.Latitude == nil || f.Longitude == nil {
+			return Filter{}, fmt.Errorf("latitude dan longitude harus diisi bersamaan")
+		}
+		asal := geo.Koordinat{Lat: *f.Latitude, Lng: *f.Longitude}
+		if !asal.Valid() {
+			return Filter{}, fmt.Errorf("koordinat titik asal tidak valid")
+		}
+		hasil.Asal = &asal
+	case strings.TrimSpace(f.DekatKota) != "":
+		asal, _, ok := geo.Geocode(f.DekatKota)
+		if !ok {
+			return Filter{}, fmt.Errorf("kota %q tidak dikenal, kirim latitude/longitude", f.DekatKota)
+		}
+		hasil.Asal, hasil.DekatKota = &asal, strings.TrimSpace(f.DekatKota)
+	}
+	if f.RadiusKm < 0 || f.RadiusKm > geo.MaksRadiusKm {
+		return Filter{}, fmt.Errorf("radius harus 0-%d km", geo.MaksRadiusKm)
+	}
+	if f.RadiusKm > 0 && hasil.Asal == nil {
+		return Filter{}, fmt.Errorf("radius membutuhkan titik asal (latitude/longitude atau dekat_kota)")
+	}
+	hasil.RadiusKm = f.RadiusKm
+
+	var err error
+	if hasil.HargaMin, err = hargaFilter(f.HargaMin); err != nil {
+		return Filter{}, err
+	}
+	if hasil.HargaMax, err = hargaFilter(f.HargaMax); err != nil {
+		return Filter{}, err
+	}
+	if hasil.HargaMin != nil && hasil.HargaMax != nil && hasil.HargaMin.Minor > hasil.HargaMax.Minor {
+		return Filter{}, fmt.Errorf("harga minimal lebih besar dari harga maksimal")
+	}
+	return hasil, nil
+}
+
+// hargaFilter membaca batas harga; harus IDR karena dibandingkan langsung dengan harga_jual
+func hargaFilter(m *pb.Money) (*money.Money, error) {
+	if m == nil {
+		return nil, nil
+	}
+	harga, err := money.FromProto(m)
+	if err != nil {
+		return nil, fmt.Errorf("harga filter tidak valid: %v", err)
+	}
+	if harga.Currency != money.DefaultCurrency {
+		return nil, fmt.Errorf("harga filter harus dalam %s", money.DefaultCurrency)
+	}
+	return &harga, nil
+}
+
+// Klausa menambahkan kondisi " AND ..." untuk filter ke args yang sudah ada.
+// prefix adalah alias tabel mobils beserta titiknya (misal "m."), atau "" tanpa alias.
+func (f Filter) Klausa(prefix string, args []interface{}) (string, []interface{}) {
+	var b strings.Builder
+	tambah := func(kondisi string, nilai interface{}) {
+		args = append(args, nilai)
+		fmt.Fprintf(&b, " AND "+kondisi, prefix, len(args))
+	}
+
+	if f.Merk != "" {
+		tambah("LOWER(%smerk) = LOWER($%d)", f.Merk)
+	}
+	if f.Model != "" {
+		tambah("LOWER(%smodel) = LOWER($%d)", f.Model)
+	}
+	if f.TahunMin > 0 {
+		tambah("%stahun >= $%d", f.TahunMin)
+	}
+	if f.TahunMax > 0 {
+		tambah("%stahun <= $%d", f.TahunMax)
+	}
+	if f.HargaMin != nil {
+		tambah("%sharga_jual >= $%d", *f.HargaMin)
+	}
+	if f.HargaMax != nil {
+		tambah("%sharga_jual <= $%d", *f.HargaMax)
+	}
+	if f.Lokasi != "" {
+		tambah("%slokasi ILIKE $%d", "%"+escapeLike(f.Lokasi)+"%")
+	}
+	if f.Kondisi != "" {
+		tambah("LOWER(%skondisi) = $%d", f.Kondisi)
+	}
+	if f.KilometerMax > 0 {
+		tambah("%skilometer <= $%d", f.KilometerMax)
+	}
+	if f.Transmisi != "" {
+		tambah("%stransmisi = $%d", f.Transmisi)
+	}
+	if f.BahanBakar != "" {
+		tambah("%sbahan_bakar = $%d", f.BahanBakar)
+	}
+	if f.Warna != "" {
+		tambah("%swarna = $%d", f.Warna)
+	}
+	if f.TipeBodi != "" {
+		tambah("%stipe_bodi = $%d", f.TipeBodi)
+	}
+	if f.JumlahKursiMin > 0 {
+		tambah("%sjumlah_kursi >= $%d", f.JumlahKursiMin)
+	}
+	if f.PlatRegion != "" {
+		tambah("%splat_region = $%d", f.PlatRegion)
+	}
+	if f.Asal != nil && f.RadiusKm > 0 {
+		// Bounding box (memakai index) lalu haversine untuk hasil yang presisi
+		kotak := geo.BoundingBox(*f.Asal, f.RadiusKm)
+		tambah("%slatitude >= $%d", kotak.LatMin)
+		tambah("%slatitude <= $%d", kotak.LatMax)
+		if !kotak.SemuaBujur {
+			tambah("%slongitude >= $%d", kotak.LngMin)
+			tambah("%slongitude <= $%d", kotak.LngMax)
+		}
+		var jarak string
+		jarak, args = f.EkspresiJarak(prefix, args)
+		args = append(args, f.RadiusKm)
+		fmt.Fprintf(&b, " AND %s <= $%d", jarak, len(args))
+	}
+	return b.String(), args
+}
+
+// EkspresiJarak menghasilkan ekspresi SQL jarak (km) dari titik asal ke listing, untuk
+// ORDER BY atau filter. Hanya valid jika f.Asal tidak nil. Listing tanpa koordinat = NULL.
+func (f Filter) EkspresiJarak(prefix string, args []interface{}) (string, []interface{}) {
+	args = append(args, f.Asal.Lat, f.Asal.Lng)
+	return geo.SQLJarak(prefix, len(args)-1, len(args)), args
+}
+
+// Deskripsi membuat nama pencarian yang mudah dibaca, misal "Toyota Avanza 2018-2020 <= Rp 180.000.000 di Bandung"
+func (f Filter) Deskripsi() string {
+	var bagian []string
+	if judul := strings.TrimSpace(f.Merk + " " + f.Model); judul != "" {
+		bagian = append(bagian, judul)
+	} else {
+		bagian = append(bagian, "Semua mobil")
+	}
+	switch {
+	case f.TahunMin > 0 && f.TahunMax > 0:
+		bagian = append(bagian, fmt.Sprintf("%d-%d", f.TahunMin, f.TahunMax))
+	case f.TahunMin > 0:
+		bagian = append(bagian, fmt.Sprintf(">= %d", f.TahunMin))
+	case f.TahunMax > 0:
+		bagian = append(bagian, fmt.Sprintf("<= %d", f.TahunMax))
+	}
+	if f.Kondisi != "" {
+		bagian = append(bagian, f.Kondisi)
+	}
+	for _, v := range []string{f.TipeBodi, f.Transmisi, f.BahanBakar, f.Warna} {
+		if v != "" {
+			bagian = append(bagian, v)
+		}
+	}
+	if f.JumlahKursiMin > 0 {
+		bagian = append(bagian, fmt.Sprintf(">= %d kursi", f.JumlahKursiMin))
+	}
+	if f.KilometerMax > 0 {
+		bagian = append(bagian, fmt.Sprintf("<= %d km", f.KilometerMax))
+	}
+	if f.HargaMin != nil {
+		bagian = append(bagian, ">= "+f.HargaMin.Format())
+	}
+	if f.HargaMax != nil {
+		bagian = append(bagian, "<= "+f.HargaMax.Format())
+	}
+	if f.Lokasi != "" {
+		bagian = append(bagian, "di "+f.Lokasi)
+	}
+	if f.PlatRegion != "" {
+		bagian = append(bagian, "plat "+f.PlatRegion)
+	}
+	if f.Asal != nil && f.RadiusKm > 0 {
+		asal := fmt.Sprintf("(%.4f, %.4f)", f.Asal.Lat, f.Asal.Lng)
+		if f.DekatKota != "" {
+			asal = f.DekatKota
+		}
+		bagian = append(bagian, fmt.Sprintf("dalam %g km dari %s", f.RadiusKm, asal))
+	}
+	return strings.Join(bagian, " ")
+}
+
+// escapeLike meloloskan karakter wildcard LIKE dari input user
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// PENJELASAN FILE filter.go:
+// File ini berisi filter pencarian mobil yang dipakai bersama oleh ListMobil dan saved search
+//
+// Fungsi ParseFilter:
+// - Validasi pb.FilterMobil: rentang tahun, harga min <= max, harga harus IDR
+//   (harga_jual di tabel mobils selalu IDR)
+// - Transmisi, bahan bakar, tipe bodi, warna, dan plat region dinormalkan lewat paket atribut
+//   sehingga alias (misal "automatic") cocok dengan nilai kanonik yang tersimpan
+//
+// Fungsi Klausa:
+// - Menghasilkan " AND ..." dengan placeholder $n lanjutan dari args yang sudah ada
+// - Merk/model/kondisi tidak case-sensitive, lokasi dicari sebagai substring (ILIKE)
+// - kilometer_max / jumlah_kursi_min: listing yang datanya kosong (NULL) tidak ikut
+// - radius_km: bounding box lintang/bujur (pra-filter ber-index) + haversine (geo.SQLJarak);
+//   listing tanpa koordinat tidak ikut
+//
+// Fungsi EkspresiJarak:
+// - Ekspresi jarak dari titik asal (latitude/longitude atau dekat_kota lewat gazetteer),
+//   dipakai juga untuk urutan "jarak" di ListMobil
+// - prefix dipakai jika query memakai alias tabel (misal "m.")
+//
+// Fungsi Deskripsi:
+// - Nama default saved search dan isi notifikasi alert
@@ -0,0 +1,245 @@
+package pencarian
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"carapp.com/m/internal/auth"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Frekuensi alert saved search
+const (
+	FrekuensiInstan   = "instan"   // Dicek setiap kali job berjalan
+	FrekuensiHarian   = "harian"   // Paling banyak satu notifikasi per hari
+	FrekuensiMingguan = "mingguan" // Paling banyak satu notifikasi per minggu
+	FrekuensiNonaktif = "nonaktif" // Unsubscribe: pencarian tetap tersimpan, tanpa alert
+)
+
+const (
+	maksSavedSearch  = 20
+	maksPanjangNama  = 100
+	kolomSavedSearch = `id, nama, filter, frekuensi_alert, terakhir_dikirim, created_at`
+)
+
+// SavedSearchServiceServer adalah implementasi dari pb.SavedSearchServiceServer
+type SavedSearchServiceServer struct {
+	pb.UnimplementedSavedSearchServiceServer
+	DB *sql.DB
+}
+
+// NewSavedSearchService membuat instance baru
+func NewSavedSearchService(db *sql.DB) *SavedSearchServiceServer {
+	return &SavedSearchServiceServer{DB: db}
+}
+
+// rowScanner bisa *sql.Row atau *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// CreateSavedSearch menyimpan filter ListMobil milik user
+func (s *SavedSearchServiceServer) CreateSavedSearch(ctx context.Context, req *pb.CreateSavedSearchRequest) (*pb.SavedSearch, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+
+	// 1. Validasi filter, frekuensi, dan nama
+	filter, err := ParseFilter(req.Filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Filter tidak valid: %v", err)
+	}
+	frekuensi := strings.ToLower(strings.TrimSpace(req.FrekuensiAlert))
+	if frekuensi == "" {
+		frekuensi = FrekuensiHarian
+	}
+	if !validFrekuensi(frekuensi) {
+		return nil, status.Errorf(codes.InvalidArgument, "Frekuensi alert harus instan, harian, mingguan, atau nonaktif")
+	}
+	nama := strings.TrimSpace(req.Nama)
+	if nama == "" {
+		nama = filter.Deskripsi()
+	}
+	if utf8.RuneCountInString(nama) > maksPanjangNama {
+		return nil, status.Errorf(codes.InvalidArgument, "Nama pencarian maksimal %d karakter", maksPanjangNama)
+	}
+
+	var jumlah int
+	if err := s.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM saved_search WHERE user_id = $1`, userID).Scan(&jumlah); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal mengecek pencarian tersimpan")
+	}
+	if jumlah >= maksSavedSearch {
+		return nil, status.Errorf(codes.ResourceExhausted, "Maksimal %d pencarian tersimpan, hapus salah satu terlebih dahulu", maksSavedSearch)
+	}
+
+	// 2. Simpan filter dalam format protojson (field baru FilterMobil otomatis ikut tersimpan)
+	filterJSON, err := protojson.Marshal(req.GetFilter())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan filter")
+	}
+
+	saved, err := scanSavedSearch(s.DB.QueryRowContext(ctx, `
+		INSERT INTO saved_search (user_id, nama, filter, frekuensi_alert)
+		VALUES ($1, $2, $3, $4)
+		RETURNING `+kolomSavedSearch,
+		userID, nama, string(filterJSON), frekuensi))
+	if err != nil {
+		log.Printf("Gagal menyimpan saved search: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan pencarian")
+	}
+
+	log.Printf("Saved search %s dibuat oleh UserID %s (%s)", saved.Id, userID, frekuensi)
+	return saved, nil
+}
+
+// ListSavedSearch menampilkan semua pencarian tersimpan milik user
+func (s *SavedSearchServiceServer) ListSavedSearch(ctx context.Context, req *pb.ListSavedSearchRequest) (*pb.ListSavedSearchResponse, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+
+	rows, err := s.DB.QueryContext(ctx, `SELECT `+kolomSavedSearch+` FROM saved_search WHERE user_id = $1 ORDER BY created_at DESC`, userID)
+	if err != nil {
+		log.Printf("Gagal query ListSavedSearch: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil pencarian tersimpan")
+	}
+	defer rows.Close()
+
+	var daftar []*pb.SavedSearch
+	for rows.Next() {
+		saved, err := scanSavedSearch(rows)
+		if err != nil {
+			log.Printf("Gagal scan saved search: %v", err)
+			continue
+		}
+		daftar = append(daftar, saved)
+	}
+	return &pb.ListSavedSearchResponse{SavedSearch: daftar}, nil
+}
+
+// UpdateSavedSearch mengubah nama dan/atau frekuensi alert
+func (s *SavedSearchServiceServer) UpdateSavedSearch(ctx context.Context, req *pb.UpdateSavedSearchRequest) (*pb.SavedSearch, error) {
+	nama := strings.TrimSpace(req.Nama)
+	if utf8.RuneCountInString(nama) > maksPanjangNama {
+		return nil, status.Errorf(codes.InvalidArgument, "Nama pencarian maksimal %d karakter", maksPanjangNama)
+	}
+	frekuensi := strings.ToLower(strings.TrimSpace(req.FrekuensiAlert))
+	if frekuensi != "" && !validFrekuensi(frekuensi) {
+		return nil, status.Errorf(codes.InvalidArgument, "Frekuensi alert harus instan, harian, mingguan, atau nonaktif")
+	}
+	return s.ubah(ctx, req.SavedSearchId, nama, frekuensi)
+}
+
+// UnsubscribeSavedSearch menghentikan alert tanpa menghapus pencarian
+func (s *SavedSearchServiceServer) UnsubscribeSavedSearch(ctx context.Context, req *pb.UnsubscribeSavedSearchRequest) (*pb.SavedSearch, error) {
+	return s.ubah(ctx, req.SavedSearchId, "", FrekuensiNonaktif)
+}
+
+// DeleteSavedSearch menghapus pencarian tersimpan
+func (s *SavedSearchServiceServer) DeleteSavedSearch(ctx context.Context, req *pb.DeleteSavedSearchRequest) (*emptypb.Empty, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.SavedSearchId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "SavedSearchID tidak boleh kosong")
+	}
+
+	res, err := s.DB.ExecContext(ctx, `DELETE FROM saved_search WHERE id = $1 AND user_id = $2`, req.SavedSearchId, userID)
+	if err != nil {
+		log.Printf("Gagal menghapus saved search: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal menghapus pencarian")
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, status.Errorf(codes.NotFound, "Pencarian tidak ditemukan")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ubah adalah alur bersama Update/Unsubscribe. Nilai kosong = tidak diubah.
+// Saat alert diaktifkan kembali, listing lama tidak dikirim ulang (terakhir_dicek di-reset).
+func (s *SavedSearchServiceServer) ubah(ctx context.Context, savedSearchID, nama, frekuensi string) (*pb.SavedSearch, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if savedSearchID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "SavedSearchID tidak boleh kosong")
+	}
+
+	saved, err := scanSavedSearch(s.DB.QueryRowContext(ctx, `
+		UPDATE saved_search SET
+			nama = COALESCE(NULLIF($1, ''), nama),
+			terakhir_dicek = CASE WHEN frekuensi_alert = $4 AND $2 NOT IN ('', $4) THEN NOW() ELSE terakhir_dicek END,
+			frekuensi_alert = COALESCE(NULLIF($2, ''), frekuensi_alert),
+			updated_at = NOW()
+		WHERE id = $3 AND user_id = $5
+		RETURNING `+kolomSavedSearch,
+		nama, frekuensi, savedSearchID, FrekuensiNonaktif, userID))
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "Pencarian tidak ditemukan")
+	}
+	if err != nil {
+		log.Printf("Gagal update saved search %s: %v", savedSearchID, err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengubah pencarian")
+	}
+	return saved, nil
+}
+
+// scanSavedSearch membaca satu baris kolomSavedSearch
+func scanSavedSearch(row rowScanner) (*pb.SavedSearch, error) {
+	var saved pb.SavedSearch
+	var filterJSON string
+	var terakhirDikirim sql.NullTime
+	var createdAt time.Time
+
+	if err := row.Scan(&saved.Id, &saved.Nama, &filterJSON, &saved.FrekuensiAlert, &terakhirDikirim, &createdAt); err != nil {
+		return nil, err
+	}
+	saved.Filter = &pb.FilterMobil{}
+	if err := protojson.Unmarshal([]byte(filterJSON), saved.Filter); err != nil {
+		return nil, err
+	}
+	if terakhirDikirim.Valid {
+		saved.TerakhirDikirim = timestamppb.New(terakhirDikirim.Time)
+	}
+	saved.CreatedAt = timestamppb.New(createdAt)
+	return &saved, nil
+}
+
+func validFrekuensi(f string) bool {
+	switch f {
+	case FrekuensiInstan, FrekuensiHarian, FrekuensiMingguan, FrekuensiNonaktif:
+		return true
+	}
+	return false
+}
+
+// PENJELASAN FILE saved_search_service.go:
+// File ini berisi implementasi SavedSearchService (Service 14) untuk pencarian tersimpan
+//
+// Fungsi CreateSavedSearch:
+// - Filter sama dengan ListMobil (pb.FilterMobil), divalidasi lewat ParseFilter
+// - Nama default dibuat dari filter (Filter.Deskripsi), maksimal 20 pencarian per user
+// - Filter disimpan sebagai JSONB (protojson) agar field filter baru tidak butuh migrasi
+// - Frekuensi alert: instan / harian (default) / mingguan / nonaktif
+//
+// Fungsi UpdateSavedSearch / UnsubscribeSavedSearch:
+// - Ubah nama atau frekuensi; Unsubscribe = frekuensi 'nonaktif'
+// - Mengaktifkan kembali alert me-reset terakhir_dicek sehingga hanya listing baru yang dikirim
+//
+// Fungsi DeleteSavedSearch:
+// - Hapus pencarian milik user, NotFound jika bukan miliknya
+//
+// Pencocokan listing baru dan pengiriman notifikasi ada di alert_job.go
@@ -0,0 +1,27 @@
+package pencarian
+
+// Status listing yang belum/tidak terlihat publik (hanya owner dan admin)
+const (
+	StatusDraft            = "draft"             // Disimpan owner, belum dipublikasikan (termasuk draft trade-in)
+	StatusMenungguModerasi = "menunggu_moderasi" // Sudah dipublikasikan, menunggu persetujuan staf
+	StatusDitolak          = "ditolak"           // Ditolak staf (dengan alasan), owner bisa memperbaiki lalu publish ulang
+)
+
+// StatusPrivat mengecek apakah listing dengan status ini disembunyikan dari publik:
+// GetMobil, CompareMobil, mobil serupa, watchlist, dan riwayat/harga pasar memperlakukannya
+// sebagai tidak ada (kecuali untuk owner/admin di GetMobil)
+func StatusPrivat(status string) bool {
+	return status == StatusDraft || status == StatusMenungguModerasi || status == StatusDitolak
+}
+
+// PENJELASAN FILE visibilitas.go:
+// File ini berisi status listing yang belum terlihat publik
+//
+// Alur status sebelum tayang:
+// - draft -> (PublishMobil) -> tersedia, atau menunggu_moderasi jika moderasi aktif
+// - menunggu_moderasi -> (ModerasiMobil) -> tersedia / ditolak
+// - ditolak -> (UpdateDraftMobil + PublishMobil) -> diajukan ulang
+//
+// Fungsi StatusPrivat:
+// - Dipakai package mobil, watchlist, dan hargapasar agar pengecekan status privat seragam
+// - ListMobil hanya menampilkan status ini untuk owner (atau admin)
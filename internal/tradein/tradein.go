@@ -0,0 +1,172 @@
+package tradein
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"carapp.com/m/internal/hargapasar"
+	"carapp.com/m/internal/money"
+	"carapp.com/m/internal/pencarian"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Status trade-in
+const (
+	StatusDiajukan   = "diajukan"   // Menunggu taksiran dealer
+	StatusDinilai    = "dinilai"    // Dealer sudah memberi nilai, menunggu jawaban pembeli
+	StatusDiterima   = "diterima"   // Pembeli setuju, bisa dipakai saat BuyMobil
+	StatusDitolak    = "ditolak"    // Pembeli menolak taksiran
+	StatusDibatalkan = "dibatalkan" // Pembeli membatalkan pengajuan
+	StatusDipakai    = "dipakai"    // Terpasang di transaksi yang sedang berjalan
+	StatusSelesai    = "selesai"    // Transaksi selesai, mobil trade-in jadi listing draft dealer
+)
+
+// Terapkan memasang trade-in yang sudah diterima ke transaksi baru di dalam tx pemanggil.
+// Mengembalikan nilai potongan. Trade-in harus milik pembeli, untuk mobil yang sama,
+// masih berlaku, dan nilainya lebih kecil dari total transaksi.
+func Terapkan(ctx context.Context, tx *sql.Tx, tradeInID, transaksiID, pembeliID, mobilID string, total money.Money) (money.Money, error) {
+	var pemilik, untukMobil, statusTradeIn string
+	var nilai money.Money
+	var berlakuSampai sql.NullTime
+	err := tx.QueryRowContext(ctx, `
+		SELECT pembeli_id, mobil_id, status, nilai_taksiran, berlaku_sampai
+		FROM trade_in WHERE id = $1 FOR UPDATE
+	`, tradeInID).Scan(&pemilik, &untukMobil, &statusTradeIn, &nilai, &berlakuSampai)
+	if err == sql.ErrNoRows || (err == nil && pemilik != pembeliID) {
+		return money.Money{}, status.Errorf(codes.NotFound, "Trade-in tidak ditemukan")
+	}
+	if err != nil {
+		return money.Money{}, status.Errorf(codes.Internal, "Gagal mengecek trade-in")
+	}
+
+	if untukMobil != mobilID {
+		return money.Money{}, status.Errorf(codes.FailedPrecondition, "Trade-in ini diajukan untuk mobil lain")
+	}
+	if statusTradeIn != StatusDiterima {
+		return money.Money{}, status.Errorf(codes.FailedPrecondition, "Trade-in berstatus '%s', harus '%s' sebelum dipakai", statusTradeIn, StatusDiterima)
+	}
+	if berlakuSampai.Valid && time.Now().After(berlakuSampai.Time) {
+		return money.Money{}, status.Errorf(codes.FailedPrecondition, "Taksiran trade-in sudah kedaluwarsa, ajukan ulang")
+	}
+	if nilai.Currency != total.Currency || nilai.Minor >= total.Minor {
+		return money.Money{}, status.Errorf(codes.FailedPrecondition, "Nilai trade-in (%s) harus lebih kecil dari harga mobil (%s)",
+			nilai.Format(), total.Format())
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE trade_in SET status = $1, transaksi_id = $2, updated_at = NOW() WHERE id = $3`,
+		StatusDipakai, transaksiID, tradeInID)
+	if err != nil {
+		return money.Money{}, status.Errorf(codes.Internal, "Gagal memakai trade-in")
+	}
+	return nilai, nil
+}
+
+// Lepas mengembalikan trade-in ke 'diterima' saat transaksinya batal/kedaluwarsa,
+// sehingga bisa dipakai lagi selama taksiran masih berlaku
+func Lepas(ctx context.Context, tx *sql.Tx, transaksiID string) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE trade_in SET status = $1, transaksi_id = NULL, updated_at = NOW()
+		WHERE transaksi_id = $2 AND status = $3
+	`, StatusDiterima, transaksiID, StatusDipakai)
+	if err != nil {
+		return fmt.Errorf("gagal melepas trade-in: %w", err)
+	}
+	return nil
+}
+
+// Selesaikan dipanggil saat transaksi 'selesai': mobil trade-in dibuat sebagai listing
+// draft milik dealer (harga awal = nilai taksiran, lokasi = lokasi mobil yang dibeli).
+// Mengembalikan ID listing baru, atau "" jika transaksi tidak memakai trade-in.
+func Selesaikan(ctx context.Context, tx *sql.Tx, transaksiID string) (string, error) {
+	var tradeInID, penjualID, merk, model string
+	var tahun int
+	var kondisi, deskripsi, vin, lokasi sql.NullString
+	var kilometer sql.NullInt32
+	var nilai money.Money
+	err := tx.QueryRowContext(ctx, `
+		SELECT ti.id, ti.penjual_id, ti.merk, ti.model, ti.tahun, ti.kondisi, ti.deskripsi,
+		       ti.vin, ti.kilometer, ti.nilai_taksiran, m.lokasi
+		FROM trade_in ti
+		JOIN mobils m ON m.id = ti.mobil_id
+		WHERE ti.transaksi_id = $1 AND ti.status = $2
+		FOR UPDATE OF ti
+	`, transaksiID, StatusDipakai).Scan(&tradeInID, &penjualID, &merk, &model, &tahun, &kondisi, &deskripsi,
+		&vin, &kilometer, &nilai, &lokasi)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("gagal mengambil trade-in: %w", err)
+	}
+
+	// Deskripsi listing draft: deskripsi pembeli + kilometer + VIN (dealer bisa mengedit sebelum publish)
+	bagian := []string{fmt.Sprintf("Mobil trade-in %d %s %s.", tahun, merk, model)}
+	if deskripsi.Valid && deskripsi.String != "" {
+		bagian = append(bagian, deskripsi.String)
+	}
+	if kilometer.Valid && kilometer.Int32 > 0 {
+		bagian = append(bagian, fmt.Sprintf("Kilometer: %d km.", kilometer.Int32))
+	}
+	if vin.Valid && vin.String != "" {
+		bagian = append(bagian, "VIN: "+vin.String)
+	}
+	kondisiMobil := kondisi.String
+	if kondisiMobil == "" {
+		kondisiMobil = "bekas"
+	}
+
+	// VIN listing hanya diisi jika valid (kolom mobils.vin punya CHECK format)
+	vinListing := ""
+	if polaVIN.MatchString(vin.String) {
+		vinListing = vin.String
+	}
+
+	var mobilBaruID string
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO mobils (owner_id, merk, model, tahun, kondisi, deskripsi, harga_jual,
+		                    harga_asli, mata_uang_asli, lokasi, status, kilometer, vin)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $7, $8, $9, $10, $11, NULLIF($12, ''))
+		RETURNING id
+	`, penjualID, merk, model, tahun, kondisiMobil, strings.Join(bagian, " "), nilai, nilai.Currency,
+		lokasi.String, pencarian.StatusDraft, kilometer, vinListing).Scan(&mobilBaruID)
+	if err != nil {
+		return "", fmt.Errorf("gagal membuat listing draft trade-in: %w", err)
+	}
+	if err := hargapasar.Catat(ctx, tx, mobilBaruID, hargapasar.JenisListing, nilai, nil, ""); err != nil {
+		return "", err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE trade_in SET status = $1, mobil_baru_id = $2, updated_at = NOW() WHERE id = $3`,
+		StatusSelesai, mobilBaruID, tradeInID)
+	if err != nil {
+		return "", fmt.Errorf("gagal menyelesaikan trade-in: %w", err)
+	}
+
+	log.Printf("Trade-in %s selesai, listing draft %s dibuat untuk dealer %s", tradeInID, mobilBaruID, penjualID)
+	return mobilBaruID, nil
+}
+
+// PENJELASAN FILE tradein.go:
+// File ini berisi siklus hidup trade-in yang dipanggil dari package transaksi
+// (di dalam transaksi DB yang sama dengan perubahan status transaksi jual)
+//
+// Alur status:
+// - diajukan -> dinilai (dealer AppraiseTradeIn) -> diterima / ditolak (pembeli RespondTradeIn)
+// - diterima -> dipakai (Terapkan, saat BuyMobil dengan trade_in_id)
+// - dipakai -> diterima (Lepas, saat transaksi dibatalkan/kedaluwarsa)
+// - dipakai -> selesai (Selesaikan, saat transaksi selesai)
+//
+// Fungsi Terapkan:
+// - Lock trade-in FOR UPDATE, cek pemilik, mobil, status, masa berlaku
+// - Nilai harus < total transaksi (sisa dibayar lewat payment provider)
+//
+// Fungsi Selesaikan:
+// - Buat listing baru status 'draft' milik dealer dengan data mobil trade-in
+// - Harga awal = nilai taksiran, dealer melengkapi foto & harga (UpdateDraftMobil) lalu PublishMobil
+// - Kilometer dan VIN trade-in juga disimpan ke kolom mobils.kilometer / mobils.vin
+// - Harga awal dicatat ke riwayat_harga (hargapasar.Catat)
@@ -0,0 +1,406 @@
+package tradein
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"carapp.com/m/internal/auth"
+	"carapp.com/m/internal/money"
+	"carapp.com/m/internal/nhtsa"
+	"carapp.com/m/internal/notifikasi"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultBerlakuTaksiran = 7 * 24 * time.Hour
+
+// polaVIN: 17 karakter, tanpa huruf I, O, Q (standar ISO 3779)
+var polaVIN = regexp.MustCompile(`^[A-HJ-NPR-Z0-9]{17}$`)
+
+// kolomTradeIn dipakai di semua SELECT agar urutan scan selalu sama
+const kolomTradeIn = `
+	id, pembeli_id, penjual_id, mobil_id, merk, model, tahun, kondisi, kilometer, deskripsi,
+	vin, vin_terverifikasi, status, nilai_taksiran, catatan_penilai, berlaku_sampai,
+	transaksi_id, mobil_baru_id, created_at
+`
+
+// TradeInServiceServer adalah implementasi dari pb.TradeInServiceServer
+type TradeInServiceServer struct {
+	pb.UnimplementedTradeInServiceServer
+	DB *sql.DB
+}
+
+// NewTradeInService membuat instance baru
+func NewTradeInService(db *sql.DB) *TradeInServiceServer {
+	return &TradeInServiceServer{DB: db}
+}
+
+// DecodeVin membaca merk/model/tahun dari VIN lewat NHTSA
+func (s *TradeInServiceServer) DecodeVin(ctx context.Context, req *pb.DecodeVinRequest) (*pb.DecodeVinResponse, error) {
+	vin := normalisasiVIN(req.Vin)
+	if !polaVIN.MatchString(vin) {
+		return nil, status.Errorf(codes.InvalidArgument, "VIN harus 17 karakter (huruf I, O, Q tidak dipakai)")
+	}
+
+	hasil, err := nhtsa.DecodeVIN(vin)
+	if err != nil {
+		log.Printf("Gagal decode VIN %s: %v", vin, err)
+		return nil, status.Errorf(codes.Unavailable, "Layanan decode VIN sedang tidak tersedia, isi data mobil secara manual")
+	}
+	if !hasil.Valid() {
+		return nil, status.Errorf(codes.NotFound, "VIN tidak dikenali: %s", hasil.ErrorText)
+	}
+	return vinToProto(vin, hasil), nil
+}
+
+// CreateTradeIn menyimpan pengajuan trade-in dari pembeli untuk mobil dealer tertentu
+func (s *TradeInServiceServer) CreateTradeIn(ctx context.Context, req *pb.CreateTradeInRequest) (*pb.TradeIn, error) {
+	pembeliID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.MobilId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "MobilID tidak boleh kosong")
+	}
+	if req.Kilometer < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Kilometer tidak boleh negatif")
+	}
+
+	// 1. VIN opsional: jika ada, lengkapi data kosong dari NHTSA (gagal decode tidak menggagalkan pengajuan)
+	vin := normalisasiVIN(req.Vin)
+	var vinTerverifikasi bool
+	if vin != "" {
+		if !polaVIN.MatchString(vin) {
+			return nil, status.Errorf(codes.InvalidArgument, "VIN harus 17 karakter (huruf I, O, Q tidak dipakai)")
+		}
+		if hasil, err := nhtsa.DecodeVIN(vin); err != nil {
+			log.Printf("CreateTradeIn: decode VIN %s gagal, lanjut tanpa verifikasi: %v", vin, err)
+		} else if hasil.Valid() {
+			v := vinToProto(vin, hasil)
+			if req.Merk == "" {
+				req.Merk = v.Merk
+			}
+			if req.Model == "" {
+				req.Model = v.Model
+			}
+			if req.Tahun == 0 {
+				req.Tahun = v.Tahun
+			}
+			vinTerverifikasi = strings.EqualFold(req.Merk, v.Merk) && req.Tahun == v.Tahun
+		}
+	}
+
+	// 2. Validasi data mobil lama
+	if req.Merk == "" || req.Model == "" || req.Tahun <= 1900 || int(req.Tahun) > time.Now().Year()+1 {
+		return nil, status.Errorf(codes.InvalidArgument, "Data mobil trade-in tidak valid (Merk, Model, Tahun)")
+	}
+
+	// 3. Mobil yang akan dibeli harus tersedia dan bukan milik sendiri
+	var penjualID, statusMobil string
+	err := s.DB.QueryRowContext(ctx, `SELECT owner_id, status FROM mobils WHERE id = $1`, req.MobilId).
+		Scan(&penjualID, &statusMobil)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "Mobil tidak ditemukan")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal mengecek mobil")
+	}
+	if statusMobil != "tersedia" {
+		return nil, status.Errorf(codes.FailedPrecondition, "Mobil saat ini tidak tersedia")
+	}
+	if penjualID == pembeliID {
+		return nil, status.Errorf(codes.FailedPrecondition, "Anda tidak bisa trade-in untuk mobil Anda sendiri")
+	}
+
+	// 4. Simpan
+	t, err := scanTradeIn(s.DB.QueryRowContext(ctx, `
+		INSERT INTO trade_in (pembeli_id, penjual_id, mobil_id, merk, model, tahun, kondisi, kilometer,
+		                      deskripsi, vin, vin_terverifikasi, status)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), NULLIF($8, 0), NULLIF($9, ''), NULLIF($10, ''), $11, $12)
+		RETURNING `+kolomTradeIn,
+		pembeliID, penjualID, req.MobilId, req.Merk, req.Model, req.Tahun, req.Kondisi, req.Kilometer,
+		req.Deskripsi, vin, vinTerverifikasi, StatusDiajukan))
+	if err != nil {
+		log.Printf("Gagal menyimpan trade-in: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan pengajuan trade-in")
+	}
+
+	log.Printf("Trade-in %s diajukan oleh %s untuk mobil %s", t.Id, pembeliID, req.MobilId)
+	go notifikasi.CreateNotification(s.DB, context.Background(), penjualID, "trade_in",
+		fmt.Sprintf("Ada pengajuan trade-in %d %s %s, silakan beri taksiran", t.Tahun, t.Merk, t.Model))
+
+	return t, nil
+}
+
+// AppraiseTradeIn menyimpan nilai taksiran dari dealer
+func (s *TradeInServiceServer) AppraiseTradeIn(ctx context.Context, req *pb.AppraiseTradeInRequest) (*pb.TradeIn, error) {
+	penjualID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	nilai, err := money.FromProto(req.NilaiTaksiran)
+	if err != nil || !nilai.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "Nilai taksiran tidak valid")
+	}
+	if nilai.Currency != money.DefaultCurrency {
+		return nil, status.Errorf(codes.InvalidArgument, "Nilai taksiran harus dalam %s", money.DefaultCurrency)
+	}
+	berlaku := defaultBerlakuTaksiran
+	if req.BerlakuHari > 0 {
+		berlaku = time.Duration(req.BerlakuHari) * 24 * time.Hour
+	} else if hari, err := strconv.Atoi(os.Getenv("TRADE_IN_BERLAKU_HARI")); err == nil && hari > 0 {
+		berlaku = time.Duration(hari) * 24 * time.Hour
+	}
+
+	t, err := s.ubahStatus(ctx, req.TradeInId, func(t *pb.TradeIn) error {
+		if t.PenjualId != penjualID {
+			return status.Errorf(codes.PermissionDenied, "Hanya dealer pemilik mobil yang bisa menaksir trade-in ini")
+		}
+		// Taksiran boleh diperbarui selama pembeli belum menjawab
+		if t.Status != StatusDiajukan && t.Status != StatusDinilai {
+			return status.Errorf(codes.FailedPrecondition, "Trade-in sudah %s", t.Status)
+		}
+		return nil
+	}, `status = $2, nilai_taksiran = $3, catatan_penilai = NULLIF($4, ''), berlaku_sampai = NOW() + make_interval(secs => $5)`,
+		StatusDinilai, nilai, req.Catatan, berlaku.Seconds())
+	if err != nil {
+		return nil, err
+	}
+
+	go notifikasi.CreateNotification(s.DB, context.Background(), t.PembeliId, "trade_in",
+		fmt.Sprintf("Mobil %d %s %s Anda ditaksir %s, berlaku sampai %s", t.Tahun, t.Merk, t.Model,
+			nilai.Format(), t.BerlakuSampai.AsTime().Local().Format("02 Jan 2006")))
+	return t, nil
+}
+
+// RespondTradeIn: pembeli menerima atau menolak taksiran
+func (s *TradeInServiceServer) RespondTradeIn(ctx context.Context, req *pb.RespondTradeInRequest) (*pb.TradeIn, error) {
+	pembeliID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	statusBaru := StatusDitolak
+	if req.Terima {
+		statusBaru = StatusDiterima
+	}
+
+	t, err := s.ubahStatus(ctx, req.TradeInId, func(t *pb.TradeIn) error {
+		if t.PembeliId != pembeliID {
+			return status.Errorf(codes.PermissionDenied, "Hanya pembeli yang bisa merespon taksiran ini")
+		}
+		if t.Status != StatusDinilai {
+			return status.Errorf(codes.FailedPrecondition, "Trade-in berstatus '%s', belum/tidak bisa direspon", t.Status)
+		}
+		if req.Terima && t.BerlakuSampai != nil && time.Now().After(t.BerlakuSampai.AsTime()) {
+			return status.Errorf(codes.FailedPrecondition, "Taksiran sudah kedaluwarsa, minta dealer menaksir ulang")
+		}
+		return nil
+	}, `status = $2`, statusBaru)
+	if err != nil {
+		return nil, err
+	}
+
+	pesan := fmt.Sprintf("Pembeli menolak taksiran trade-in %s %s", t.Merk, t.Model)
+	if req.Terima {
+		pesan = fmt.Sprintf("Pembeli menerima taksiran trade-in %s %s, potongan akan dipakai saat pembelian", t.Merk, t.Model)
+	}
+	go notifikasi.CreateNotification(s.DB, context.Background(), t.PenjualId, "trade_in", pesan)
+	return t, nil
+}
+
+// CancelTradeIn: pembeli membatalkan trade-in yang belum dipakai di transaksi
+func (s *TradeInServiceServer) CancelTradeIn(ctx context.Context, req *pb.CancelTradeInRequest) (*pb.TradeIn, error) {
+	pembeliID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+
+	t, err := s.ubahStatus(ctx, req.TradeInId, func(t *pb.TradeIn) error {
+		if t.PembeliId != pembeliID {
+			return status.Errorf(codes.PermissionDenied, "Hanya pembeli yang bisa membatalkan trade-in ini")
+		}
+		if t.Status != StatusDiajukan && t.Status != StatusDinilai && t.Status != StatusDiterima {
+			return status.Errorf(codes.FailedPrecondition, "Trade-in berstatus '%s' tidak bisa dibatalkan", t.Status)
+		}
+		return nil
+	}, `status = $2`, StatusDibatalkan)
+	if err != nil {
+		return nil, err
+	}
+
+	go notifikasi.CreateNotification(s.DB, context.Background(), t.PenjualId, "trade_in",
+		fmt.Sprintf("Pembeli membatalkan pengajuan trade-in %s %s", t.Merk, t.Model))
+	return t, nil
+}
+
+// ListTradeIn menampilkan trade-in sebagai pembeli atau penjual (dealer)
+func (s *TradeInServiceServer) ListTradeIn(ctx context.Context, req *pb.ListTradeInRequest) (*pb.ListTradeInResponse, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+
+	kolomUser := "pembeli_id"
+	switch req.Peran {
+	case "", "pembeli":
+	case "penjual":
+		kolomUser = "penjual_id"
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Peran harus pembeli atau penjual")
+	}
+
+	query := `SELECT ` + kolomTradeIn + ` FROM trade_in WHERE ` + kolomUser + ` = $1`
+	args := []interface{}{userID}
+	if req.FilterStatus != nil {
+		args = append(args, *req.FilterStatus)
+		query += fmt.Sprintf(" AND status = $%d", len(args))
+	}
+	query += " ORDER BY created_at DESC LIMIT 100"
+
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Printf("Gagal query ListTradeIn: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil data trade-in")
+	}
+	defer rows.Close()
+
+	var list []*pb.TradeIn
+	for rows.Next() {
+		t, err := scanTradeIn(rows)
+		if err != nil {
+			log.Printf("Gagal scan trade-in: %v", err)
+			continue
+		}
+		list = append(list, t)
+	}
+	return &pb.ListTradeInResponse{TradeIn: list}, nil
+}
+
+// ubahStatus mengunci trade-in, menjalankan cek hak akses, lalu UPDATE kolom yang diberikan.
+// set memakai $1 = id trade-in, argumen lain mulai dari $2.
+func (s *TradeInServiceServer) ubahStatus(ctx context.Context, tradeInID string, authorize func(t *pb.TradeIn) error,
+	set string, args ...interface{}) (*pb.TradeIn, error) {
+	if tradeInID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "TradeInID tidak boleh kosong")
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal memulai transaksi DB")
+	}
+	defer tx.Rollback()
+
+	t, err := scanTradeIn(tx.QueryRowContext(ctx, `SELECT `+kolomTradeIn+` FROM trade_in WHERE id = $1 FOR UPDATE`, tradeInID))
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "Trade-in tidak ditemukan")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil trade-in")
+	}
+	if err := authorize(t); err != nil {
+		return nil, err
+	}
+
+	t, err = scanTradeIn(tx.QueryRowContext(ctx,
+		`UPDATE trade_in SET `+set+`, updated_at = NOW() WHERE id = $1 RETURNING `+kolomTradeIn,
+		append([]interface{}{tradeInID}, args...)...))
+	if err != nil {
+		log.Printf("Gagal update trade-in %s: %v", tradeInID, err)
+		return nil, status.Errorf(codes.Internal, "Gagal update trade-in")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal update trade-in")
+	}
+
+	log.Printf("Trade-in %s sekarang berstatus '%s'", t.Id, t.Status)
+	return t, nil
+}
+
+// rowScanner adalah interface bersama *sql.Row dan *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanTradeIn membaca satu baris dengan urutan kolom kolomTradeIn
+func scanTradeIn(row rowScanner) (*pb.TradeIn, error) {
+	var t pb.TradeIn
+	var kondisi, deskripsi, vin, catatan, transaksiID, mobilBaruID sql.NullString
+	var kilometer sql.NullInt32
+	var nilai sql.NullString
+	var berlakuSampai sql.NullTime
+	var createdAt time.Time
+
+	err := row.Scan(&t.Id, &t.PembeliId, &t.PenjualId, &t.MobilId, &t.Merk, &t.Model, &t.Tahun,
+		&kondisi, &kilometer, &deskripsi, &vin, &t.VinTerverifikasi, &t.Status, &nilai, &catatan,
+		&berlakuSampai, &transaksiID, &mobilBaruID, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	t.Kondisi = kondisi.String
+	t.Kilometer = kilometer.Int32
+	t.Deskripsi = deskripsi.String
+	t.Vin = vin.String
+	t.CatatanPenilai = catatan.String
+	t.TransaksiId = transaksiID.String
+	t.MobilBaruId = mobilBaruID.String
+	t.CreatedAt = timestamppb.New(createdAt)
+	if nilai.Valid {
+		m, err := money.Parse(nilai.String, money.DefaultCurrency)
+		if err != nil {
+			return nil, err
+		}
+		t.NilaiTaksiran = m.ToProto()
+	}
+	if berlakuSampai.Valid {
+		t.BerlakuSampai = timestamppb.New(berlakuSampai.Time)
+	}
+	return &t, nil
+}
+
+func normalisasiVIN(vin string) string {
+	return strings.ToUpper(strings.TrimSpace(vin))
+}
+
+func vinToProto(vin string, v *nhtsa.NhtsaVin) *pb.DecodeVinResponse {
+	tahun, _ := strconv.Atoi(v.ModelYear)
+	return &pb.DecodeVinResponse{
+		Vin:        vin,
+		Merk:       v.Make,
+		Model:      v.Model,
+		Tahun:      int32(tahun),
+		TipeBodi:   v.BodyClass,
+		BahanBakar: v.FuelTypePrimary,
+	}
+}
+
+// PENJELASAN FILE tradein_service.go:
+// File ini berisi implementasi TradeInService (Service 11) untuk tukar tambah
+//
+// Fungsi DecodeVin:
+// - Validasi format VIN (17 karakter) lalu decode lewat NHTSA (merk, model, tahun, bodi, BBM)
+//
+// Fungsi CreateTradeIn (pembeli):
+// - Mobil tujuan harus 'tersedia' dan bukan milik sendiri; penjual_id = pemilik mobil tujuan
+// - VIN opsional: data kosong dilengkapi dari NHTSA, vin_terverifikasi jika merk & tahun cocok
+//   (NHTSA tidak tersedia -> pengajuan tetap disimpan tanpa verifikasi)
+//
+// Fungsi AppraiseTradeIn (dealer):
+// - Nilai taksiran (IDR) + catatan, berlaku N hari (request, env TRADE_IN_BERLAKU_HARI, default 7)
+//   (berlaku_sampai dihitung dari NOW() DB, kolom TIMESTAMPTZ)
+//
+// Fungsi RespondTradeIn / CancelTradeIn (pembeli):
+// - terima -> 'diterima' (bisa dipakai di BuyMobil.trade_in_id), tolak -> 'ditolak'
+// - Batal hanya sebelum dipakai di transaksi
+//
+// Potongan di transaksi dan pembuatan listing draft ada di tradein.go
+// Notifikasi (tipe 'trade_in') dikirim ke pihak lain di setiap langkah.
@@ -0,0 +1,182 @@
+package transaksi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"carapp.com/m/internal/money"
+	"carapp.com/m/internal/pembayaran"
+)
+
+const maxWebhookBody = 64 * 1024 // 64KB cukup untuk event pembayaran
+
+var errJumlahTidakSesuai = errors.New("jumlah pembayaran tidak sesuai tagihan")
+
+// WebhookHandler menerima notifikasi status pembayaran dari payment provider
+type WebhookHandler struct {
+	DB       *sql.DB
+	Provider pembayaran.Provider
+	Secret   []byte
+}
+
+// NewWebhookHandler membuat handler webhook pembayaran
+func NewWebhookHandler(db *sql.DB, provider pembayaran.Provider, secret []byte) *WebhookHandler {
+	return &WebhookHandler{DB: db, Provider: provider, Secret: secret}
+}
+
+// ServeHTTP memverifikasi signature, menyimpan event (idempoten) dan memajukan status transaksi
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method tidak diizinkan", http.StatusMethodNotAllowed)
+		return
+	}
+	if len(h.Secret) == 0 {
+		http.Error(w, "webhook pembayaran belum dikonfigurasi", http.StatusServiceUnavailable)
+		return
+	}
+
+	// 1. Baca body (dibatasi) dan verifikasi HMAC
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+	if err != nil {
+		http.Error(w, "gagal membaca body", http.StatusBadRequest)
+		return
+	}
+	if !pembayaran.VerifySignature(h.Secret, body, r.Header.Get(pembayaran.SignatureHeader)) {
+		log.Printf("Webhook pembayaran: signature tidak valid dari %s", r.RemoteAddr)
+		http.Error(w, "signature tidak valid", http.StatusUnauthorized)
+		return
+	}
+
+	var event pembayaran.WebhookEvent
+	if err := json.Unmarshal(body, &event); err != nil || event.EventID == "" || event.ChargeID == "" ||
+		!pembayaran.IsValidStatus(event.Status) {
+		http.Error(w, "payload tidak valid", http.StatusBadRequest)
+		return
+	}
+
+	// 2. Proses event
+	t, duplikat, err := h.prosesEvent(r.Context(), &event, body)
+	if err != nil {
+		log.Printf("Webhook pembayaran: gagal memproses event %s: %v", event.EventID, err)
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	if duplikat {
+		log.Printf("Webhook pembayaran: event %s sudah pernah diproses, diabaikan", event.EventID)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	// 3. Notifikasi setelah commit
+	if t != nil {
+		kirimNotifikasiStatus(h.DB, t)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// prosesEvent mencatat event dan mengubah status pembayaran/transaksi dalam satu transaction DB.
+// Return duplikat=true jika event ID sudah pernah diproses.
+func (h *WebhookHandler) prosesEvent(ctx context.Context, event *pembayaran.WebhookEvent, raw []byte) (*transaksiJual, bool, error) {
+	tx, err := h.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	defer tx.Rollback()
+
+	// 1. Idempotensi: event ID unik per provider
+	res, err := tx.ExecContext(ctx, `
+		INSERT INTO pembayaran_events (provider, event_id, charge_id, status, payload)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (provider, event_id) DO NOTHING
+	`, h.Provider.Name(), event.EventID, event.ChargeID, event.Status, raw)
+	if err != nil {
+		return nil, false, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, true, nil
+	}
+
+	// 2. Kunci baris pembayaran
+	var pembayaranID, transaksiID, statusLama string
+	var amount money.Money
+	err = tx.QueryRowContext(ctx, `
+		SELECT id, transaksi_id, amount, status FROM pembayaran
+		WHERE provider = $1 AND charge_id = $2
+		FOR UPDATE
+	`, h.Provider.Name(), event.ChargeID).Scan(&pembayaranID, &transaksiID, &amount, &statusLama)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, pembayaran.ErrChargeNotFound
+		}
+		return nil, false, err
+	}
+	if event.Status == pembayaran.StatusPaid && event.Jumlah() != amount {
+		return nil, false, errJumlahTidakSesuai
+	}
+
+	// Event yang datang terlambat tidak boleh memundurkan status (misal 'failed' setelah 'paid')
+	sudahDibayar := statusLama == pembayaran.StatusPaid || statusLama == pembayaran.StatusRefundPending
+	if statusLama == pembayaran.StatusRefunded || (sudahDibayar && event.Status != pembayaran.StatusRefunded) {
+		if err := tx.Commit(); err != nil {
+			return nil, false, err
+		}
+		return nil, false, nil
+	}
+
+	// 3. Majukan status transaksi jika pembayaran berhasil
+	var t *transaksiJual
+	statusBaru := event.Status
+	perluRefund := false
+	if event.Status == pembayaran.StatusPaid {
+		t, err = lockTransaksi(ctx, tx, transaksiID)
+		if err != nil {
+			return nil, false, err
+		}
+		if CanTransition(t.Status, StatusDibayar) {
+			if err := applyTransition(ctx, tx, t, StatusDibayar, ""); err != nil {
+				return nil, false, err
+			}
+		} else {
+			// Pembayaran masuk setelah reservasi kedaluwarsa/dibatalkan
+			// Dicatat 'refund_pending' di transaksi DB yang sama agar refund tidak hilang jika gagal
+			log.Printf("Webhook pembayaran: transaksi %s berstatus '%s', dana akan di-refund", transaksiID, t.Status)
+			t = nil
+			statusBaru = pembayaran.StatusRefundPending
+			perluRefund = true
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE pembayaran SET status = $1, updated_at = NOW() WHERE id = $2`,
+		statusBaru, pembayaranID); err != nil {
+		return nil, false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+
+	// Percobaan pertama langsung; jika gagal dicoba ulang StartRefundJob
+	if perluRefund {
+		go cobaRefund(h.DB, h.Provider, event.ChargeID)
+	}
+	return t, false, nil
+}
+
+// PENJELASAN FILE pembayaran_webhook.go:
+// File ini menangani webhook dari payment provider (endpoint HTTP biasa, bukan gRPC)
+//
+// Alur WebhookHandler.ServeHTTP:
+// 1. Hanya terima POST, body maksimal 64KB (503 jika secret belum diset)
+// 2. Verifikasi HMAC-SHA256 di header X-Pembayaran-Signature (401 jika salah)
+// 3. Insert ke pembayaran_events dengan ON CONFLICT DO NOTHING
+//    -> jika event ID sudah ada, balas 200 tanpa memproses ulang (idempoten)
+// 4. Update status di tabel pembayaran (FOR UPDATE)
+// 5. Jika status 'paid': transaksi_jual dipindah ke 'dibayar' lewat applyTransition
+// 6. Jika transaksi sudah kedaluwarsa/dibatalkan: pembayaran dicatat 'refund_pending' di transaksi
+//    DB yang sama, refund dicoba langsung dan diulang StartRefundJob sampai berhasil
+// 7. Semua langkah 3-5 dalam satu transaction DB, notifikasi dikirim setelah commit
@@ -0,0 +1,104 @@
+package transaksi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"carapp.com/m/internal/pembayaran"
+)
+
+// StartRefundJob menjalankan job berkala yang mencoba ulang refund pembayaran 'refund_pending'.
+// Dipanggil sebagai goroutine dari main.go.
+func StartRefundJob(db *sql.DB, provider pembayaran.Provider, interval time.Duration) {
+	log.Printf("Job refund: berjalan setiap %s", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		n, err := ProsesRefund(context.Background(), db, provider)
+		if err != nil {
+			log.Printf("Job refund: gagal memproses refund tertunda: %v", err)
+			continue
+		}
+		if n > 0 {
+			log.Printf("Job refund: %d refund berhasil", n)
+		}
+	}
+}
+
+// ProsesRefund mencoba refund semua pembayaran 'refund_pending' milik provider ini.
+// Mengembalikan jumlah refund yang berhasil; yang gagal dicoba lagi di putaran berikutnya.
+func ProsesRefund(ctx context.Context, db *sql.DB, provider pembayaran.Provider) (int, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT charge_id FROM pembayaran WHERE provider = $1 AND status = $2 ORDER BY updated_at
+	`, provider.Name(), pembayaran.StatusRefundPending)
+	if err != nil {
+		return 0, err
+	}
+	var chargeIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		chargeIDs = append(chargeIDs, id)
+	}
+	rows.Close()
+
+	count := 0
+	for _, id := range chargeIDs {
+		if err := refundCharge(ctx, db, provider, id); err != nil {
+			log.Printf("Job refund: GAGAL refund tagihan %s: %v", id, err)
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+// cobaRefund adalah percobaan refund pertama setelah commit (goroutine). Kegagalan cukup
+// dicatat di log karena status 'refund_pending' tetap tersimpan untuk StartRefundJob.
+func cobaRefund(db *sql.DB, provider pembayaran.Provider, chargeID string) {
+	if err := refundCharge(context.Background(), db, provider, chargeID); err != nil {
+		log.Printf("GAGAL refund tagihan %s, akan dicoba ulang job refund: %v", chargeID, err)
+	}
+}
+
+// refundCharge meminta refund ke provider lalu mencatat hasilnya. Jika refund ditolak karena
+// sebelumnya sudah berhasil (misal pencatatan DB gagal), status di provider yang dipakai.
+func refundCharge(ctx context.Context, db *sql.DB, provider pembayaran.Provider, chargeID string) error {
+	charge, err := provider.Refund(ctx, chargeID)
+	if err != nil {
+		sekarang, errStatus := provider.GetStatus(ctx, chargeID)
+		if errStatus != nil || sekarang.Status != pembayaran.StatusRefunded {
+			return err
+		}
+		charge = sekarang
+	}
+	if charge.Status != pembayaran.StatusRefunded {
+		return fmt.Errorf("status tagihan setelah refund: %s", charge.Status)
+	}
+	_, err = db.ExecContext(ctx, `
+		UPDATE pembayaran SET status = $1, updated_at = NOW() WHERE provider = $2 AND charge_id = $3 AND status = $4
+	`, charge.Status, provider.Name(), chargeID, pembayaran.StatusRefundPending)
+	if err != nil {
+		return fmt.Errorf("gagal mencatat refund: %w", err)
+	}
+	log.Printf("Tagihan %s berhasil di-refund", chargeID)
+	return nil
+}
+
+// PENJELASAN FILE refund_job.go:
+// File ini berisi pengembalian dana pembayaran yang transaksinya batal / kedaluwarsa
+//
+// Alur:
+// - applyTransition (batal / kedaluwarsa) dan webhook 'paid' yang terlambat menandai pembayaran
+//   'refund_pending' di transaksi DB yang sama, jadi kewajiban refund tidak pernah hilang
+// - Setelah commit, cobaRefund langsung mencoba sekali di goroutine
+// - StartRefundJob mencoba ulang semua 'refund_pending' setiap interval sampai provider berhasil
+// - Refund yang ditolak karena sudah refunded di provider dicek lewat GetStatus lalu dicatat
+// - Update hanya dari 'refund_pending' sehingga percobaan bersamaan tidak saling menimpa
@@ -0,0 +1,108 @@
+package transaksi
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// StartReservasiExpiryJob menjalankan job berkala yang membatalkan reservasi kedaluwarsa.
+// Dipanggil sebagai goroutine dari main.go.
+func StartReservasiExpiryJob(db *sql.DB, interval time.Duration) {
+	log.Printf("Job reservasi: berjalan setiap %s", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		n, err := ExpireReservasi(context.Background(), db)
+		if err != nil {
+			log.Printf("Job reservasi: gagal memproses reservasi kedaluwarsa: %v", err)
+			continue
+		}
+		if n > 0 {
+			log.Printf("Job reservasi: %d reservasi kedaluwarsa diproses", n)
+		}
+	}
+}
+
+// ExpireReservasi memindahkan semua transaksi 'menunggu_pembayaran' yang melewati
+// reserved_until ke status 'kedaluwarsa' dan mengembalikan mobilnya ke 'tersedia'
+func ExpireReservasi(ctx context.Context, db *sql.DB) (int, error) {
+	// 1. Cari kandidat (tanpa lock, hanya ID)
+	rows, err := db.QueryContext(ctx, `
+		SELECT id FROM transaksi_jual
+		WHERE status = $1 AND reserved_until < NOW()
+	`, StatusMenungguPembayaran)
+	if err != nil {
+		return 0, err
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+
+	// 2. Proses satu per satu dalam transaction masing-masing,
+	// supaya satu kegagalan tidak membatalkan yang lain
+	count := 0
+	for _, id := range ids {
+		t, err := expireSatu(ctx, db, id)
+		if err != nil {
+			log.Printf("Job reservasi: gagal expire transaksi %s: %v", id, err)
+			continue
+		}
+		if t == nil {
+			continue // Sudah dibayar/dibatalkan sebelum sempat diproses
+		}
+		count++
+		kirimNotifikasiStatus(db, t)
+	}
+	return count, nil
+}
+
+// expireSatu mengunci transaksi lalu menjalankan transisi ke 'kedaluwarsa'
+func expireSatu(ctx context.Context, db *sql.DB, transaksiID string) (*transaksiJual, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	t, err := lockTransaksi(ctx, tx, transaksiID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cek ulang setelah lock: status bisa saja sudah berubah
+	if t.Status != StatusMenungguPembayaran || !t.ReservedUntil.Valid || time.Now().Before(t.ReservedUntil.Time) {
+		return nil, nil
+	}
+
+	if err := applyTransition(ctx, tx, t, StatusKedaluwarsa, "Batas waktu pembayaran terlewati"); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// PENJELASAN FILE reservasi_job.go:
+// File ini berisi job terjadwal untuk reservasi mobil yang tidak dibayar
+//
+// Fungsi StartReservasiExpiryJob:
+// - Dijalankan sebagai goroutine dari main.go
+// - time.Ticker memanggil ExpireReservasi setiap interval (default 5 menit)
+//
+// Fungsi ExpireReservasi:
+// - Cari transaksi 'menunggu_pembayaran' dengan reserved_until < NOW()
+// - Setiap transaksi diproses di transaction DB sendiri (expireSatu)
+// - Status dicek ulang setelah FOR UPDATE (bisa saja pembeli baru membayar)
+// - Transisi ke 'kedaluwarsa' lewat applyTransition (mobil kembali 'tersedia', atau 'kedaluwarsa' jika masa tayang habis)
+// - Kirim notifikasi ke pembeli dan penjual
@@ -0,0 +1,300 @@
+package transaksi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"carapp.com/m/internal/auth"
+	"carapp.com/m/internal/invoice"
+	"carapp.com/m/internal/money"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultLimitRiwayat = 20
+	maxLimitRiwayat     = 100
+)
+
+// kolomDetail dipakai ListMyTransactions dan GetTransaction agar urutan scan selalu sama.
+// Pembayaran yang ditampilkan adalah tagihan terakhir (LATERAL).
+const kolomDetail = `
+	t.id, t.mobil_id, t.penjual_id, t.pembeli_id, t.total, t.status,
+	t.reserved_until, t.alasan_batal, t.created_at, m.merk, m.model,
+	t.paid_at, t.confirmed_at, t.completed_at, t.cancelled_at,
+	m.tahun, m.kondisi, m.harga_jual, m.foto_url, m.lokasi, m.status,
+	uj.name, uj.email, uj.phone, ub.name, ub.email, ub.phone,
+	p.charge_id, p.payment_url, p.status, t.trade_in_id, t.potongan_trade_in
+`
+
+const fromDetail = `
+	FROM transaksi_jual t
+	JOIN mobils m ON m.id = t.mobil_id
+	JOIN users uj ON uj.id = t.penjual_id
+	JOIN users ub ON ub.id = t.pembeli_id
+	LEFT JOIN LATERAL (
+		SELECT charge_id, payment_url, status FROM pembayaran
+		WHERE transaksi_id = t.id ORDER BY created_at DESC LIMIT 1
+	) p ON true
+`
+
+// ListMyTransactions menampilkan riwayat transaksi user sebagai pembeli dan/atau penjual
+func (s *TransaksiServiceServer) ListMyTransactions(ctx context.Context, req *pb.ListMyTransactionsRequest) (*pb.ListMyTransactionsResponse, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+
+	// 1. Filter peran
+	var where string
+	switch req.Peran {
+	case "":
+		where = "(t.pembeli_id = $1 OR t.penjual_id = $1)"
+	case "pembeli":
+		where = "t.pembeli_id = $1"
+	case "penjual":
+		where = "t.penjual_id = $1"
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Peran harus pembeli atau penjual")
+	}
+	args := []interface{}{userID}
+
+	// 2. Filter status & rentang tanggal
+	if req.FilterStatus != nil {
+		args = append(args, *req.FilterStatus)
+		where += fmt.Sprintf(" AND t.status = $%d", len(args))
+	}
+	if req.Dari != nil {
+		args = append(args, req.Dari.AsTime())
+		where += fmt.Sprintf(" AND t.created_at >= $%d", len(args))
+	}
+	if req.Sampai != nil {
+		if req.Dari != nil && req.Sampai.AsTime().Before(req.Dari.AsTime()) {
+			return nil, status.Errorf(codes.InvalidArgument, "Tanggal sampai tidak boleh sebelum tanggal dari")
+		}
+		args = append(args, req.Sampai.AsTime())
+		where += fmt.Sprintf(" AND t.created_at <= $%d", len(args))
+	}
+
+	// 3. Paginasi
+	limit := defaultLimitRiwayat
+	if req.Limit > 0 && req.Limit <= maxLimitRiwayat {
+		limit = int(req.Limit)
+	}
+	offset := 0
+	if req.Page > 1 {
+		offset = (int(req.Page) - 1) * limit
+	}
+
+	query := `SELECT ` + kolomDetail + fromDetail + ` WHERE ` + where +
+		fmt.Sprintf(" ORDER BY t.created_at DESC LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	rows, err := s.DB.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		log.Printf("Gagal query ListMyTransactions: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil riwayat transaksi")
+	}
+	defer rows.Close()
+
+	var list []*pb.TransaksiDetail
+	for rows.Next() {
+		d, err := scanDetail(rows)
+		if err != nil {
+			log.Printf("Gagal scan transaksi: %v", err)
+			continue
+		}
+		d.Peran = peranUser(d, userID)
+		list = append(list, d)
+	}
+
+	var total int32
+	if err := s.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM transaksi_jual t WHERE `+where, args...).Scan(&total); err != nil {
+		log.Printf("Gagal menghitung transaksi: %v", err)
+	}
+
+	return &pb.ListMyTransactionsResponse{Transaksi: list, Total: total}, nil
+}
+
+// GetTransaction mengambil detail satu transaksi beserta data mobil dan pihak lain
+func (s *TransaksiServiceServer) GetTransaction(ctx context.Context, req *pb.GetTransactionRequest) (*pb.TransaksiDetail, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.TransaksiId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "TransaksiID tidak boleh kosong")
+	}
+
+	d, err := scanDetail(s.DB.QueryRowContext(ctx, `SELECT `+kolomDetail+fromDetail+` WHERE t.id = $1`, req.TransaksiId))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "Transaksi tidak ditemukan")
+		}
+		log.Printf("Gagal query GetTransaction: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil transaksi")
+	}
+
+	// Hanya pembeli, penjual, atau admin
+	d.Peran = peranUser(d, userID)
+	if d.Peran == "" {
+		if !auth.IsAdmin(ctx) {
+			// NotFound agar ID transaksi orang lain tidak bisa ditebak
+			return nil, status.Errorf(codes.NotFound, "Transaksi tidak ditemukan")
+		}
+		d.Peran = auth.RoleAdmin
+	}
+	return d, nil
+}
+
+// GetInvoice mengembalikan PDF invoice untuk transaksi 'selesai' (hanya pembeli dan penjual)
+func (s *TransaksiServiceServer) GetInvoice(ctx context.Context, req *pb.GetInvoiceRequest) (*pb.InvoiceResponse, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.TransaksiId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "TransaksiID tidak boleh kosong")
+	}
+
+	// 1. Cek peserta transaksi
+	var penjualID, pembeliID, statusTrx string
+	err := s.DB.QueryRowContext(ctx, `SELECT penjual_id, pembeli_id, status FROM transaksi_jual WHERE id = $1`, req.TransaksiId).
+		Scan(&penjualID, &pembeliID, &statusTrx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "Transaksi tidak ditemukan")
+		}
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil transaksi")
+	}
+	if userID != penjualID && userID != pembeliID {
+		return nil, status.Errorf(codes.NotFound, "Transaksi tidak ditemukan")
+	}
+	if statusTrx != StatusSelesai {
+		return nil, status.Errorf(codes.FailedPrecondition, "Invoice hanya tersedia untuk transaksi yang sudah selesai")
+	}
+
+	// 2. Ambil PDF (dibuat ulang jika belum ada)
+	inv, data, err := invoice.Ambil(ctx, s.DB, req.TransaksiId)
+	if err != nil {
+		if err == invoice.ErrBelumAda {
+			return nil, status.Errorf(codes.NotFound, "Invoice belum tersedia")
+		}
+		log.Printf("Gagal mengambil invoice transaksi %s: %v", req.TransaksiId, err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil invoice")
+	}
+
+	return &pb.InvoiceResponse{
+		Nomor:       inv.Nomor,
+		TransaksiId: inv.TransaksiID,
+		Total:       inv.Total.Float64(),
+		TotalMoney:  inv.Total.ToProto(),
+		CreatedAt:   timestamppb.New(inv.CreatedAt),
+		Filename:    strings.ReplaceAll(inv.Nomor, "/", "-") + ".pdf",
+		ContentType: "application/pdf",
+		PdfData:     data,
+	}, nil
+}
+
+// rowScanner adalah interface bersama *sql.Row dan *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanDetail membaca satu baris dengan urutan kolom kolomDetail
+func scanDetail(row rowScanner) (*pb.TransaksiDetail, error) {
+	var t transaksiJual
+	var paidAt, confirmedAt, completedAt, cancelledAt sql.NullTime
+	var mobil pb.Mobil
+	var fotoURL, lokasi, kondisi sql.NullString
+	var tahun sql.NullInt32
+	var hargaJual money.Money
+	var penjual, pembeli pb.PihakTransaksi
+	var phonePenjual, phonePembeli sql.NullString
+	var chargeID, paymentURL, paymentStatus sql.NullString
+
+	err := row.Scan(
+		&t.ID, &t.MobilID, &t.PenjualID, &t.PembeliID, &t.Total, &t.Status,
+		&t.ReservedUntil, &t.AlasanBatal, &t.CreatedAt, &t.Merk, &t.Model,
+		&paidAt, &confirmedAt, &completedAt, &cancelledAt,
+		&tahun, &kondisi, &hargaJual, &fotoURL, &lokasi, &mobil.Status,
+		&penjual.Name, &penjual.Email, &phonePenjual, &pembeli.Name, &pembeli.Email, &phonePembeli,
+		&chargeID, &paymentURL, &paymentStatus, &t.TradeInID, &t.PotonganTradeIn,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &pb.TransaksiDetail{Transaksi: t.toProto()}
+	d.Transaksi.PaymentChargeId = chargeID.String
+	d.Transaksi.PaymentUrl = paymentURL.String
+	d.Transaksi.PaymentStatus = paymentStatus.String
+
+	mobil.Id = t.MobilID
+	mobil.OwnerId = t.PenjualID
+	mobil.OwnerName = penjual.Name
+	mobil.Merk = t.Merk
+	mobil.Model = t.Model
+	mobil.Tahun = tahun.Int32
+	mobil.Kondisi = kondisi.String
+	mobil.HargaJual = hargaJual.Float64()
+	mobil.HargaJualMoney = hargaJual.ToProto()
+	mobil.FotoUrl = fotoURL.String
+	mobil.Lokasi = lokasi.String
+	d.Mobil = &mobil
+
+	penjual.Id = t.PenjualID
+	penjual.Phone = phonePenjual.String
+	pembeli.Id = t.PembeliID
+	pembeli.Phone = phonePembeli.String
+	d.Penjual = &penjual
+	d.Pembeli = &pembeli
+
+	d.PaidAt = optionalTimestamp(paidAt)
+	d.ConfirmedAt = optionalTimestamp(confirmedAt)
+	d.CompletedAt = optionalTimestamp(completedAt)
+	d.CancelledAt = optionalTimestamp(cancelledAt)
+	return d, nil
+}
+
+// peranUser menentukan peran user dalam transaksi ("" jika bukan peserta)
+func peranUser(d *pb.TransaksiDetail, userID string) string {
+	switch userID {
+	case d.Transaksi.PembeliId:
+		return "pembeli"
+	case d.Transaksi.PenjualId:
+		return "penjual"
+	}
+	return ""
+}
+
+// optionalTimestamp mengubah sql.NullTime ke timestamp proto (nil jika NULL)
+func optionalTimestamp(t sql.NullTime) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
+
+// PENJELASAN FILE transaksi_riwayat.go:
+// File ini berisi RPC baca untuk transaksi jual (sebelumnya transaksi tidak bisa dilihat lagi
+// setelah BuyMobil)
+//
+// Fungsi ListMyTransactions:
+// - Peran: kosong (pembeli + penjual), 'pembeli', atau 'penjual'
+// - Filter opsional: status, rentang tanggal created_at (dari / sampai)
+// - Paginasi page/limit (default 20, maksimal 100) + total untuk paginasi
+//
+// Fungsi GetTransaction:
+// - Detail transaksi + data mobil + data penjual & pembeli + tagihan pembayaran terakhir
+// - Hanya pembeli, penjual, atau admin; selain itu NotFound (bukan PermissionDenied)
+//   agar keberadaan transaksi orang lain tidak bocor
+//
+// ListMyTransactions dan GetTransaction memakai kolomDetail/fromDetail dan scanDetail yang sama.
+//
+// Fungsi GetInvoice:
+// - Hanya pembeli dan penjual (admin tidak termasuk), transaksi harus 'selesai'
+// - Return bytes PDF + nomor invoice (lihat package invoice)
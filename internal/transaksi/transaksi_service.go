@@ -5,24 +5,34 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"os"
+	"strconv"
 	"time"
 
 	"carapp.com/m/internal/auth" // Sesuaikan dengan modul Anda
+	"carapp.com/m/internal/invoice"
+	"carapp.com/m/internal/money"
 	"carapp.com/m/internal/notifikasi"
+	"carapp.com/m/internal/pembayaran"
+	"carapp.com/m/internal/tradein"
+	"carapp.com/m/internal/watchlist"
 	pb "carapp.com/m/proto" // Sesuaikan dengan modul Anda
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const defaultDurasiReservasi = 24 * time.Hour
+
 // TransaksiServiceServer adalah implementasi dari pb.TransaksiServiceServer
 type TransaksiServiceServer struct {
 	pb.UnimplementedTransaksiServiceServer
-	DB *sql.DB
+	DB       *sql.DB
+	Provider pembayaran.Provider
 }
 
 // NewTransaksiService membuat instance baru
-func NewTransaksiService(db *sql.DB) *TransaksiServiceServer {
-	return &TransaksiServiceServer{DB: db}
+func NewTransaksiService(db *sql.DB, provider pembayaran.Provider) *TransaksiServiceServer {
+	return &TransaksiServiceServer{DB: db, Provider: provider}
 }
 
 // BuyMobil menangani logika pembelian mobil (Fitur 3)
@@ -47,11 +57,49 @@ func (s *TransaksiServiceServer) BuyMobil(ctx context.Context, req *pb.BuyMobilR
 	// Pastikan di-rollback jika ada error
 	defer tx.Rollback()
 
-	// 3. Kunci mobil dan cek status (PENTING: FOR UPDATE)
+	// 3-5. Kunci mobil, validasi, lalu reservasi dengan harga jual saat ini
+	t, err := reserveMobil(ctx, tx, req.MobilId, pembeliID, money.Money{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Trade-in yang sudah diterima menjadi potongan harga
+	if req.TradeInId != "" {
+		if err := terapkanTradeIn(ctx, tx, t, req.TradeInId); err != nil {
+			return nil, err
+		}
+	}
+
+	// 6. Commit Transaksi DB
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menyelesaikan transaksi")
+	}
+
+	log.Printf("Reservasi sukses: Mobil %s dipesan oleh %s sampai %s", req.MobilId, pembeliID, t.ReservedUntil.Time.Format(time.RFC3339))
+
+	// --- 7. BUAT NOTIFIKASI (SETELAH COMMIT) ---
+	KirimNotifikasiReservasi(s.DB, t.toProto(), fmt.Sprintf("%s %s", t.Merk, t.Model))
+
+	return t.toProto(), nil
+}
+
+// ReserveMobil mengunci mobil dan membuat transaksi 'menunggu_pembayaran' di dalam tx milik pemanggil.
+// hargaDisepakati > 0 dipakai sebagai total (misal hasil negosiasi), jika 0 dipakai harga_jual mobil.
+func ReserveMobil(ctx context.Context, tx *sql.Tx, mobilID, pembeliID string, hargaDisepakati money.Money) (*pb.TransaksiJualResponse, error) {
+	t, err := reserveMobil(ctx, tx, mobilID, pembeliID, hargaDisepakati)
+	if err != nil {
+		return nil, err
+	}
+	return t.toProto(), nil
+}
+
+// reserveMobil berisi langkah reservasi yang dipakai BuyMobil dan ReserveMobil
+func reserveMobil(ctx context.Context, tx *sql.Tx, mobilID, pembeliID string, hargaDisepakati money.Money) (*transaksiJual, error) {
+	// 1. Kunci mobil dan cek status (PENTING: FOR UPDATE)
 	var penjualID, statusMobil, merkMobil, modelMobil string
-	var hargaJual float64
+	var hargaJual money.Money
 	queryCek := `SELECT owner_id, harga_jual, status, merk, model FROM mobils WHERE id = $1 FOR UPDATE`
-	err = tx.QueryRowContext(ctx, queryCek, req.MobilId).Scan(&penjualID, &hargaJual, &statusMobil, &merkMobil, &modelMobil)
+	err := tx.QueryRowContext(ctx, queryCek, mobilID).Scan(&penjualID, &hargaJual, &statusMobil, &merkMobil, &modelMobil)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, status.Errorf(codes.NotFound, "Mobil tidak ditemukan")
@@ -59,57 +107,336 @@ func (s *TransaksiServiceServer) BuyMobil(ctx context.Context, req *pb.BuyMobilR
 		return nil, status.Errorf(codes.Internal, "Gagal mengecek mobil")
 	}
 
-	if statusMobil != "tersedia" {
+	if statusMobil != MobilTersedia {
 		return nil, status.Errorf(codes.FailedPrecondition, "Mobil saat ini tidak tersedia")
 	}
 	if penjualID == pembeliID {
 		return nil, status.Errorf(codes.FailedPrecondition, "Anda tidak bisa membeli mobil Anda sendiri")
 	}
 
-	// 4. Update status mobil
-	queryUpdate := `UPDATE mobils SET status = 'terjual' WHERE id = $1`
-	_, err = tx.ExecContext(ctx, queryUpdate, req.MobilId)
+	// Mobil tidak bisa dijual selama test drive yang dikonfirmasi sedang berlangsung
+	var sedangTestDrive bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM janji_temu j
+			JOIN jadwal_slot s ON s.id = j.slot_id
+			WHERE j.mobil_id = $1 AND j.tipe = 'test_drive' AND j.status = 'dikonfirmasi'
+			  AND NOW() BETWEEN s.mulai AND s.selesai
+		)
+	`, mobilID).Scan(&sedangTestDrive)
 	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal mengecek jadwal test drive")
+	}
+	if sedangTestDrive {
+		return nil, status.Errorf(codes.FailedPrecondition, "Mobil sedang dalam test drive, coba lagi setelah test drive selesai")
+	}
+
+	total := hargaJual
+	if hargaDisepakati.IsPositive() {
+		if hargaDisepakati.Currency != hargaJual.Currency {
+			return nil, status.Errorf(codes.InvalidArgument, "Harga disepakati harus dalam %s", hargaJual.Currency)
+		}
+		total = hargaDisepakati
+	}
+
+	// 2. Reservasi mobil (mobil tidak bisa dibeli orang lain selama menunggu pembayaran)
+	queryUpdate := `UPDATE mobils SET status = $1, updated_at = NOW() WHERE id = $2`
+	if _, err := tx.ExecContext(ctx, queryUpdate, MobilDipesan, mobilID); err != nil {
 		return nil, status.Errorf(codes.Internal, "Gagal update status mobil")
 	}
 
-	// 5. Buat catatan transaksi
-	var resp pb.TransaksiJualResponse
-	statusTransaksi := "selesai" // Langsung selesai (simulasi pembayaran sukses)
+	// 3. Buat catatan transaksi dengan batas waktu pembayaran (dihitung dari jam DB, sama dengan job)
+	t := transaksiJual{Merk: merkMobil, Model: modelMobil}
 	queryInsert := `
-		INSERT INTO transaksi_jual (mobil_id, penjual_id, pembeli_id, total, status)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING id, mobil_id, penjual_id, pembeli_id, total, status
+		INSERT INTO transaksi_jual (mobil_id, penjual_id, pembeli_id, total, status, reserved_until)
+		VALUES ($1, $2, $3, $4, $5, NOW() + make_interval(secs => $6))
+		RETURNING id, mobil_id, penjual_id, pembeli_id, total, status, reserved_until, created_at
 	`
-	err = tx.QueryRowContext(ctx, queryInsert, req.MobilId, penjualID, pembeliID, hargaJual, statusTransaksi).
-		Scan(&resp.Id, &resp.MobilId, &resp.PenjualId, &resp.PembeliId, &resp.Total, &resp.Status)
-
+	err = tx.QueryRowContext(ctx, queryInsert, mobilID, penjualID, pembeliID, total, StatusMenungguPembayaran, durasiReservasi().Seconds()).
+		Scan(&t.ID, &t.MobilID, &t.PenjualID, &t.PembeliID, &t.Total, &t.Status, &t.ReservedUntil, &t.CreatedAt)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Gagal mencatat transaksi")
 	}
 
-	// 6. Commit Transaksi DB
-	if err := tx.Commit(); err != nil {
-		return nil, status.Errorf(codes.Internal, "Gagal menyelesaikan transaksi")
+	return &t, nil
+}
+
+// terapkanTradeIn memasang trade-in ke transaksi baru dan mengurangi total dengan nilai taksirannya
+func terapkanTradeIn(ctx context.Context, tx *sql.Tx, t *transaksiJual, tradeInID string) error {
+	potongan, err := tradein.Terapkan(ctx, tx, tradeInID, t.ID, t.PembeliID, t.MobilID, t.Total)
+	if err != nil {
+		return err
 	}
 
-	log.Printf("Transaksi sukses: Mobil %s dibeli oleh %s", req.MobilId, pembeliID)
-	// (Nanti di Langkah 6, kita buat notifikasi di sini)
-	// --- 7. BUAT NOTIFIKASI (SETELAH COMMIT) ---
-	pesanMobil := fmt.Sprintf("%s %s", merkMobil, modelMobil)
-	tanggalSekarang := time.Now().Format("02 Jan 2006")
+	err = tx.QueryRowContext(ctx, `
+		UPDATE transaksi_jual SET total = total - $1, trade_in_id = $2, potongan_trade_in = $1, updated_at = NOW()
+		WHERE id = $3
+		RETURNING total, trade_in_id, potongan_trade_in
+	`, potongan, tradeInID, t.ID).Scan(&t.Total, &t.TradeInID, &t.PotonganTradeIn)
+	if err != nil {
+		log.Printf("Gagal menerapkan trade-in %s ke transaksi %s: %v", tradeInID, t.ID, err)
+		return status.Errorf(codes.Internal, "Gagal menerapkan potongan trade-in")
+	}
+	return nil
+}
+
+// KirimNotifikasiReservasi memberi tahu pembeli dan penjual bahwa mobil sudah dipesan
+func KirimNotifikasiReservasi(db *sql.DB, t *pb.TransaksiJualResponse, namaMobil string) {
+	batasBayar := t.ReservedUntil.AsTime().Local().Format("02 Jan 2006 15:04")
 
 	// Notifikasi untuk Pembeli (jalankan di goroutine baru)
-	go notifikasi.CreateNotification(s.DB, context.Background(), pembeliID, "beli",
-		fmt.Sprintf("Anda melakukan pembelian mobil %s pada tanggal %s dengan harga Rp %.0f",
-			pesanMobil, tanggalSekarang, hargaJual))
+	go notifikasi.CreateNotification(db, context.Background(), t.PembeliId, "beli",
+		fmt.Sprintf("Anda memesan mobil %s dengan harga %s. Selesaikan pembayaran sebelum %s",
+			namaMobil, formatTotal(t), batasBayar))
 
 	// Notifikasi untuk Penjual (jalankan di goroutine baru)
-	go notifikasi.CreateNotification(s.DB, context.Background(), penjualID, "jual",
-		fmt.Sprintf("Anda melakukan penjualan mobil %s pada tanggal %s dengan harga Rp %.0f",
-			pesanMobil, tanggalSekarang, hargaJual))
+	go notifikasi.CreateNotification(db, context.Background(), t.PenjualId, "jual",
+		fmt.Sprintf("Mobil %s Anda dipesan pembeli dengan harga %s, menunggu pembayaran sampai %s",
+			namaMobil, formatTotal(t), batasBayar))
+}
+
+// formatTotal menampilkan total transaksi dari total_money (fallback ke field total lama)
+func formatTotal(t *pb.TransaksiJualResponse) string {
+	total, err := money.FromProto(t.TotalMoney)
+	if err != nil {
+		total = money.FromFloat(t.Total, money.DefaultCurrency)
+	}
+	return total.Format()
+}
+
+// PayTransaksi membuat tagihan di payment provider untuk transaksi yang menunggu pembayaran.
+// Status 'dibayar' baru diisi saat webhook provider mengabarkan pembayaran sukses.
+func (s *TransaksiServiceServer) PayTransaksi(ctx context.Context, req *pb.PayTransaksiRequest) (*pb.TransaksiJualResponse, error) {
+	pembeliID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.TransaksiId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "TransaksiID tidak boleh kosong")
+	}
+
+	log.Printf("TransaksiService: PayTransaksi dipanggil oleh %s untuk transaksi %s", pembeliID, req.TransaksiId)
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal memulai transaksi DB")
+	}
+	defer tx.Rollback()
+
+	// 1. Kunci transaksi agar tidak ada dua tagihan dibuat bersamaan
+	t, err := lockTransaksi(ctx, tx, req.TransaksiId)
+	if err != nil {
+		return nil, err
+	}
+	if t.PembeliID != pembeliID {
+		return nil, status.Errorf(codes.PermissionDenied, "Hanya pembeli yang bisa membayar transaksi ini")
+	}
+	if t.Status != StatusMenungguPembayaran {
+		return nil, status.Errorf(codes.FailedPrecondition, "Transaksi berstatus '%s' tidak menunggu pembayaran", t.Status)
+	}
+	if t.ReservedUntil.Valid && time.Now().After(t.ReservedUntil.Time) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Reservasi sudah kedaluwarsa")
+	}
+
+	// 2. Pakai ulang tagihan pending yang masih berlaku
+	resp := t.toProto()
+	var chargeID string
+	var paymentURL sql.NullString
+	err = tx.QueryRowContext(ctx, `
+		SELECT charge_id, payment_url FROM pembayaran
+		WHERE transaksi_id = $1 AND provider = $2 AND status = $3
+		ORDER BY created_at DESC LIMIT 1
+	`, t.ID, s.Provider.Name(), pembayaran.StatusPending).Scan(&chargeID, &paymentURL)
+	if err == nil {
+		if charge, errStatus := s.Provider.GetStatus(ctx, chargeID); errStatus == nil && charge.Status == pembayaran.StatusPending {
+			resp.PaymentChargeId = chargeID
+			resp.PaymentUrl = paymentURL.String
+			resp.PaymentStatus = charge.Status
+			return resp, nil
+		}
+		// Tagihan lama tidak dikenal provider lagi, tandai gagal dan buat yang baru
+		if _, err := tx.ExecContext(ctx, `UPDATE pembayaran SET status = $1, updated_at = NOW() WHERE provider = $2 AND charge_id = $3`,
+			pembayaran.StatusFailed, s.Provider.Name(), chargeID); err != nil {
+			return nil, status.Errorf(codes.Internal, "Gagal update tagihan lama")
+		}
+	} else if err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "Gagal mengecek tagihan")
+	}
+
+	// 3. Buat tagihan baru di provider
+	charge, err := s.Provider.CreateCharge(ctx, pembayaran.ChargeRequest{
+		TransaksiID: t.ID,
+		Amount:      t.Total,
+		Deskripsi:   fmt.Sprintf("Pembelian %s %s", t.Merk, t.Model),
+	})
+	if err != nil {
+		log.Printf("Gagal membuat tagihan di provider %s: %v", s.Provider.Name(), err)
+		return nil, status.Errorf(codes.Unavailable, "Gagal membuat tagihan pembayaran")
+	}
 
-	return &resp, nil
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO pembayaran (transaksi_id, provider, charge_id, amount, status, payment_url)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, t.ID, s.Provider.Name(), charge.ID, charge.Amount, charge.Status, charge.PaymentURL)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal mencatat tagihan")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menyelesaikan transaksi")
+	}
+
+	log.Printf("Tagihan %s dibuat untuk transaksi %s", charge.ID, t.ID)
+	resp.PaymentChargeId = charge.ID
+	resp.PaymentUrl = charge.PaymentURL
+	resp.PaymentStatus = charge.Status
+	return resp, nil
+}
+
+// ConfirmTransaksi dipanggil penjual setelah menerima pembayaran
+func (s *TransaksiServiceServer) ConfirmTransaksi(ctx context.Context, req *pb.ConfirmTransaksiRequest) (*pb.TransaksiJualResponse, error) {
+	return s.ubahStatus(ctx, req.TransaksiId, StatusDiproses, "", func(t *transaksiJual, userID string) error {
+		if t.PenjualID != userID {
+			return status.Errorf(codes.PermissionDenied, "Hanya penjual yang bisa mengkonfirmasi transaksi ini")
+		}
+		return nil
+	})
+}
+
+// CompleteTransaksi dipanggil pembeli setelah mobil diterima
+func (s *TransaksiServiceServer) CompleteTransaksi(ctx context.Context, req *pb.CompleteTransaksiRequest) (*pb.TransaksiJualResponse, error) {
+	return s.ubahStatus(ctx, req.TransaksiId, StatusSelesai, "", func(t *transaksiJual, userID string) error {
+		if t.PembeliID != userID {
+			return status.Errorf(codes.PermissionDenied, "Hanya pembeli yang bisa menyelesaikan transaksi ini")
+		}
+		return nil
+	})
+}
+
+// CancelTransaksi membatalkan transaksi oleh pembeli atau penjual, mobil kembali tersedia
+func (s *TransaksiServiceServer) CancelTransaksi(ctx context.Context, req *pb.CancelTransaksiRequest) (*pb.TransaksiJualResponse, error) {
+	alasan := req.Alasan
+	if alasan == "" {
+		alasan = "Dibatalkan oleh pengguna"
+	}
+	resp, err := s.ubahStatus(ctx, req.TransaksiId, StatusDibatalkan, alasan, func(t *transaksiJual, userID string) error {
+		if t.PembeliID != userID && t.PenjualID != userID {
+			return status.Errorf(codes.PermissionDenied, "Anda bukan bagian dari transaksi ini")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Jika sudah dibayar, pembayaran sudah ditandai 'refund_pending' oleh applyTransition.
+	// Refund dicoba langsung di latar belakang; jika gagal dicoba ulang StartRefundJob.
+	rows, err := s.DB.QueryContext(ctx, `SELECT charge_id FROM pembayaran WHERE transaksi_id = $1 AND provider = $2 AND status = $3`,
+		resp.Id, s.Provider.Name(), pembayaran.StatusRefundPending)
+	if err != nil {
+		log.Printf("Gagal mencari pembayaran untuk refund transaksi %s: %v", resp.Id, err)
+		return resp, nil
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var chargeID string
+		if err := rows.Scan(&chargeID); err == nil {
+			go cobaRefund(s.DB, s.Provider, chargeID)
+		}
+	}
+	return resp, nil
+}
+
+// ubahStatus adalah alur bersama untuk semua RPC perubahan status transaksi:
+// kunci transaksi, cek hak akses, jalankan transisi, commit, lalu kirim notifikasi
+func (s *TransaksiServiceServer) ubahStatus(
+	ctx context.Context,
+	transaksiID, ke, alasan string,
+	authorize func(t *transaksiJual, userID string) error,
+) (*pb.TransaksiJualResponse, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if transaksiID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "TransaksiID tidak boleh kosong")
+	}
+
+	log.Printf("TransaksiService: %s mengubah transaksi %s menjadi '%s'", userID, transaksiID, ke)
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal memulai transaksi DB")
+	}
+	defer tx.Rollback()
+
+	t, err := lockTransaksi(ctx, tx, transaksiID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorize(t, userID); err != nil {
+		return nil, err
+	}
+	if err := applyTransition(ctx, tx, t, ke, alasan); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal menyelesaikan transaksi")
+	}
+
+	log.Printf("Transaksi %s sekarang berstatus '%s'", t.ID, t.Status)
+	kirimNotifikasiStatus(s.DB, t)
+
+	// PDF invoice dibuat di background; GetInvoice akan membuatnya jika belum ada
+	if t.Status == StatusSelesai {
+		go func(transaksiID string) {
+			if _, _, err := invoice.Generate(context.Background(), s.DB, transaksiID); err != nil {
+				log.Printf("Gagal membuat PDF invoice transaksi %s: %v", transaksiID, err)
+			}
+		}(t.ID)
+	}
+
+	return t.toProto(), nil
+}
+
+// kirimNotifikasiStatus membuat notifikasi untuk pembeli dan penjual sesuai status baru
+func kirimNotifikasiStatus(db *sql.DB, t *transaksiJual) {
+	pesanMobil := fmt.Sprintf("%s %s", t.Merk, t.Model)
+	var pesanPembeli, pesanPenjual string
+
+	switch t.Status {
+	case StatusDibayar:
+		pesanPembeli = fmt.Sprintf("Pembayaran mobil %s sebesar %s berhasil, menunggu konfirmasi penjual", pesanMobil, t.Total.Format())
+		pesanPenjual = fmt.Sprintf("Pembeli sudah membayar mobil %s sebesar %s, silakan konfirmasi", pesanMobil, t.Total.Format())
+	case StatusDiproses:
+		pesanPembeli = fmt.Sprintf("Penjual mengkonfirmasi transaksi mobil %s, mobil sedang disiapkan", pesanMobil)
+		pesanPenjual = fmt.Sprintf("Anda mengkonfirmasi transaksi mobil %s, silakan serahkan mobil ke pembeli", pesanMobil)
+	case StatusSelesai:
+		tanggal := time.Now().Format("02 Jan 2006")
+		pesanPembeli = fmt.Sprintf("Anda melakukan pembelian mobil %s pada tanggal %s dengan harga %s", pesanMobil, tanggal, t.Total.Format())
+		pesanPenjual = fmt.Sprintf("Anda melakukan penjualan mobil %s pada tanggal %s dengan harga %s", pesanMobil, tanggal, t.Total.Format())
+		go watchlist.NotifyTerjual(db, t.MobilID, t.PembeliID)
+	case StatusDibatalkan:
+		pesanPembeli = fmt.Sprintf("Transaksi mobil %s dibatalkan: %s", pesanMobil, t.AlasanBatal.String)
+		pesanPenjual = pesanPembeli
+	case StatusKedaluwarsa:
+		pesanPembeli = fmt.Sprintf("Reservasi mobil %s kedaluwarsa karena pembayaran tidak diterima", pesanMobil)
+		pesanPenjual = fmt.Sprintf("Reservasi mobil %s kedaluwarsa, mobil kembali tersedia", pesanMobil)
+	default:
+		return
+	}
+
+	go notifikasi.CreateNotification(db, context.Background(), t.PembeliID, "beli", pesanPembeli)
+	go notifikasi.CreateNotification(db, context.Background(), t.PenjualID, "jual", pesanPenjual)
+}
+
+// durasiReservasi membaca lama reservasi dari env RESERVASI_DURASI_JAM (default 24 jam)
+func durasiReservasi() time.Duration {
+	if jam, err := strconv.Atoi(os.Getenv("RESERVASI_DURASI_JAM")); err == nil && jam > 0 {
+		return time.Duration(jam) * time.Hour
+	}
+	return defaultDurasiReservasi
 }
 
 // PENJELASAN FILE transaksi_service.go:
@@ -119,11 +446,30 @@ func (s *TransaksiServiceServer) BuyMobil(ctx context.Context, req *pb.BuyMobilR
 // - Ambil pembeli_id dari context (user yang membeli)
 // - Mulai database transaction (PENTING untuk data consistency)
 // - Lock mobil dengan FOR UPDATE (prevent race condition)
-// - Validasi: mobil harus tersedia, pembeli != penjual
-// - Update status mobil jadi 'terjual'
-// - Insert record ke transaksi_jual dengan status 'selesai'
+// - Validasi: mobil harus tersedia, pembeli != penjual, tidak sedang test drive (janji_temu)
+// - Update status mobil jadi 'dipesan' (reservasi)
+// - Insert record ke transaksi_jual dengan status 'menunggu_pembayaran'
+//   dan reserved_until = NOW() DB + RESERVASI_DURASI_JAM (default 24 jam, TIMESTAMPTZ)
 // - Commit transaction
 // - Buat notifikasi untuk pembeli dan penjual (goroutine background)
+// - trade_in_id (opsional): nilai taksiran trade-in yang sudah diterima mengurangi total
+//   (lihat package tradein), total yang dibayar = harga mobil - potongan
+// - Langkah lock + reservasi ada di reserveMobil, diekspor sebagai ReserveMobil
+//   agar bisa dipakai fitur lain (misal penawaran harga) di dalam transaction mereka
+//
+// Fungsi PayTransaksi:
+// - Hanya pembeli, sebelum reserved_until
+// - Pakai ulang tagihan 'pending' jika masih ada, jika tidak buat tagihan baru di Provider
+// - Return charge ID + payment URL; status 'dibayar' diisi oleh webhook (pembayaran_webhook.go)
+//
+// Fungsi ConfirmTransaksi / CompleteTransaksi / CancelTransaksi:
+// - Semua lewat ubahStatus: lock transaksi, cek hak akses, applyTransition, commit
+// - Confirm: hanya penjual, setelah dibayar
+// - Complete: hanya pembeli, setelah dikonfirmasi (mobil jadi 'terjual'), nomor invoice
+//   dialokasikan dan PDF invoice dibuat di background
+// - Saat selesai, user lain yang menyimpan mobil di watchlist diberi tahu mobil sudah terjual
+// - Cancel: pembeli atau penjual (mobil kembali 'tersedia'), dana di-refund jika sudah dibayar
+// - Aturan transisi ada di transaksi_status.go
 //
 // Keamanan Transaction:
 // - FOR UPDATE: Lock row mobil saat transaction (prevent double booking)
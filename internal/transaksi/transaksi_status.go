@@ -0,0 +1,259 @@
+package transaksi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"carapp.com/m/internal/hargapasar"
+	"carapp.com/m/internal/invoice"
+	"carapp.com/m/internal/money"
+	"carapp.com/m/internal/pembayaran"
+	"carapp.com/m/internal/tradein"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Status transaksi jual
+const (
+	StatusMenungguPembayaran = "menunggu_pembayaran"
+	StatusDibayar            = "dibayar"
+	StatusDiproses           = "diproses"
+	StatusSelesai            = "selesai"
+	StatusDibatalkan         = "dibatalkan"
+	StatusKedaluwarsa        = "kedaluwarsa"
+)
+
+// Status mobil yang dipengaruhi oleh transaksi jual
+const (
+	MobilTersedia = "tersedia"
+	MobilDipesan  = "dipesan"
+	MobilTerjual  = "terjual"
+	// Masa tayang listing habis selama reservasi; owner perlu RenewMobil
+	MobilKedaluwarsa = "kedaluwarsa"
+)
+
+// validTransitions adalah satu-satunya sumber kebenaran untuk perpindahan status
+var validTransitions = map[string][]string{
+	StatusMenungguPembayaran: {StatusDibayar, StatusDibatalkan, StatusKedaluwarsa},
+	StatusDibayar:            {StatusDiproses, StatusDibatalkan},
+	StatusDiproses:           {StatusSelesai, StatusDibatalkan},
+}
+
+// StatusAktif adalah status transaksi yang masih berjalan (mobil terkunci)
+var StatusAktif = []string{StatusMenungguPembayaran, StatusDibayar, StatusDiproses}
+
+// CanTransition mengecek apakah status transaksi boleh berpindah dari -> ke
+func CanTransition(dari, ke string) bool {
+	for _, s := range validTransitions[dari] {
+		if s == ke {
+			return true
+		}
+	}
+	return false
+}
+
+// statusMobilUntuk menentukan status mobil setelah transaksi berpindah ke status tertentu
+func statusMobilUntuk(statusTransaksi string) string {
+	switch statusTransaksi {
+	case StatusSelesai:
+		return MobilTerjual
+	case StatusDibatalkan, StatusKedaluwarsa:
+		return MobilTersedia
+	default:
+		return MobilDipesan
+	}
+}
+
+// kolomWaktuUntuk mengembalikan kolom timestamp yang diisi saat masuk ke status tertentu
+func kolomWaktuUntuk(statusTransaksi string) string {
+	switch statusTransaksi {
+	case StatusDibayar:
+		return "paid_at"
+	case StatusDiproses:
+		return "confirmed_at"
+	case StatusSelesai:
+		return "completed_at"
+	default:
+		return "cancelled_at"
+	}
+}
+
+// transaksiJual adalah baris transaksi_jual beserta info mobil untuk notifikasi
+type transaksiJual struct {
+	ID            string
+	MobilID       string
+	PenjualID     string
+	PembeliID     string
+	Total         money.Money
+	Status        string
+	ReservedUntil sql.NullTime
+	AlasanBatal   sql.NullString
+	CreatedAt     time.Time
+	Merk          string
+	Model         string
+	// Trade-in yang dipakai sebagai potongan (Total sudah dikurangi PotonganTradeIn)
+	TradeInID       sql.NullString
+	PotonganTradeIn money.Money
+}
+
+// hargaMobil adalah harga mobil yang disepakati (Total + potongan trade-in)
+func (t *transaksiJual) hargaMobil() money.Money {
+	if t.PotonganTradeIn.Minor == 0 || t.PotonganTradeIn.Currency != t.Total.Currency {
+		return t.Total
+	}
+	return money.New(t.Total.Minor+t.PotonganTradeIn.Minor, t.Total.Currency)
+}
+
+// toProto mengubah transaksiJual ke format response proto
+func (t *transaksiJual) toProto() *pb.TransaksiJualResponse {
+	resp := &pb.TransaksiJualResponse{
+		Id:         t.ID,
+		MobilId:    t.MobilID,
+		PenjualId:  t.PenjualID,
+		PembeliId:  t.PembeliID,
+		Total:      t.Total.Float64(),
+		TotalMoney: t.Total.ToProto(),
+		Status:     t.Status,
+		CreatedAt:  timestamppb.New(t.CreatedAt),
+	}
+	if t.ReservedUntil.Valid {
+		resp.ReservedUntil = timestamppb.New(t.ReservedUntil.Time)
+	}
+	if t.AlasanBatal.Valid {
+		resp.AlasanBatal = t.AlasanBatal.String
+	}
+	if t.TradeInID.Valid {
+		resp.TradeInId = t.TradeInID.String
+		resp.PotonganTradeIn = t.PotonganTradeIn.ToProto()
+	}
+	return resp
+}
+
+// lockTransaksi mengambil transaksi dan mengunci baris transaksi & mobil (FOR UPDATE)
+func lockTransaksi(ctx context.Context, tx *sql.Tx, transaksiID string) (*transaksiJual, error) {
+	query := `
+		SELECT t.id, t.mobil_id, t.penjual_id, t.pembeli_id, t.total, t.status,
+		       t.reserved_until, t.alasan_batal, t.created_at, m.merk, m.model,
+		       t.trade_in_id, t.potongan_trade_in
+		FROM transaksi_jual t
+		JOIN mobils m ON m.id = t.mobil_id
+		WHERE t.id = $1
+		FOR UPDATE
+	`
+	var t transaksiJual
+	err := tx.QueryRowContext(ctx, query, transaksiID).Scan(
+		&t.ID, &t.MobilID, &t.PenjualID, &t.PembeliID, &t.Total, &t.Status,
+		&t.ReservedUntil, &t.AlasanBatal, &t.CreatedAt, &t.Merk, &t.Model,
+		&t.TradeInID, &t.PotonganTradeIn,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "Transaksi tidak ditemukan")
+		}
+		return nil, status.Errorf(codes.Internal, "Gagal mengecek transaksi")
+	}
+	return &t, nil
+}
+
+// applyTransition memindahkan status transaksi (dan status mobil) setelah divalidasi.
+// Harus dipanggil di dalam transaksi DB setelah lockTransaksi.
+func applyTransition(ctx context.Context, tx *sql.Tx, t *transaksiJual, ke, alasan string) error {
+	if !CanTransition(t.Status, ke) {
+		return status.Errorf(codes.FailedPrecondition,
+			"Transaksi berstatus '%s' tidak bisa diubah menjadi '%s'", t.Status, ke)
+	}
+
+	queryTransaksi := fmt.Sprintf(`
+		UPDATE transaksi_jual
+		SET status = $1, %s = NOW(), alasan_batal = NULLIF($2, ''), updated_at = NOW()
+		WHERE id = $3
+	`, kolomWaktuUntuk(ke))
+	if _, err := tx.ExecContext(ctx, queryTransaksi, ke, alasan, t.ID); err != nil {
+		return status.Errorf(codes.Internal, "Gagal update status transaksi")
+	}
+
+	// Mobil yang dilepas kembali 'tersedia', kecuali masa tayangnya sudah lewat selama reservasi
+	queryMobil := `
+		UPDATE mobils
+		SET status = CASE WHEN $1::text = $3 AND berlaku_sampai <= NOW() THEN $4 ELSE $1::text END,
+		    updated_at = NOW()
+		WHERE id = $2
+	`
+	if _, err := tx.ExecContext(ctx, queryMobil, statusMobilUntuk(ke), t.MobilID, MobilTersedia, MobilKedaluwarsa); err != nil {
+		return status.Errorf(codes.Internal, "Gagal update status mobil")
+	}
+
+	// Dana yang sudah dibayar harus dikembalikan: ditandai 'refund_pending' di transaksi DB
+	// yang sama, refund ke provider dilakukan setelah commit (cobaRefund / StartRefundJob)
+	if ke == StatusDibatalkan || ke == StatusKedaluwarsa {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE pembayaran SET status = $1, updated_at = NOW() WHERE transaksi_id = $2 AND status = $3
+		`, pembayaran.StatusRefundPending, t.ID, pembayaran.StatusPaid)
+		if err != nil {
+			log.Printf("Transaksi %s: gagal menandai refund: %v", t.ID, err)
+			return status.Errorf(codes.Internal, "Gagal menandai refund pembayaran")
+		}
+	}
+
+	// Nomor invoice dialokasikan di transaksi DB yang sama agar tidak ada nomor yang loncat
+	if ke == StatusSelesai {
+		if _, err := invoice.AlokasiNomor(ctx, tx, t.ID, t.Total); err != nil {
+			log.Printf("Transaksi %s: %v", t.ID, err)
+			return status.Errorf(codes.Internal, "Gagal membuat nomor invoice")
+		}
+		// Harga terjual untuk statistik harga pasar = harga mobil sebelum potongan trade-in
+		if err := hargapasar.Catat(ctx, tx, t.MobilID, hargapasar.JenisTerjual, t.hargaMobil(), nil, t.ID); err != nil {
+			log.Printf("Transaksi %s: %v", t.ID, err)
+			return status.Errorf(codes.Internal, "Gagal mencatat harga terjual")
+		}
+	}
+
+	// Trade-in ikut berpindah: selesai -> jadi listing draft dealer, batal -> bisa dipakai lagi
+	if t.TradeInID.Valid {
+		switch ke {
+		case StatusSelesai:
+			if _, err := tradein.Selesaikan(ctx, tx, t.ID); err != nil {
+				log.Printf("Transaksi %s: %v", t.ID, err)
+				return status.Errorf(codes.Internal, "Gagal memproses mobil trade-in")
+			}
+		case StatusDibatalkan, StatusKedaluwarsa:
+			if err := tradein.Lepas(ctx, tx, t.ID); err != nil {
+				log.Printf("Transaksi %s: %v", t.ID, err)
+				return status.Errorf(codes.Internal, "Gagal melepas trade-in")
+			}
+		}
+	}
+
+	t.Status = ke
+	if alasan != "" {
+		t.AlasanBatal = sql.NullString{String: alasan, Valid: true}
+	}
+	return nil
+}
+
+// PENJELASAN FILE transaksi_status.go:
+// File ini berisi state machine untuk transaksi jual
+//
+// Alur status:
+// - menunggu_pembayaran: Mobil direservasi (status mobil 'dipesan') sampai reserved_until
+// - dibayar: Pembeli sudah membayar, menunggu konfirmasi penjual
+// - diproses: Penjual sudah konfirmasi, proses serah terima mobil
+// - selesai: Pembeli menerima mobil (status mobil 'terjual')
+// - dibatalkan / kedaluwarsa: Mobil kembali 'tersedia' (atau 'kedaluwarsa' jika berlaku_sampai sudah lewat)
+//
+// validTransitions:
+// - Satu-satunya tempat aturan perpindahan status didefinisikan
+// - CanTransition dipakai oleh semua RPC dan job reservasi
+//
+// Helper:
+// - lockTransaksi: SELECT ... FOR UPDATE agar tidak ada perubahan status bersamaan
+// - applyTransition: Validasi transisi, update transaksi_jual + mobils dalam satu transaction
+//   (batal / kedaluwarsa: pembayaran 'paid' ditandai 'refund_pending', diproses StartRefundJob)
+//   (status 'selesai' sekaligus mengalokasikan nomor invoice, mencatat harga terjual ke riwayat_harga,
+//   dan membuat listing draft trade-in;
+//   'dibatalkan'/'kedaluwarsa' melepas trade-in agar bisa dipakai lagi)
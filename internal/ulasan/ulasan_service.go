@@ -0,0 +1,342 @@
+package ulasan
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"carapp.com/m/internal/auth"
+	"carapp.com/m/internal/notifikasi"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Peran penulis ulasan
+const (
+	PeranPembeli = "pembeli" // Pembeli mengulas penjual
+	PeranPenjual = "penjual" // Penjual mengulas pembeli
+)
+
+const maksPanjangKomentar = 1000
+
+// kolomUlasan dipakai di semua SELECT agar urutan scan selalu sama
+const kolomUlasan = `
+	u.id, u.transaksi_id, u.penulis_id, p.name, u.target_id, u.peran_penulis, u.rating, u.komentar,
+	u.disembunyikan, u.alasan_disembunyikan, m.merk, m.model, u.created_at
+`
+
+const fromUlasan = `
+	FROM ulasan u
+	JOIN users p ON p.id = u.penulis_id
+	JOIN transaksi_jual t ON t.id = u.transaksi_id
+	JOIN mobils m ON m.id = t.mobil_id
+`
+
+// UlasanServiceServer adalah implementasi dari pb.UlasanServiceServer
+type UlasanServiceServer struct {
+	pb.UnimplementedUlasanServiceServer
+	DB *sql.DB
+}
+
+// NewUlasanService membuat instance baru
+func NewUlasanService(db *sql.DB) *UlasanServiceServer {
+	return &UlasanServiceServer{DB: db}
+}
+
+// CreateUlasan menyimpan ulasan untuk pihak lain dari transaksi yang sudah selesai
+func (s *UlasanServiceServer) CreateUlasan(ctx context.Context, req *pb.CreateUlasanRequest) (*pb.Ulasan, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+
+	// 1. Validasi input
+	if req.TransaksiId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "TransaksiID tidak boleh kosong")
+	}
+	if req.Rating < 1 || req.Rating > 5 {
+		return nil, status.Errorf(codes.InvalidArgument, "Rating harus 1 sampai 5")
+	}
+	komentar := strings.TrimSpace(req.Komentar)
+	if utf8.RuneCountInString(komentar) > maksPanjangKomentar {
+		return nil, status.Errorf(codes.InvalidArgument, "Komentar maksimal %d karakter", maksPanjangKomentar)
+	}
+
+	// 2. Hanya pembeli/penjual dari transaksi 'selesai'
+	var pembeliID, penjualID, statusTransaksi string
+	err := s.DB.QueryRowContext(ctx, `SELECT pembeli_id, penjual_id, status FROM transaksi_jual WHERE id = $1`, req.TransaksiId).
+		Scan(&pembeliID, &penjualID, &statusTransaksi)
+	if err == sql.ErrNoRows || (err == nil && userID != pembeliID && userID != penjualID) {
+		return nil, status.Errorf(codes.NotFound, "Transaksi tidak ditemukan")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal mengecek transaksi")
+	}
+	if statusTransaksi != "selesai" {
+		return nil, status.Errorf(codes.FailedPrecondition, "Ulasan hanya bisa diberikan setelah transaksi selesai")
+	}
+
+	peran, targetID := PeranPembeli, penjualID
+	if userID == penjualID {
+		peran, targetID = PeranPenjual, pembeliID
+	}
+
+	// 3. Simpan (UNIQUE transaksi_id + penulis_id: satu ulasan per pihak)
+	var ulasanID string
+	err = s.DB.QueryRowContext(ctx, `
+		INSERT INTO ulasan (transaksi_id, penulis_id, target_id, peran_penulis, rating, komentar)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''))
+		RETURNING id
+	`, req.TransaksiId, userID, targetID, peran, req.Rating, komentar).Scan(&ulasanID)
+	if err != nil {
+		if strings.Contains(err.Error(), "unique constraint") {
+			return nil, status.Errorf(codes.AlreadyExists, "Anda sudah memberi ulasan untuk transaksi ini")
+		}
+		log.Printf("Gagal menyimpan ulasan: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan ulasan")
+	}
+
+	u, err := scanUlasan(s.DB.QueryRowContext(ctx, `SELECT `+kolomUlasan+fromUlasan+` WHERE u.id = $1`, ulasanID))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil ulasan")
+	}
+
+	log.Printf("Ulasan %s: %s memberi rating %d untuk %s", u.Id, userID, u.Rating, targetID)
+	go notifikasi.CreateNotification(s.DB, context.Background(), targetID, "ulasan",
+		fmt.Sprintf("%s memberi Anda rating %d/5 untuk transaksi mobil %s %s", u.PenulisName, u.Rating, u.Merk, u.Model))
+
+	return u, nil
+}
+
+// ListUlasan menampilkan ulasan yang diterima seorang user
+func (s *UlasanServiceServer) ListUlasan(ctx context.Context, req *pb.ListUlasanRequest) (*pb.ListUlasanResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "UserID tidak boleh kosong")
+	}
+
+	// Ulasan untuk user sebagai penjual ditulis oleh pembeli, dan sebaliknya
+	peranPenulis := PeranPembeli
+	switch req.Peran {
+	case "", PeranPenjual:
+	case PeranPembeli:
+		peranPenulis = PeranPenjual
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Peran harus penjual atau pembeli")
+	}
+
+	limit := 20
+	if req.Limit > 0 && req.Limit <= 100 {
+		limit = int(req.Limit)
+	}
+	offset := 0
+	if req.Page > 1 {
+		offset = (int(req.Page) - 1) * limit
+	}
+
+	where := ` WHERE u.target_id = $1 AND u.peran_penulis = $2`
+	// Ulasan tersembunyi hanya untuk admin (RPC ini publik, jadi role bisa tidak ada)
+	if !req.TermasukDisembunyikan || !auth.IsAdmin(ctx) {
+		where += ` AND NOT u.disembunyikan`
+	}
+
+	list, err := s.queryUlasan(ctx, `SELECT `+kolomUlasan+fromUlasan+where+` ORDER BY u.created_at DESC LIMIT $3 OFFSET $4`,
+		req.UserId, peranPenulis, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	var total int32
+	if err := s.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM ulasan u`+where, req.UserId, peranPenulis).Scan(&total); err != nil {
+		log.Printf("Gagal menghitung ulasan: %v", err)
+	}
+
+	return &pb.ListUlasanResponse{Ulasan: list, Total: total}, nil
+}
+
+// GetProfilPenjual mengembalikan ringkasan reputasi penjual
+func (s *UlasanServiceServer) GetProfilPenjual(ctx context.Context, req *pb.GetProfilPenjualRequest) (*pb.ProfilPenjual, error) {
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "UserID tidak boleh kosong")
+	}
+
+	// 1. Data user + jumlah mobil terjual / listing aktif
+	profil := &pb.ProfilPenjual{UserId: req.UserId}
+	var bergabung time.Time
+	err := s.DB.QueryRowContext(ctx, `
+		SELECT u.name, u.created_at,
+		       (SELECT COUNT(*) FROM transaksi_jual t WHERE t.penjual_id = u.id AND t.status = 'selesai'),
+		       (SELECT COUNT(*) FROM mobils m WHERE m.owner_id = u.id AND m.status = 'tersedia')
+		FROM users u WHERE u.id = $1
+	`, req.UserId).Scan(&profil.Name, &bergabung, &profil.JumlahTerjual, &profil.JumlahListingAktif)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "Penjual tidak ditemukan")
+	}
+	if err != nil {
+		log.Printf("Gagal query profil penjual: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil profil penjual")
+	}
+	profil.BergabungSejak = timestamppb.New(bergabung)
+
+	// 2. Distribusi rating dari pembeli (ulasan tersembunyi tidak dihitung)
+	profil.DistribusiRating = make([]int32, 5)
+	rows, err := s.DB.QueryContext(ctx, `
+		SELECT rating, COUNT(*) FROM ulasan
+		WHERE target_id = $1 AND peran_penulis = $2 AND NOT disembunyikan
+		GROUP BY rating
+	`, req.UserId, PeranPembeli)
+	if err != nil {
+		log.Printf("Gagal query rating penjual: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil rating penjual")
+	}
+	defer rows.Close()
+
+	var totalBintang int32
+	for rows.Next() {
+		var rating, jumlah int32
+		if err := rows.Scan(&rating, &jumlah); err != nil || rating < 1 || rating > 5 {
+			continue
+		}
+		profil.DistribusiRating[rating-1] = jumlah
+		profil.JumlahUlasan += jumlah
+		totalBintang += rating * jumlah
+	}
+	if profil.JumlahUlasan > 0 {
+		profil.Rating = RataRata(totalBintang, profil.JumlahUlasan)
+	}
+
+	// 3. Lima ulasan terbaru
+	profil.UlasanTerbaru, err = s.queryUlasan(ctx, `SELECT `+kolomUlasan+fromUlasan+`
+		WHERE u.target_id = $1 AND u.peran_penulis = $2 AND NOT u.disembunyikan
+		ORDER BY u.created_at DESC LIMIT 5`, req.UserId, PeranPembeli)
+	if err != nil {
+		return nil, err
+	}
+
+	return profil, nil
+}
+
+// HideUlasan menyembunyikan atau menampilkan kembali ulasan (khusus admin)
+func (s *UlasanServiceServer) HideUlasan(ctx context.Context, req *pb.HideUlasanRequest) (*pb.Ulasan, error) {
+	adminID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if !auth.IsAdmin(ctx) {
+		return nil, status.Errorf(codes.PermissionDenied, "Hanya admin yang dapat menyembunyikan ulasan")
+	}
+	if req.UlasanId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "UlasanID tidak boleh kosong")
+	}
+	if req.Sembunyikan && strings.TrimSpace(req.Alasan) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Alasan harus diisi saat menyembunyikan ulasan")
+	}
+
+	var res sql.Result
+	var err error
+	if req.Sembunyikan {
+		res, err = s.DB.ExecContext(ctx, `
+			UPDATE ulasan SET disembunyikan = TRUE, alasan_disembunyikan = $1, disembunyikan_oleh = $2, updated_at = NOW()
+			WHERE id = $3
+		`, strings.TrimSpace(req.Alasan), adminID, req.UlasanId)
+	} else {
+		res, err = s.DB.ExecContext(ctx, `
+			UPDATE ulasan SET disembunyikan = FALSE, alasan_disembunyikan = NULL, disembunyikan_oleh = NULL, updated_at = NOW()
+			WHERE id = $1
+		`, req.UlasanId)
+	}
+	if err != nil {
+		log.Printf("Gagal update ulasan %s: %v", req.UlasanId, err)
+		return nil, status.Errorf(codes.Internal, "Gagal update ulasan")
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, status.Errorf(codes.NotFound, "Ulasan tidak ditemukan")
+	}
+
+	log.Printf("Admin %s mengubah ulasan %s (disembunyikan: %v)", adminID, req.UlasanId, req.Sembunyikan)
+	u, err := scanUlasan(s.DB.QueryRowContext(ctx, `SELECT `+kolomUlasan+fromUlasan+` WHERE u.id = $1`, req.UlasanId))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil ulasan")
+	}
+	return u, nil
+}
+
+// RataRata menghitung rata-rata rating dibulatkan ke 1 desimal (contoh 4.666 -> 4.7)
+func RataRata(totalBintang, jumlah int32) float64 {
+	if jumlah == 0 {
+		return 0
+	}
+	// Pembulatan di integer agar hasil stabil (tanpa error float 4.65 -> 4.6)
+	return float64((totalBintang*20+jumlah)/(jumlah*2)) / 10
+}
+
+// queryUlasan menjalankan SELECT kolomUlasan dan memindai semua baris
+func (s *UlasanServiceServer) queryUlasan(ctx context.Context, query string, args ...interface{}) ([]*pb.Ulasan, error) {
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Printf("Gagal query ulasan: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil ulasan")
+	}
+	defer rows.Close()
+
+	var list []*pb.Ulasan
+	for rows.Next() {
+		u, err := scanUlasan(rows)
+		if err != nil {
+			log.Printf("Gagal scan ulasan: %v", err)
+			continue
+		}
+		list = append(list, u)
+	}
+	return list, nil
+}
+
+// rowScanner adalah interface bersama *sql.Row dan *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanUlasan membaca satu baris dengan urutan kolom kolomUlasan
+func scanUlasan(row rowScanner) (*pb.Ulasan, error) {
+	var u pb.Ulasan
+	var komentar, alasan sql.NullString
+	var createdAt time.Time
+
+	err := row.Scan(&u.Id, &u.TransaksiId, &u.PenulisId, &u.PenulisName, &u.TargetId, &u.PeranPenulis,
+		&u.Rating, &komentar, &u.Disembunyikan, &alasan, &u.Merk, &u.Model, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+	u.Komentar = komentar.String
+	u.AlasanDisembunyikan = alasan.String
+	u.CreatedAt = timestamppb.New(createdAt)
+	return &u, nil
+}
+
+// PENJELASAN FILE ulasan_service.go:
+// File ini berisi implementasi UlasanService (Service 12) untuk rating & ulasan
+//
+// Fungsi CreateUlasan:
+// - Hanya pembeli atau penjual dari transaksi 'selesai'
+// - Target otomatis pihak lain; satu ulasan per pihak per transaksi (UNIQUE)
+// - Rating 1-5, komentar opsional maksimal 1000 karakter
+// - Notifikasi (tipe 'ulasan') ke user yang diulas
+//
+// Fungsi ListUlasan (publik):
+// - Ulasan yang diterima user sebagai penjual (default) atau pembeli, dengan paginasi
+// - Ulasan tersembunyi hanya ikut jika admin meminta termasuk_disembunyikan
+//
+// Fungsi GetProfilPenjual (publik):
+// - Nama, tanggal bergabung, jumlah terjual & listing aktif
+// - Rating rata-rata + distribusi bintang dari ulasan pembeli, 5 ulasan terbaru
+//
+// Fungsi HideUlasan (admin):
+// - Sembunyikan (wajib alasan) atau tampilkan kembali; ulasan tersembunyi tidak dihitung di rating
+//
+// Rating rata-rata juga ditampilkan di pb.Mobil (owner_rating, owner_jumlah_ulasan) lewat
+// ListMobil/GetMobil di mobil_service.go
@@ -0,0 +1,89 @@
+package watchlist
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"carapp.com/m/internal/money"
+	"carapp.com/m/internal/notifikasi"
+)
+
+// TipeNotifikasi adalah tipe notifikasi untuk semua pemberitahuan watchlist
+const TipeNotifikasi = "watchlist"
+
+// NotifyHargaTurun memberi tahu semua watcher bahwa harga mobil turun.
+// Dipanggil sebagai goroutine setelah perubahan harga di-commit.
+func NotifyHargaTurun(db *sql.DB, mobilID string, lama, baru money.Money) {
+	beritahuWatcher(db, mobilID, "", func(judul string) string {
+		return fmt.Sprintf("Harga mobil %s di watchlist Anda turun dari %s menjadi %s",
+			judul, lama.Format(), baru.Format())
+	})
+}
+
+// NotifyTerjual memberi tahu watcher bahwa mobil sudah terjual (pembeli tidak ikut diberi tahu)
+func NotifyTerjual(db *sql.DB, mobilID, pembeliID string) {
+	beritahuWatcher(db, mobilID, pembeliID, func(judul string) string {
+		return fmt.Sprintf("Mobil %s di watchlist Anda sudah terjual", judul)
+	})
+}
+
+// NotifyDitarik memberi tahu watcher bahwa penjual menarik listing dari penjualan
+func NotifyDitarik(db *sql.DB, mobilID string) {
+	beritahuWatcher(db, mobilID, "", func(judul string) string {
+		return fmt.Sprintf("Mobil %s di watchlist Anda ditarik dari penjualan oleh penjual", judul)
+	})
+}
+
+// NotifyKedaluwarsa memberi tahu watcher bahwa masa tayang listing habis
+func NotifyKedaluwarsa(db *sql.DB, mobilID string) {
+	beritahuWatcher(db, mobilID, "", func(judul string) string {
+		return fmt.Sprintf("Mobil %s di watchlist Anda tidak lagi tayang karena masa iklannya habis", judul)
+	})
+}
+
+// beritahuWatcher membuat satu notifikasi untuk setiap user yang menyimpan mobil,
+// kecuali userID `kecuali` (kosong = semua watcher)
+func beritahuWatcher(db *sql.DB, mobilID, kecuali string, pesan func(judul string) string) {
+	ctx := context.Background()
+	rows, err := db.QueryContext(ctx, `
+		SELECT w.user_id, m.tahun, m.merk, m.model
+		FROM watchlist w
+		JOIN mobils m ON m.id = w.mobil_id
+		WHERE w.mobil_id = $1 AND w.user_id::text <> $2
+	`, mobilID, kecuali)
+	if err != nil {
+		log.Printf("Gagal mengambil watcher mobil %s: %v", mobilID, err)
+		return
+	}
+	defer rows.Close()
+
+	jumlah := 0
+	for rows.Next() {
+		var userID, merk, model string
+		var tahun int
+		if err := rows.Scan(&userID, &tahun, &merk, &model); err != nil {
+			log.Printf("Gagal scan watcher: %v", err)
+			continue
+		}
+		notifikasi.CreateNotification(db, ctx, userID, TipeNotifikasi, pesan(fmt.Sprintf("%d %s %s", tahun, merk, model)))
+		jumlah++
+	}
+	if jumlah > 0 {
+		log.Printf("Watchlist: %d watcher mobil %s diberi tahu", jumlah, mobilID)
+	}
+}
+
+// PENJELASAN FILE watchlist.go:
+// File ini berisi pemberitahuan untuk user yang menyimpan mobil di watchlist
+//
+// Kejadian yang diberitahukan (tipe notifikasi 'watchlist'):
+// - NotifyHargaTurun: owner menurunkan harga (mobil.UpdateHargaMobil)
+// - NotifyTerjual: transaksi jual mobil selesai (transaksi.kirimNotifikasiStatus), pembeli dilewati
+// - NotifyDitarik: owner menarik listing (mobil.WithdrawMobil)
+// - NotifyKedaluwarsa: masa tayang listing habis (job kedaluwarsa listing di paket mobil)
+//
+// Catatan:
+// - Semua fungsi dipanggil sebagai goroutine setelah commit, sama seperti notifikasi lain
+// - Satu notifikasi per watcher lewat notifikasi.CreateNotification (masuk ke stream GetNotifications)
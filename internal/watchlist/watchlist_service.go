@@ -0,0 +1,202 @@
+package watchlist
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"carapp.com/m/internal/auth"
+	"carapp.com/m/internal/money"
+	"carapp.com/m/internal/pencarian"
+	pb "carapp.com/m/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WatchlistServiceServer adalah implementasi dari pb.WatchlistServiceServer
+type WatchlistServiceServer struct {
+	pb.UnimplementedWatchlistServiceServer
+	DB *sql.DB
+}
+
+// NewWatchlistService membuat instance baru
+func NewWatchlistService(db *sql.DB) *WatchlistServiceServer {
+	return &WatchlistServiceServer{DB: db}
+}
+
+// rowScanner bisa *sql.Row atau *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// kolomWatchlist dipakai di semua SELECT agar urutan scan selalu sama
+const kolomWatchlist = `
+	m.id, m.owner_id, m.merk, m.model, m.tahun, m.kondisi, m.harga_jual, m.foto_url,
+	m.lokasi, m.status, m.created_at, w.harga_saat_ditambah, w.created_at
+`
+
+const fromWatchlist = `
+	FROM watchlist w
+	JOIN mobils m ON m.id = w.mobil_id
+`
+
+// AddToWatchlist menyimpan mobil ke watchlist user. Menambahkan mobil yang sama dua kali
+// tidak mengubah harga_saat_ditambah.
+func (s *WatchlistServiceServer) AddToWatchlist(ctx context.Context, req *pb.AddToWatchlistRequest) (*pb.WatchlistItem, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.MobilId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "MobilID tidak boleh kosong")
+	}
+
+	// 1. Mobil harus ada, sudah publik, dan bukan milik sendiri
+	var ownerID, statusMobil string
+	var harga money.Money
+	err := s.DB.QueryRowContext(ctx, `SELECT owner_id, status, harga_jual FROM mobils WHERE id = $1`, req.MobilId).
+		Scan(&ownerID, &statusMobil, &harga)
+	if err == sql.ErrNoRows || (err == nil && pencarian.StatusPrivat(statusMobil)) {
+		return nil, status.Errorf(codes.NotFound, "Mobil tidak ditemukan")
+	}
+	if err != nil {
+		log.Printf("Gagal query mobil untuk watchlist: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil data mobil")
+	}
+	if ownerID == userID {
+		return nil, status.Errorf(codes.FailedPrecondition, "Tidak bisa menyimpan mobil milik sendiri ke watchlist")
+	}
+
+	// 2. Simpan (idempoten)
+	_, err = s.DB.ExecContext(ctx, `
+		INSERT INTO watchlist (user_id, mobil_id, harga_saat_ditambah)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, mobil_id) DO NOTHING
+	`, userID, req.MobilId, harga)
+	if err != nil {
+		log.Printf("Gagal menyimpan watchlist: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal menyimpan watchlist")
+	}
+	log.Printf("Watchlist: UserID %s menyimpan MobilID %s", userID, req.MobilId)
+
+	return scanItem(s.DB.QueryRowContext(ctx,
+		`SELECT `+kolomWatchlist+fromWatchlist+` WHERE w.user_id = $1 AND w.mobil_id = $2`, userID, req.MobilId))
+}
+
+// RemoveFromWatchlist menghapus mobil dari watchlist user
+func (s *WatchlistServiceServer) RemoveFromWatchlist(ctx context.Context, req *pb.RemoveFromWatchlistRequest) (*emptypb.Empty, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+	if req.MobilId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "MobilID tidak boleh kosong")
+	}
+
+	res, err := s.DB.ExecContext(ctx, `DELETE FROM watchlist WHERE user_id = $1 AND mobil_id = $2`, userID, req.MobilId)
+	if err != nil {
+		log.Printf("Gagal menghapus watchlist: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal menghapus watchlist")
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, status.Errorf(codes.NotFound, "Mobil tidak ada di watchlist")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListWatchlist menampilkan watchlist user, terbaru di atas
+func (s *WatchlistServiceServer) ListWatchlist(ctx context.Context, req *pb.ListWatchlistRequest) (*pb.ListWatchlistResponse, error) {
+	userID, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Tidak dapat mengambil UserID dari token")
+	}
+
+	limit := 20
+	if req.Limit > 0 && req.Limit <= 100 {
+		limit = int(req.Limit)
+	}
+	offset := 0
+	if req.Page > 1 {
+		offset = (int(req.Page) - 1) * limit
+	}
+
+	rows, err := s.DB.QueryContext(ctx, `SELECT `+kolomWatchlist+fromWatchlist+`
+		WHERE w.user_id = $1
+		ORDER BY w.created_at DESC
+		LIMIT $2 OFFSET $3
+	`, userID, limit, offset)
+	if err != nil {
+		log.Printf("Gagal query ListWatchlist: %v", err)
+		return nil, status.Errorf(codes.Internal, "Gagal mengambil watchlist")
+	}
+	defer rows.Close()
+
+	var items []*pb.WatchlistItem
+	for rows.Next() {
+		item, err := scanItem(rows)
+		if err != nil {
+			log.Printf("Gagal scan watchlist: %v", err)
+			continue
+		}
+		items = append(items, item)
+	}
+
+	var total int32
+	if err := s.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM watchlist WHERE user_id = $1`, userID).Scan(&total); err != nil {
+		log.Printf("Gagal menghitung watchlist: %v", err)
+	}
+
+	return &pb.ListWatchlistResponse{Items: items, Total: total}, nil
+}
+
+// scanItem membaca satu baris kolomWatchlist
+func scanItem(row rowScanner) (*pb.WatchlistItem, error) {
+	var mobil pb.Mobil
+	var kondisi, fotoUrl, lokasi sql.NullString
+	var harga, hargaAwal money.Money
+	var createdAt, ditambahkan time.Time
+
+	err := row.Scan(&mobil.Id, &mobil.OwnerId, &mobil.Merk, &mobil.Model, &mobil.Tahun, &kondisi,
+		&harga, &fotoUrl, &lokasi, &mobil.Status, &createdAt, &hargaAwal, &ditambahkan)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "Mobil tidak ada di watchlist")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	mobil.Kondisi = kondisi.String
+	mobil.FotoUrl = fotoUrl.String
+	mobil.Lokasi = lokasi.String
+	mobil.HargaJualMoney = harga.ToProto()
+	mobil.HargaJual = harga.Float64()
+	mobil.CreatedAt = timestamppb.New(createdAt)
+
+	return &pb.WatchlistItem{
+		Mobil:             &mobil,
+		HargaSaatDitambah: hargaAwal.ToProto(),
+		HargaTurun:        harga.Currency == hargaAwal.Currency && harga.Minor < hargaAwal.Minor,
+		DitambahkanPada:   timestamppb.New(ditambahkan),
+	}, nil
+}
+
+// PENJELASAN FILE watchlist_service.go:
+// File ini berisi implementasi WatchlistService (Service 13) untuk mobil favorit pembeli
+//
+// Fungsi AddToWatchlist:
+// - Mobil harus ada, sudah publik (bukan draft/moderasi), dan bukan milik user sendiri
+// - Simpan harga_jual saat ini sebagai harga_saat_ditambah
+// - Idempoten: ON CONFLICT DO NOTHING, harga awal tidak ditimpa
+//
+// Fungsi RemoveFromWatchlist:
+// - Hapus baris (user_id, mobil_id), NotFound jika tidak ada
+//
+// Fungsi ListWatchlist:
+// - Paginasi (default 20, maksimal 100), terbaru di atas
+// - Mobil terjual/ditarik tetap tampil dengan status terbarunya
+// - harga_turun = harga sekarang < harga saat disimpan
+//
+// Notifikasi harga turun / terjual / ditarik ada di watchlist.go
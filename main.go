@@ -4,14 +4,31 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"path"
+	"strings"
+	"time"
 
 	"carapp.com/m/internal/auth"
+	"carapp.com/m/internal/chat"
 	"carapp.com/m/internal/dashboard"
 	"carapp.com/m/internal/db"
+	"carapp.com/m/internal/geo"
+	"carapp.com/m/internal/hargapasar"
+	"carapp.com/m/internal/idempotensi"
+	"carapp.com/m/internal/janjitemu"
+	"carapp.com/m/internal/kredit"
+	"carapp.com/m/internal/kurs"
 	"carapp.com/m/internal/mobil"
+	"carapp.com/m/internal/moderasi"
 	"carapp.com/m/internal/nhtsa/nhtsa_service"
 	"carapp.com/m/internal/notifikasi"
+	"carapp.com/m/internal/pembayaran"
+	"carapp.com/m/internal/penawaran"
+	"carapp.com/m/internal/pencarian"
+	"carapp.com/m/internal/tradein"
 	"carapp.com/m/internal/transaksi"
+	"carapp.com/m/internal/ulasan"
+	"carapp.com/m/internal/watchlist"
 	pb "carapp.com/m/proto"
 
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
@@ -32,8 +49,13 @@ func main() {
 	defer dbConn.Close()
 
 	// 3. Buat server gRPC dengan UnaryInterceptor dan StreamInterceptor
+	// Urutan penting: auth dulu (isi UserID), baru idempotensi (key disimpan per user)
+	idempotensiInterceptor := idempotensi.NewInterceptor(dbConn)
+	// MaxRecvMsgSize: default gRPC 4 MB lebih kecil dari batas file 5 MB (UploadFoto, BulkImportMobil),
+	// jadi dinaikkan ke 6 MB agar file + field lain di request tetap sampai ke handler
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(auth.AuthInterceptor),
+		grpc.MaxRecvMsgSize(6<<20),
+		grpc.ChainUnaryInterceptor(auth.AuthInterceptor, idempotensiInterceptor.Unary),
 		grpc.StreamInterceptor(auth.StreamAuthInterceptor),
 	)
 
@@ -47,7 +69,11 @@ func main() {
 	nhtsaServer := nhtsa_service.NewNhtsaDataService(dbConn)
 	pb.RegisterNhtsaDataServiceServer(grpcServer, nhtsaServer)
 
-	transaksiServer := transaksi.NewTransaksiService(dbConn)
+	paymentProvider, err := pembayaran.NewProviderFromEnv()
+	if err != nil {
+		log.Fatalf("Gagal menyiapkan payment provider: %v", err)
+	}
+	transaksiServer := transaksi.NewTransaksiService(dbConn, paymentProvider)
 	pb.RegisterTransaksiServiceServer(grpcServer, transaksiServer)
 
 	notifikasiServer := notifikasi.NewNotifikasiService(dbConn)
@@ -56,8 +82,58 @@ func main() {
 	dashboardServer := dashboard.NewDashboardService(dbConn)
 	pb.RegisterDashboardServiceServer(grpcServer, dashboardServer)
 
+	penawaranServer := penawaran.NewPenawaranService(dbConn)
+	pb.RegisterPenawaranServiceServer(grpcServer, penawaranServer)
+
+	chatServer := chat.NewChatService(dbConn)
+	pb.RegisterChatServiceServer(grpcServer, chatServer)
+
+	janjiTemuServer := janjitemu.NewJanjiTemuService(dbConn)
+	pb.RegisterJanjiTemuServiceServer(grpcServer, janjiTemuServer)
+
+	kursServer := kurs.NewKursService(dbConn)
+	pb.RegisterKursServiceServer(grpcServer, kursServer)
+
+	kreditServer := kredit.NewKreditService(dbConn)
+	pb.RegisterKreditServiceServer(grpcServer, kreditServer)
+
+	tradeInServer := tradein.NewTradeInService(dbConn)
+	pb.RegisterTradeInServiceServer(grpcServer, tradeInServer)
+
+	ulasanServer := ulasan.NewUlasanService(dbConn)
+	pb.RegisterUlasanServiceServer(grpcServer, ulasanServer)
+
+	watchlistServer := watchlist.NewWatchlistService(dbConn)
+	pb.RegisterWatchlistServiceServer(grpcServer, watchlistServer)
+
+	savedSearchServer := pencarian.NewSavedSearchService(dbConn)
+	pb.RegisterSavedSearchServiceServer(grpcServer, savedSearchServer)
+
+	hargaPasarServer := hargapasar.NewHargaPasarService(dbConn)
+	pb.RegisterHargaPasarServiceServer(grpcServer, hargaPasarServer)
+
+	adminServer := moderasi.NewAdminService(dbConn)
+	pb.RegisterAdminServiceServer(grpcServer, adminServer)
+
 	reflection.Register(grpcServer)
 
+	// Koordinat listing lama dilengkapi dari teks lokasi (gazetteer offline), sekali saat start
+	go geo.StartBackfill(dbConn)
+	// Job terjadwal: batalkan reservasi mobil yang tidak dibayar
+	go transaksi.StartReservasiExpiryJob(dbConn, 5*time.Minute)
+	// Job terjadwal: coba ulang refund pembayaran yang tertunda
+	go transaksi.StartRefundJob(dbConn, paymentProvider, 10*time.Minute)
+	// Job terjadwal: tutup penawaran harga yang tidak direspon
+	go penawaran.StartExpiryJob(dbConn, 10*time.Minute)
+	// Job terjadwal: reminder janji temu / test drive
+	go janjitemu.StartReminderJob(dbConn, 5*time.Minute)
+	// Job terjadwal: hapus idempotency key yang sudah lewat 24 jam
+	go idempotensi.StartCleanupJob(dbConn, time.Hour)
+	// Job terjadwal: peringatan & kedaluwarsa masa tayang listing
+	go mobil.StartKedaluwarsaJob(dbConn, time.Hour)
+	// Job terjadwal: alert listing baru untuk saved search
+	go pencarian.StartAlertJob(dbConn, pencarian.IntervalAlert())
+
 	// 5. Buat wrapper gRPC-Web
 	wrappedGrpc := grpcweb.WrapServer(grpcServer,
 		grpcweb.WithOriginFunc(func(origin string) bool { return true }),
@@ -65,12 +141,24 @@ func main() {
 
 	// 6. Buat Handler HTTP dengan CORS
 	// File server untuk uploads
-	fileServer := http.FileServer(http.Dir("./uploads"))
+	fileServer := http.FileServer(tanpaDaftarFolder{http.Dir("./uploads")})
+
+	// Webhook payment provider (HTTP biasa, diverifikasi dengan HMAC)
+	webhookSecret := pembayaran.WebhookSecret()
+	webhookHandler := transaksi.NewWebhookHandler(dbConn, paymentProvider, webhookSecret)
+	var mockPayHandler http.Handler
+	if mock, ok := paymentProvider.(*pembayaran.MockProvider); ok {
+		// Tanpa secret, webhook menolak semua event dan alur bayar mock tidak bisa dipakai
+		if webhookSecret == nil {
+			log.Fatalf("PAYMENT_PROVIDER=mock membutuhkan PAYMENT_WEBHOOK_SECRET")
+		}
+		mockPayHandler = pembayaran.MockPayHandler(mock, webhookSecret, webhookHandler)
+	}
 
 	corsHandler := cors.New(cors.Options{
 		AllowedOrigins:   []string{"http://localhost:3000", "http://localhost:3001", "http://10.0.7.129:3000", "http://127.0.0.1:3000", "http://172.18.208.1:3000"},
 		AllowedMethods:   []string{"POST", "GET", "OPTIONS", "PUT", "DELETE"},
-		AllowedHeaders:   []string{"Content-Type", "X-Grpc-Web", "X-User-Agent", "Authorization", "authorization"},
+		AllowedHeaders:   []string{"Content-Type", "X-Grpc-Web", "X-User-Agent", "Authorization", "authorization", "Idempotency-Key", "idempotency-key"},
 		ExposedHeaders:   []string{"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin"},
 		AllowCredentials: true,
 		Debug:            true,
@@ -79,11 +167,29 @@ func main() {
 
 		// Serve static files dari /uploads/
 		if len(r.URL.Path) > 9 && r.URL.Path[:9] == "/uploads/" {
+			// PDF invoice berisi data pribadi, hanya boleh lewat RPC GetInvoice
+			bersih := path.Clean(r.URL.Path)
+			if bersih == "/uploads/invoice" || strings.HasPrefix(bersih, "/uploads/invoice/") {
+				http.NotFound(w, r)
+				return
+			}
 			log.Printf("Serving static file: %s", r.URL.Path)
 			http.StripPrefix("/uploads/", fileServer).ServeHTTP(w, r)
 			return
 		}
 
+		// Webhook pembayaran dari provider
+		if r.URL.Path == "/webhooks/pembayaran" {
+			webhookHandler.ServeHTTP(w, r)
+			return
+		}
+
+		// Halaman bayar simulasi (hanya untuk mock provider)
+		if r.URL.Path == "/mock-pembayaran/bayar" && mockPayHandler != nil {
+			mockPayHandler.ServeHTTP(w, r)
+			return
+		}
+
 		if wrappedGrpc.IsAcceptableGrpcCorsRequest(r) || wrappedGrpc.IsGrpcWebRequest(r) {
 			wrappedGrpc.ServeHTTP(w, r)
 			return
@@ -109,13 +215,41 @@ func main() {
 	}
 }
 
+// tanpaDaftarFolder membungkus http.FileSystem agar folder tidak bisa dibuka,
+// sehingga FileServer tidak pernah menampilkan daftar isi folder (404)
+type tanpaDaftarFolder struct {
+	fs http.FileSystem
+}
+
+func (t tanpaDaftarFolder) Open(name string) (http.File, error) {
+	f, err := t.fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil || info.IsDir() {
+		f.Close()
+		return nil, os.ErrNotExist
+	}
+	return f, nil
+}
+
 // PENJELASAN FILE main.go:
 // File ini adalah entry point aplikasi backend Car Dealer gRPC server
 // Fungsi utama:
 // - Load konfigurasi dari .env (DB_SOURCE, JWT_SECRET_KEY, dll)
 // - Buat koneksi ke database PostgreSQL
 // - Setup gRPC server dengan middleware autentikasi (UnaryInterceptor & StreamInterceptor)
-// - Daftarkan semua service: Auth, Mobil, NHTSA, Transaksi, Notifikasi, Dashboard
+// - Batas pesan masuk gRPC 6 MB (file upload / impor maksimal 5 MB)
+// - Interceptor idempotensi (header 'idempotency-key') setelah auth
+// - Daftarkan semua service: Auth, Mobil, NHTSA, Transaksi, Notifikasi, Dashboard, Penawaran, Chat, JanjiTemu, Kurs, Kredit, TradeIn, Ulasan, Watchlist, SavedSearch, HargaPasar, Admin
+// - Jalankan job terjadwal (reservasi & penawaran kedaluwarsa, refund tertunda, reminder janji temu, cleanup idempotency key,
+//   masa tayang listing, alert saved search) di goroutine
+// - Backfill koordinat listing dari teks lokasi (geo.StartBackfill) sekali saat start
 // - Wrap gRPC dengan grpc-web agar bisa diakses browser
 // - Setup CORS untuk mengizinkan frontend mengakses API
+// - Serve /uploads/ (foto mobil, tanpa daftar isi folder); /uploads/invoice ditolak, PDF invoice lewat GetInvoice
+// - Endpoint /webhooks/pembayaran untuk webhook payment provider (HMAC)
+// - Endpoint POST /mock-pembayaran/bayar untuk simulasi bayar (hanya jika PAYMENT_PROVIDER=mock,
+//   server gagal start jika PAYMENT_WEBHOOK_SECRET kosong)
 // - Jalankan HTTP server di port 9090 (default)
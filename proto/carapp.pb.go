@@ -23,6 +23,60 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Nilai uang tanpa floating-point: jumlah dalam satuan terkecil (minor unit ISO 4217)
+// contoh: Rp 150.000.000 -> { minor_units: 15000000000, currency_code: "IDR" } (IDR = 2 desimal)
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinorUnits    int64                  `protobuf:"varint,1,opt,name=minor_units,json=minorUnits,proto3" json:"minor_units,omitempty"`
+	CurrencyCode  string                 `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"` // ISO 4217, contoh "IDR", "USD"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_proto_carapp_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetMinorUnits() int64 {
+	if x != nil {
+		return x.MinorUnits
+	}
+	return 0
+}
+
+func (x *Money) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
 type User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -37,7 +91,7 @@ type User struct {
 
 func (x *User) Reset() {
 	*x = User{}
-	mi := &file_proto_carapp_proto_msgTypes[0]
+	mi := &file_proto_carapp_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49,7 +103,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[0]
+	mi := &file_proto_carapp_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -62,7 +116,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{0}
+	return file_proto_carapp_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *User) GetId() string {
@@ -108,28 +162,49 @@ func (x *User) GetCreatedAt() *timestamppb.Timestamp {
 }
 
 type Mobil struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OwnerId            string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	OwnerName          string                 `protobuf:"bytes,13,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
-	Merk               string                 `protobuf:"bytes,3,opt,name=merk,proto3" json:"merk,omitempty"`
-	Model              string                 `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
-	Tahun              int32                  `protobuf:"varint,5,opt,name=tahun,proto3" json:"tahun,omitempty"`
-	Kondisi            string                 `protobuf:"bytes,6,opt,name=kondisi,proto3" json:"kondisi,omitempty"`
-	Deskripsi          string                 `protobuf:"bytes,7,opt,name=deskripsi,proto3" json:"deskripsi,omitempty"`
-	HargaJual          float64                `protobuf:"fixed64,8,opt,name=harga_jual,json=hargaJual,proto3" json:"harga_jual,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId   string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	OwnerName string                 `protobuf:"bytes,13,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+	Merk      string                 `protobuf:"bytes,3,opt,name=merk,proto3" json:"merk,omitempty"`
+	Model     string                 `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
+	Tahun     int32                  `protobuf:"varint,5,opt,name=tahun,proto3" json:"tahun,omitempty"`
+	Kondisi   string                 `protobuf:"bytes,6,opt,name=kondisi,proto3" json:"kondisi,omitempty"`
+	Deskripsi string                 `protobuf:"bytes,7,opt,name=deskripsi,proto3" json:"deskripsi,omitempty"`
+	// Deprecated: Marked as deprecated in proto/carapp.proto.
+	HargaJual          float64                `protobuf:"fixed64,8,opt,name=harga_jual,json=hargaJual,proto3" json:"harga_jual,omitempty"` // Pakai harga_jual_money
 	FotoUrl            string                 `protobuf:"bytes,9,opt,name=foto_url,json=fotoUrl,proto3" json:"foto_url,omitempty"`
 	Lokasi             string                 `protobuf:"bytes,10,opt,name=lokasi,proto3" json:"lokasi,omitempty"`
 	Status             string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
 	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	HargaRentalPerHari float64                `protobuf:"fixed64,14,opt,name=harga_rental_per_hari,json=hargaRentalPerHari,proto3" json:"harga_rental_per_hari,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	HargaJualMoney     *Money                 `protobuf:"bytes,15,opt,name=harga_jual_money,json=hargaJualMoney,proto3" json:"harga_jual_money,omitempty"`
+	HargaAsliMoney     *Money                 `protobuf:"bytes,16,opt,name=harga_asli_money,json=hargaAsliMoney,proto3" json:"harga_asli_money,omitempty"`       // Harga dalam mata uang asli listing
+	HargaTampilMoney   *Money                 `protobuf:"bytes,17,opt,name=harga_tampil_money,json=hargaTampilMoney,proto3" json:"harga_tampil_money,omitempty"` // Harga dalam display_currency (kurs yang berlaku hari ini)
+	OwnerRating        float64                `protobuf:"fixed64,18,opt,name=owner_rating,json=ownerRating,proto3" json:"owner_rating,omitempty"`                // Rata-rata rating penjual dari pembeli (0 = belum ada ulasan)
+	OwnerJumlahUlasan  int32                  `protobuf:"varint,19,opt,name=owner_jumlah_ulasan,json=ownerJumlahUlasan,proto3" json:"owner_jumlah_ulasan,omitempty"`
+	// Atribut terstruktur (kosong/0 = tidak diketahui)
+	Kilometer       *int32                 `protobuf:"varint,20,opt,name=kilometer,proto3,oneof" json:"kilometer,omitempty"`
+	Transmisi       string                 `protobuf:"bytes,21,opt,name=transmisi,proto3" json:"transmisi,omitempty"`                     // manual/matic
+	BahanBakar      string                 `protobuf:"bytes,22,opt,name=bahan_bakar,json=bahanBakar,proto3" json:"bahan_bakar,omitempty"` // bensin/diesel/hybrid/listrik
+	Warna           string                 `protobuf:"bytes,23,opt,name=warna,proto3" json:"warna,omitempty"`
+	TipeBodi        string                 `protobuf:"bytes,24,opt,name=tipe_bodi,json=tipeBodi,proto3" json:"tipe_bodi,omitempty"` // sedan/hatchback/suv/mpv/pickup/coupe/konvertibel/wagon/van
+	JumlahKursi     int32                  `protobuf:"varint,25,opt,name=jumlah_kursi,json=jumlahKursi,proto3" json:"jumlah_kursi,omitempty"`
+	PlatRegion      string                 `protobuf:"bytes,26,opt,name=plat_region,json=platRegion,proto3" json:"plat_region,omitempty"` // Kode wilayah plat nomor, misal "B", "D", "AB"
+	Latitude        *float64               `protobuf:"fixed64,27,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`               // Koordinat listing (dari request atau gazetteer kota)
+	Longitude       *float64               `protobuf:"fixed64,28,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	JarakKm         *float64               `protobuf:"fixed64,29,opt,name=jarak_km,json=jarakKm,proto3,oneof" json:"jarak_km,omitempty"`                 // Diisi ListMobil jika filter punya titik asal
+	BerlakuSampai   *timestamppb.Timestamp `protobuf:"bytes,30,opt,name=berlaku_sampai,json=berlakuSampai,proto3" json:"berlaku_sampai,omitempty"`       // Akhir masa tayang listing (kosong untuk draft)
+	AlasanPenolakan string                 `protobuf:"bytes,31,opt,name=alasan_penolakan,json=alasanPenolakan,proto3" json:"alasan_penolakan,omitempty"` // Diisi staf saat status 'ditolak' (hanya terlihat owner/admin)
+	Vin             string                 `protobuf:"bytes,32,opt,name=vin,proto3" json:"vin,omitempty"`                                                // Nomor rangka 17 karakter (kosong = tidak diketahui)
+	NomorStok       string                 `protobuf:"bytes,33,opt,name=nomor_stok,json=nomorStok,proto3" json:"nomor_stok,omitempty"`                   // Nomor stok internal dealer (diisi BulkImportMobil)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Mobil) Reset() {
 	*x = Mobil{}
-	mi := &file_proto_carapp_proto_msgTypes[1]
+	mi := &file_proto_carapp_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -141,7 +216,7 @@ func (x *Mobil) String() string {
 func (*Mobil) ProtoMessage() {}
 
 func (x *Mobil) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[1]
+	mi := &file_proto_carapp_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -154,7 +229,7 @@ func (x *Mobil) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Mobil.ProtoReflect.Descriptor instead.
 func (*Mobil) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{1}
+	return file_proto_carapp_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Mobil) GetId() string {
@@ -213,6 +288,7 @@ func (x *Mobil) GetDeskripsi() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in proto/carapp.proto.
 func (x *Mobil) GetHargaJual() float64 {
 	if x != nil {
 		return x.HargaJual
@@ -255,6 +331,139 @@ func (x *Mobil) GetHargaRentalPerHari() float64 {
 	return 0
 }
 
+func (x *Mobil) GetHargaJualMoney() *Money {
+	if x != nil {
+		return x.HargaJualMoney
+	}
+	return nil
+}
+
+func (x *Mobil) GetHargaAsliMoney() *Money {
+	if x != nil {
+		return x.HargaAsliMoney
+	}
+	return nil
+}
+
+func (x *Mobil) GetHargaTampilMoney() *Money {
+	if x != nil {
+		return x.HargaTampilMoney
+	}
+	return nil
+}
+
+func (x *Mobil) GetOwnerRating() float64 {
+	if x != nil {
+		return x.OwnerRating
+	}
+	return 0
+}
+
+func (x *Mobil) GetOwnerJumlahUlasan() int32 {
+	if x != nil {
+		return x.OwnerJumlahUlasan
+	}
+	return 0
+}
+
+func (x *Mobil) GetKilometer() int32 {
+	if x != nil && x.Kilometer != nil {
+		return *x.Kilometer
+	}
+	return 0
+}
+
+func (x *Mobil) GetTransmisi() string {
+	if x != nil {
+		return x.Transmisi
+	}
+	return ""
+}
+
+func (x *Mobil) GetBahanBakar() string {
+	if x != nil {
+		return x.BahanBakar
+	}
+	return ""
+}
+
+func (x *Mobil) GetWarna() string {
+	if x != nil {
+		return x.Warna
+	}
+	return ""
+}
+
+func (x *Mobil) GetTipeBodi() string {
+	if x != nil {
+		return x.TipeBodi
+	}
+	return ""
+}
+
+func (x *Mobil) GetJumlahKursi() int32 {
+	if x != nil {
+		return x.JumlahKursi
+	}
+	return 0
+}
+
+func (x *Mobil) GetPlatRegion() string {
+	if x != nil {
+		return x.PlatRegion
+	}
+	return ""
+}
+
+func (x *Mobil) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *Mobil) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *Mobil) GetJarakKm() float64 {
+	if x != nil && x.JarakKm != nil {
+		return *x.JarakKm
+	}
+	return 0
+}
+
+func (x *Mobil) GetBerlakuSampai() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BerlakuSampai
+	}
+	return nil
+}
+
+func (x *Mobil) GetAlasanPenolakan() string {
+	if x != nil {
+		return x.AlasanPenolakan
+	}
+	return ""
+}
+
+func (x *Mobil) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *Mobil) GetNomorStok() string {
+	if x != nil {
+		return x.NomorStok
+	}
+	return ""
+}
+
 type Notifikasi struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -270,7 +479,7 @@ type Notifikasi struct {
 
 func (x *Notifikasi) Reset() {
 	*x = Notifikasi{}
-	mi := &file_proto_carapp_proto_msgTypes[2]
+	mi := &file_proto_carapp_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -282,7 +491,7 @@ func (x *Notifikasi) String() string {
 func (*Notifikasi) ProtoMessage() {}
 
 func (x *Notifikasi) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[2]
+	mi := &file_proto_carapp_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -295,7 +504,7 @@ func (x *Notifikasi) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Notifikasi.ProtoReflect.Descriptor instead.
 func (*Notifikasi) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{2}
+	return file_proto_carapp_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Notifikasi) GetId() string {
@@ -359,7 +568,7 @@ type RegisterRequest struct {
 
 func (x *RegisterRequest) Reset() {
 	*x = RegisterRequest{}
-	mi := &file_proto_carapp_proto_msgTypes[3]
+	mi := &file_proto_carapp_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -371,7 +580,7 @@ func (x *RegisterRequest) String() string {
 func (*RegisterRequest) ProtoMessage() {}
 
 func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[3]
+	mi := &file_proto_carapp_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -384,7 +593,7 @@ func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
 func (*RegisterRequest) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{3}
+	return file_proto_carapp_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RegisterRequest) GetName() string {
@@ -425,7 +634,7 @@ type LoginRequest struct {
 
 func (x *LoginRequest) Reset() {
 	*x = LoginRequest{}
-	mi := &file_proto_carapp_proto_msgTypes[4]
+	mi := &file_proto_carapp_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -437,7 +646,7 @@ func (x *LoginRequest) String() string {
 func (*LoginRequest) ProtoMessage() {}
 
 func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[4]
+	mi := &file_proto_carapp_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,7 +659,7 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{4}
+	return file_proto_carapp_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *LoginRequest) GetEmail() string {
@@ -477,7 +686,7 @@ type AuthResponse struct {
 
 func (x *AuthResponse) Reset() {
 	*x = AuthResponse{}
-	mi := &file_proto_carapp_proto_msgTypes[5]
+	mi := &file_proto_carapp_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -489,7 +698,7 @@ func (x *AuthResponse) String() string {
 func (*AuthResponse) ProtoMessage() {}
 
 func (x *AuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[5]
+	mi := &file_proto_carapp_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -502,7 +711,7 @@ func (x *AuthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
 func (*AuthResponse) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{5}
+	return file_proto_carapp_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AuthResponse) GetUser() *User {
@@ -522,22 +731,38 @@ func (x *AuthResponse) GetToken() string {
 type CreateMobilRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// owner_id diambil dari JWT Token
-	Merk               string  `protobuf:"bytes,1,opt,name=merk,proto3" json:"merk,omitempty"`
-	Model              string  `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
-	Tahun              int32   `protobuf:"varint,3,opt,name=tahun,proto3" json:"tahun,omitempty"`
-	Kondisi            string  `protobuf:"bytes,4,opt,name=kondisi,proto3" json:"kondisi,omitempty"`
-	Deskripsi          string  `protobuf:"bytes,5,opt,name=deskripsi,proto3" json:"deskripsi,omitempty"`
-	HargaJual          float64 `protobuf:"fixed64,6,opt,name=harga_jual,json=hargaJual,proto3" json:"harga_jual,omitempty"`
+	Merk      string `protobuf:"bytes,1,opt,name=merk,proto3" json:"merk,omitempty"`
+	Model     string `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	Tahun     int32  `protobuf:"varint,3,opt,name=tahun,proto3" json:"tahun,omitempty"`
+	Kondisi   string `protobuf:"bytes,4,opt,name=kondisi,proto3" json:"kondisi,omitempty"`
+	Deskripsi string `protobuf:"bytes,5,opt,name=deskripsi,proto3" json:"deskripsi,omitempty"`
+	// Deprecated: Marked as deprecated in proto/carapp.proto.
+	HargaJual          float64 `protobuf:"fixed64,6,opt,name=harga_jual,json=hargaJual,proto3" json:"harga_jual,omitempty"` // Pakai harga_jual_money (diabaikan jika harga_jual_money diisi)
 	FotoUrl            string  `protobuf:"bytes,7,opt,name=foto_url,json=fotoUrl,proto3" json:"foto_url,omitempty"`
 	Lokasi             string  `protobuf:"bytes,8,opt,name=lokasi,proto3" json:"lokasi,omitempty"`
 	HargaRentalPerHari float64 `protobuf:"fixed64,9,opt,name=harga_rental_per_hari,json=hargaRentalPerHari,proto3" json:"harga_rental_per_hari,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	HargaJualMoney     *Money  `protobuf:"bytes,10,opt,name=harga_jual_money,json=hargaJualMoney,proto3" json:"harga_jual_money,omitempty"`
+	// Atribut terstruktur opsional, lihat pb.Mobil (alias seperti "automatic" atau "minivan" diterima)
+	Kilometer   *int32 `protobuf:"varint,11,opt,name=kilometer,proto3,oneof" json:"kilometer,omitempty"`
+	Transmisi   string `protobuf:"bytes,12,opt,name=transmisi,proto3" json:"transmisi,omitempty"`
+	BahanBakar  string `protobuf:"bytes,13,opt,name=bahan_bakar,json=bahanBakar,proto3" json:"bahan_bakar,omitempty"`
+	Warna       string `protobuf:"bytes,14,opt,name=warna,proto3" json:"warna,omitempty"`
+	TipeBodi    string `protobuf:"bytes,15,opt,name=tipe_bodi,json=tipeBodi,proto3" json:"tipe_bodi,omitempty"`
+	JumlahKursi int32  `protobuf:"varint,16,opt,name=jumlah_kursi,json=jumlahKursi,proto3" json:"jumlah_kursi,omitempty"`
+	PlatRegion  string `protobuf:"bytes,17,opt,name=plat_region,json=platRegion,proto3" json:"plat_region,omitempty"`
+	// Koordinat listing opsional; jika kosong diambil dari lokasi lewat gazetteer kota
+	Latitude  *float64 `protobuf:"fixed64,18,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude *float64 `protobuf:"fixed64,19,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	// true = simpan sebagai draft (hanya merk & model wajib), dipublikasikan lewat PublishMobil
+	SimpanDraft   bool   `protobuf:"varint,20,opt,name=simpan_draft,json=simpanDraft,proto3" json:"simpan_draft,omitempty"`
+	Vin           string `protobuf:"bytes,21,opt,name=vin,proto3" json:"vin,omitempty"` // Opsional; jika kosong diambil dari "VIN: ..." di deskripsi
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateMobilRequest) Reset() {
 	*x = CreateMobilRequest{}
-	mi := &file_proto_carapp_proto_msgTypes[6]
+	mi := &file_proto_carapp_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -549,7 +774,7 @@ func (x *CreateMobilRequest) String() string {
 func (*CreateMobilRequest) ProtoMessage() {}
 
 func (x *CreateMobilRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[6]
+	mi := &file_proto_carapp_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,7 +787,7 @@ func (x *CreateMobilRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMobilRequest.ProtoReflect.Descriptor instead.
 func (*CreateMobilRequest) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{6}
+	return file_proto_carapp_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateMobilRequest) GetMerk() string {
@@ -600,6 +825,7 @@ func (x *CreateMobilRequest) GetDeskripsi() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in proto/carapp.proto.
 func (x *CreateMobilRequest) GetHargaJual() float64 {
 	if x != nil {
 		return x.HargaJual
@@ -628,18 +854,105 @@ func (x *CreateMobilRequest) GetHargaRentalPerHari() float64 {
 	return 0
 }
 
+func (x *CreateMobilRequest) GetHargaJualMoney() *Money {
+	if x != nil {
+		return x.HargaJualMoney
+	}
+	return nil
+}
+
+func (x *CreateMobilRequest) GetKilometer() int32 {
+	if x != nil && x.Kilometer != nil {
+		return *x.Kilometer
+	}
+	return 0
+}
+
+func (x *CreateMobilRequest) GetTransmisi() string {
+	if x != nil {
+		return x.Transmisi
+	}
+	return ""
+}
+
+func (x *CreateMobilRequest) GetBahanBakar() string {
+	if x != nil {
+		return x.BahanBakar
+	}
+	return ""
+}
+
+func (x *CreateMobilRequest) GetWarna() string {
+	if x != nil {
+		return x.Warna
+	}
+	return ""
+}
+
+func (x *CreateMobilRequest) GetTipeBodi() string {
+	if x != nil {
+		return x.TipeBodi
+	}
+	return ""
+}
+
+func (x *CreateMobilRequest) GetJumlahKursi() int32 {
+	if x != nil {
+		return x.JumlahKursi
+	}
+	return 0
+}
+
+func (x *CreateMobilRequest) GetPlatRegion() string {
+	if x != nil {
+		return x.PlatRegion
+	}
+	return ""
+}
+
+func (x *CreateMobilRequest) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *CreateMobilRequest) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *CreateMobilRequest) GetSimpanDraft() bool {
+	if x != nil {
+		return x.SimpanDraft
+	}
+	return false
+}
+
+func (x *CreateMobilRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
 type ListMobilRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	FilterStatus  *string                `protobuf:"bytes,3,opt,name=filter_status,json=filterStatus,proto3,oneof" json:"filter_status,omitempty"` // "tersedia", "terjual", dll.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Page            int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit           int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	FilterStatus    *string                `protobuf:"bytes,3,opt,name=filter_status,json=filterStatus,proto3,oneof" json:"filter_status,omitempty"`          // "tersedia", "terjual", dll.
+	DisplayCurrency *string                `protobuf:"bytes,4,opt,name=display_currency,json=displayCurrency,proto3,oneof" json:"display_currency,omitempty"` // Kode ISO 4217 untuk harga_tampil_money (default: mata uang asli)
+	Filter          *FilterMobil           `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	Urutkan         string                 `protobuf:"bytes,6,opt,name=urutkan,proto3" json:"urutkan,omitempty"` // "terbaru" (default) atau "jarak" (butuh titik asal di filter)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListMobilRequest) Reset() {
 	*x = ListMobilRequest{}
-	mi := &file_proto_carapp_proto_msgTypes[7]
+	mi := &file_proto_carapp_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -651,7 +964,7 @@ func (x *ListMobilRequest) String() string {
 func (*ListMobilRequest) ProtoMessage() {}
 
 func (x *ListMobilRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[7]
+	mi := &file_proto_carapp_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -664,7 +977,7 @@ func (x *ListMobilRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMobilRequest.ProtoReflect.Descriptor instead.
 func (*ListMobilRequest) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{7}
+	return file_proto_carapp_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListMobilRequest) GetPage() int32 {
@@ -688,17 +1001,228 @@ func (x *ListMobilRequest) GetFilterStatus() string {
 	return ""
 }
 
-type ListMobilResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mobils        []*Mobil               `protobuf:"bytes,1,rep,name=mobils,proto3" json:"mobils,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListMobilRequest) GetDisplayCurrency() string {
+	if x != nil && x.DisplayCurrency != nil {
+		return *x.DisplayCurrency
+	}
+	return ""
 }
 
-func (x *ListMobilResponse) Reset() {
-	*x = ListMobilResponse{}
-	mi := &file_proto_carapp_proto_msgTypes[8]
+func (x *ListMobilRequest) GetFilter() *FilterMobil {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListMobilRequest) GetUrutkan() string {
+	if x != nil {
+		return x.Urutkan
+	}
+	return ""
+}
+
+// Filter pencarian mobil, dipakai ListMobil dan saved search (field kosong = tidak difilter)
+type FilterMobil struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Merk           string                 `protobuf:"bytes,1,opt,name=merk,proto3" json:"merk,omitempty"` // Tidak case-sensitive
+	Model          string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	TahunMin       int32                  `protobuf:"varint,3,opt,name=tahun_min,json=tahunMin,proto3" json:"tahun_min,omitempty"`
+	TahunMax       int32                  `protobuf:"varint,4,opt,name=tahun_max,json=tahunMax,proto3" json:"tahun_max,omitempty"`
+	HargaMin       *Money                 `protobuf:"bytes,5,opt,name=harga_min,json=hargaMin,proto3" json:"harga_min,omitempty"` // Dibandingkan dengan harga_jual (IDR)
+	HargaMax       *Money                 `protobuf:"bytes,6,opt,name=harga_max,json=hargaMax,proto3" json:"harga_max,omitempty"`
+	Lokasi         string                 `protobuf:"bytes,7,opt,name=lokasi,proto3" json:"lokasi,omitempty"`                                  // Mengandung teks ini (misal "Bandung")
+	Kondisi        string                 `protobuf:"bytes,8,opt,name=kondisi,proto3" json:"kondisi,omitempty"`                                // baru/bekas
+	KilometerMax   int32                  `protobuf:"varint,9,opt,name=kilometer_max,json=kilometerMax,proto3" json:"kilometer_max,omitempty"` // 0 = tanpa batas; listing tanpa data kilometer tidak ikut
+	Transmisi      string                 `protobuf:"bytes,10,opt,name=transmisi,proto3" json:"transmisi,omitempty"`                           // manual/matic
+	BahanBakar     string                 `protobuf:"bytes,11,opt,name=bahan_bakar,json=bahanBakar,proto3" json:"bahan_bakar,omitempty"`
+	Warna          string                 `protobuf:"bytes,12,opt,name=warna,proto3" json:"warna,omitempty"` // Sama persis (tidak case-sensitive)
+	TipeBodi       string                 `protobuf:"bytes,13,opt,name=tipe_bodi,json=tipeBodi,proto3" json:"tipe_bodi,omitempty"`
+	JumlahKursiMin int32                  `protobuf:"varint,14,opt,name=jumlah_kursi_min,json=jumlahKursiMin,proto3" json:"jumlah_kursi_min,omitempty"`
+	PlatRegion     string                 `protobuf:"bytes,15,opt,name=plat_region,json=platRegion,proto3" json:"plat_region,omitempty"`
+	// Titik asal pembeli untuk radius & urutan jarak: latitude/longitude, atau nama kota
+	Latitude      *float64 `protobuf:"fixed64,16,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude     *float64 `protobuf:"fixed64,17,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	DekatKota     string   `protobuf:"bytes,18,opt,name=dekat_kota,json=dekatKota,proto3" json:"dekat_kota,omitempty"` // Dipakai jika latitude/longitude kosong, misal "Bandung"
+	RadiusKm      float64  `protobuf:"fixed64,19,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`  // 0 = tanpa batas radius; listing tanpa koordinat tidak ikut
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterMobil) Reset() {
+	*x = FilterMobil{}
+	mi := &file_proto_carapp_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterMobil) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterMobil) ProtoMessage() {}
+
+func (x *FilterMobil) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterMobil.ProtoReflect.Descriptor instead.
+func (*FilterMobil) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FilterMobil) GetMerk() string {
+	if x != nil {
+		return x.Merk
+	}
+	return ""
+}
+
+func (x *FilterMobil) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *FilterMobil) GetTahunMin() int32 {
+	if x != nil {
+		return x.TahunMin
+	}
+	return 0
+}
+
+func (x *FilterMobil) GetTahunMax() int32 {
+	if x != nil {
+		return x.TahunMax
+	}
+	return 0
+}
+
+func (x *FilterMobil) GetHargaMin() *Money {
+	if x != nil {
+		return x.HargaMin
+	}
+	return nil
+}
+
+func (x *FilterMobil) GetHargaMax() *Money {
+	if x != nil {
+		return x.HargaMax
+	}
+	return nil
+}
+
+func (x *FilterMobil) GetLokasi() string {
+	if x != nil {
+		return x.Lokasi
+	}
+	return ""
+}
+
+func (x *FilterMobil) GetKondisi() string {
+	if x != nil {
+		return x.Kondisi
+	}
+	return ""
+}
+
+func (x *FilterMobil) GetKilometerMax() int32 {
+	if x != nil {
+		return x.KilometerMax
+	}
+	return 0
+}
+
+func (x *FilterMobil) GetTransmisi() string {
+	if x != nil {
+		return x.Transmisi
+	}
+	return ""
+}
+
+func (x *FilterMobil) GetBahanBakar() string {
+	if x != nil {
+		return x.BahanBakar
+	}
+	return ""
+}
+
+func (x *FilterMobil) GetWarna() string {
+	if x != nil {
+		return x.Warna
+	}
+	return ""
+}
+
+func (x *FilterMobil) GetTipeBodi() string {
+	if x != nil {
+		return x.TipeBodi
+	}
+	return ""
+}
+
+func (x *FilterMobil) GetJumlahKursiMin() int32 {
+	if x != nil {
+		return x.JumlahKursiMin
+	}
+	return 0
+}
+
+func (x *FilterMobil) GetPlatRegion() string {
+	if x != nil {
+		return x.PlatRegion
+	}
+	return ""
+}
+
+func (x *FilterMobil) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *FilterMobil) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *FilterMobil) GetDekatKota() string {
+	if x != nil {
+		return x.DekatKota
+	}
+	return ""
+}
+
+func (x *FilterMobil) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+type ListMobilResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mobils        []*Mobil               `protobuf:"bytes,1,rep,name=mobils,proto3" json:"mobils,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMobilResponse) Reset() {
+	*x = ListMobilResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -710,7 +1234,7 @@ func (x *ListMobilResponse) String() string {
 func (*ListMobilResponse) ProtoMessage() {}
 
 func (x *ListMobilResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[8]
+	mi := &file_proto_carapp_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,7 +1247,7 @@ func (x *ListMobilResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMobilResponse.ProtoReflect.Descriptor instead.
 func (*ListMobilResponse) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{8}
+	return file_proto_carapp_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListMobilResponse) GetMobils() []*Mobil {
@@ -741,15 +1265,16 @@ func (x *ListMobilResponse) GetTotal() int32 {
 }
 
 type GetMobilRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MobilId         string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	DisplayCurrency *string                `protobuf:"bytes,2,opt,name=display_currency,json=displayCurrency,proto3,oneof" json:"display_currency,omitempty"` // Kode ISO 4217 untuk harga_tampil_money (default: mata uang asli)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetMobilRequest) Reset() {
 	*x = GetMobilRequest{}
-	mi := &file_proto_carapp_proto_msgTypes[9]
+	mi := &file_proto_carapp_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -761,7 +1286,7 @@ func (x *GetMobilRequest) String() string {
 func (*GetMobilRequest) ProtoMessage() {}
 
 func (x *GetMobilRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[9]
+	mi := &file_proto_carapp_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -774,7 +1299,7 @@ func (x *GetMobilRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMobilRequest.ProtoReflect.Descriptor instead.
 func (*GetMobilRequest) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{9}
+	return file_proto_carapp_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetMobilRequest) GetMobilId() string {
@@ -784,6 +1309,13 @@ func (x *GetMobilRequest) GetMobilId() string {
 	return ""
 }
 
+func (x *GetMobilRequest) GetDisplayCurrency() string {
+	if x != nil && x.DisplayCurrency != nil {
+		return *x.DisplayCurrency
+	}
+	return ""
+}
+
 // Upload foto (unary - untuk gRPC-Web)
 type UploadFotoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -796,7 +1328,7 @@ type UploadFotoRequest struct {
 
 func (x *UploadFotoRequest) Reset() {
 	*x = UploadFotoRequest{}
-	mi := &file_proto_carapp_proto_msgTypes[10]
+	mi := &file_proto_carapp_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -808,7 +1340,7 @@ func (x *UploadFotoRequest) String() string {
 func (*UploadFotoRequest) ProtoMessage() {}
 
 func (x *UploadFotoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[10]
+	mi := &file_proto_carapp_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,7 +1353,7 @@ func (x *UploadFotoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadFotoRequest.ProtoReflect.Descriptor instead.
 func (*UploadFotoRequest) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{10}
+	return file_proto_carapp_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UploadFotoRequest) GetFilename() string {
@@ -855,7 +1387,7 @@ type UploadFotoResponse struct {
 
 func (x *UploadFotoResponse) Reset() {
 	*x = UploadFotoResponse{}
-	mi := &file_proto_carapp_proto_msgTypes[11]
+	mi := &file_proto_carapp_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -867,7 +1399,7 @@ func (x *UploadFotoResponse) String() string {
 func (*UploadFotoResponse) ProtoMessage() {}
 
 func (x *UploadFotoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[11]
+	mi := &file_proto_carapp_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -880,7 +1412,7 @@ func (x *UploadFotoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadFotoResponse.ProtoReflect.Descriptor instead.
 func (*UploadFotoResponse) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{11}
+	return file_proto_carapp_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UploadFotoResponse) GetUrl() string {
@@ -897,30 +1429,29 @@ func (x *UploadFotoResponse) GetMessage() string {
 	return ""
 }
 
-// Pesan untuk NHTSA Cache
-type Make struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BrandId       string                 `protobuf:"bytes,1,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateHargaMobilRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MobilId        string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	HargaJualMoney *Money                 `protobuf:"bytes,2,opt,name=harga_jual_money,json=hargaJualMoney,proto3" json:"harga_jual_money,omitempty"` // Mata uang asing dikonversi ke IDR seperti CreateMobil
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Make) Reset() {
-	*x = Make{}
-	mi := &file_proto_carapp_proto_msgTypes[12]
+func (x *UpdateHargaMobilRequest) Reset() {
+	*x = UpdateHargaMobilRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Make) String() string {
+func (x *UpdateHargaMobilRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Make) ProtoMessage() {}
+func (*UpdateHargaMobilRequest) ProtoMessage() {}
 
-func (x *Make) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[12]
+func (x *UpdateHargaMobilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -931,49 +1462,48 @@ func (x *Make) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Make.ProtoReflect.Descriptor instead.
-func (*Make) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use UpdateHargaMobilRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHargaMobilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Make) GetBrandId() string {
+func (x *UpdateHargaMobilRequest) GetMobilId() string {
 	if x != nil {
-		return x.BrandId
+		return x.MobilId
 	}
 	return ""
 }
 
-func (x *Make) GetName() string {
+func (x *UpdateHargaMobilRequest) GetHargaJualMoney() *Money {
 	if x != nil {
-		return x.Name
+		return x.HargaJualMoney
 	}
-	return ""
+	return nil
 }
 
-type Model struct {
+type WithdrawMobilRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ModelId       string                 `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
-	BrandId       string                 `protobuf:"bytes,2,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	Alasan        string                 `protobuf:"bytes,2,opt,name=alasan,proto3" json:"alasan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Model) Reset() {
-	*x = Model{}
-	mi := &file_proto_carapp_proto_msgTypes[13]
+func (x *WithdrawMobilRequest) Reset() {
+	*x = WithdrawMobilRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Model) String() string {
+func (x *WithdrawMobilRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Model) ProtoMessage() {}
+func (*WithdrawMobilRequest) ProtoMessage() {}
 
-func (x *Model) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[13]
+func (x *WithdrawMobilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -984,53 +1514,47 @@ func (x *Model) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Model.ProtoReflect.Descriptor instead.
-func (*Model) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *Model) GetModelId() string {
-	if x != nil {
-		return x.ModelId
-	}
-	return ""
+// Deprecated: Use WithdrawMobilRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawMobilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Model) GetBrandId() string {
+func (x *WithdrawMobilRequest) GetMobilId() string {
 	if x != nil {
-		return x.BrandId
+		return x.MobilId
 	}
 	return ""
 }
 
-func (x *Model) GetName() string {
+func (x *WithdrawMobilRequest) GetAlasan() string {
 	if x != nil {
-		return x.Name
+		return x.Alasan
 	}
 	return ""
 }
 
-type GetMakesRequest struct {
+type RenewMobilRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMakesRequest) Reset() {
-	*x = GetMakesRequest{}
-	mi := &file_proto_carapp_proto_msgTypes[14]
+func (x *RenewMobilRequest) Reset() {
+	*x = RenewMobilRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMakesRequest) String() string {
+func (x *RenewMobilRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMakesRequest) ProtoMessage() {}
+func (*RenewMobilRequest) ProtoMessage() {}
 
-func (x *GetMakesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[14]
+func (x *RenewMobilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1041,33 +1565,41 @@ func (x *GetMakesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMakesRequest.ProtoReflect.Descriptor instead.
-func (*GetMakesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RenewMobilRequest.ProtoReflect.Descriptor instead.
+func (*RenewMobilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{16}
 }
 
-type GetMakesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Makes         []*Make                `protobuf:"bytes,1,rep,name=makes,proto3" json:"makes,omitempty"`
+func (x *RenewMobilRequest) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+type UpdateDraftMobilRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	Data          *CreateMobilRequest    `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"` // simpan_draft diabaikan
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMakesResponse) Reset() {
-	*x = GetMakesResponse{}
-	mi := &file_proto_carapp_proto_msgTypes[15]
+func (x *UpdateDraftMobilRequest) Reset() {
+	*x = UpdateDraftMobilRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMakesResponse) String() string {
+func (x *UpdateDraftMobilRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMakesResponse) ProtoMessage() {}
+func (*UpdateDraftMobilRequest) ProtoMessage() {}
 
-func (x *GetMakesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[15]
+func (x *UpdateDraftMobilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1078,40 +1610,47 @@ func (x *GetMakesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMakesResponse.ProtoReflect.Descriptor instead.
-func (*GetMakesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use UpdateDraftMobilRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDraftMobilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetMakesResponse) GetMakes() []*Make {
+func (x *UpdateDraftMobilRequest) GetMobilId() string {
 	if x != nil {
-		return x.Makes
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *UpdateDraftMobilRequest) GetData() *CreateMobilRequest {
+	if x != nil {
+		return x.Data
 	}
 	return nil
 }
 
-type GetModelsForMakeRequest struct {
+type PublishMobilRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BrandId       string                 `protobuf:"bytes,1,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetModelsForMakeRequest) Reset() {
-	*x = GetModelsForMakeRequest{}
-	mi := &file_proto_carapp_proto_msgTypes[16]
+func (x *PublishMobilRequest) Reset() {
+	*x = PublishMobilRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetModelsForMakeRequest) String() string {
+func (x *PublishMobilRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetModelsForMakeRequest) ProtoMessage() {}
+func (*PublishMobilRequest) ProtoMessage() {}
 
-func (x *GetModelsForMakeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[16]
+func (x *PublishMobilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1122,40 +1661,41 @@ func (x *GetModelsForMakeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetModelsForMakeRequest.ProtoReflect.Descriptor instead.
-func (*GetModelsForMakeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use PublishMobilRequest.ProtoReflect.Descriptor instead.
+func (*PublishMobilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetModelsForMakeRequest) GetBrandId() string {
+func (x *PublishMobilRequest) GetMobilId() string {
 	if x != nil {
-		return x.BrandId
+		return x.MobilId
 	}
 	return ""
 }
 
-type GetModelsForMakeResponse struct {
+type ListModerasiMobilRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Models        []*Model               `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetModelsForMakeResponse) Reset() {
-	*x = GetModelsForMakeResponse{}
-	mi := &file_proto_carapp_proto_msgTypes[17]
+func (x *ListModerasiMobilRequest) Reset() {
+	*x = ListModerasiMobilRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetModelsForMakeResponse) String() string {
+func (x *ListModerasiMobilRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetModelsForMakeResponse) ProtoMessage() {}
+func (*ListModerasiMobilRequest) ProtoMessage() {}
 
-func (x *GetModelsForMakeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[17]
+func (x *ListModerasiMobilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1166,40 +1706,49 @@ func (x *GetModelsForMakeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetModelsForMakeResponse.ProtoReflect.Descriptor instead.
-func (*GetModelsForMakeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListModerasiMobilRequest.ProtoReflect.Descriptor instead.
+func (*ListModerasiMobilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetModelsForMakeResponse) GetModels() []*Model {
+func (x *ListModerasiMobilRequest) GetPage() int32 {
 	if x != nil {
-		return x.Models
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-type BuyMobilRequest struct {
+func (x *ListModerasiMobilRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ModerasiMobilRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"` // pembeli_id diambil dari JWT
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	Setujui       bool                   `protobuf:"varint,2,opt,name=setujui,proto3" json:"setujui,omitempty"`
+	Alasan        string                 `protobuf:"bytes,3,opt,name=alasan,proto3" json:"alasan,omitempty"` // Wajib jika ditolak, dikirim ke owner
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuyMobilRequest) Reset() {
-	*x = BuyMobilRequest{}
-	mi := &file_proto_carapp_proto_msgTypes[18]
+func (x *ModerasiMobilRequest) Reset() {
+	*x = ModerasiMobilRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuyMobilRequest) String() string {
+func (x *ModerasiMobilRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuyMobilRequest) ProtoMessage() {}
+func (*ModerasiMobilRequest) ProtoMessage() {}
 
-func (x *BuyMobilRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[18]
+func (x *ModerasiMobilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1210,45 +1759,56 @@ func (x *BuyMobilRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuyMobilRequest.ProtoReflect.Descriptor instead.
-func (*BuyMobilRequest) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ModerasiMobilRequest.ProtoReflect.Descriptor instead.
+func (*ModerasiMobilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *BuyMobilRequest) GetMobilId() string {
+func (x *ModerasiMobilRequest) GetMobilId() string {
 	if x != nil {
 		return x.MobilId
 	}
 	return ""
 }
 
-type TransaksiJualResponse struct {
+func (x *ModerasiMobilRequest) GetSetujui() bool {
+	if x != nil {
+		return x.Setujui
+	}
+	return false
+}
+
+func (x *ModerasiMobilRequest) GetAlasan() string {
+	if x != nil {
+		return x.Alasan
+	}
+	return ""
+}
+
+type ReportMobilRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	MobilId       string                 `protobuf:"bytes,2,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
-	PenjualId     string                 `protobuf:"bytes,3,opt,name=penjual_id,json=penjualId,proto3" json:"penjual_id,omitempty"`
-	PembeliId     string                 `protobuf:"bytes,4,opt,name=pembeli_id,json=pembeliId,proto3" json:"pembeli_id,omitempty"`
-	Total         float64                `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
-	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	Alasan        string                 `protobuf:"bytes,2,opt,name=alasan,proto3" json:"alasan,omitempty"`         // penipuan/harga_tidak_wajar/foto_palsu/data_salah/duplikat/lainnya
+	Keterangan    string                 `protobuf:"bytes,3,opt,name=keterangan,proto3" json:"keterangan,omitempty"` // Wajib jika alasan 'lainnya'
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransaksiJualResponse) Reset() {
-	*x = TransaksiJualResponse{}
-	mi := &file_proto_carapp_proto_msgTypes[19]
+func (x *ReportMobilRequest) Reset() {
+	*x = ReportMobilRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransaksiJualResponse) String() string {
+func (x *ReportMobilRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransaksiJualResponse) ProtoMessage() {}
+func (*ReportMobilRequest) ProtoMessage() {}
 
-func (x *TransaksiJualResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[19]
+func (x *ReportMobilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1259,77 +1819,7827 @@ func (x *TransaksiJualResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransaksiJualResponse.ProtoReflect.Descriptor instead.
-func (*TransaksiJualResponse) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ReportMobilRequest.ProtoReflect.Descriptor instead.
+func (*ReportMobilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *TransaksiJualResponse) GetId() string {
+func (x *ReportMobilRequest) GetMobilId() string {
 	if x != nil {
-		return x.Id
+		return x.MobilId
 	}
 	return ""
 }
 
-func (x *TransaksiJualResponse) GetMobilId() string {
+func (x *ReportMobilRequest) GetAlasan() string {
 	if x != nil {
-		return x.MobilId
+		return x.Alasan
 	}
 	return ""
 }
 
-func (x *TransaksiJualResponse) GetPenjualId() string {
+func (x *ReportMobilRequest) GetKeterangan() string {
 	if x != nil {
-		return x.PenjualId
+		return x.Keterangan
 	}
 	return ""
 }
 
-func (x *TransaksiJualResponse) GetPembeliId() string {
+type BulkImportMobilRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`                // "csv" atau "json" (array objek dengan nama kolom yang sama)
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // true = hanya validasi & laporan, tidak ada yang disimpan
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkImportMobilRequest) Reset() {
+	*x = BulkImportMobilRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkImportMobilRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkImportMobilRequest) ProtoMessage() {}
+
+func (x *BulkImportMobilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[22]
 	if x != nil {
-		return x.PembeliId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkImportMobilRequest.ProtoReflect.Descriptor instead.
+func (*BulkImportMobilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BulkImportMobilRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BulkImportMobilRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
 	}
 	return ""
 }
 
-func (x *TransaksiJualResponse) GetTotal() float64 {
+func (x *BulkImportMobilRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Total
+		return x.DryRun
+	}
+	return false
+}
+
+type HasilImportBaris struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Baris         int32                  `protobuf:"varint,1,opt,name=baris,proto3" json:"baris,omitempty"` // Nomor baris CSV (header = 1) atau urutan objek JSON (mulai 1)
+	NomorStok     string                 `protobuf:"bytes,2,opt,name=nomor_stok,json=nomorStok,proto3" json:"nomor_stok,omitempty"`
+	Vin           string                 `protobuf:"bytes,3,opt,name=vin,proto3" json:"vin,omitempty"`
+	Aksi          string                 `protobuf:"bytes,4,opt,name=aksi,proto3" json:"aksi,omitempty"`                      // "buat", "perbarui", atau "gagal"
+	MobilId       string                 `protobuf:"bytes,5,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"` // Kosong untuk baris baru saat dry run / gagal
+	Errors        []string               `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // Status listing setelah impor (baru bisa 'menunggu_moderasi')
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HasilImportBaris) Reset() {
+	*x = HasilImportBaris{}
+	mi := &file_proto_carapp_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HasilImportBaris) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasilImportBaris) ProtoMessage() {}
+
+func (x *HasilImportBaris) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasilImportBaris.ProtoReflect.Descriptor instead.
+func (*HasilImportBaris) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *HasilImportBaris) GetBaris() int32 {
+	if x != nil {
+		return x.Baris
 	}
 	return 0
 }
 
-func (x *TransaksiJualResponse) GetStatus() string {
+func (x *HasilImportBaris) GetNomorStok() string {
+	if x != nil {
+		return x.NomorStok
+	}
+	return ""
+}
+
+func (x *HasilImportBaris) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *HasilImportBaris) GetAksi() string {
+	if x != nil {
+		return x.Aksi
+	}
+	return ""
+}
+
+func (x *HasilImportBaris) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *HasilImportBaris) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *HasilImportBaris) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-type RentMobilRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	MobilId        string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
-	TanggalMulai   string                 `protobuf:"bytes,2,opt,name=tanggal_mulai,json=tanggalMulai,proto3" json:"tanggal_mulai,omitempty"`       // Format: "YYYY-MM-DD"
-	TanggalSelesai string                 `protobuf:"bytes,3,opt,name=tanggal_selesai,json=tanggalSelesai,proto3" json:"tanggal_selesai,omitempty"` // Format: "YYYY-MM-DD"
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type BulkImportMobilResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dibuat        int32                  `protobuf:"varint,1,opt,name=dibuat,proto3" json:"dibuat,omitempty"`
+	Diperbarui    int32                  `protobuf:"varint,2,opt,name=diperbarui,proto3" json:"diperbarui,omitempty"`
+	Gagal         int32                  `protobuf:"varint,3,opt,name=gagal,proto3" json:"gagal,omitempty"`
+	Disimpan      bool                   `protobuf:"varint,4,opt,name=disimpan,proto3" json:"disimpan,omitempty"` // false jika dry run atau ada baris gagal (tidak ada yang disimpan)
+	Hasil         []*HasilImportBaris    `protobuf:"bytes,5,rep,name=hasil,proto3" json:"hasil,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RentMobilRequest) Reset() {
-	*x = RentMobilRequest{}
-	mi := &file_proto_carapp_proto_msgTypes[20]
+func (x *BulkImportMobilResponse) Reset() {
+	*x = BulkImportMobilResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkImportMobilResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkImportMobilResponse) ProtoMessage() {}
+
+func (x *BulkImportMobilResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkImportMobilResponse.ProtoReflect.Descriptor instead.
+func (*BulkImportMobilResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BulkImportMobilResponse) GetDibuat() int32 {
+	if x != nil {
+		return x.Dibuat
+	}
+	return 0
+}
+
+func (x *BulkImportMobilResponse) GetDiperbarui() int32 {
+	if x != nil {
+		return x.Diperbarui
+	}
+	return 0
+}
+
+func (x *BulkImportMobilResponse) GetGagal() int32 {
+	if x != nil {
+		return x.Gagal
+	}
+	return 0
+}
+
+func (x *BulkImportMobilResponse) GetDisimpan() bool {
+	if x != nil {
+		return x.Disimpan
+	}
+	return false
+}
+
+func (x *BulkImportMobilResponse) GetHasil() []*HasilImportBaris {
+	if x != nil {
+		return x.Hasil
+	}
+	return nil
+}
+
+type ExportInventoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // "csv" (default) atau "jsonl"
+	Status        []string               `protobuf:"bytes,2,rep,name=status,proto3" json:"status,omitempty"` // Kosong = semua status
+	Filter        *FilterMobil           `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // Khusus admin: hanya mobil owner ini (kosong = semua owner)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportInventoryRequest) Reset() {
+	*x = ExportInventoryRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportInventoryRequest) ProtoMessage() {}
+
+func (x *ExportInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportInventoryRequest.ProtoReflect.Descriptor instead.
+func (*ExportInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExportInventoryRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportInventoryRequest) GetStatus() []string {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ExportInventoryRequest) GetFilter() *FilterMobil {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportInventoryRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type ExportInventoryChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // Potongan file; gabungkan semua chunk sesuai urutan
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportInventoryChunk) Reset() {
+	*x = ExportInventoryChunk{}
+	mi := &file_proto_carapp_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportInventoryChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportInventoryChunk) ProtoMessage() {}
+
+func (x *ExportInventoryChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportInventoryChunk.ProtoReflect.Descriptor instead.
+func (*ExportInventoryChunk) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExportInventoryChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetSimilarMobilRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MobilId         string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	Limit           int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                                 // Default 6, maksimal 20
+	DisplayCurrency *string                `protobuf:"bytes,3,opt,name=display_currency,json=displayCurrency,proto3,oneof" json:"display_currency,omitempty"` // Sama seperti ListMobil
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetSimilarMobilRequest) Reset() {
+	*x = GetSimilarMobilRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimilarMobilRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilarMobilRequest) ProtoMessage() {}
+
+func (x *GetSimilarMobilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilarMobilRequest.ProtoReflect.Descriptor instead.
+func (*GetSimilarMobilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetSimilarMobilRequest) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *GetSimilarMobilRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetSimilarMobilRequest) GetDisplayCurrency() string {
+	if x != nil && x.DisplayCurrency != nil {
+		return *x.DisplayCurrency
+	}
+	return ""
+}
+
+type MobilMirip struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mobil         *Mobil                 `protobuf:"bytes,1,opt,name=mobil,proto3" json:"mobil,omitempty"`
+	Skor          int32                  `protobuf:"varint,2,opt,name=skor,proto3" json:"skor,omitempty"`    // Skor kemiripan 0-100
+	Alasan        []string               `protobuf:"bytes,3,rep,name=alasan,proto3" json:"alasan,omitempty"` // Contoh: "merk & model sama", "tahun +-1"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MobilMirip) Reset() {
+	*x = MobilMirip{}
+	mi := &file_proto_carapp_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MobilMirip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MobilMirip) ProtoMessage() {}
+
+func (x *MobilMirip) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MobilMirip.ProtoReflect.Descriptor instead.
+func (*MobilMirip) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MobilMirip) GetMobil() *Mobil {
+	if x != nil {
+		return x.Mobil
+	}
+	return nil
+}
+
+func (x *MobilMirip) GetSkor() int32 {
+	if x != nil {
+		return x.Skor
+	}
+	return 0
+}
+
+func (x *MobilMirip) GetAlasan() []string {
+	if x != nil {
+		return x.Alasan
+	}
+	return nil
+}
+
+type GetSimilarMobilResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mobils        []*MobilMirip          `protobuf:"bytes,1,rep,name=mobils,proto3" json:"mobils,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSimilarMobilResponse) Reset() {
+	*x = GetSimilarMobilResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimilarMobilResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilarMobilResponse) ProtoMessage() {}
+
+func (x *GetSimilarMobilResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilarMobilResponse.ProtoReflect.Descriptor instead.
+func (*GetSimilarMobilResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetSimilarMobilResponse) GetMobils() []*MobilMirip {
+	if x != nil {
+		return x.Mobils
+	}
+	return nil
+}
+
+type CompareMobilRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MobilIds        []string               `protobuf:"bytes,1,rep,name=mobil_ids,json=mobilIds,proto3" json:"mobil_ids,omitempty"`                            // 2-4 mobil, urutan kolom mengikuti urutan ini
+	DisplayCurrency *string                `protobuf:"bytes,2,opt,name=display_currency,json=displayCurrency,proto3,oneof" json:"display_currency,omitempty"` // Sama seperti ListMobil
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CompareMobilRequest) Reset() {
+	*x = CompareMobilRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareMobilRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareMobilRequest) ProtoMessage() {}
+
+func (x *CompareMobilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareMobilRequest.ProtoReflect.Descriptor instead.
+func (*CompareMobilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CompareMobilRequest) GetMobilIds() []string {
+	if x != nil {
+		return x.MobilIds
+	}
+	return nil
+}
+
+func (x *CompareMobilRequest) GetDisplayCurrency() string {
+	if x != nil && x.DisplayCurrency != nil {
+		return *x.DisplayCurrency
+	}
+	return ""
+}
+
+// Satu baris tabel perbandingan: satu atribut untuk semua mobil
+type BarisPerbandingan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Atribut       string                 `protobuf:"bytes,1,opt,name=atribut,proto3" json:"atribut,omitempty"`                                   // Kunci stabil untuk client (harga, tahun, kilometer, ...)
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`                                       // Label tampilan
+	Nilai         []string               `protobuf:"bytes,3,rep,name=nilai,proto3" json:"nilai,omitempty"`                                       // Satu nilai per mobil (urutan = mobil_ids), "" = tidak tersedia
+	Berbeda       bool                   `protobuf:"varint,4,opt,name=berbeda,proto3" json:"berbeda,omitempty"`                                  // TRUE jika nilai yang tersedia tidak semuanya sama
+	IndeksTerbaik int32                  `protobuf:"varint,5,opt,name=indeks_terbaik,json=indeksTerbaik,proto3" json:"indeks_terbaik,omitempty"` // Index mobil dengan nilai terbaik (-1 = tidak dinilai)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BarisPerbandingan) Reset() {
+	*x = BarisPerbandingan{}
+	mi := &file_proto_carapp_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BarisPerbandingan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BarisPerbandingan) ProtoMessage() {}
+
+func (x *BarisPerbandingan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BarisPerbandingan.ProtoReflect.Descriptor instead.
+func (*BarisPerbandingan) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BarisPerbandingan) GetAtribut() string {
+	if x != nil {
+		return x.Atribut
+	}
+	return ""
+}
+
+func (x *BarisPerbandingan) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *BarisPerbandingan) GetNilai() []string {
+	if x != nil {
+		return x.Nilai
+	}
+	return nil
+}
+
+func (x *BarisPerbandingan) GetBerbeda() bool {
+	if x != nil {
+		return x.Berbeda
+	}
+	return false
+}
+
+func (x *BarisPerbandingan) GetIndeksTerbaik() int32 {
+	if x != nil {
+		return x.IndeksTerbaik
+	}
+	return 0
+}
+
+type CompareMobilResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mobils        []*Mobil               `protobuf:"bytes,1,rep,name=mobils,proto3" json:"mobils,omitempty"`
+	Baris         []*BarisPerbandingan   `protobuf:"bytes,2,rep,name=baris,proto3" json:"baris,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareMobilResponse) Reset() {
+	*x = CompareMobilResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareMobilResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareMobilResponse) ProtoMessage() {}
+
+func (x *CompareMobilResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareMobilResponse.ProtoReflect.Descriptor instead.
+func (*CompareMobilResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CompareMobilResponse) GetMobils() []*Mobil {
+	if x != nil {
+		return x.Mobils
+	}
+	return nil
+}
+
+func (x *CompareMobilResponse) GetBaris() []*BarisPerbandingan {
+	if x != nil {
+		return x.Baris
+	}
+	return nil
+}
+
+// Pesan untuk NHTSA Cache
+type Make struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BrandId       string                 `protobuf:"bytes,1,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Make) Reset() {
+	*x = Make{}
+	mi := &file_proto_carapp_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Make) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Make) ProtoMessage() {}
+
+func (x *Make) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Make.ProtoReflect.Descriptor instead.
+func (*Make) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Make) GetBrandId() string {
+	if x != nil {
+		return x.BrandId
+	}
+	return ""
+}
+
+func (x *Make) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Model struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelId       string                 `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	BrandId       string                 `protobuf:"bytes,2,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Model) Reset() {
+	*x = Model{}
+	mi := &file_proto_carapp_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Model) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Model) ProtoMessage() {}
+
+func (x *Model) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Model.ProtoReflect.Descriptor instead.
+func (*Model) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Model) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *Model) GetBrandId() string {
+	if x != nil {
+		return x.BrandId
+	}
+	return ""
+}
+
+func (x *Model) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetMakesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMakesRequest) Reset() {
+	*x = GetMakesRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMakesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMakesRequest) ProtoMessage() {}
+
+func (x *GetMakesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMakesRequest.ProtoReflect.Descriptor instead.
+func (*GetMakesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{35}
+}
+
+type GetMakesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Makes         []*Make                `protobuf:"bytes,1,rep,name=makes,proto3" json:"makes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMakesResponse) Reset() {
+	*x = GetMakesResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMakesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMakesResponse) ProtoMessage() {}
+
+func (x *GetMakesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMakesResponse.ProtoReflect.Descriptor instead.
+func (*GetMakesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetMakesResponse) GetMakes() []*Make {
+	if x != nil {
+		return x.Makes
+	}
+	return nil
+}
+
+type GetModelsForMakeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BrandId       string                 `protobuf:"bytes,1,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModelsForMakeRequest) Reset() {
+	*x = GetModelsForMakeRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModelsForMakeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModelsForMakeRequest) ProtoMessage() {}
+
+func (x *GetModelsForMakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModelsForMakeRequest.ProtoReflect.Descriptor instead.
+func (*GetModelsForMakeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetModelsForMakeRequest) GetBrandId() string {
+	if x != nil {
+		return x.BrandId
+	}
+	return ""
+}
+
+type GetModelsForMakeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Models        []*Model               `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModelsForMakeResponse) Reset() {
+	*x = GetModelsForMakeResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModelsForMakeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModelsForMakeResponse) ProtoMessage() {}
+
+func (x *GetModelsForMakeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModelsForMakeResponse.ProtoReflect.Descriptor instead.
+func (*GetModelsForMakeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetModelsForMakeResponse) GetModels() []*Model {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+type BuyMobilRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	TradeInId     string                 `protobuf:"bytes,2,opt,name=trade_in_id,json=tradeInId,proto3" json:"trade_in_id,omitempty"` // Opsional: trade-in yang sudah diterima, dipakai sebagai potongan
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyMobilRequest) Reset() {
+	*x = BuyMobilRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyMobilRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyMobilRequest) ProtoMessage() {}
+
+func (x *BuyMobilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyMobilRequest.ProtoReflect.Descriptor instead.
+func (*BuyMobilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BuyMobilRequest) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *BuyMobilRequest) GetTradeInId() string {
+	if x != nil {
+		return x.TradeInId
+	}
+	return ""
+}
+
+type TransaksiJualResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MobilId   string                 `protobuf:"bytes,2,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	PenjualId string                 `protobuf:"bytes,3,opt,name=penjual_id,json=penjualId,proto3" json:"penjual_id,omitempty"`
+	PembeliId string                 `protobuf:"bytes,4,opt,name=pembeli_id,json=pembeliId,proto3" json:"pembeli_id,omitempty"`
+	// Deprecated: Marked as deprecated in proto/carapp.proto.
+	Total         float64                `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`                                    // Pakai total_money
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                                    // menunggu_pembayaran/dibayar/diproses/selesai/dibatalkan/kedaluwarsa
+	ReservedUntil *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=reserved_until,json=reservedUntil,proto3" json:"reserved_until,omitempty"` // Batas waktu pembayaran reservasi
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AlasanBatal   string                 `protobuf:"bytes,9,opt,name=alasan_batal,json=alasanBatal,proto3" json:"alasan_batal,omitempty"`
+	// Info pembayaran (diisi oleh PayTransaksi)
+	PaymentChargeId string `protobuf:"bytes,10,opt,name=payment_charge_id,json=paymentChargeId,proto3" json:"payment_charge_id,omitempty"`
+	PaymentUrl      string `protobuf:"bytes,11,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
+	PaymentStatus   string `protobuf:"bytes,12,opt,name=payment_status,json=paymentStatus,proto3" json:"payment_status,omitempty"` // pending/paid/failed/refunded
+	TotalMoney      *Money `protobuf:"bytes,13,opt,name=total_money,json=totalMoney,proto3" json:"total_money,omitempty"`
+	TradeInId       string `protobuf:"bytes,14,opt,name=trade_in_id,json=tradeInId,proto3" json:"trade_in_id,omitempty"`
+	PotonganTradeIn *Money `protobuf:"bytes,15,opt,name=potongan_trade_in,json=potonganTradeIn,proto3" json:"potongan_trade_in,omitempty"` // Total sudah dikurangi potongan ini
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransaksiJualResponse) Reset() {
+	*x = TransaksiJualResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransaksiJualResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransaksiJualResponse) ProtoMessage() {}
+
+func (x *TransaksiJualResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransaksiJualResponse.ProtoReflect.Descriptor instead.
+func (*TransaksiJualResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TransaksiJualResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransaksiJualResponse) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *TransaksiJualResponse) GetPenjualId() string {
+	if x != nil {
+		return x.PenjualId
+	}
+	return ""
+}
+
+func (x *TransaksiJualResponse) GetPembeliId() string {
+	if x != nil {
+		return x.PembeliId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/carapp.proto.
+func (x *TransaksiJualResponse) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TransaksiJualResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TransaksiJualResponse) GetReservedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReservedUntil
+	}
+	return nil
+}
+
+func (x *TransaksiJualResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TransaksiJualResponse) GetAlasanBatal() string {
+	if x != nil {
+		return x.AlasanBatal
+	}
+	return ""
+}
+
+func (x *TransaksiJualResponse) GetPaymentChargeId() string {
+	if x != nil {
+		return x.PaymentChargeId
+	}
+	return ""
+}
+
+func (x *TransaksiJualResponse) GetPaymentUrl() string {
+	if x != nil {
+		return x.PaymentUrl
+	}
+	return ""
+}
+
+func (x *TransaksiJualResponse) GetPaymentStatus() string {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return ""
+}
+
+func (x *TransaksiJualResponse) GetTotalMoney() *Money {
+	if x != nil {
+		return x.TotalMoney
+	}
+	return nil
+}
+
+func (x *TransaksiJualResponse) GetTradeInId() string {
+	if x != nil {
+		return x.TradeInId
+	}
+	return ""
+}
+
+func (x *TransaksiJualResponse) GetPotonganTradeIn() *Money {
+	if x != nil {
+		return x.PotonganTradeIn
+	}
+	return nil
+}
+
+type PayTransaksiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransaksiId   string                 `protobuf:"bytes,1,opt,name=transaksi_id,json=transaksiId,proto3" json:"transaksi_id,omitempty"` // pembeli_id diambil dari JWT
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayTransaksiRequest) Reset() {
+	*x = PayTransaksiRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayTransaksiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayTransaksiRequest) ProtoMessage() {}
+
+func (x *PayTransaksiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayTransaksiRequest.ProtoReflect.Descriptor instead.
+func (*PayTransaksiRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PayTransaksiRequest) GetTransaksiId() string {
+	if x != nil {
+		return x.TransaksiId
+	}
+	return ""
+}
+
+type ConfirmTransaksiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransaksiId   string                 `protobuf:"bytes,1,opt,name=transaksi_id,json=transaksiId,proto3" json:"transaksi_id,omitempty"` // penjual_id diambil dari JWT
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTransaksiRequest) Reset() {
+	*x = ConfirmTransaksiRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTransaksiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTransaksiRequest) ProtoMessage() {}
+
+func (x *ConfirmTransaksiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTransaksiRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTransaksiRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ConfirmTransaksiRequest) GetTransaksiId() string {
+	if x != nil {
+		return x.TransaksiId
+	}
+	return ""
+}
+
+type CompleteTransaksiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransaksiId   string                 `protobuf:"bytes,1,opt,name=transaksi_id,json=transaksiId,proto3" json:"transaksi_id,omitempty"` // pembeli_id diambil dari JWT
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTransaksiRequest) Reset() {
+	*x = CompleteTransaksiRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTransaksiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTransaksiRequest) ProtoMessage() {}
+
+func (x *CompleteTransaksiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTransaksiRequest.ProtoReflect.Descriptor instead.
+func (*CompleteTransaksiRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CompleteTransaksiRequest) GetTransaksiId() string {
+	if x != nil {
+		return x.TransaksiId
+	}
+	return ""
+}
+
+type CancelTransaksiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransaksiId   string                 `protobuf:"bytes,1,opt,name=transaksi_id,json=transaksiId,proto3" json:"transaksi_id,omitempty"`
+	Alasan        string                 `protobuf:"bytes,2,opt,name=alasan,proto3" json:"alasan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTransaksiRequest) Reset() {
+	*x = CancelTransaksiRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTransaksiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTransaksiRequest) ProtoMessage() {}
+
+func (x *CancelTransaksiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTransaksiRequest.ProtoReflect.Descriptor instead.
+func (*CancelTransaksiRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CancelTransaksiRequest) GetTransaksiId() string {
+	if x != nil {
+		return x.TransaksiId
+	}
+	return ""
+}
+
+func (x *CancelTransaksiRequest) GetAlasan() string {
+	if x != nil {
+		return x.Alasan
+	}
+	return ""
+}
+
+type ListMyTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Peran         string                 `protobuf:"bytes,1,opt,name=peran,proto3" json:"peran,omitempty"` // pembeli/penjual (default: keduanya)
+	FilterStatus  *string                `protobuf:"bytes,2,opt,name=filter_status,json=filterStatus,proto3,oneof" json:"filter_status,omitempty"`
+	Dari          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=dari,proto3" json:"dari,omitempty"`     // Opsional, berdasarkan created_at
+	Sampai        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=sampai,proto3" json:"sampai,omitempty"` // Opsional, berdasarkan created_at
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTransactionsRequest) Reset() {
+	*x = ListMyTransactionsRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTransactionsRequest) ProtoMessage() {}
+
+func (x *ListMyTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListMyTransactionsRequest) GetPeran() string {
+	if x != nil {
+		return x.Peran
+	}
+	return ""
+}
+
+func (x *ListMyTransactionsRequest) GetFilterStatus() string {
+	if x != nil && x.FilterStatus != nil {
+		return *x.FilterStatus
+	}
+	return ""
+}
+
+func (x *ListMyTransactionsRequest) GetDari() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Dari
+	}
+	return nil
+}
+
+func (x *ListMyTransactionsRequest) GetSampai() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Sampai
+	}
+	return nil
+}
+
+func (x *ListMyTransactionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMyTransactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListMyTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaksi     []*TransaksiDetail     `protobuf:"bytes,1,rep,name=transaksi,proto3" json:"transaksi,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTransactionsResponse) Reset() {
+	*x = ListMyTransactionsResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTransactionsResponse) ProtoMessage() {}
+
+func (x *ListMyTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListMyTransactionsResponse) GetTransaksi() []*TransaksiDetail {
+	if x != nil {
+		return x.Transaksi
+	}
+	return nil
+}
+
+func (x *ListMyTransactionsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransaksiId   string                 `protobuf:"bytes,1,opt,name=transaksi_id,json=transaksiId,proto3" json:"transaksi_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionRequest) ProtoMessage() {}
+
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetTransactionRequest) GetTransaksiId() string {
+	if x != nil {
+		return x.TransaksiId
+	}
+	return ""
+}
+
+// Ringkasan pihak lain dalam transaksi
+type PihakTransaksi struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PihakTransaksi) Reset() {
+	*x = PihakTransaksi{}
+	mi := &file_proto_carapp_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PihakTransaksi) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PihakTransaksi) ProtoMessage() {}
+
+func (x *PihakTransaksi) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PihakTransaksi.ProtoReflect.Descriptor instead.
+func (*PihakTransaksi) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PihakTransaksi) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PihakTransaksi) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PihakTransaksi) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *PihakTransaksi) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type TransaksiDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaksi     *TransaksiJualResponse `protobuf:"bytes,1,opt,name=transaksi,proto3" json:"transaksi,omitempty"`
+	Mobil         *Mobil                 `protobuf:"bytes,2,opt,name=mobil,proto3" json:"mobil,omitempty"`
+	Penjual       *PihakTransaksi        `protobuf:"bytes,3,opt,name=penjual,proto3" json:"penjual,omitempty"`
+	Pembeli       *PihakTransaksi        `protobuf:"bytes,4,opt,name=pembeli,proto3" json:"pembeli,omitempty"`
+	Peran         string                 `protobuf:"bytes,5,opt,name=peran,proto3" json:"peran,omitempty"` // Peran user yang meminta: pembeli/penjual/admin
+	PaidAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	ConfirmedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	CancelledAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransaksiDetail) Reset() {
+	*x = TransaksiDetail{}
+	mi := &file_proto_carapp_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransaksiDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransaksiDetail) ProtoMessage() {}
+
+func (x *TransaksiDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransaksiDetail.ProtoReflect.Descriptor instead.
+func (*TransaksiDetail) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TransaksiDetail) GetTransaksi() *TransaksiJualResponse {
+	if x != nil {
+		return x.Transaksi
+	}
+	return nil
+}
+
+func (x *TransaksiDetail) GetMobil() *Mobil {
+	if x != nil {
+		return x.Mobil
+	}
+	return nil
+}
+
+func (x *TransaksiDetail) GetPenjual() *PihakTransaksi {
+	if x != nil {
+		return x.Penjual
+	}
+	return nil
+}
+
+func (x *TransaksiDetail) GetPembeli() *PihakTransaksi {
+	if x != nil {
+		return x.Pembeli
+	}
+	return nil
+}
+
+func (x *TransaksiDetail) GetPeran() string {
+	if x != nil {
+		return x.Peran
+	}
+	return ""
+}
+
+func (x *TransaksiDetail) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+func (x *TransaksiDetail) GetConfirmedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConfirmedAt
+	}
+	return nil
+}
+
+func (x *TransaksiDetail) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *TransaksiDetail) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+type GetInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransaksiId   string                 `protobuf:"bytes,1,opt,name=transaksi_id,json=transaksiId,proto3" json:"transaksi_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceRequest) Reset() {
+	*x = GetInvoiceRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceRequest) ProtoMessage() {}
+
+func (x *GetInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetInvoiceRequest) GetTransaksiId() string {
+	if x != nil {
+		return x.TransaksiId
+	}
+	return ""
+}
+
+type InvoiceResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Nomor       string                 `protobuf:"bytes,1,opt,name=nomor,proto3" json:"nomor,omitempty"` // INV/2025/000001
+	TransaksiId string                 `protobuf:"bytes,2,opt,name=transaksi_id,json=transaksiId,proto3" json:"transaksi_id,omitempty"`
+	// Deprecated: Marked as deprecated in proto/carapp.proto.
+	Total         float64                `protobuf:"fixed64,3,opt,name=total,proto3" json:"total,omitempty"` // Pakai total_money
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Filename      string                 `protobuf:"bytes,5,opt,name=filename,proto3" json:"filename,omitempty"`                          // Nama file yang disarankan untuk diunduh
+	ContentType   string                 `protobuf:"bytes,6,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // application/pdf
+	PdfData       []byte                 `protobuf:"bytes,7,opt,name=pdf_data,json=pdfData,proto3" json:"pdf_data,omitempty"`
+	TotalMoney    *Money                 `protobuf:"bytes,8,opt,name=total_money,json=totalMoney,proto3" json:"total_money,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvoiceResponse) Reset() {
+	*x = InvoiceResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceResponse) ProtoMessage() {}
+
+func (x *InvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceResponse.ProtoReflect.Descriptor instead.
+func (*InvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *InvoiceResponse) GetNomor() string {
+	if x != nil {
+		return x.Nomor
+	}
+	return ""
+}
+
+func (x *InvoiceResponse) GetTransaksiId() string {
+	if x != nil {
+		return x.TransaksiId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/carapp.proto.
+func (x *InvoiceResponse) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *InvoiceResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *InvoiceResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *InvoiceResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *InvoiceResponse) GetPdfData() []byte {
+	if x != nil {
+		return x.PdfData
+	}
+	return nil
+}
+
+func (x *InvoiceResponse) GetTotalMoney() *Money {
+	if x != nil {
+		return x.TotalMoney
+	}
+	return nil
+}
+
+type RentMobilRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MobilId        string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	TanggalMulai   string                 `protobuf:"bytes,2,opt,name=tanggal_mulai,json=tanggalMulai,proto3" json:"tanggal_mulai,omitempty"`       // Format: "YYYY-MM-DD"
+	TanggalSelesai string                 `protobuf:"bytes,3,opt,name=tanggal_selesai,json=tanggalSelesai,proto3" json:"tanggal_selesai,omitempty"` // Format: "YYYY-MM-DD"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RentMobilRequest) Reset() {
+	*x = RentMobilRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RentMobilRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RentMobilRequest) ProtoMessage() {}
+
+func (x *RentMobilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RentMobilRequest.ProtoReflect.Descriptor instead.
+func (*RentMobilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RentMobilRequest) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *RentMobilRequest) GetTanggalMulai() string {
+	if x != nil {
+		return x.TanggalMulai
+	}
+	return ""
+}
+
+func (x *RentMobilRequest) GetTanggalSelesai() string {
+	if x != nil {
+		return x.TanggalSelesai
+	}
+	return ""
+}
+
+type CompleteRentalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RentalId      string                 `protobuf:"bytes,1,opt,name=rental_id,json=rentalId,proto3" json:"rental_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteRentalRequest) Reset() {
+	*x = CompleteRentalRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteRentalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteRentalRequest) ProtoMessage() {}
+
+func (x *CompleteRentalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteRentalRequest.ProtoReflect.Descriptor instead.
+func (*CompleteRentalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CompleteRentalRequest) GetRentalId() string {
+	if x != nil {
+		return x.RentalId
+	}
+	return ""
+}
+
+type TransaksiRentalResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MobilId        string                 `protobuf:"bytes,2,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	PemilikId      string                 `protobuf:"bytes,3,opt,name=pemilik_id,json=pemilikId,proto3" json:"pemilik_id,omitempty"`
+	PenyewaId      string                 `protobuf:"bytes,4,opt,name=penyewa_id,json=penyewaId,proto3" json:"penyewa_id,omitempty"`
+	TanggalMulai   string                 `protobuf:"bytes,5,opt,name=tanggal_mulai,json=tanggalMulai,proto3" json:"tanggal_mulai,omitempty"`
+	TanggalSelesai string                 `protobuf:"bytes,6,opt,name=tanggal_selesai,json=tanggalSelesai,proto3" json:"tanggal_selesai,omitempty"`
+	Total          float64                `protobuf:"fixed64,7,opt,name=total,proto3" json:"total,omitempty"`
+	Status         string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Denda          float64                `protobuf:"fixed64,9,opt,name=denda,proto3" json:"denda,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TransaksiRentalResponse) Reset() {
+	*x = TransaksiRentalResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransaksiRentalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransaksiRentalResponse) ProtoMessage() {}
+
+func (x *TransaksiRentalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransaksiRentalResponse.ProtoReflect.Descriptor instead.
+func (*TransaksiRentalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TransaksiRentalResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransaksiRentalResponse) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *TransaksiRentalResponse) GetPemilikId() string {
+	if x != nil {
+		return x.PemilikId
+	}
+	return ""
+}
+
+func (x *TransaksiRentalResponse) GetPenyewaId() string {
+	if x != nil {
+		return x.PenyewaId
+	}
+	return ""
+}
+
+func (x *TransaksiRentalResponse) GetTanggalMulai() string {
+	if x != nil {
+		return x.TanggalMulai
+	}
+	return ""
+}
+
+func (x *TransaksiRentalResponse) GetTanggalSelesai() string {
+	if x != nil {
+		return x.TanggalSelesai
+	}
+	return ""
+}
+
+func (x *TransaksiRentalResponse) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TransaksiRentalResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TransaksiRentalResponse) GetDenda() float64 {
+	if x != nil {
+		return x.Denda
+	}
+	return 0
+}
+
+type GetNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationsRequest) Reset() {
+	*x = GetNotificationsRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationsRequest) ProtoMessage() {}
+
+func (x *GetNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{55}
+}
+
+type DashboardSummary struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TotalMobilAnda int32                  `protobuf:"varint,1,opt,name=total_mobil_anda,json=totalMobilAnda,proto3" json:"total_mobil_anda,omitempty"`
+	TransaksiAktif int32                  `protobuf:"varint,2,opt,name=transaksi_aktif,json=transaksiAktif,proto3" json:"transaksi_aktif,omitempty"`
+	// Deprecated: Marked as deprecated in proto/carapp.proto.
+	PendapatanTerakhir      float64 `protobuf:"fixed64,3,opt,name=pendapatan_terakhir,json=pendapatanTerakhir,proto3" json:"pendapatan_terakhir,omitempty"` // Pakai pendapatan_terakhir_money
+	NotifikasiBaru          int32   `protobuf:"varint,4,opt,name=notifikasi_baru,json=notifikasiBaru,proto3" json:"notifikasi_baru,omitempty"`
+	PendapatanTerakhirMoney *Money  `protobuf:"bytes,5,opt,name=pendapatan_terakhir_money,json=pendapatanTerakhirMoney,proto3" json:"pendapatan_terakhir_money,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *DashboardSummary) Reset() {
+	*x = DashboardSummary{}
+	mi := &file_proto_carapp_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DashboardSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DashboardSummary) ProtoMessage() {}
+
+func (x *DashboardSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DashboardSummary.ProtoReflect.Descriptor instead.
+func (*DashboardSummary) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DashboardSummary) GetTotalMobilAnda() int32 {
+	if x != nil {
+		return x.TotalMobilAnda
+	}
+	return 0
+}
+
+func (x *DashboardSummary) GetTransaksiAktif() int32 {
+	if x != nil {
+		return x.TransaksiAktif
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in proto/carapp.proto.
+func (x *DashboardSummary) GetPendapatanTerakhir() float64 {
+	if x != nil {
+		return x.PendapatanTerakhir
+	}
+	return 0
+}
+
+func (x *DashboardSummary) GetNotifikasiBaru() int32 {
+	if x != nil {
+		return x.NotifikasiBaru
+	}
+	return 0
+}
+
+func (x *DashboardSummary) GetPendapatanTerakhirMoney() *Money {
+	if x != nil {
+		return x.PendapatanTerakhirMoney
+	}
+	return nil
+}
+
+type Penawaran struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MobilId   string                 `protobuf:"bytes,2,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	PembeliId string                 `protobuf:"bytes,3,opt,name=pembeli_id,json=pembeliId,proto3" json:"pembeli_id,omitempty"`
+	PenjualId string                 `protobuf:"bytes,4,opt,name=penjual_id,json=penjualId,proto3" json:"penjual_id,omitempty"`
+	// Deprecated: Marked as deprecated in proto/carapp.proto.
+	Harga float64 `protobuf:"fixed64,5,opt,name=harga,proto3" json:"harga,omitempty"` // Pakai harga_money
+	// Deprecated: Marked as deprecated in proto/carapp.proto.
+	HargaJual      float64                `protobuf:"fixed64,6,opt,name=harga_jual,json=hargaJual,proto3" json:"harga_jual,omitempty"`      // Pakai harga_jual_money
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                               // menunggu/diterima/ditolak/dibatalkan/kedaluwarsa
+	Giliran        string                 `protobuf:"bytes,8,opt,name=giliran,proto3" json:"giliran,omitempty"`                             // pembeli/penjual (pihak yang harus merespon)
+	Pesan          string                 `protobuf:"bytes,9,opt,name=pesan,proto3" json:"pesan,omitempty"`                                 // Pesan terakhir
+	TransaksiId    string                 `protobuf:"bytes,10,opt,name=transaksi_id,json=transaksiId,proto3" json:"transaksi_id,omitempty"` // Diisi setelah penawaran diterima
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Merk           string                 `protobuf:"bytes,14,opt,name=merk,proto3" json:"merk,omitempty"`
+	Model          string                 `protobuf:"bytes,15,opt,name=model,proto3" json:"model,omitempty"`
+	HargaMoney     *Money                 `protobuf:"bytes,16,opt,name=harga_money,json=hargaMoney,proto3" json:"harga_money,omitempty"`               // Harga tawaran terakhir (IDR)
+	HargaJualMoney *Money                 `protobuf:"bytes,17,opt,name=harga_jual_money,json=hargaJualMoney,proto3" json:"harga_jual_money,omitempty"` // Harga listing saat ini (IDR)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Penawaran) Reset() {
+	*x = Penawaran{}
+	mi := &file_proto_carapp_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Penawaran) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Penawaran) ProtoMessage() {}
+
+func (x *Penawaran) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Penawaran.ProtoReflect.Descriptor instead.
+func (*Penawaran) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Penawaran) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Penawaran) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *Penawaran) GetPembeliId() string {
+	if x != nil {
+		return x.PembeliId
+	}
+	return ""
+}
+
+func (x *Penawaran) GetPenjualId() string {
+	if x != nil {
+		return x.PenjualId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/carapp.proto.
+func (x *Penawaran) GetHarga() float64 {
+	if x != nil {
+		return x.Harga
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in proto/carapp.proto.
+func (x *Penawaran) GetHargaJual() float64 {
+	if x != nil {
+		return x.HargaJual
+	}
+	return 0
+}
+
+func (x *Penawaran) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Penawaran) GetGiliran() string {
+	if x != nil {
+		return x.Giliran
+	}
+	return ""
+}
+
+func (x *Penawaran) GetPesan() string {
+	if x != nil {
+		return x.Pesan
+	}
+	return ""
+}
+
+func (x *Penawaran) GetTransaksiId() string {
+	if x != nil {
+		return x.TransaksiId
+	}
+	return ""
+}
+
+func (x *Penawaran) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Penawaran) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Penawaran) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Penawaran) GetMerk() string {
+	if x != nil {
+		return x.Merk
+	}
+	return ""
+}
+
+func (x *Penawaran) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Penawaran) GetHargaMoney() *Money {
+	if x != nil {
+		return x.HargaMoney
+	}
+	return nil
+}
+
+func (x *Penawaran) GetHargaJualMoney() *Money {
+	if x != nil {
+		return x.HargaJualMoney
+	}
+	return nil
+}
+
+type CreatePenawaranRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	MobilId string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	// Deprecated: Marked as deprecated in proto/carapp.proto.
+	Harga         float64 `protobuf:"fixed64,2,opt,name=harga,proto3" json:"harga,omitempty"` // Pakai harga_money (diabaikan jika harga_money diisi)
+	Pesan         string  `protobuf:"bytes,3,opt,name=pesan,proto3" json:"pesan,omitempty"`
+	HargaMoney    *Money  `protobuf:"bytes,4,opt,name=harga_money,json=hargaMoney,proto3" json:"harga_money,omitempty"` // Harga tawaran dalam IDR
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePenawaranRequest) Reset() {
+	*x = CreatePenawaranRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePenawaranRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePenawaranRequest) ProtoMessage() {}
+
+func (x *CreatePenawaranRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePenawaranRequest.ProtoReflect.Descriptor instead.
+func (*CreatePenawaranRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreatePenawaranRequest) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/carapp.proto.
+func (x *CreatePenawaranRequest) GetHarga() float64 {
+	if x != nil {
+		return x.Harga
+	}
+	return 0
+}
+
+func (x *CreatePenawaranRequest) GetPesan() string {
+	if x != nil {
+		return x.Pesan
+	}
+	return ""
+}
+
+func (x *CreatePenawaranRequest) GetHargaMoney() *Money {
+	if x != nil {
+		return x.HargaMoney
+	}
+	return nil
+}
+
+type RespondPenawaranRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PenawaranId string                 `protobuf:"bytes,1,opt,name=penawaran_id,json=penawaranId,proto3" json:"penawaran_id,omitempty"`
+	Aksi        string                 `protobuf:"bytes,2,opt,name=aksi,proto3" json:"aksi,omitempty"` // terima/tolak/tawar_balik
+	// Deprecated: Marked as deprecated in proto/carapp.proto.
+	Harga         float64 `protobuf:"fixed64,3,opt,name=harga,proto3" json:"harga,omitempty"` // Pakai harga_money (diabaikan jika harga_money diisi)
+	Pesan         string  `protobuf:"bytes,4,opt,name=pesan,proto3" json:"pesan,omitempty"`
+	HargaMoney    *Money  `protobuf:"bytes,5,opt,name=harga_money,json=hargaMoney,proto3" json:"harga_money,omitempty"` // Wajib untuk tawar_balik (IDR)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondPenawaranRequest) Reset() {
+	*x = RespondPenawaranRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondPenawaranRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondPenawaranRequest) ProtoMessage() {}
+
+func (x *RespondPenawaranRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondPenawaranRequest.ProtoReflect.Descriptor instead.
+func (*RespondPenawaranRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RespondPenawaranRequest) GetPenawaranId() string {
+	if x != nil {
+		return x.PenawaranId
+	}
+	return ""
+}
+
+func (x *RespondPenawaranRequest) GetAksi() string {
+	if x != nil {
+		return x.Aksi
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/carapp.proto.
+func (x *RespondPenawaranRequest) GetHarga() float64 {
+	if x != nil {
+		return x.Harga
+	}
+	return 0
+}
+
+func (x *RespondPenawaranRequest) GetPesan() string {
+	if x != nil {
+		return x.Pesan
+	}
+	return ""
+}
+
+func (x *RespondPenawaranRequest) GetHargaMoney() *Money {
+	if x != nil {
+		return x.HargaMoney
+	}
+	return nil
+}
+
+type RespondPenawaranResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Penawaran     *Penawaran             `protobuf:"bytes,1,opt,name=penawaran,proto3" json:"penawaran,omitempty"`
+	Transaksi     *TransaksiJualResponse `protobuf:"bytes,2,opt,name=transaksi,proto3" json:"transaksi,omitempty"` // Diisi jika aksi = terima
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondPenawaranResponse) Reset() {
+	*x = RespondPenawaranResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondPenawaranResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondPenawaranResponse) ProtoMessage() {}
+
+func (x *RespondPenawaranResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondPenawaranResponse.ProtoReflect.Descriptor instead.
+func (*RespondPenawaranResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RespondPenawaranResponse) GetPenawaran() *Penawaran {
+	if x != nil {
+		return x.Penawaran
+	}
+	return nil
+}
+
+func (x *RespondPenawaranResponse) GetTransaksi() *TransaksiJualResponse {
+	if x != nil {
+		return x.Transaksi
+	}
+	return nil
+}
+
+type CancelPenawaranRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PenawaranId   string                 `protobuf:"bytes,1,opt,name=penawaran_id,json=penawaranId,proto3" json:"penawaran_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPenawaranRequest) Reset() {
+	*x = CancelPenawaranRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPenawaranRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPenawaranRequest) ProtoMessage() {}
+
+func (x *CancelPenawaranRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPenawaranRequest.ProtoReflect.Descriptor instead.
+func (*CancelPenawaranRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CancelPenawaranRequest) GetPenawaranId() string {
+	if x != nil {
+		return x.PenawaranId
+	}
+	return ""
+}
+
+type ListPenawaranRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Peran         string                 `protobuf:"bytes,1,opt,name=peran,proto3" json:"peran,omitempty"` // pembeli/penjual (default: pembeli)
+	FilterStatus  *string                `protobuf:"bytes,2,opt,name=filter_status,json=filterStatus,proto3,oneof" json:"filter_status,omitempty"`
+	MobilId       string                 `protobuf:"bytes,3,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"` // Opsional, untuk penjual melihat penawaran satu mobil
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPenawaranRequest) Reset() {
+	*x = ListPenawaranRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPenawaranRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPenawaranRequest) ProtoMessage() {}
+
+func (x *ListPenawaranRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPenawaranRequest.ProtoReflect.Descriptor instead.
+func (*ListPenawaranRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListPenawaranRequest) GetPeran() string {
+	if x != nil {
+		return x.Peran
+	}
+	return ""
+}
+
+func (x *ListPenawaranRequest) GetFilterStatus() string {
+	if x != nil && x.FilterStatus != nil {
+		return *x.FilterStatus
+	}
+	return ""
+}
+
+func (x *ListPenawaranRequest) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+type ListPenawaranResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Penawaran     []*Penawaran           `protobuf:"bytes,1,rep,name=penawaran,proto3" json:"penawaran,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPenawaranResponse) Reset() {
+	*x = ListPenawaranResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPenawaranResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPenawaranResponse) ProtoMessage() {}
+
+func (x *ListPenawaranResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPenawaranResponse.ProtoReflect.Descriptor instead.
+func (*ListPenawaranResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListPenawaranResponse) GetPenawaran() []*Penawaran {
+	if x != nil {
+		return x.Penawaran
+	}
+	return nil
+}
+
+type Percakapan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MobilId       string                 `protobuf:"bytes,2,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	PembeliId     string                 `protobuf:"bytes,3,opt,name=pembeli_id,json=pembeliId,proto3" json:"pembeli_id,omitempty"`
+	PenjualId     string                 `protobuf:"bytes,4,opt,name=penjual_id,json=penjualId,proto3" json:"penjual_id,omitempty"`
+	PembeliName   string                 `protobuf:"bytes,5,opt,name=pembeli_name,json=pembeliName,proto3" json:"pembeli_name,omitempty"`
+	PenjualName   string                 `protobuf:"bytes,6,opt,name=penjual_name,json=penjualName,proto3" json:"penjual_name,omitempty"`
+	Merk          string                 `protobuf:"bytes,7,opt,name=merk,proto3" json:"merk,omitempty"`
+	Model         string                 `protobuf:"bytes,8,opt,name=model,proto3" json:"model,omitempty"`
+	PesanTerakhir *PesanChat             `protobuf:"bytes,9,opt,name=pesan_terakhir,json=pesanTerakhir,proto3" json:"pesan_terakhir,omitempty"`
+	BelumDibaca   int32                  `protobuf:"varint,10,opt,name=belum_dibaca,json=belumDibaca,proto3" json:"belum_dibaca,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Percakapan) Reset() {
+	*x = Percakapan{}
+	mi := &file_proto_carapp_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Percakapan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Percakapan) ProtoMessage() {}
+
+func (x *Percakapan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Percakapan.ProtoReflect.Descriptor instead.
+func (*Percakapan) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Percakapan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Percakapan) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *Percakapan) GetPembeliId() string {
+	if x != nil {
+		return x.PembeliId
+	}
+	return ""
+}
+
+func (x *Percakapan) GetPenjualId() string {
+	if x != nil {
+		return x.PenjualId
+	}
+	return ""
+}
+
+func (x *Percakapan) GetPembeliName() string {
+	if x != nil {
+		return x.PembeliName
+	}
+	return ""
+}
+
+func (x *Percakapan) GetPenjualName() string {
+	if x != nil {
+		return x.PenjualName
+	}
+	return ""
+}
+
+func (x *Percakapan) GetMerk() string {
+	if x != nil {
+		return x.Merk
+	}
+	return ""
+}
+
+func (x *Percakapan) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Percakapan) GetPesanTerakhir() *PesanChat {
+	if x != nil {
+		return x.PesanTerakhir
+	}
+	return nil
+}
+
+func (x *Percakapan) GetBelumDibaca() int32 {
+	if x != nil {
+		return x.BelumDibaca
+	}
+	return 0
+}
+
+func (x *Percakapan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type PesanChat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PercakapanId  string                 `protobuf:"bytes,2,opt,name=percakapan_id,json=percakapanId,proto3" json:"percakapan_id,omitempty"`
+	PengirimId    string                 `protobuf:"bytes,3,opt,name=pengirim_id,json=pengirimId,proto3" json:"pengirim_id,omitempty"`
+	Isi           string                 `protobuf:"bytes,4,opt,name=isi,proto3" json:"isi,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReadAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PesanChat) Reset() {
+	*x = PesanChat{}
+	mi := &file_proto_carapp_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PesanChat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PesanChat) ProtoMessage() {}
+
+func (x *PesanChat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PesanChat.ProtoReflect.Descriptor instead.
+func (*PesanChat) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *PesanChat) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PesanChat) GetPercakapanId() string {
+	if x != nil {
+		return x.PercakapanId
+	}
+	return ""
+}
+
+func (x *PesanChat) GetPengirimId() string {
+	if x != nil {
+		return x.PengirimId
+	}
+	return ""
+}
+
+func (x *PesanChat) GetIsi() string {
+	if x != nil {
+		return x.Isi
+	}
+	return ""
+}
+
+func (x *PesanChat) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PesanChat) GetReadAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadAt
+	}
+	return nil
+}
+
+type ChatEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tipe          string                 `protobuf:"bytes,1,opt,name=tipe,proto3" json:"tipe,omitempty"`   // pesan/dibaca
+	Pesan         *PesanChat             `protobuf:"bytes,2,opt,name=pesan,proto3" json:"pesan,omitempty"` // Diisi jika tipe = pesan
+	PercakapanId  string                 `protobuf:"bytes,3,opt,name=percakapan_id,json=percakapanId,proto3" json:"percakapan_id,omitempty"`
+	DibacaOleh    string                 `protobuf:"bytes,4,opt,name=dibaca_oleh,json=dibacaOleh,proto3" json:"dibaca_oleh,omitempty"` // Diisi jika tipe = dibaca
+	DibacaAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=dibaca_at,json=dibacaAt,proto3" json:"dibaca_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatEvent) Reset() {
+	*x = ChatEvent{}
+	mi := &file_proto_carapp_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatEvent) ProtoMessage() {}
+
+func (x *ChatEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatEvent.ProtoReflect.Descriptor instead.
+func (*ChatEvent) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ChatEvent) GetTipe() string {
+	if x != nil {
+		return x.Tipe
+	}
+	return ""
+}
+
+func (x *ChatEvent) GetPesan() *PesanChat {
+	if x != nil {
+		return x.Pesan
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetPercakapanId() string {
+	if x != nil {
+		return x.PercakapanId
+	}
+	return ""
+}
+
+func (x *ChatEvent) GetDibacaOleh() string {
+	if x != nil {
+		return x.DibacaOleh
+	}
+	return ""
+}
+
+func (x *ChatEvent) GetDibacaAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DibacaAt
+	}
+	return nil
+}
+
+type StartPercakapanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"` // pembeli_id diambil dari JWT
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartPercakapanRequest) Reset() {
+	*x = StartPercakapanRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartPercakapanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPercakapanRequest) ProtoMessage() {}
+
+func (x *StartPercakapanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPercakapanRequest.ProtoReflect.Descriptor instead.
+func (*StartPercakapanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *StartPercakapanRequest) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+type ListPercakapanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPercakapanRequest) Reset() {
+	*x = ListPercakapanRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPercakapanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPercakapanRequest) ProtoMessage() {}
+
+func (x *ListPercakapanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPercakapanRequest.ProtoReflect.Descriptor instead.
+func (*ListPercakapanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{68}
+}
+
+type ListPercakapanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Percakapan    []*Percakapan          `protobuf:"bytes,1,rep,name=percakapan,proto3" json:"percakapan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPercakapanResponse) Reset() {
+	*x = ListPercakapanResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPercakapanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPercakapanResponse) ProtoMessage() {}
+
+func (x *ListPercakapanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPercakapanResponse.ProtoReflect.Descriptor instead.
+func (*ListPercakapanResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListPercakapanResponse) GetPercakapan() []*Percakapan {
+	if x != nil {
+		return x.Percakapan
+	}
+	return nil
+}
+
+type SendPesanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PercakapanId  string                 `protobuf:"bytes,1,opt,name=percakapan_id,json=percakapanId,proto3" json:"percakapan_id,omitempty"`
+	Isi           string                 `protobuf:"bytes,2,opt,name=isi,proto3" json:"isi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendPesanRequest) Reset() {
+	*x = SendPesanRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendPesanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPesanRequest) ProtoMessage() {}
+
+func (x *SendPesanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPesanRequest.ProtoReflect.Descriptor instead.
+func (*SendPesanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SendPesanRequest) GetPercakapanId() string {
+	if x != nil {
+		return x.PercakapanId
+	}
+	return ""
+}
+
+func (x *SendPesanRequest) GetIsi() string {
+	if x != nil {
+		return x.Isi
+	}
+	return ""
+}
+
+type ListPesanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PercakapanId  string                 `protobuf:"bytes,1,opt,name=percakapan_id,json=percakapanId,proto3" json:"percakapan_id,omitempty"`
+	Setelah       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=setelah,proto3" json:"setelah,omitempty"` // Opsional: hanya pesan setelah waktu ini
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPesanRequest) Reset() {
+	*x = ListPesanRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPesanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPesanRequest) ProtoMessage() {}
+
+func (x *ListPesanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPesanRequest.ProtoReflect.Descriptor instead.
+func (*ListPesanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListPesanRequest) GetPercakapanId() string {
+	if x != nil {
+		return x.PercakapanId
+	}
+	return ""
+}
+
+func (x *ListPesanRequest) GetSetelah() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Setelah
+	}
+	return nil
+}
+
+func (x *ListPesanRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListPesanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pesan         []*PesanChat           `protobuf:"bytes,1,rep,name=pesan,proto3" json:"pesan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPesanResponse) Reset() {
+	*x = ListPesanResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPesanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPesanResponse) ProtoMessage() {}
+
+func (x *ListPesanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPesanResponse.ProtoReflect.Descriptor instead.
+func (*ListPesanResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListPesanResponse) GetPesan() []*PesanChat {
+	if x != nil {
+		return x.Pesan
+	}
+	return nil
+}
+
+type StreamPesanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PercakapanId  string                 `protobuf:"bytes,1,opt,name=percakapan_id,json=percakapanId,proto3" json:"percakapan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamPesanRequest) Reset() {
+	*x = StreamPesanRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamPesanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPesanRequest) ProtoMessage() {}
+
+func (x *StreamPesanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPesanRequest.ProtoReflect.Descriptor instead.
+func (*StreamPesanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *StreamPesanRequest) GetPercakapanId() string {
+	if x != nil {
+		return x.PercakapanId
+	}
+	return ""
+}
+
+type MarkDibacaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PercakapanId  string                 `protobuf:"bytes,1,opt,name=percakapan_id,json=percakapanId,proto3" json:"percakapan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkDibacaRequest) Reset() {
+	*x = MarkDibacaRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkDibacaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkDibacaRequest) ProtoMessage() {}
+
+func (x *MarkDibacaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkDibacaRequest.ProtoReflect.Descriptor instead.
+func (*MarkDibacaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *MarkDibacaRequest) GetPercakapanId() string {
+	if x != nil {
+		return x.PercakapanId
+	}
+	return ""
+}
+
+type MarkDibacaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jumlah        int32                  `protobuf:"varint,1,opt,name=jumlah,proto3" json:"jumlah,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkDibacaResponse) Reset() {
+	*x = MarkDibacaResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkDibacaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkDibacaResponse) ProtoMessage() {}
+
+func (x *MarkDibacaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkDibacaResponse.ProtoReflect.Descriptor instead.
+func (*MarkDibacaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *MarkDibacaResponse) GetJumlah() int32 {
+	if x != nil {
+		return x.Jumlah
+	}
+	return 0
+}
+
+type BlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *BlockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReportUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PercakapanId  string                 `protobuf:"bytes,2,opt,name=percakapan_id,json=percakapanId,proto3" json:"percakapan_id,omitempty"`
+	Alasan        string                 `protobuf:"bytes,3,opt,name=alasan,proto3" json:"alasan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportUserRequest) Reset() {
+	*x = ReportUserRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportUserRequest) ProtoMessage() {}
+
+func (x *ReportUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportUserRequest.ProtoReflect.Descriptor instead.
+func (*ReportUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ReportUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReportUserRequest) GetPercakapanId() string {
+	if x != nil {
+		return x.PercakapanId
+	}
+	return ""
+}
+
+func (x *ReportUserRequest) GetAlasan() string {
+	if x != nil {
+		return x.Alasan
+	}
+	return ""
+}
+
+type SlotJadwal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PenjualId     string                 `protobuf:"bytes,2,opt,name=penjual_id,json=penjualId,proto3" json:"penjual_id,omitempty"`
+	MobilId       string                 `protobuf:"bytes,3,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"` // Kosong jika slot per lokasi dealer
+	Lokasi        string                 `protobuf:"bytes,4,opt,name=lokasi,proto3" json:"lokasi,omitempty"`
+	Mulai         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=mulai,proto3" json:"mulai,omitempty"`
+	Selesai       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=selesai,proto3" json:"selesai,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // tersedia/dipesan/dibatalkan
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotJadwal) Reset() {
+	*x = SlotJadwal{}
+	mi := &file_proto_carapp_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotJadwal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotJadwal) ProtoMessage() {}
+
+func (x *SlotJadwal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotJadwal.ProtoReflect.Descriptor instead.
+func (*SlotJadwal) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SlotJadwal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SlotJadwal) GetPenjualId() string {
+	if x != nil {
+		return x.PenjualId
+	}
+	return ""
+}
+
+func (x *SlotJadwal) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *SlotJadwal) GetLokasi() string {
+	if x != nil {
+		return x.Lokasi
+	}
+	return ""
+}
+
+func (x *SlotJadwal) GetMulai() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Mulai
+	}
+	return nil
+}
+
+func (x *SlotJadwal) GetSelesai() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Selesai
+	}
+	return nil
+}
+
+func (x *SlotJadwal) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CreateSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"` // Isi salah satu: mobil_id atau lokasi
+	Lokasi        string                 `protobuf:"bytes,2,opt,name=lokasi,proto3" json:"lokasi,omitempty"`
+	Mulai         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=mulai,proto3" json:"mulai,omitempty"`
+	Selesai       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=selesai,proto3" json:"selesai,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSlotRequest) Reset() {
+	*x = CreateSlotRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSlotRequest) ProtoMessage() {}
+
+func (x *CreateSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSlotRequest.ProtoReflect.Descriptor instead.
+func (*CreateSlotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CreateSlotRequest) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *CreateSlotRequest) GetLokasi() string {
+	if x != nil {
+		return x.Lokasi
+	}
+	return ""
+}
+
+func (x *CreateSlotRequest) GetMulai() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Mulai
+	}
+	return nil
+}
+
+func (x *CreateSlotRequest) GetSelesai() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Selesai
+	}
+	return nil
+}
+
+type ListSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"` // Slot untuk mobil ini (termasuk slot lokasi dealer mobil tsb)
+	PenjualId     string                 `protobuf:"bytes,2,opt,name=penjual_id,json=penjualId,proto3" json:"penjual_id,omitempty"`
+	Lokasi        string                 `protobuf:"bytes,3,opt,name=lokasi,proto3" json:"lokasi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSlotRequest) Reset() {
+	*x = ListSlotRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSlotRequest) ProtoMessage() {}
+
+func (x *ListSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSlotRequest.ProtoReflect.Descriptor instead.
+func (*ListSlotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListSlotRequest) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *ListSlotRequest) GetPenjualId() string {
+	if x != nil {
+		return x.PenjualId
+	}
+	return ""
+}
+
+func (x *ListSlotRequest) GetLokasi() string {
+	if x != nil {
+		return x.Lokasi
+	}
+	return ""
+}
+
+type ListSlotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          []*SlotJadwal          `protobuf:"bytes,1,rep,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSlotResponse) Reset() {
+	*x = ListSlotResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSlotResponse) ProtoMessage() {}
+
+func (x *ListSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSlotResponse.ProtoReflect.Descriptor instead.
+func (*ListSlotResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListSlotResponse) GetSlot() []*SlotJadwal {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+type DeleteSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlotId        string                 `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSlotRequest) Reset() {
+	*x = DeleteSlotRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSlotRequest) ProtoMessage() {}
+
+func (x *DeleteSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSlotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSlotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DeleteSlotRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+type JanjiTemu struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SlotId        string                 `protobuf:"bytes,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	MobilId       string                 `protobuf:"bytes,3,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	PembeliId     string                 `protobuf:"bytes,4,opt,name=pembeli_id,json=pembeliId,proto3" json:"pembeli_id,omitempty"`
+	PenjualId     string                 `protobuf:"bytes,5,opt,name=penjual_id,json=penjualId,proto3" json:"penjual_id,omitempty"`
+	Tipe          string                 `protobuf:"bytes,6,opt,name=tipe,proto3" json:"tipe,omitempty"`     // lihat/test_drive
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // dikonfirmasi/dibatalkan
+	Catatan       string                 `protobuf:"bytes,8,opt,name=catatan,proto3" json:"catatan,omitempty"`
+	AlasanBatal   string                 `protobuf:"bytes,9,opt,name=alasan_batal,json=alasanBatal,proto3" json:"alasan_batal,omitempty"`
+	Mulai         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=mulai,proto3" json:"mulai,omitempty"`
+	Selesai       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=selesai,proto3" json:"selesai,omitempty"`
+	Lokasi        string                 `protobuf:"bytes,12,opt,name=lokasi,proto3" json:"lokasi,omitempty"`
+	Merk          string                 `protobuf:"bytes,13,opt,name=merk,proto3" json:"merk,omitempty"`
+	Model         string                 `protobuf:"bytes,14,opt,name=model,proto3" json:"model,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JanjiTemu) Reset() {
+	*x = JanjiTemu{}
+	mi := &file_proto_carapp_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JanjiTemu) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JanjiTemu) ProtoMessage() {}
+
+func (x *JanjiTemu) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JanjiTemu.ProtoReflect.Descriptor instead.
+func (*JanjiTemu) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *JanjiTemu) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JanjiTemu) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *JanjiTemu) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *JanjiTemu) GetPembeliId() string {
+	if x != nil {
+		return x.PembeliId
+	}
+	return ""
+}
+
+func (x *JanjiTemu) GetPenjualId() string {
+	if x != nil {
+		return x.PenjualId
+	}
+	return ""
+}
+
+func (x *JanjiTemu) GetTipe() string {
+	if x != nil {
+		return x.Tipe
+	}
+	return ""
+}
+
+func (x *JanjiTemu) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JanjiTemu) GetCatatan() string {
+	if x != nil {
+		return x.Catatan
+	}
+	return ""
+}
+
+func (x *JanjiTemu) GetAlasanBatal() string {
+	if x != nil {
+		return x.AlasanBatal
+	}
+	return ""
+}
+
+func (x *JanjiTemu) GetMulai() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Mulai
+	}
+	return nil
+}
+
+func (x *JanjiTemu) GetSelesai() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Selesai
+	}
+	return nil
+}
+
+func (x *JanjiTemu) GetLokasi() string {
+	if x != nil {
+		return x.Lokasi
+	}
+	return ""
+}
+
+func (x *JanjiTemu) GetMerk() string {
+	if x != nil {
+		return x.Merk
+	}
+	return ""
+}
+
+func (x *JanjiTemu) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *JanjiTemu) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type BookJanjiTemuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlotId        string                 `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	MobilId       string                 `protobuf:"bytes,2,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"` // Wajib untuk slot lokasi, diabaikan untuk slot per mobil
+	Tipe          string                 `protobuf:"bytes,3,opt,name=tipe,proto3" json:"tipe,omitempty"`                      // lihat/test_drive (default: lihat)
+	Catatan       string                 `protobuf:"bytes,4,opt,name=catatan,proto3" json:"catatan,omitempty"`                // pembeli_id diambil dari JWT
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookJanjiTemuRequest) Reset() {
+	*x = BookJanjiTemuRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookJanjiTemuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookJanjiTemuRequest) ProtoMessage() {}
+
+func (x *BookJanjiTemuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookJanjiTemuRequest.ProtoReflect.Descriptor instead.
+func (*BookJanjiTemuRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *BookJanjiTemuRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *BookJanjiTemuRequest) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *BookJanjiTemuRequest) GetTipe() string {
+	if x != nil {
+		return x.Tipe
+	}
+	return ""
+}
+
+func (x *BookJanjiTemuRequest) GetCatatan() string {
+	if x != nil {
+		return x.Catatan
+	}
+	return ""
+}
+
+type CancelJanjiTemuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JanjiTemuId   string                 `protobuf:"bytes,1,opt,name=janji_temu_id,json=janjiTemuId,proto3" json:"janji_temu_id,omitempty"`
+	Alasan        string                 `protobuf:"bytes,2,opt,name=alasan,proto3" json:"alasan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelJanjiTemuRequest) Reset() {
+	*x = CancelJanjiTemuRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelJanjiTemuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJanjiTemuRequest) ProtoMessage() {}
+
+func (x *CancelJanjiTemuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJanjiTemuRequest.ProtoReflect.Descriptor instead.
+func (*CancelJanjiTemuRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CancelJanjiTemuRequest) GetJanjiTemuId() string {
+	if x != nil {
+		return x.JanjiTemuId
+	}
+	return ""
+}
+
+func (x *CancelJanjiTemuRequest) GetAlasan() string {
+	if x != nil {
+		return x.Alasan
+	}
+	return ""
+}
+
+type ListJanjiTemuRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Peran          string                 `protobuf:"bytes,1,opt,name=peran,proto3" json:"peran,omitempty"`                                          // pembeli/penjual (default: pembeli)
+	TermasukLampau bool                   `protobuf:"varint,2,opt,name=termasuk_lampau,json=termasukLampau,proto3" json:"termasuk_lampau,omitempty"` // Default hanya janji temu yang akan datang
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListJanjiTemuRequest) Reset() {
+	*x = ListJanjiTemuRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJanjiTemuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJanjiTemuRequest) ProtoMessage() {}
+
+func (x *ListJanjiTemuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJanjiTemuRequest.ProtoReflect.Descriptor instead.
+func (*ListJanjiTemuRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListJanjiTemuRequest) GetPeran() string {
+	if x != nil {
+		return x.Peran
+	}
+	return ""
+}
+
+func (x *ListJanjiTemuRequest) GetTermasukLampau() bool {
+	if x != nil {
+		return x.TermasukLampau
+	}
+	return false
+}
+
+type ListJanjiTemuResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JanjiTemu     []*JanjiTemu           `protobuf:"bytes,1,rep,name=janji_temu,json=janjiTemu,proto3" json:"janji_temu,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJanjiTemuResponse) Reset() {
+	*x = ListJanjiTemuResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJanjiTemuResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJanjiTemuResponse) ProtoMessage() {}
+
+func (x *ListJanjiTemuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJanjiTemuResponse.ProtoReflect.Descriptor instead.
+func (*ListJanjiTemuResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListJanjiTemuResponse) GetJanjiTemu() []*JanjiTemu {
+	if x != nil {
+		return x.JanjiTemu
+	}
+	return nil
+}
+
+type Kurs struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MataUangAsal   string                 `protobuf:"bytes,1,opt,name=mata_uang_asal,json=mataUangAsal,proto3" json:"mata_uang_asal,omitempty"`
+	MataUangTujuan string                 `protobuf:"bytes,2,opt,name=mata_uang_tujuan,json=mataUangTujuan,proto3" json:"mata_uang_tujuan,omitempty"`
+	Rate           string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`                                     // Desimal persis, contoh "15800" (1 asal = rate tujuan)
+	BerlakuMulai   string                 `protobuf:"bytes,4,opt,name=berlaku_mulai,json=berlakuMulai,proto3" json:"berlaku_mulai,omitempty"` // YYYY-MM-DD
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Kurs) Reset() {
+	*x = Kurs{}
+	mi := &file_proto_carapp_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Kurs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Kurs) ProtoMessage() {}
+
+func (x *Kurs) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Kurs.ProtoReflect.Descriptor instead.
+func (*Kurs) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *Kurs) GetMataUangAsal() string {
+	if x != nil {
+		return x.MataUangAsal
+	}
+	return ""
+}
+
+func (x *Kurs) GetMataUangTujuan() string {
+	if x != nil {
+		return x.MataUangTujuan
+	}
+	return ""
+}
+
+func (x *Kurs) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *Kurs) GetBerlakuMulai() string {
+	if x != nil {
+		return x.BerlakuMulai
+	}
+	return ""
+}
+
+type ImportKursRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CsvData       []byte                 `protobuf:"bytes,1,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportKursRequest) Reset() {
+	*x = ImportKursRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportKursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportKursRequest) ProtoMessage() {}
+
+func (x *ImportKursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportKursRequest.ProtoReflect.Descriptor instead.
+func (*ImportKursRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ImportKursRequest) GetCsvData() []byte {
+	if x != nil {
+		return x.CsvData
+	}
+	return nil
+}
+
+type ImportKursResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jumlah        int32                  `protobuf:"varint,1,opt,name=jumlah,proto3" json:"jumlah,omitempty"` // Jumlah baris yang disimpan
+	Errors        []string               `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`  // Baris yang ditolak (tidak ada yang disimpan jika ada error)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportKursResponse) Reset() {
+	*x = ImportKursResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportKursResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportKursResponse) ProtoMessage() {}
+
+func (x *ImportKursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportKursResponse.ProtoReflect.Descriptor instead.
+func (*ImportKursResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ImportKursResponse) GetJumlah() int32 {
+	if x != nil {
+		return x.Jumlah
+	}
+	return 0
+}
+
+func (x *ImportKursResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ListKursRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MataUangAsal   string                 `protobuf:"bytes,1,opt,name=mata_uang_asal,json=mataUangAsal,proto3" json:"mata_uang_asal,omitempty"`       // Opsional
+	MataUangTujuan string                 `protobuf:"bytes,2,opt,name=mata_uang_tujuan,json=mataUangTujuan,proto3" json:"mata_uang_tujuan,omitempty"` // Opsional
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListKursRequest) Reset() {
+	*x = ListKursRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKursRequest) ProtoMessage() {}
+
+func (x *ListKursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKursRequest.ProtoReflect.Descriptor instead.
+func (*ListKursRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListKursRequest) GetMataUangAsal() string {
+	if x != nil {
+		return x.MataUangAsal
+	}
+	return ""
+}
+
+func (x *ListKursRequest) GetMataUangTujuan() string {
+	if x != nil {
+		return x.MataUangTujuan
+	}
+	return ""
+}
+
+type ListKursResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kurs          []*Kurs                `protobuf:"bytes,1,rep,name=kurs,proto3" json:"kurs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKursResponse) Reset() {
+	*x = ListKursResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKursResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKursResponse) ProtoMessage() {}
+
+func (x *ListKursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKursResponse.ProtoReflect.Descriptor instead.
+func (*ListKursResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListKursResponse) GetKurs() []*Kurs {
+	if x != nil {
+		return x.Kurs
+	}
+	return nil
+}
+
+type ProdukKredit struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nama               string                 `protobuf:"bytes,2,opt,name=nama,proto3" json:"nama,omitempty"`
+	Lembaga            string                 `protobuf:"bytes,3,opt,name=lembaga,proto3" json:"lembaga,omitempty"`
+	BungaFlatPersen    string                 `protobuf:"bytes,4,opt,name=bunga_flat_persen,json=bungaFlatPersen,proto3" json:"bunga_flat_persen,omitempty"`          // Per tahun, kosong jika skema flat tidak tersedia
+	BungaEfektifPersen string                 `protobuf:"bytes,5,opt,name=bunga_efektif_persen,json=bungaEfektifPersen,proto3" json:"bunga_efektif_persen,omitempty"` // Per tahun, kosong jika skema anuitas tidak tersedia
+	TenorMinBulan      int32                  `protobuf:"varint,6,opt,name=tenor_min_bulan,json=tenorMinBulan,proto3" json:"tenor_min_bulan,omitempty"`
+	TenorMaxBulan      int32                  `protobuf:"varint,7,opt,name=tenor_max_bulan,json=tenorMaxBulan,proto3" json:"tenor_max_bulan,omitempty"`
+	DpMinPersen        string                 `protobuf:"bytes,8,opt,name=dp_min_persen,json=dpMinPersen,proto3" json:"dp_min_persen,omitempty"`
+	BiayaAdmin         *Money                 `protobuf:"bytes,9,opt,name=biaya_admin,json=biayaAdmin,proto3" json:"biaya_admin,omitempty"`
+	Kondisi            string                 `protobuf:"bytes,10,opt,name=kondisi,proto3" json:"kondisi,omitempty"`                                     // baru/bekas, kosong = semua kondisi
+	UsiaMaksTahun      int32                  `protobuf:"varint,11,opt,name=usia_maks_tahun,json=usiaMaksTahun,proto3" json:"usia_maks_tahun,omitempty"` // 0 = tanpa batas
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ProdukKredit) Reset() {
+	*x = ProdukKredit{}
+	mi := &file_proto_carapp_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProdukKredit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProdukKredit) ProtoMessage() {}
+
+func (x *ProdukKredit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProdukKredit.ProtoReflect.Descriptor instead.
+func (*ProdukKredit) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ProdukKredit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProdukKredit) GetNama() string {
+	if x != nil {
+		return x.Nama
+	}
+	return ""
+}
+
+func (x *ProdukKredit) GetLembaga() string {
+	if x != nil {
+		return x.Lembaga
+	}
+	return ""
+}
+
+func (x *ProdukKredit) GetBungaFlatPersen() string {
+	if x != nil {
+		return x.BungaFlatPersen
+	}
+	return ""
+}
+
+func (x *ProdukKredit) GetBungaEfektifPersen() string {
+	if x != nil {
+		return x.BungaEfektifPersen
+	}
+	return ""
+}
+
+func (x *ProdukKredit) GetTenorMinBulan() int32 {
+	if x != nil {
+		return x.TenorMinBulan
+	}
+	return 0
+}
+
+func (x *ProdukKredit) GetTenorMaxBulan() int32 {
+	if x != nil {
+		return x.TenorMaxBulan
+	}
+	return 0
+}
+
+func (x *ProdukKredit) GetDpMinPersen() string {
+	if x != nil {
+		return x.DpMinPersen
+	}
+	return ""
+}
+
+func (x *ProdukKredit) GetBiayaAdmin() *Money {
+	if x != nil {
+		return x.BiayaAdmin
+	}
+	return nil
+}
+
+func (x *ProdukKredit) GetKondisi() string {
+	if x != nil {
+		return x.Kondisi
+	}
+	return ""
+}
+
+func (x *ProdukKredit) GetUsiaMaksTahun() int32 {
+	if x != nil {
+		return x.UsiaMaksTahun
+	}
+	return 0
+}
+
+type ListProdukKreditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"` // Opsional: filter produk yang bisa dipakai untuk mobil ini
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProdukKreditRequest) Reset() {
+	*x = ListProdukKreditRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProdukKreditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProdukKreditRequest) ProtoMessage() {}
+
+func (x *ListProdukKreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProdukKreditRequest.ProtoReflect.Descriptor instead.
+func (*ListProdukKreditRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListProdukKreditRequest) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+type ListProdukKreditResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Produk        []*ProdukKredit        `protobuf:"bytes,1,rep,name=produk,proto3" json:"produk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProdukKreditResponse) Reset() {
+	*x = ListProdukKreditResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProdukKreditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProdukKreditResponse) ProtoMessage() {}
+
+func (x *ListProdukKreditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProdukKreditResponse.ProtoReflect.Descriptor instead.
+func (*ListProdukKreditResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListProdukKreditResponse) GetProduk() []*ProdukKredit {
+	if x != nil {
+		return x.Produk
+	}
+	return nil
+}
+
+type SimulateKreditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	ProdukId      string                 `protobuf:"bytes,2,opt,name=produk_id,json=produkId,proto3" json:"produk_id,omitempty"`
+	DpPersen      float64                `protobuf:"fixed64,3,opt,name=dp_persen,json=dpPersen,proto3" json:"dp_persen,omitempty"` // Uang muka, persen dari harga mobil
+	TenorBulan    int32                  `protobuf:"varint,4,opt,name=tenor_bulan,json=tenorBulan,proto3" json:"tenor_bulan,omitempty"`
+	Skema         string                 `protobuf:"bytes,5,opt,name=skema,proto3" json:"skema,omitempty"` // flat/anuitas
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateKreditRequest) Reset() {
+	*x = SimulateKreditRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateKreditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateKreditRequest) ProtoMessage() {}
+
+func (x *SimulateKreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateKreditRequest.ProtoReflect.Descriptor instead.
+func (*SimulateKreditRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SimulateKreditRequest) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *SimulateKreditRequest) GetProdukId() string {
+	if x != nil {
+		return x.ProdukId
+	}
+	return ""
+}
+
+func (x *SimulateKreditRequest) GetDpPersen() float64 {
+	if x != nil {
+		return x.DpPersen
+	}
+	return 0
+}
+
+func (x *SimulateKreditRequest) GetTenorBulan() int32 {
+	if x != nil {
+		return x.TenorBulan
+	}
+	return 0
+}
+
+func (x *SimulateKreditRequest) GetSkema() string {
+	if x != nil {
+		return x.Skema
+	}
+	return ""
+}
+
+type AngsuranKredit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BulanKe       int32                  `protobuf:"varint,1,opt,name=bulan_ke,json=bulanKe,proto3" json:"bulan_ke,omitempty"`
+	Angsuran      *Money                 `protobuf:"bytes,2,opt,name=angsuran,proto3" json:"angsuran,omitempty"`
+	Pokok         *Money                 `protobuf:"bytes,3,opt,name=pokok,proto3" json:"pokok,omitempty"`
+	Bunga         *Money                 `protobuf:"bytes,4,opt,name=bunga,proto3" json:"bunga,omitempty"`
+	SisaPokok     *Money                 `protobuf:"bytes,5,opt,name=sisa_pokok,json=sisaPokok,proto3" json:"sisa_pokok,omitempty"` // Sisa pokok setelah angsuran ini
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AngsuranKredit) Reset() {
+	*x = AngsuranKredit{}
+	mi := &file_proto_carapp_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AngsuranKredit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AngsuranKredit) ProtoMessage() {}
+
+func (x *AngsuranKredit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AngsuranKredit.ProtoReflect.Descriptor instead.
+func (*AngsuranKredit) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *AngsuranKredit) GetBulanKe() int32 {
+	if x != nil {
+		return x.BulanKe
+	}
+	return 0
+}
+
+func (x *AngsuranKredit) GetAngsuran() *Money {
+	if x != nil {
+		return x.Angsuran
+	}
+	return nil
+}
+
+func (x *AngsuranKredit) GetPokok() *Money {
+	if x != nil {
+		return x.Pokok
+	}
+	return nil
+}
+
+func (x *AngsuranKredit) GetBunga() *Money {
+	if x != nil {
+		return x.Bunga
+	}
+	return nil
+}
+
+func (x *AngsuranKredit) GetSisaPokok() *Money {
+	if x != nil {
+		return x.SisaPokok
+	}
+	return nil
+}
+
+type SimulasiKredit struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	MobilId             string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	Produk              *ProdukKredit          `protobuf:"bytes,2,opt,name=produk,proto3" json:"produk,omitempty"`
+	Skema               string                 `protobuf:"bytes,3,opt,name=skema,proto3" json:"skema,omitempty"`
+	BungaPerTahunPersen string                 `protobuf:"bytes,4,opt,name=bunga_per_tahun_persen,json=bungaPerTahunPersen,proto3" json:"bunga_per_tahun_persen,omitempty"`
+	TenorBulan          int32                  `protobuf:"varint,5,opt,name=tenor_bulan,json=tenorBulan,proto3" json:"tenor_bulan,omitempty"`
+	Harga               *Money                 `protobuf:"bytes,6,opt,name=harga,proto3" json:"harga,omitempty"`
+	Dp                  *Money                 `protobuf:"bytes,7,opt,name=dp,proto3" json:"dp,omitempty"`
+	PokokPinjaman       *Money                 `protobuf:"bytes,8,opt,name=pokok_pinjaman,json=pokokPinjaman,proto3" json:"pokok_pinjaman,omitempty"`
+	AngsuranPerBulan    *Money                 `protobuf:"bytes,9,opt,name=angsuran_per_bulan,json=angsuranPerBulan,proto3" json:"angsuran_per_bulan,omitempty"` // Anuitas: angsuran tetap (bulan terakhir bisa beda karena pembulatan)
+	TotalBunga          *Money                 `protobuf:"bytes,10,opt,name=total_bunga,json=totalBunga,proto3" json:"total_bunga,omitempty"`
+	BiayaAdmin          *Money                 `protobuf:"bytes,11,opt,name=biaya_admin,json=biayaAdmin,proto3" json:"biaya_admin,omitempty"`
+	PembayaranPertama   *Money                 `protobuf:"bytes,12,opt,name=pembayaran_pertama,json=pembayaranPertama,proto3" json:"pembayaran_pertama,omitempty"` // DP + biaya admin
+	TotalBayar          *Money                 `protobuf:"bytes,13,opt,name=total_bayar,json=totalBayar,proto3" json:"total_bayar,omitempty"`                      // DP + biaya admin + semua angsuran
+	Jadwal              []*AngsuranKredit      `protobuf:"bytes,14,rep,name=jadwal,proto3" json:"jadwal,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SimulasiKredit) Reset() {
+	*x = SimulasiKredit{}
+	mi := &file_proto_carapp_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulasiKredit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulasiKredit) ProtoMessage() {}
+
+func (x *SimulasiKredit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulasiKredit.ProtoReflect.Descriptor instead.
+func (*SimulasiKredit) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SimulasiKredit) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *SimulasiKredit) GetProduk() *ProdukKredit {
+	if x != nil {
+		return x.Produk
+	}
+	return nil
+}
+
+func (x *SimulasiKredit) GetSkema() string {
+	if x != nil {
+		return x.Skema
+	}
+	return ""
+}
+
+func (x *SimulasiKredit) GetBungaPerTahunPersen() string {
+	if x != nil {
+		return x.BungaPerTahunPersen
+	}
+	return ""
+}
+
+func (x *SimulasiKredit) GetTenorBulan() int32 {
+	if x != nil {
+		return x.TenorBulan
+	}
+	return 0
+}
+
+func (x *SimulasiKredit) GetHarga() *Money {
+	if x != nil {
+		return x.Harga
+	}
+	return nil
+}
+
+func (x *SimulasiKredit) GetDp() *Money {
+	if x != nil {
+		return x.Dp
+	}
+	return nil
+}
+
+func (x *SimulasiKredit) GetPokokPinjaman() *Money {
+	if x != nil {
+		return x.PokokPinjaman
+	}
+	return nil
+}
+
+func (x *SimulasiKredit) GetAngsuranPerBulan() *Money {
+	if x != nil {
+		return x.AngsuranPerBulan
+	}
+	return nil
+}
+
+func (x *SimulasiKredit) GetTotalBunga() *Money {
+	if x != nil {
+		return x.TotalBunga
+	}
+	return nil
+}
+
+func (x *SimulasiKredit) GetBiayaAdmin() *Money {
+	if x != nil {
+		return x.BiayaAdmin
+	}
+	return nil
+}
+
+func (x *SimulasiKredit) GetPembayaranPertama() *Money {
+	if x != nil {
+		return x.PembayaranPertama
+	}
+	return nil
+}
+
+func (x *SimulasiKredit) GetTotalBayar() *Money {
+	if x != nil {
+		return x.TotalBayar
+	}
+	return nil
+}
+
+func (x *SimulasiKredit) GetJadwal() []*AngsuranKredit {
+	if x != nil {
+		return x.Jadwal
+	}
+	return nil
+}
+
+type TradeIn struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PembeliId        string                 `protobuf:"bytes,2,opt,name=pembeli_id,json=pembeliId,proto3" json:"pembeli_id,omitempty"`
+	PenjualId        string                 `protobuf:"bytes,3,opt,name=penjual_id,json=penjualId,proto3" json:"penjual_id,omitempty"`
+	MobilId          string                 `protobuf:"bytes,4,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"` // Mobil dealer yang akan dibeli
+	Merk             string                 `protobuf:"bytes,5,opt,name=merk,proto3" json:"merk,omitempty"`
+	Model            string                 `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
+	Tahun            int32                  `protobuf:"varint,7,opt,name=tahun,proto3" json:"tahun,omitempty"`
+	Kondisi          string                 `protobuf:"bytes,8,opt,name=kondisi,proto3" json:"kondisi,omitempty"`
+	Kilometer        int32                  `protobuf:"varint,9,opt,name=kilometer,proto3" json:"kilometer,omitempty"`
+	Deskripsi        string                 `protobuf:"bytes,10,opt,name=deskripsi,proto3" json:"deskripsi,omitempty"`
+	Vin              string                 `protobuf:"bytes,11,opt,name=vin,proto3" json:"vin,omitempty"`
+	VinTerverifikasi bool                   `protobuf:"varint,12,opt,name=vin_terverifikasi,json=vinTerverifikasi,proto3" json:"vin_terverifikasi,omitempty"`
+	Status           string                 `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"` // diajukan/dinilai/diterima/ditolak/dibatalkan/dipakai/selesai
+	NilaiTaksiran    *Money                 `protobuf:"bytes,14,opt,name=nilai_taksiran,json=nilaiTaksiran,proto3" json:"nilai_taksiran,omitempty"`
+	CatatanPenilai   string                 `protobuf:"bytes,15,opt,name=catatan_penilai,json=catatanPenilai,proto3" json:"catatan_penilai,omitempty"`
+	BerlakuSampai    *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=berlaku_sampai,json=berlakuSampai,proto3" json:"berlaku_sampai,omitempty"`
+	TransaksiId      string                 `protobuf:"bytes,17,opt,name=transaksi_id,json=transaksiId,proto3" json:"transaksi_id,omitempty"`
+	MobilBaruId      string                 `protobuf:"bytes,18,opt,name=mobil_baru_id,json=mobilBaruId,proto3" json:"mobil_baru_id,omitempty"` // Listing draft dealer setelah transaksi selesai
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TradeIn) Reset() {
+	*x = TradeIn{}
+	mi := &file_proto_carapp_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradeIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeIn) ProtoMessage() {}
+
+func (x *TradeIn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeIn.ProtoReflect.Descriptor instead.
+func (*TradeIn) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *TradeIn) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TradeIn) GetPembeliId() string {
+	if x != nil {
+		return x.PembeliId
+	}
+	return ""
+}
+
+func (x *TradeIn) GetPenjualId() string {
+	if x != nil {
+		return x.PenjualId
+	}
+	return ""
+}
+
+func (x *TradeIn) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *TradeIn) GetMerk() string {
+	if x != nil {
+		return x.Merk
+	}
+	return ""
+}
+
+func (x *TradeIn) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *TradeIn) GetTahun() int32 {
+	if x != nil {
+		return x.Tahun
+	}
+	return 0
+}
+
+func (x *TradeIn) GetKondisi() string {
+	if x != nil {
+		return x.Kondisi
+	}
+	return ""
+}
+
+func (x *TradeIn) GetKilometer() int32 {
+	if x != nil {
+		return x.Kilometer
+	}
+	return 0
+}
+
+func (x *TradeIn) GetDeskripsi() string {
+	if x != nil {
+		return x.Deskripsi
+	}
+	return ""
+}
+
+func (x *TradeIn) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *TradeIn) GetVinTerverifikasi() bool {
+	if x != nil {
+		return x.VinTerverifikasi
+	}
+	return false
+}
+
+func (x *TradeIn) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TradeIn) GetNilaiTaksiran() *Money {
+	if x != nil {
+		return x.NilaiTaksiran
+	}
+	return nil
+}
+
+func (x *TradeIn) GetCatatanPenilai() string {
+	if x != nil {
+		return x.CatatanPenilai
+	}
+	return ""
+}
+
+func (x *TradeIn) GetBerlakuSampai() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BerlakuSampai
+	}
+	return nil
+}
+
+func (x *TradeIn) GetTransaksiId() string {
+	if x != nil {
+		return x.TransaksiId
+	}
+	return ""
+}
+
+func (x *TradeIn) GetMobilBaruId() string {
+	if x != nil {
+		return x.MobilBaruId
+	}
+	return ""
+}
+
+func (x *TradeIn) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type DecodeVinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vin           string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodeVinRequest) Reset() {
+	*x = DecodeVinRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeVinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeVinRequest) ProtoMessage() {}
+
+func (x *DecodeVinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeVinRequest.ProtoReflect.Descriptor instead.
+func (*DecodeVinRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *DecodeVinRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+type DecodeVinResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vin           string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Merk          string                 `protobuf:"bytes,2,opt,name=merk,proto3" json:"merk,omitempty"`
+	Model         string                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	Tahun         int32                  `protobuf:"varint,4,opt,name=tahun,proto3" json:"tahun,omitempty"`
+	TipeBodi      string                 `protobuf:"bytes,5,opt,name=tipe_bodi,json=tipeBodi,proto3" json:"tipe_bodi,omitempty"`
+	BahanBakar    string                 `protobuf:"bytes,6,opt,name=bahan_bakar,json=bahanBakar,proto3" json:"bahan_bakar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodeVinResponse) Reset() {
+	*x = DecodeVinResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeVinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeVinResponse) ProtoMessage() {}
+
+func (x *DecodeVinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeVinResponse.ProtoReflect.Descriptor instead.
+func (*DecodeVinResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *DecodeVinResponse) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *DecodeVinResponse) GetMerk() string {
+	if x != nil {
+		return x.Merk
+	}
+	return ""
+}
+
+func (x *DecodeVinResponse) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *DecodeVinResponse) GetTahun() int32 {
+	if x != nil {
+		return x.Tahun
+	}
+	return 0
+}
+
+func (x *DecodeVinResponse) GetTipeBodi() string {
+	if x != nil {
+		return x.TipeBodi
+	}
+	return ""
+}
+
+func (x *DecodeVinResponse) GetBahanBakar() string {
+	if x != nil {
+		return x.BahanBakar
+	}
+	return ""
+}
+
+type CreateTradeInRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"` // Mobil dealer yang ingin dibeli
+	Merk          string                 `protobuf:"bytes,2,opt,name=merk,proto3" json:"merk,omitempty"`                      // Boleh kosong jika VIN diisi (diisi dari NHTSA)
+	Model         string                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	Tahun         int32                  `protobuf:"varint,4,opt,name=tahun,proto3" json:"tahun,omitempty"`
+	Kondisi       string                 `protobuf:"bytes,5,opt,name=kondisi,proto3" json:"kondisi,omitempty"`
+	Kilometer     int32                  `protobuf:"varint,6,opt,name=kilometer,proto3" json:"kilometer,omitempty"`
+	Deskripsi     string                 `protobuf:"bytes,7,opt,name=deskripsi,proto3" json:"deskripsi,omitempty"`
+	Vin           string                 `protobuf:"bytes,8,opt,name=vin,proto3" json:"vin,omitempty"` // Opsional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTradeInRequest) Reset() {
+	*x = CreateTradeInRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTradeInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTradeInRequest) ProtoMessage() {}
+
+func (x *CreateTradeInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTradeInRequest.ProtoReflect.Descriptor instead.
+func (*CreateTradeInRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *CreateTradeInRequest) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *CreateTradeInRequest) GetMerk() string {
+	if x != nil {
+		return x.Merk
+	}
+	return ""
+}
+
+func (x *CreateTradeInRequest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *CreateTradeInRequest) GetTahun() int32 {
+	if x != nil {
+		return x.Tahun
+	}
+	return 0
+}
+
+func (x *CreateTradeInRequest) GetKondisi() string {
+	if x != nil {
+		return x.Kondisi
+	}
+	return ""
+}
+
+func (x *CreateTradeInRequest) GetKilometer() int32 {
+	if x != nil {
+		return x.Kilometer
+	}
+	return 0
+}
+
+func (x *CreateTradeInRequest) GetDeskripsi() string {
+	if x != nil {
+		return x.Deskripsi
+	}
+	return ""
+}
+
+func (x *CreateTradeInRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+type AppraiseTradeInRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TradeInId     string                 `protobuf:"bytes,1,opt,name=trade_in_id,json=tradeInId,proto3" json:"trade_in_id,omitempty"`
+	NilaiTaksiran *Money                 `protobuf:"bytes,2,opt,name=nilai_taksiran,json=nilaiTaksiran,proto3" json:"nilai_taksiran,omitempty"`
+	Catatan       string                 `protobuf:"bytes,3,opt,name=catatan,proto3" json:"catatan,omitempty"`
+	BerlakuHari   int32                  `protobuf:"varint,4,opt,name=berlaku_hari,json=berlakuHari,proto3" json:"berlaku_hari,omitempty"` // Masa berlaku taksiran (default 7 hari)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppraiseTradeInRequest) Reset() {
+	*x = AppraiseTradeInRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppraiseTradeInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppraiseTradeInRequest) ProtoMessage() {}
+
+func (x *AppraiseTradeInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppraiseTradeInRequest.ProtoReflect.Descriptor instead.
+func (*AppraiseTradeInRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *AppraiseTradeInRequest) GetTradeInId() string {
+	if x != nil {
+		return x.TradeInId
+	}
+	return ""
+}
+
+func (x *AppraiseTradeInRequest) GetNilaiTaksiran() *Money {
+	if x != nil {
+		return x.NilaiTaksiran
+	}
+	return nil
+}
+
+func (x *AppraiseTradeInRequest) GetCatatan() string {
+	if x != nil {
+		return x.Catatan
+	}
+	return ""
+}
+
+func (x *AppraiseTradeInRequest) GetBerlakuHari() int32 {
+	if x != nil {
+		return x.BerlakuHari
+	}
+	return 0
+}
+
+type RespondTradeInRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TradeInId     string                 `protobuf:"bytes,1,opt,name=trade_in_id,json=tradeInId,proto3" json:"trade_in_id,omitempty"`
+	Terima        bool                   `protobuf:"varint,2,opt,name=terima,proto3" json:"terima,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondTradeInRequest) Reset() {
+	*x = RespondTradeInRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondTradeInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondTradeInRequest) ProtoMessage() {}
+
+func (x *RespondTradeInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondTradeInRequest.ProtoReflect.Descriptor instead.
+func (*RespondTradeInRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *RespondTradeInRequest) GetTradeInId() string {
+	if x != nil {
+		return x.TradeInId
+	}
+	return ""
+}
+
+func (x *RespondTradeInRequest) GetTerima() bool {
+	if x != nil {
+		return x.Terima
+	}
+	return false
+}
+
+type CancelTradeInRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TradeInId     string                 `protobuf:"bytes,1,opt,name=trade_in_id,json=tradeInId,proto3" json:"trade_in_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTradeInRequest) Reset() {
+	*x = CancelTradeInRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTradeInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTradeInRequest) ProtoMessage() {}
+
+func (x *CancelTradeInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTradeInRequest.ProtoReflect.Descriptor instead.
+func (*CancelTradeInRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *CancelTradeInRequest) GetTradeInId() string {
+	if x != nil {
+		return x.TradeInId
+	}
+	return ""
+}
+
+type ListTradeInRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Peran         string                 `protobuf:"bytes,1,opt,name=peran,proto3" json:"peran,omitempty"` // pembeli/penjual (default: pembeli)
+	FilterStatus  *string                `protobuf:"bytes,2,opt,name=filter_status,json=filterStatus,proto3,oneof" json:"filter_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTradeInRequest) Reset() {
+	*x = ListTradeInRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTradeInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTradeInRequest) ProtoMessage() {}
+
+func (x *ListTradeInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTradeInRequest.ProtoReflect.Descriptor instead.
+func (*ListTradeInRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListTradeInRequest) GetPeran() string {
+	if x != nil {
+		return x.Peran
+	}
+	return ""
+}
+
+func (x *ListTradeInRequest) GetFilterStatus() string {
+	if x != nil && x.FilterStatus != nil {
+		return *x.FilterStatus
+	}
+	return ""
+}
+
+type ListTradeInResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TradeIn       []*TradeIn             `protobuf:"bytes,1,rep,name=trade_in,json=tradeIn,proto3" json:"trade_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTradeInResponse) Reset() {
+	*x = ListTradeInResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTradeInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTradeInResponse) ProtoMessage() {}
+
+func (x *ListTradeInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTradeInResponse.ProtoReflect.Descriptor instead.
+func (*ListTradeInResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListTradeInResponse) GetTradeIn() []*TradeIn {
+	if x != nil {
+		return x.TradeIn
+	}
+	return nil
+}
+
+type Ulasan struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransaksiId         string                 `protobuf:"bytes,2,opt,name=transaksi_id,json=transaksiId,proto3" json:"transaksi_id,omitempty"`
+	PenulisId           string                 `protobuf:"bytes,3,opt,name=penulis_id,json=penulisId,proto3" json:"penulis_id,omitempty"`
+	PenulisName         string                 `protobuf:"bytes,4,opt,name=penulis_name,json=penulisName,proto3" json:"penulis_name,omitempty"`
+	TargetId            string                 `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	PeranPenulis        string                 `protobuf:"bytes,6,opt,name=peran_penulis,json=peranPenulis,proto3" json:"peran_penulis,omitempty"` // pembeli/penjual
+	Rating              int32                  `protobuf:"varint,7,opt,name=rating,proto3" json:"rating,omitempty"`                                // 1-5
+	Komentar            string                 `protobuf:"bytes,8,opt,name=komentar,proto3" json:"komentar,omitempty"`
+	Disembunyikan       bool                   `protobuf:"varint,9,opt,name=disembunyikan,proto3" json:"disembunyikan,omitempty"` // Hanya terlihat oleh admin
+	AlasanDisembunyikan string                 `protobuf:"bytes,10,opt,name=alasan_disembunyikan,json=alasanDisembunyikan,proto3" json:"alasan_disembunyikan,omitempty"`
+	Merk                string                 `protobuf:"bytes,11,opt,name=merk,proto3" json:"merk,omitempty"` // Mobil yang ditransaksikan
+	Model               string                 `protobuf:"bytes,12,opt,name=model,proto3" json:"model,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Ulasan) Reset() {
+	*x = Ulasan{}
+	mi := &file_proto_carapp_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ulasan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ulasan) ProtoMessage() {}
+
+func (x *Ulasan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ulasan.ProtoReflect.Descriptor instead.
+func (*Ulasan) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *Ulasan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Ulasan) GetTransaksiId() string {
+	if x != nil {
+		return x.TransaksiId
+	}
+	return ""
+}
+
+func (x *Ulasan) GetPenulisId() string {
+	if x != nil {
+		return x.PenulisId
+	}
+	return ""
+}
+
+func (x *Ulasan) GetPenulisName() string {
+	if x != nil {
+		return x.PenulisName
+	}
+	return ""
+}
+
+func (x *Ulasan) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *Ulasan) GetPeranPenulis() string {
+	if x != nil {
+		return x.PeranPenulis
+	}
+	return ""
+}
+
+func (x *Ulasan) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Ulasan) GetKomentar() string {
+	if x != nil {
+		return x.Komentar
+	}
+	return ""
+}
+
+func (x *Ulasan) GetDisembunyikan() bool {
+	if x != nil {
+		return x.Disembunyikan
+	}
+	return false
+}
+
+func (x *Ulasan) GetAlasanDisembunyikan() string {
+	if x != nil {
+		return x.AlasanDisembunyikan
+	}
+	return ""
+}
+
+func (x *Ulasan) GetMerk() string {
+	if x != nil {
+		return x.Merk
+	}
+	return ""
+}
+
+func (x *Ulasan) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Ulasan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateUlasanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransaksiId   string                 `protobuf:"bytes,1,opt,name=transaksi_id,json=transaksiId,proto3" json:"transaksi_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	Komentar      string                 `protobuf:"bytes,3,opt,name=komentar,proto3" json:"komentar,omitempty"` // penulis_id diambil dari JWT
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUlasanRequest) Reset() {
+	*x = CreateUlasanRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUlasanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUlasanRequest) ProtoMessage() {}
+
+func (x *CreateUlasanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUlasanRequest.ProtoReflect.Descriptor instead.
+func (*CreateUlasanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *CreateUlasanRequest) GetTransaksiId() string {
+	if x != nil {
+		return x.TransaksiId
+	}
+	return ""
+}
+
+func (x *CreateUlasanRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreateUlasanRequest) GetKomentar() string {
+	if x != nil {
+		return x.Komentar
+	}
+	return ""
+}
+
+type ListUlasanRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	UserId                string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Peran                 string                 `protobuf:"bytes,2,opt,name=peran,proto3" json:"peran,omitempty"` // penjual (ulasan sebagai penjual, default) / pembeli
+	Page                  int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit                 int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	TermasukDisembunyikan bool                   `protobuf:"varint,5,opt,name=termasuk_disembunyikan,json=termasukDisembunyikan,proto3" json:"termasuk_disembunyikan,omitempty"` // Hanya berlaku untuk admin
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ListUlasanRequest) Reset() {
+	*x = ListUlasanRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUlasanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUlasanRequest) ProtoMessage() {}
+
+func (x *ListUlasanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUlasanRequest.ProtoReflect.Descriptor instead.
+func (*ListUlasanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ListUlasanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListUlasanRequest) GetPeran() string {
+	if x != nil {
+		return x.Peran
+	}
+	return ""
+}
+
+func (x *ListUlasanRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUlasanRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListUlasanRequest) GetTermasukDisembunyikan() bool {
+	if x != nil {
+		return x.TermasukDisembunyikan
+	}
+	return false
+}
+
+type ListUlasanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ulasan        []*Ulasan              `protobuf:"bytes,1,rep,name=ulasan,proto3" json:"ulasan,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUlasanResponse) Reset() {
+	*x = ListUlasanResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUlasanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUlasanResponse) ProtoMessage() {}
+
+func (x *ListUlasanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUlasanResponse.ProtoReflect.Descriptor instead.
+func (*ListUlasanResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListUlasanResponse) GetUlasan() []*Ulasan {
+	if x != nil {
+		return x.Ulasan
+	}
+	return nil
+}
+
+func (x *ListUlasanResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetProfilPenjualRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfilPenjualRequest) Reset() {
+	*x = GetProfilPenjualRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfilPenjualRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfilPenjualRequest) ProtoMessage() {}
+
+func (x *GetProfilPenjualRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfilPenjualRequest.ProtoReflect.Descriptor instead.
+func (*GetProfilPenjualRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetProfilPenjualRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ProfilPenjual struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BergabungSejak     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=bergabung_sejak,json=bergabungSejak,proto3" json:"bergabung_sejak,omitempty"`
+	Rating             float64                `protobuf:"fixed64,4,opt,name=rating,proto3" json:"rating,omitempty"` // Rata-rata rating dari pembeli (0 = belum ada)
+	JumlahUlasan       int32                  `protobuf:"varint,5,opt,name=jumlah_ulasan,json=jumlahUlasan,proto3" json:"jumlah_ulasan,omitempty"`
+	DistribusiRating   []int32                `protobuf:"varint,6,rep,packed,name=distribusi_rating,json=distribusiRating,proto3" json:"distribusi_rating,omitempty"` // Jumlah ulasan bintang 1..5 (index 0 = bintang 1)
+	JumlahTerjual      int32                  `protobuf:"varint,7,opt,name=jumlah_terjual,json=jumlahTerjual,proto3" json:"jumlah_terjual,omitempty"`
+	JumlahListingAktif int32                  `protobuf:"varint,8,opt,name=jumlah_listing_aktif,json=jumlahListingAktif,proto3" json:"jumlah_listing_aktif,omitempty"`
+	UlasanTerbaru      []*Ulasan              `protobuf:"bytes,9,rep,name=ulasan_terbaru,json=ulasanTerbaru,proto3" json:"ulasan_terbaru,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ProfilPenjual) Reset() {
+	*x = ProfilPenjual{}
+	mi := &file_proto_carapp_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfilPenjual) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfilPenjual) ProtoMessage() {}
+
+func (x *ProfilPenjual) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfilPenjual.ProtoReflect.Descriptor instead.
+func (*ProfilPenjual) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ProfilPenjual) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ProfilPenjual) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProfilPenjual) GetBergabungSejak() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BergabungSejak
+	}
+	return nil
+}
+
+func (x *ProfilPenjual) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ProfilPenjual) GetJumlahUlasan() int32 {
+	if x != nil {
+		return x.JumlahUlasan
+	}
+	return 0
+}
+
+func (x *ProfilPenjual) GetDistribusiRating() []int32 {
+	if x != nil {
+		return x.DistribusiRating
+	}
+	return nil
+}
+
+func (x *ProfilPenjual) GetJumlahTerjual() int32 {
+	if x != nil {
+		return x.JumlahTerjual
+	}
+	return 0
+}
+
+func (x *ProfilPenjual) GetJumlahListingAktif() int32 {
+	if x != nil {
+		return x.JumlahListingAktif
+	}
+	return 0
+}
+
+func (x *ProfilPenjual) GetUlasanTerbaru() []*Ulasan {
+	if x != nil {
+		return x.UlasanTerbaru
+	}
+	return nil
+}
+
+type HideUlasanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UlasanId      string                 `protobuf:"bytes,1,opt,name=ulasan_id,json=ulasanId,proto3" json:"ulasan_id,omitempty"`
+	Sembunyikan   bool                   `protobuf:"varint,2,opt,name=sembunyikan,proto3" json:"sembunyikan,omitempty"` // false = tampilkan kembali
+	Alasan        string                 `protobuf:"bytes,3,opt,name=alasan,proto3" json:"alasan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HideUlasanRequest) Reset() {
+	*x = HideUlasanRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HideUlasanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HideUlasanRequest) ProtoMessage() {}
+
+func (x *HideUlasanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HideUlasanRequest.ProtoReflect.Descriptor instead.
+func (*HideUlasanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *HideUlasanRequest) GetUlasanId() string {
+	if x != nil {
+		return x.UlasanId
+	}
+	return ""
+}
+
+func (x *HideUlasanRequest) GetSembunyikan() bool {
+	if x != nil {
+		return x.Sembunyikan
+	}
+	return false
+}
+
+func (x *HideUlasanRequest) GetAlasan() string {
+	if x != nil {
+		return x.Alasan
+	}
+	return ""
+}
+
+type WatchlistItem struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Mobil             *Mobil                 `protobuf:"bytes,1,opt,name=mobil,proto3" json:"mobil,omitempty"`
+	HargaSaatDitambah *Money                 `protobuf:"bytes,2,opt,name=harga_saat_ditambah,json=hargaSaatDitambah,proto3" json:"harga_saat_ditambah,omitempty"` // harga_jual (IDR) saat mobil disimpan
+	HargaTurun        bool                   `protobuf:"varint,3,opt,name=harga_turun,json=hargaTurun,proto3" json:"harga_turun,omitempty"`                       // Harga sekarang lebih murah dari harga_saat_ditambah
+	DitambahkanPada   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ditambahkan_pada,json=ditambahkanPada,proto3" json:"ditambahkan_pada,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WatchlistItem) Reset() {
+	*x = WatchlistItem{}
+	mi := &file_proto_carapp_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistItem) ProtoMessage() {}
+
+func (x *WatchlistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistItem.ProtoReflect.Descriptor instead.
+func (*WatchlistItem) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *WatchlistItem) GetMobil() *Mobil {
+	if x != nil {
+		return x.Mobil
+	}
+	return nil
+}
+
+func (x *WatchlistItem) GetHargaSaatDitambah() *Money {
+	if x != nil {
+		return x.HargaSaatDitambah
+	}
+	return nil
+}
+
+func (x *WatchlistItem) GetHargaTurun() bool {
+	if x != nil {
+		return x.HargaTurun
+	}
+	return false
+}
+
+func (x *WatchlistItem) GetDitambahkanPada() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DitambahkanPada
+	}
+	return nil
+}
+
+type AddToWatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToWatchlistRequest) Reset() {
+	*x = AddToWatchlistRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToWatchlistRequest) ProtoMessage() {}
+
+func (x *AddToWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*AddToWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *AddToWatchlistRequest) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+type RemoveFromWatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromWatchlistRequest) Reset() {
+	*x = RemoveFromWatchlistRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromWatchlistRequest) ProtoMessage() {}
+
+func (x *RemoveFromWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *RemoveFromWatchlistRequest) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+type ListWatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchlistRequest) Reset() {
+	*x = ListWatchlistRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchlistRequest) ProtoMessage() {}
+
+func (x *ListWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*ListWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ListWatchlistRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListWatchlistRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListWatchlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*WatchlistItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchlistResponse) Reset() {
+	*x = ListWatchlistResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchlistResponse) ProtoMessage() {}
+
+func (x *ListWatchlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchlistResponse.ProtoReflect.Descriptor instead.
+func (*ListWatchlistResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListWatchlistResponse) GetItems() []*WatchlistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListWatchlistResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SavedSearch struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nama            string                 `protobuf:"bytes,2,opt,name=nama,proto3" json:"nama,omitempty"`
+	Filter          *FilterMobil           `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	FrekuensiAlert  string                 `protobuf:"bytes,4,opt,name=frekuensi_alert,json=frekuensiAlert,proto3" json:"frekuensi_alert,omitempty"` // instan/harian/mingguan/nonaktif
+	TerakhirDikirim *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=terakhir_dikirim,json=terakhirDikirim,proto3" json:"terakhir_dikirim,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SavedSearch) Reset() {
+	*x = SavedSearch{}
+	mi := &file_proto_carapp_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearch) ProtoMessage() {}
+
+func (x *SavedSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearch.ProtoReflect.Descriptor instead.
+func (*SavedSearch) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *SavedSearch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetNama() string {
+	if x != nil {
+		return x.Nama
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetFilter() *FilterMobil {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SavedSearch) GetFrekuensiAlert() string {
+	if x != nil {
+		return x.FrekuensiAlert
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetTerakhirDikirim() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TerakhirDikirim
+	}
+	return nil
+}
+
+func (x *SavedSearch) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateSavedSearchRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Nama           string                 `protobuf:"bytes,1,opt,name=nama,proto3" json:"nama,omitempty"` // Opsional, default dibuat dari filter
+	Filter         *FilterMobil           `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	FrekuensiAlert string                 `protobuf:"bytes,3,opt,name=frekuensi_alert,json=frekuensiAlert,proto3" json:"frekuensi_alert,omitempty"` // Default: harian
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateSavedSearchRequest) Reset() {
+	*x = CreateSavedSearchRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedSearchRequest) ProtoMessage() {}
+
+func (x *CreateSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*CreateSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *CreateSavedSearchRequest) GetNama() string {
+	if x != nil {
+		return x.Nama
+	}
+	return ""
+}
+
+func (x *CreateSavedSearchRequest) GetFilter() *FilterMobil {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *CreateSavedSearchRequest) GetFrekuensiAlert() string {
+	if x != nil {
+		return x.FrekuensiAlert
+	}
+	return ""
+}
+
+type ListSavedSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchRequest) Reset() {
+	*x = ListSavedSearchRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchRequest) ProtoMessage() {}
+
+func (x *ListSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{122}
+}
+
+type ListSavedSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearch   []*SavedSearch         `protobuf:"bytes,1,rep,name=saved_search,json=savedSearch,proto3" json:"saved_search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchResponse) Reset() {
+	*x = ListSavedSearchResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchResponse) ProtoMessage() {}
+
+func (x *ListSavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ListSavedSearchResponse) GetSavedSearch() []*SavedSearch {
+	if x != nil {
+		return x.SavedSearch
+	}
+	return nil
+}
+
+type UpdateSavedSearchRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearchId  string                 `protobuf:"bytes,1,opt,name=saved_search_id,json=savedSearchId,proto3" json:"saved_search_id,omitempty"`
+	Nama           string                 `protobuf:"bytes,2,opt,name=nama,proto3" json:"nama,omitempty"`                                           // Kosong = tidak diubah
+	FrekuensiAlert string                 `protobuf:"bytes,3,opt,name=frekuensi_alert,json=frekuensiAlert,proto3" json:"frekuensi_alert,omitempty"` // Kosong = tidak diubah
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateSavedSearchRequest) Reset() {
+	*x = UpdateSavedSearchRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSavedSearchRequest) ProtoMessage() {}
+
+func (x *UpdateSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *UpdateSavedSearchRequest) GetSavedSearchId() string {
+	if x != nil {
+		return x.SavedSearchId
+	}
+	return ""
+}
+
+func (x *UpdateSavedSearchRequest) GetNama() string {
+	if x != nil {
+		return x.Nama
+	}
+	return ""
+}
+
+func (x *UpdateSavedSearchRequest) GetFrekuensiAlert() string {
+	if x != nil {
+		return x.FrekuensiAlert
+	}
+	return ""
+}
+
+type UnsubscribeSavedSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearchId string                 `protobuf:"bytes,1,opt,name=saved_search_id,json=savedSearchId,proto3" json:"saved_search_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeSavedSearchRequest) Reset() {
+	*x = UnsubscribeSavedSearchRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeSavedSearchRequest) ProtoMessage() {}
+
+func (x *UnsubscribeSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *UnsubscribeSavedSearchRequest) GetSavedSearchId() string {
+	if x != nil {
+		return x.SavedSearchId
+	}
+	return ""
+}
+
+type DeleteSavedSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearchId string                 `protobuf:"bytes,1,opt,name=saved_search_id,json=savedSearchId,proto3" json:"saved_search_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchRequest) Reset() {
+	*x = DeleteSavedSearchRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchRequest) ProtoMessage() {}
+
+func (x *DeleteSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *DeleteSavedSearchRequest) GetSavedSearchId() string {
+	if x != nil {
+		return x.SavedSearchId
+	}
+	return ""
+}
+
+type RiwayatHarga struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jenis         string                 `protobuf:"bytes,1,opt,name=jenis,proto3" json:"jenis,omitempty"`                          // listing/perubahan/terjual
+	Harga         *Money                 `protobuf:"bytes,2,opt,name=harga,proto3" json:"harga,omitempty"`                          // IDR
+	HargaAsli     *Money                 `protobuf:"bytes,3,opt,name=harga_asli,json=hargaAsli,proto3" json:"harga_asli,omitempty"` // Mata uang asli listing (jika berbeda dari IDR)
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RiwayatHarga) Reset() {
+	*x = RiwayatHarga{}
+	mi := &file_proto_carapp_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiwayatHarga) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiwayatHarga) ProtoMessage() {}
+
+func (x *RiwayatHarga) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiwayatHarga.ProtoReflect.Descriptor instead.
+func (*RiwayatHarga) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *RiwayatHarga) GetJenis() string {
+	if x != nil {
+		return x.Jenis
+	}
+	return ""
+}
+
+func (x *RiwayatHarga) GetHarga() *Money {
+	if x != nil {
+		return x.Harga
+	}
+	return nil
+}
+
+func (x *RiwayatHarga) GetHargaAsli() *Money {
+	if x != nil {
+		return x.HargaAsli
+	}
+	return nil
+}
+
+func (x *RiwayatHarga) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetPriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GetPriceHistoryRequest) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+type GetPriceHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	Riwayat       []*RiwayatHarga        `protobuf:"bytes,2,rep,name=riwayat,proto3" json:"riwayat,omitempty"` // Urut dari yang paling lama
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryResponse) Reset() {
+	*x = GetPriceHistoryResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryResponse) ProtoMessage() {}
+
+func (x *GetPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *GetPriceHistoryResponse) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *GetPriceHistoryResponse) GetRiwayat() []*RiwayatHarga {
+	if x != nil {
+		return x.Riwayat
+	}
+	return nil
+}
+
+type GetMarketPriceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Isi mobil_id (bucket diambil dari mobil tsb) atau merk + model + tahun
+	MobilId        string `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	Merk           string `protobuf:"bytes,2,opt,name=merk,proto3" json:"merk,omitempty"`
+	Model          string `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	Tahun          int32  `protobuf:"varint,4,opt,name=tahun,proto3" json:"tahun,omitempty"`
+	ToleransiTahun int32  `protobuf:"varint,5,opt,name=toleransi_tahun,json=toleransiTahun,proto3" json:"toleransi_tahun,omitempty"` // tahun +- toleransi (0-3, default 0)
+	PeriodeBulan   int32  `protobuf:"varint,6,opt,name=periode_bulan,json=periodeBulan,proto3" json:"periode_bulan,omitempty"`       // Harga terjual dalam N bulan terakhir (default 12)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetMarketPriceRequest) Reset() {
+	*x = GetMarketPriceRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketPriceRequest) ProtoMessage() {}
+
+func (x *GetMarketPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketPriceRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketPriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetMarketPriceRequest) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *GetMarketPriceRequest) GetMerk() string {
+	if x != nil {
+		return x.Merk
+	}
+	return ""
+}
+
+func (x *GetMarketPriceRequest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *GetMarketPriceRequest) GetTahun() int32 {
+	if x != nil {
+		return x.Tahun
+	}
+	return 0
+}
+
+func (x *GetMarketPriceRequest) GetToleransiTahun() int32 {
+	if x != nil {
+		return x.ToleransiTahun
+	}
+	return 0
+}
+
+func (x *GetMarketPriceRequest) GetPeriodeBulan() int32 {
+	if x != nil {
+		return x.PeriodeBulan
+	}
+	return 0
+}
+
+// Statistik satu kelompok harga (semua dalam IDR)
+type StatistikHarga struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jumlah        int32                  `protobuf:"varint,1,opt,name=jumlah,proto3" json:"jumlah,omitempty"`
+	Minimum       *Money                 `protobuf:"bytes,2,opt,name=minimum,proto3" json:"minimum,omitempty"`
+	P25           *Money                 `protobuf:"bytes,3,opt,name=p25,proto3" json:"p25,omitempty"`
+	Median        *Money                 `protobuf:"bytes,4,opt,name=median,proto3" json:"median,omitempty"`
+	P75           *Money                 `protobuf:"bytes,5,opt,name=p75,proto3" json:"p75,omitempty"`
+	Maksimum      *Money                 `protobuf:"bytes,6,opt,name=maksimum,proto3" json:"maksimum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatistikHarga) Reset() {
+	*x = StatistikHarga{}
+	mi := &file_proto_carapp_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatistikHarga) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatistikHarga) ProtoMessage() {}
+
+func (x *StatistikHarga) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatistikHarga.ProtoReflect.Descriptor instead.
+func (*StatistikHarga) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *StatistikHarga) GetJumlah() int32 {
+	if x != nil {
+		return x.Jumlah
+	}
+	return 0
+}
+
+func (x *StatistikHarga) GetMinimum() *Money {
+	if x != nil {
+		return x.Minimum
+	}
+	return nil
+}
+
+func (x *StatistikHarga) GetP25() *Money {
+	if x != nil {
+		return x.P25
+	}
+	return nil
+}
+
+func (x *StatistikHarga) GetMedian() *Money {
+	if x != nil {
+		return x.Median
+	}
+	return nil
+}
+
+func (x *StatistikHarga) GetP75() *Money {
+	if x != nil {
+		return x.P75
+	}
+	return nil
+}
+
+func (x *StatistikHarga) GetMaksimum() *Money {
+	if x != nil {
+		return x.Maksimum
+	}
+	return nil
+}
+
+type ListingPasar struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	Harga         *Money                 `protobuf:"bytes,2,opt,name=harga,proto3" json:"harga,omitempty"`
+	SelisihPersen float64                `protobuf:"fixed64,3,opt,name=selisih_persen,json=selisihPersen,proto3" json:"selisih_persen,omitempty"` // Terhadap median acuan (positif = lebih mahal)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListingPasar) Reset() {
+	*x = ListingPasar{}
+	mi := &file_proto_carapp_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListingPasar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListingPasar) ProtoMessage() {}
+
+func (x *ListingPasar) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListingPasar.ProtoReflect.Descriptor instead.
+func (*ListingPasar) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ListingPasar) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *ListingPasar) GetHarga() *Money {
+	if x != nil {
+		return x.Harga
+	}
+	return nil
+}
+
+func (x *ListingPasar) GetSelisihPersen() float64 {
+	if x != nil {
+		return x.SelisihPersen
+	}
+	return 0
+}
+
+type MarketPrice struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Merk         string                 `protobuf:"bytes,1,opt,name=merk,proto3" json:"merk,omitempty"`
+	Model        string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	TahunMin     int32                  `protobuf:"varint,3,opt,name=tahun_min,json=tahunMin,proto3" json:"tahun_min,omitempty"`
+	TahunMax     int32                  `protobuf:"varint,4,opt,name=tahun_max,json=tahunMax,proto3" json:"tahun_max,omitempty"`
+	HargaMinta   *StatistikHarga        `protobuf:"bytes,5,opt,name=harga_minta,json=hargaMinta,proto3" json:"harga_minta,omitempty"`       // Listing 'tersedia'
+	HargaTerjual *StatistikHarga        `protobuf:"bytes,6,opt,name=harga_terjual,json=hargaTerjual,proto3" json:"harga_terjual,omitempty"` // Transaksi selesai dalam periode
+	Acuan        string                 `protobuf:"bytes,7,opt,name=acuan,proto3" json:"acuan,omitempty"`                                   // terjual/minta: median yang dipakai untuk penilaian
+	// Penilaian mobil_id dari request (kosong jika request tanpa mobil_id)
+	Listing            *ListingPasar `protobuf:"bytes,8,opt,name=listing,proto3" json:"listing,omitempty"`
+	ListingDiAtasPasar bool          `protobuf:"varint,9,opt,name=listing_di_atas_pasar,json=listingDiAtasPasar,proto3" json:"listing_di_atas_pasar,omitempty"`
+	// Listing tersedia di bucket ini yang harganya jauh di atas median acuan
+	DiAtasPasar   []*ListingPasar `protobuf:"bytes,10,rep,name=di_atas_pasar,json=diAtasPasar,proto3" json:"di_atas_pasar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketPrice) Reset() {
+	*x = MarketPrice{}
+	mi := &file_proto_carapp_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketPrice) ProtoMessage() {}
+
+func (x *MarketPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketPrice.ProtoReflect.Descriptor instead.
+func (*MarketPrice) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *MarketPrice) GetMerk() string {
+	if x != nil {
+		return x.Merk
+	}
+	return ""
+}
+
+func (x *MarketPrice) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *MarketPrice) GetTahunMin() int32 {
+	if x != nil {
+		return x.TahunMin
+	}
+	return 0
+}
+
+func (x *MarketPrice) GetTahunMax() int32 {
+	if x != nil {
+		return x.TahunMax
+	}
+	return 0
+}
+
+func (x *MarketPrice) GetHargaMinta() *StatistikHarga {
+	if x != nil {
+		return x.HargaMinta
+	}
+	return nil
+}
+
+func (x *MarketPrice) GetHargaTerjual() *StatistikHarga {
+	if x != nil {
+		return x.HargaTerjual
+	}
+	return nil
+}
+
+func (x *MarketPrice) GetAcuan() string {
+	if x != nil {
+		return x.Acuan
+	}
+	return ""
+}
+
+func (x *MarketPrice) GetListing() *ListingPasar {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+func (x *MarketPrice) GetListingDiAtasPasar() bool {
+	if x != nil {
+		return x.ListingDiAtasPasar
+	}
+	return false
+}
+
+func (x *MarketPrice) GetDiAtasPasar() []*ListingPasar {
+	if x != nil {
+		return x.DiAtasPasar
+	}
+	return nil
+}
+
+type LaporanListing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MobilId       string                 `protobuf:"bytes,2,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	PelaporId     string                 `protobuf:"bytes,3,opt,name=pelapor_id,json=pelaporId,proto3" json:"pelapor_id,omitempty"`
+	Alasan        string                 `protobuf:"bytes,4,opt,name=alasan,proto3" json:"alasan,omitempty"`
+	Keterangan    string                 `protobuf:"bytes,5,opt,name=keterangan,proto3" json:"keterangan,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // terbuka/ditindak/diabaikan
+	CatatanAdmin  string                 `protobuf:"bytes,7,opt,name=catatan_admin,json=catatanAdmin,proto3" json:"catatan_admin,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DitanganiPada *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=ditangani_pada,json=ditanganiPada,proto3" json:"ditangani_pada,omitempty"`
+	// Ringkasan listing untuk antrean admin
+	JudulMobil           string `protobuf:"bytes,10,opt,name=judul_mobil,json=judulMobil,proto3" json:"judul_mobil,omitempty"` // "2023 Toyota Fortuner"
+	StatusMobil          string `protobuf:"bytes,11,opt,name=status_mobil,json=statusMobil,proto3" json:"status_mobil,omitempty"`
+	JumlahLaporanTerbuka int32  `protobuf:"varint,12,opt,name=jumlah_laporan_terbuka,json=jumlahLaporanTerbuka,proto3" json:"jumlah_laporan_terbuka,omitempty"` // Semua pelapor untuk listing ini
+	SkorRisiko           int32  `protobuf:"varint,13,opt,name=skor_risiko,json=skorRisiko,proto3" json:"skor_risiko,omitempty"`                                 // Penilaian risiko terakhir (0 = belum dinilai)
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *LaporanListing) Reset() {
+	*x = LaporanListing{}
+	mi := &file_proto_carapp_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LaporanListing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LaporanListing) ProtoMessage() {}
+
+func (x *LaporanListing) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LaporanListing.ProtoReflect.Descriptor instead.
+func (*LaporanListing) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *LaporanListing) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LaporanListing) GetMobilId() string {
+	if x != nil {
+		return x.MobilId
+	}
+	return ""
+}
+
+func (x *LaporanListing) GetPelaporId() string {
+	if x != nil {
+		return x.PelaporId
+	}
+	return ""
+}
+
+func (x *LaporanListing) GetAlasan() string {
+	if x != nil {
+		return x.Alasan
+	}
+	return ""
+}
+
+func (x *LaporanListing) GetKeterangan() string {
+	if x != nil {
+		return x.Keterangan
+	}
+	return ""
+}
+
+func (x *LaporanListing) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LaporanListing) GetCatatanAdmin() string {
+	if x != nil {
+		return x.CatatanAdmin
+	}
+	return ""
+}
+
+func (x *LaporanListing) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LaporanListing) GetDitanganiPada() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DitanganiPada
+	}
+	return nil
+}
+
+func (x *LaporanListing) GetJudulMobil() string {
+	if x != nil {
+		return x.JudulMobil
+	}
+	return ""
+}
+
+func (x *LaporanListing) GetStatusMobil() string {
+	if x != nil {
+		return x.StatusMobil
+	}
+	return ""
+}
+
+func (x *LaporanListing) GetJumlahLaporanTerbuka() int32 {
+	if x != nil {
+		return x.JumlahLaporanTerbuka
+	}
+	return 0
+}
+
+func (x *LaporanListing) GetSkorRisiko() int32 {
+	if x != nil {
+		return x.SkorRisiko
+	}
+	return 0
+}
+
+type ListLaporanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // terbuka (default)/ditindak/diabaikan
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLaporanRequest) Reset() {
+	*x = ListLaporanRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLaporanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLaporanRequest) ProtoMessage() {}
+
+func (x *ListLaporanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLaporanRequest.ProtoReflect.Descriptor instead.
+func (*ListLaporanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ListLaporanRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListLaporanRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListLaporanRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListLaporanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Laporan       []*LaporanListing      `protobuf:"bytes,1,rep,name=laporan,proto3" json:"laporan,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLaporanResponse) Reset() {
+	*x = ListLaporanResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLaporanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLaporanResponse) ProtoMessage() {}
+
+func (x *ListLaporanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLaporanResponse.ProtoReflect.Descriptor instead.
+func (*ListLaporanResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ListLaporanResponse) GetLaporan() []*LaporanListing {
+	if x != nil {
+		return x.Laporan
+	}
+	return nil
+}
+
+func (x *ListLaporanResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type TanganiLaporanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LaporanId     string                 `protobuf:"bytes,1,opt,name=laporan_id,json=laporanId,proto3" json:"laporan_id,omitempty"`
+	Tindakan      string                 `protobuf:"bytes,2,opt,name=tindakan,proto3" json:"tindakan,omitempty"` // tahan/abaikan
+	Catatan       string                 `protobuf:"bytes,3,opt,name=catatan,proto3" json:"catatan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TanganiLaporanRequest) Reset() {
+	*x = TanganiLaporanRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TanganiLaporanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TanganiLaporanRequest) ProtoMessage() {}
+
+func (x *TanganiLaporanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TanganiLaporanRequest.ProtoReflect.Descriptor instead.
+func (*TanganiLaporanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *TanganiLaporanRequest) GetLaporanId() string {
+	if x != nil {
+		return x.LaporanId
+	}
+	return ""
+}
+
+func (x *TanganiLaporanRequest) GetTindakan() string {
+	if x != nil {
+		return x.Tindakan
+	}
+	return ""
+}
+
+func (x *TanganiLaporanRequest) GetCatatan() string {
+	if x != nil {
+		return x.Catatan
+	}
+	return ""
+}
+
+type LaporanUser struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PelaporId          string                 `protobuf:"bytes,2,opt,name=pelapor_id,json=pelaporId,proto3" json:"pelapor_id,omitempty"`
+	PelaporNama        string                 `protobuf:"bytes,3,opt,name=pelapor_nama,json=pelaporNama,proto3" json:"pelapor_nama,omitempty"`
+	DilaporkanId       string                 `protobuf:"bytes,4,opt,name=dilaporkan_id,json=dilaporkanId,proto3" json:"dilaporkan_id,omitempty"`
+	DilaporkanNama     string                 `protobuf:"bytes,5,opt,name=dilaporkan_nama,json=dilaporkanNama,proto3" json:"dilaporkan_nama,omitempty"`
+	PercakapanId       string                 `protobuf:"bytes,6,opt,name=percakapan_id,json=percakapanId,proto3" json:"percakapan_id,omitempty"` // Kosong jika laporan tidak dari percakapan
+	Alasan             string                 `protobuf:"bytes,7,opt,name=alasan,proto3" json:"alasan,omitempty"`
+	Status             string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // baru/ditinjau/ditutup
+	CatatanAdmin       string                 `protobuf:"bytes,9,opt,name=catatan_admin,json=catatanAdmin,proto3" json:"catatan_admin,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DitanganiPada      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=ditangani_pada,json=ditanganiPada,proto3" json:"ditangani_pada,omitempty"`
+	JumlahLaporanAktif int32                  `protobuf:"varint,12,opt,name=jumlah_laporan_aktif,json=jumlahLaporanAktif,proto3" json:"jumlah_laporan_aktif,omitempty"` // Laporan baru/ditinjau untuk user yang dilaporkan (semua pelapor)
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *LaporanUser) Reset() {
+	*x = LaporanUser{}
+	mi := &file_proto_carapp_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LaporanUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LaporanUser) ProtoMessage() {}
+
+func (x *LaporanUser) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LaporanUser.ProtoReflect.Descriptor instead.
+func (*LaporanUser) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *LaporanUser) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LaporanUser) GetPelaporId() string {
+	if x != nil {
+		return x.PelaporId
+	}
+	return ""
+}
+
+func (x *LaporanUser) GetPelaporNama() string {
+	if x != nil {
+		return x.PelaporNama
+	}
+	return ""
+}
+
+func (x *LaporanUser) GetDilaporkanId() string {
+	if x != nil {
+		return x.DilaporkanId
+	}
+	return ""
+}
+
+func (x *LaporanUser) GetDilaporkanNama() string {
+	if x != nil {
+		return x.DilaporkanNama
+	}
+	return ""
+}
+
+func (x *LaporanUser) GetPercakapanId() string {
+	if x != nil {
+		return x.PercakapanId
+	}
+	return ""
+}
+
+func (x *LaporanUser) GetAlasan() string {
+	if x != nil {
+		return x.Alasan
+	}
+	return ""
+}
+
+func (x *LaporanUser) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LaporanUser) GetCatatanAdmin() string {
+	if x != nil {
+		return x.CatatanAdmin
+	}
+	return ""
+}
+
+func (x *LaporanUser) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LaporanUser) GetDitanganiPada() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DitanganiPada
+	}
+	return nil
+}
+
+func (x *LaporanUser) GetJumlahLaporanAktif() int32 {
+	if x != nil {
+		return x.JumlahLaporanAktif
+	}
+	return 0
+}
+
+type ListLaporanUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // baru (default)/ditinjau/ditutup
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLaporanUserRequest) Reset() {
+	*x = ListLaporanUserRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RentMobilRequest) String() string {
+func (x *ListLaporanUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RentMobilRequest) ProtoMessage() {}
+func (*ListLaporanUserRequest) ProtoMessage() {}
 
-func (x *RentMobilRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[20]
+func (x *ListLaporanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1340,54 +9650,55 @@ func (x *RentMobilRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RentMobilRequest.ProtoReflect.Descriptor instead.
-func (*RentMobilRequest) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListLaporanUserRequest.ProtoReflect.Descriptor instead.
+func (*ListLaporanUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *RentMobilRequest) GetMobilId() string {
+func (x *ListLaporanUserRequest) GetStatus() string {
 	if x != nil {
-		return x.MobilId
+		return x.Status
 	}
 	return ""
 }
 
-func (x *RentMobilRequest) GetTanggalMulai() string {
+func (x *ListLaporanUserRequest) GetPage() int32 {
 	if x != nil {
-		return x.TanggalMulai
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *RentMobilRequest) GetTanggalSelesai() string {
+func (x *ListLaporanUserRequest) GetLimit() int32 {
 	if x != nil {
-		return x.TanggalSelesai
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type CompleteRentalRequest struct {
+type ListLaporanUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RentalId      string                 `protobuf:"bytes,1,opt,name=rental_id,json=rentalId,proto3" json:"rental_id,omitempty"`
+	Laporan       []*LaporanUser         `protobuf:"bytes,1,rep,name=laporan,proto3" json:"laporan,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompleteRentalRequest) Reset() {
-	*x = CompleteRentalRequest{}
-	mi := &file_proto_carapp_proto_msgTypes[21]
+func (x *ListLaporanUserResponse) Reset() {
+	*x = ListLaporanUserResponse{}
+	mi := &file_proto_carapp_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompleteRentalRequest) String() string {
+func (x *ListLaporanUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteRentalRequest) ProtoMessage() {}
+func (*ListLaporanUserResponse) ProtoMessage() {}
 
-func (x *CompleteRentalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[21]
+func (x *ListLaporanUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1398,48 +9709,49 @@ func (x *CompleteRentalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteRentalRequest.ProtoReflect.Descriptor instead.
-func (*CompleteRentalRequest) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ListLaporanUserResponse.ProtoReflect.Descriptor instead.
+func (*ListLaporanUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *CompleteRentalRequest) GetRentalId() string {
+func (x *ListLaporanUserResponse) GetLaporan() []*LaporanUser {
 	if x != nil {
-		return x.RentalId
+		return x.Laporan
 	}
-	return ""
+	return nil
 }
 
-type TransaksiRentalResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	MobilId        string                 `protobuf:"bytes,2,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
-	PemilikId      string                 `protobuf:"bytes,3,opt,name=pemilik_id,json=pemilikId,proto3" json:"pemilik_id,omitempty"`
-	PenyewaId      string                 `protobuf:"bytes,4,opt,name=penyewa_id,json=penyewaId,proto3" json:"penyewa_id,omitempty"`
-	TanggalMulai   string                 `protobuf:"bytes,5,opt,name=tanggal_mulai,json=tanggalMulai,proto3" json:"tanggal_mulai,omitempty"`
-	TanggalSelesai string                 `protobuf:"bytes,6,opt,name=tanggal_selesai,json=tanggalSelesai,proto3" json:"tanggal_selesai,omitempty"`
-	Total          float64                `protobuf:"fixed64,7,opt,name=total,proto3" json:"total,omitempty"`
-	Status         string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	Denda          float64                `protobuf:"fixed64,9,opt,name=denda,proto3" json:"denda,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ListLaporanUserResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
 }
 
-func (x *TransaksiRentalResponse) Reset() {
-	*x = TransaksiRentalResponse{}
-	mi := &file_proto_carapp_proto_msgTypes[22]
+type TanganiLaporanUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LaporanId     string                 `protobuf:"bytes,1,opt,name=laporan_id,json=laporanId,proto3" json:"laporan_id,omitempty"`
+	Tindakan      string                 `protobuf:"bytes,2,opt,name=tindakan,proto3" json:"tindakan,omitempty"` // tinjau/tutup
+	Catatan       string                 `protobuf:"bytes,3,opt,name=catatan,proto3" json:"catatan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TanganiLaporanUserRequest) Reset() {
+	*x = TanganiLaporanUserRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransaksiRentalResponse) String() string {
+func (x *TanganiLaporanUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransaksiRentalResponse) ProtoMessage() {}
+func (*TanganiLaporanUserRequest) ProtoMessage() {}
 
-func (x *TransaksiRentalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[22]
+func (x *TanganiLaporanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1450,95 +9762,100 @@ func (x *TransaksiRentalResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransaksiRentalResponse.ProtoReflect.Descriptor instead.
-func (*TransaksiRentalResponse) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use TanganiLaporanUserRequest.ProtoReflect.Descriptor instead.
+func (*TanganiLaporanUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *TransaksiRentalResponse) GetId() string {
+func (x *TanganiLaporanUserRequest) GetLaporanId() string {
 	if x != nil {
-		return x.Id
+		return x.LaporanId
 	}
 	return ""
 }
 
-func (x *TransaksiRentalResponse) GetMobilId() string {
+func (x *TanganiLaporanUserRequest) GetTindakan() string {
 	if x != nil {
-		return x.MobilId
+		return x.Tindakan
 	}
 	return ""
 }
 
-func (x *TransaksiRentalResponse) GetPemilikId() string {
+func (x *TanganiLaporanUserRequest) GetCatatan() string {
 	if x != nil {
-		return x.PemilikId
+		return x.Catatan
 	}
 	return ""
 }
 
-func (x *TransaksiRentalResponse) GetPenyewaId() string {
-	if x != nil {
-		return x.PenyewaId
-	}
-	return ""
+type GetRisikoMobilRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransaksiRentalResponse) GetTanggalMulai() string {
-	if x != nil {
-		return x.TanggalMulai
-	}
-	return ""
+func (x *GetRisikoMobilRequest) Reset() {
+	*x = GetRisikoMobilRequest{}
+	mi := &file_proto_carapp_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransaksiRentalResponse) GetTanggalSelesai() string {
-	if x != nil {
-		return x.TanggalSelesai
-	}
-	return ""
+func (x *GetRisikoMobilRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TransaksiRentalResponse) GetTotal() float64 {
+func (*GetRisikoMobilRequest) ProtoMessage() {}
+
+func (x *GetRisikoMobilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[142]
 	if x != nil {
-		return x.Total
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *TransaksiRentalResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use GetRisikoMobilRequest.ProtoReflect.Descriptor instead.
+func (*GetRisikoMobilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *TransaksiRentalResponse) GetDenda() float64 {
+func (x *GetRisikoMobilRequest) GetMobilId() string {
 	if x != nil {
-		return x.Denda
+		return x.MobilId
 	}
-	return 0
+	return ""
 }
 
-type GetNotificationsRequest struct {
+type SinyalRisiko struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Aturan        string                 `protobuf:"bytes,1,opt,name=aturan,proto3" json:"aturan,omitempty"` // harga_di_bawah_pasar/foto_duplikat/akun_baru_banyak_listing/vin_duplikat/laporan_pengguna
+	Skor          int32                  `protobuf:"varint,2,opt,name=skor,proto3" json:"skor,omitempty"`
+	Keterangan    string                 `protobuf:"bytes,3,opt,name=keterangan,proto3" json:"keterangan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNotificationsRequest) Reset() {
-	*x = GetNotificationsRequest{}
-	mi := &file_proto_carapp_proto_msgTypes[23]
+func (x *SinyalRisiko) Reset() {
+	*x = SinyalRisiko{}
+	mi := &file_proto_carapp_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNotificationsRequest) String() string {
+func (x *SinyalRisiko) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNotificationsRequest) ProtoMessage() {}
+func (*SinyalRisiko) ProtoMessage() {}
 
-func (x *GetNotificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[23]
+func (x *SinyalRisiko) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1549,36 +9866,58 @@ func (x *GetNotificationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNotificationsRequest.ProtoReflect.Descriptor instead.
-func (*GetNotificationsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use SinyalRisiko.ProtoReflect.Descriptor instead.
+func (*SinyalRisiko) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{143}
 }
 
-type DashboardSummary struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	TotalMobilAnda     int32                  `protobuf:"varint,1,opt,name=total_mobil_anda,json=totalMobilAnda,proto3" json:"total_mobil_anda,omitempty"`
-	TransaksiAktif     int32                  `protobuf:"varint,2,opt,name=transaksi_aktif,json=transaksiAktif,proto3" json:"transaksi_aktif,omitempty"`
-	PendapatanTerakhir float64                `protobuf:"fixed64,3,opt,name=pendapatan_terakhir,json=pendapatanTerakhir,proto3" json:"pendapatan_terakhir,omitempty"`
-	NotifikasiBaru     int32                  `protobuf:"varint,4,opt,name=notifikasi_baru,json=notifikasiBaru,proto3" json:"notifikasi_baru,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *SinyalRisiko) GetAturan() string {
+	if x != nil {
+		return x.Aturan
+	}
+	return ""
 }
 
-func (x *DashboardSummary) Reset() {
-	*x = DashboardSummary{}
-	mi := &file_proto_carapp_proto_msgTypes[24]
+func (x *SinyalRisiko) GetSkor() int32 {
+	if x != nil {
+		return x.Skor
+	}
+	return 0
+}
+
+func (x *SinyalRisiko) GetKeterangan() string {
+	if x != nil {
+		return x.Keterangan
+	}
+	return ""
+}
+
+type PenilaianRisiko struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MobilId       string                 `protobuf:"bytes,1,opt,name=mobil_id,json=mobilId,proto3" json:"mobil_id,omitempty"`
+	Skor          int32                  `protobuf:"varint,2,opt,name=skor,proto3" json:"skor,omitempty"` // 0-100
+	Sinyal        []*SinyalRisiko        `protobuf:"bytes,3,rep,name=sinyal,proto3" json:"sinyal,omitempty"`
+	Ditahan       bool                   `protobuf:"varint,4,opt,name=ditahan,proto3" json:"ditahan,omitempty"` // Listing ditahan otomatis ke antrean moderasi
+	DinilaiPada   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=dinilai_pada,json=dinilaiPada,proto3" json:"dinilai_pada,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PenilaianRisiko) Reset() {
+	*x = PenilaianRisiko{}
+	mi := &file_proto_carapp_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DashboardSummary) String() string {
+func (x *PenilaianRisiko) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DashboardSummary) ProtoMessage() {}
+func (*PenilaianRisiko) ProtoMessage() {}
 
-func (x *DashboardSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carapp_proto_msgTypes[24]
+func (x *PenilaianRisiko) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carapp_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1589,44 +9928,55 @@ func (x *DashboardSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DashboardSummary.ProtoReflect.Descriptor instead.
-func (*DashboardSummary) Descriptor() ([]byte, []int) {
-	return file_proto_carapp_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use PenilaianRisiko.ProtoReflect.Descriptor instead.
+func (*PenilaianRisiko) Descriptor() ([]byte, []int) {
+	return file_proto_carapp_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *DashboardSummary) GetTotalMobilAnda() int32 {
+func (x *PenilaianRisiko) GetMobilId() string {
 	if x != nil {
-		return x.TotalMobilAnda
+		return x.MobilId
 	}
-	return 0
+	return ""
 }
 
-func (x *DashboardSummary) GetTransaksiAktif() int32 {
+func (x *PenilaianRisiko) GetSkor() int32 {
 	if x != nil {
-		return x.TransaksiAktif
+		return x.Skor
 	}
 	return 0
 }
 
-func (x *DashboardSummary) GetPendapatanTerakhir() float64 {
+func (x *PenilaianRisiko) GetSinyal() []*SinyalRisiko {
 	if x != nil {
-		return x.PendapatanTerakhir
+		return x.Sinyal
 	}
-	return 0
+	return nil
 }
 
-func (x *DashboardSummary) GetNotifikasiBaru() int32 {
+func (x *PenilaianRisiko) GetDitahan() bool {
 	if x != nil {
-		return x.NotifikasiBaru
+		return x.Ditahan
 	}
-	return 0
+	return false
+}
+
+func (x *PenilaianRisiko) GetDinilaiPada() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DinilaiPada
+	}
+	return nil
 }
 
 var File_proto_carapp_proto protoreflect.FileDescriptor
 
 const file_proto_carapp_proto_rawDesc = "" +
 	"\n" +
-	"\x12proto/carapp.proto\x12\x06carapp\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xa5\x01\n" +
+	"\x12proto/carapp.proto\x12\x06carapp\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"M\n" +
+	"\x05Money\x12\x1f\n" +
+	"\vminor_units\x18\x01 \x01(\x03R\n" +
+	"minorUnits\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\"\xa5\x01\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -1634,7 +9984,7 @@ const file_proto_carapp_proto_rawDesc = "" +
 	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x12\n" +
 	"\x04role\x18\x05 \x01(\tR\x04role\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa1\x03\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb9\t\n" +
 	"\x05Mobil\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x1d\n" +
@@ -1644,16 +9994,44 @@ const file_proto_carapp_proto_rawDesc = "" +
 	"\x05model\x18\x04 \x01(\tR\x05model\x12\x14\n" +
 	"\x05tahun\x18\x05 \x01(\x05R\x05tahun\x12\x18\n" +
 	"\akondisi\x18\x06 \x01(\tR\akondisi\x12\x1c\n" +
-	"\tdeskripsi\x18\a \x01(\tR\tdeskripsi\x12\x1d\n" +
+	"\tdeskripsi\x18\a \x01(\tR\tdeskripsi\x12!\n" +
 	"\n" +
-	"harga_jual\x18\b \x01(\x01R\thargaJual\x12\x19\n" +
+	"harga_jual\x18\b \x01(\x01B\x02\x18\x01R\thargaJual\x12\x19\n" +
 	"\bfoto_url\x18\t \x01(\tR\afotoUrl\x12\x16\n" +
 	"\x06lokasi\x18\n" +
 	" \x01(\tR\x06lokasi\x12\x16\n" +
 	"\x06status\x18\v \x01(\tR\x06status\x129\n" +
 	"\n" +
 	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x121\n" +
-	"\x15harga_rental_per_hari\x18\x0e \x01(\x01R\x12hargaRentalPerHari\"\xeb\x01\n" +
+	"\x15harga_rental_per_hari\x18\x0e \x01(\x01R\x12hargaRentalPerHari\x127\n" +
+	"\x10harga_jual_money\x18\x0f \x01(\v2\r.carapp.MoneyR\x0ehargaJualMoney\x127\n" +
+	"\x10harga_asli_money\x18\x10 \x01(\v2\r.carapp.MoneyR\x0ehargaAsliMoney\x12;\n" +
+	"\x12harga_tampil_money\x18\x11 \x01(\v2\r.carapp.MoneyR\x10hargaTampilMoney\x12!\n" +
+	"\fowner_rating\x18\x12 \x01(\x01R\vownerRating\x12.\n" +
+	"\x13owner_jumlah_ulasan\x18\x13 \x01(\x05R\x11ownerJumlahUlasan\x12!\n" +
+	"\tkilometer\x18\x14 \x01(\x05H\x00R\tkilometer\x88\x01\x01\x12\x1c\n" +
+	"\ttransmisi\x18\x15 \x01(\tR\ttransmisi\x12\x1f\n" +
+	"\vbahan_bakar\x18\x16 \x01(\tR\n" +
+	"bahanBakar\x12\x14\n" +
+	"\x05warna\x18\x17 \x01(\tR\x05warna\x12\x1b\n" +
+	"\ttipe_bodi\x18\x18 \x01(\tR\btipeBodi\x12!\n" +
+	"\fjumlah_kursi\x18\x19 \x01(\x05R\vjumlahKursi\x12\x1f\n" +
+	"\vplat_region\x18\x1a \x01(\tR\n" +
+	"platRegion\x12\x1f\n" +
+	"\blatitude\x18\x1b \x01(\x01H\x01R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\x1c \x01(\x01H\x02R\tlongitude\x88\x01\x01\x12\x1e\n" +
+	"\bjarak_km\x18\x1d \x01(\x01H\x03R\ajarakKm\x88\x01\x01\x12A\n" +
+	"\x0eberlaku_sampai\x18\x1e \x01(\v2\x1a.google.protobuf.TimestampR\rberlakuSampai\x12)\n" +
+	"\x10alasan_penolakan\x18\x1f \x01(\tR\x0falasanPenolakan\x12\x10\n" +
+	"\x03vin\x18  \x01(\tR\x03vin\x12\x1d\n" +
+	"\n" +
+	"nomor_stok\x18! \x01(\tR\tnomorStokB\f\n" +
+	"\n" +
+	"_kilometerB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitudeB\v\n" +
+	"\t_jarak_km\"\xeb\x01\n" +
 	"\n" +
 	"Notifikasi\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
@@ -1674,35 +10052,167 @@ const file_proto_carapp_proto_rawDesc = "" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"F\n" +
 	"\fAuthResponse\x12 \n" +
 	"\x04user\x18\x01 \x01(\v2\f.carapp.UserR\x04user\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\"\x91\x02\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\xc9\x05\n" +
 	"\x12CreateMobilRequest\x12\x12\n" +
 	"\x04merk\x18\x01 \x01(\tR\x04merk\x12\x14\n" +
 	"\x05model\x18\x02 \x01(\tR\x05model\x12\x14\n" +
 	"\x05tahun\x18\x03 \x01(\x05R\x05tahun\x12\x18\n" +
 	"\akondisi\x18\x04 \x01(\tR\akondisi\x12\x1c\n" +
-	"\tdeskripsi\x18\x05 \x01(\tR\tdeskripsi\x12\x1d\n" +
+	"\tdeskripsi\x18\x05 \x01(\tR\tdeskripsi\x12!\n" +
 	"\n" +
-	"harga_jual\x18\x06 \x01(\x01R\thargaJual\x12\x19\n" +
+	"harga_jual\x18\x06 \x01(\x01B\x02\x18\x01R\thargaJual\x12\x19\n" +
 	"\bfoto_url\x18\a \x01(\tR\afotoUrl\x12\x16\n" +
 	"\x06lokasi\x18\b \x01(\tR\x06lokasi\x121\n" +
-	"\x15harga_rental_per_hari\x18\t \x01(\x01R\x12hargaRentalPerHari\"x\n" +
+	"\x15harga_rental_per_hari\x18\t \x01(\x01R\x12hargaRentalPerHari\x127\n" +
+	"\x10harga_jual_money\x18\n" +
+	" \x01(\v2\r.carapp.MoneyR\x0ehargaJualMoney\x12!\n" +
+	"\tkilometer\x18\v \x01(\x05H\x00R\tkilometer\x88\x01\x01\x12\x1c\n" +
+	"\ttransmisi\x18\f \x01(\tR\ttransmisi\x12\x1f\n" +
+	"\vbahan_bakar\x18\r \x01(\tR\n" +
+	"bahanBakar\x12\x14\n" +
+	"\x05warna\x18\x0e \x01(\tR\x05warna\x12\x1b\n" +
+	"\ttipe_bodi\x18\x0f \x01(\tR\btipeBodi\x12!\n" +
+	"\fjumlah_kursi\x18\x10 \x01(\x05R\vjumlahKursi\x12\x1f\n" +
+	"\vplat_region\x18\x11 \x01(\tR\n" +
+	"platRegion\x12\x1f\n" +
+	"\blatitude\x18\x12 \x01(\x01H\x01R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\x13 \x01(\x01H\x02R\tlongitude\x88\x01\x01\x12!\n" +
+	"\fsimpan_draft\x18\x14 \x01(\bR\vsimpanDraft\x12\x10\n" +
+	"\x03vin\x18\x15 \x01(\tR\x03vinB\f\n" +
+	"\n" +
+	"_kilometerB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"\x84\x02\n" +
 	"\x10ListMobilRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12(\n" +
-	"\rfilter_status\x18\x03 \x01(\tH\x00R\ffilterStatus\x88\x01\x01B\x10\n" +
-	"\x0e_filter_status\"P\n" +
+	"\rfilter_status\x18\x03 \x01(\tH\x00R\ffilterStatus\x88\x01\x01\x12.\n" +
+	"\x10display_currency\x18\x04 \x01(\tH\x01R\x0fdisplayCurrency\x88\x01\x01\x12+\n" +
+	"\x06filter\x18\x05 \x01(\v2\x13.carapp.FilterMobilR\x06filter\x12\x18\n" +
+	"\aurutkan\x18\x06 \x01(\tR\aurutkanB\x10\n" +
+	"\x0e_filter_statusB\x13\n" +
+	"\x11_display_currency\"\xf8\x04\n" +
+	"\vFilterMobil\x12\x12\n" +
+	"\x04merk\x18\x01 \x01(\tR\x04merk\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x12\x1b\n" +
+	"\ttahun_min\x18\x03 \x01(\x05R\btahunMin\x12\x1b\n" +
+	"\ttahun_max\x18\x04 \x01(\x05R\btahunMax\x12*\n" +
+	"\tharga_min\x18\x05 \x01(\v2\r.carapp.MoneyR\bhargaMin\x12*\n" +
+	"\tharga_max\x18\x06 \x01(\v2\r.carapp.MoneyR\bhargaMax\x12\x16\n" +
+	"\x06lokasi\x18\a \x01(\tR\x06lokasi\x12\x18\n" +
+	"\akondisi\x18\b \x01(\tR\akondisi\x12#\n" +
+	"\rkilometer_max\x18\t \x01(\x05R\fkilometerMax\x12\x1c\n" +
+	"\ttransmisi\x18\n" +
+	" \x01(\tR\ttransmisi\x12\x1f\n" +
+	"\vbahan_bakar\x18\v \x01(\tR\n" +
+	"bahanBakar\x12\x14\n" +
+	"\x05warna\x18\f \x01(\tR\x05warna\x12\x1b\n" +
+	"\ttipe_bodi\x18\r \x01(\tR\btipeBodi\x12(\n" +
+	"\x10jumlah_kursi_min\x18\x0e \x01(\x05R\x0ejumlahKursiMin\x12\x1f\n" +
+	"\vplat_region\x18\x0f \x01(\tR\n" +
+	"platRegion\x12\x1f\n" +
+	"\blatitude\x18\x10 \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\x11 \x01(\x01H\x01R\tlongitude\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"dekat_kota\x18\x12 \x01(\tR\tdekatKota\x12\x1b\n" +
+	"\tradius_km\x18\x13 \x01(\x01R\bradiusKmB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"P\n" +
 	"\x11ListMobilResponse\x12%\n" +
 	"\x06mobils\x18\x01 \x03(\v2\r.carapp.MobilR\x06mobils\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\",\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"q\n" +
 	"\x0fGetMobilRequest\x12\x19\n" +
-	"\bmobil_id\x18\x01 \x01(\tR\amobilId\"o\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x12.\n" +
+	"\x10display_currency\x18\x02 \x01(\tH\x00R\x0fdisplayCurrency\x88\x01\x01B\x13\n" +
+	"\x11_display_currency\"o\n" +
 	"\x11UploadFotoRequest\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
 	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1b\n" +
 	"\tfile_data\x18\x03 \x01(\fR\bfileData\"@\n" +
 	"\x12UploadFotoResponse\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"5\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"m\n" +
+	"\x17UpdateHargaMobilRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x127\n" +
+	"\x10harga_jual_money\x18\x02 \x01(\v2\r.carapp.MoneyR\x0ehargaJualMoney\"I\n" +
+	"\x14WithdrawMobilRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x12\x16\n" +
+	"\x06alasan\x18\x02 \x01(\tR\x06alasan\".\n" +
+	"\x11RenewMobilRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\"d\n" +
+	"\x17UpdateDraftMobilRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x12.\n" +
+	"\x04data\x18\x02 \x01(\v2\x1a.carapp.CreateMobilRequestR\x04data\"0\n" +
+	"\x13PublishMobilRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\"D\n" +
+	"\x18ListModerasiMobilRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"c\n" +
+	"\x14ModerasiMobilRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x12\x18\n" +
+	"\asetujui\x18\x02 \x01(\bR\asetujui\x12\x16\n" +
+	"\x06alasan\x18\x03 \x01(\tR\x06alasan\"g\n" +
+	"\x12ReportMobilRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x12\x16\n" +
+	"\x06alasan\x18\x02 \x01(\tR\x06alasan\x12\x1e\n" +
+	"\n" +
+	"keterangan\x18\x03 \x01(\tR\n" +
+	"keterangan\"]\n" +
+	"\x16BulkImportMobilRequest\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\xb8\x01\n" +
+	"\x10HasilImportBaris\x12\x14\n" +
+	"\x05baris\x18\x01 \x01(\x05R\x05baris\x12\x1d\n" +
+	"\n" +
+	"nomor_stok\x18\x02 \x01(\tR\tnomorStok\x12\x10\n" +
+	"\x03vin\x18\x03 \x01(\tR\x03vin\x12\x12\n" +
+	"\x04aksi\x18\x04 \x01(\tR\x04aksi\x12\x19\n" +
+	"\bmobil_id\x18\x05 \x01(\tR\amobilId\x12\x16\n" +
+	"\x06errors\x18\x06 \x03(\tR\x06errors\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\"\xb3\x01\n" +
+	"\x17BulkImportMobilResponse\x12\x16\n" +
+	"\x06dibuat\x18\x01 \x01(\x05R\x06dibuat\x12\x1e\n" +
+	"\n" +
+	"diperbarui\x18\x02 \x01(\x05R\n" +
+	"diperbarui\x12\x14\n" +
+	"\x05gagal\x18\x03 \x01(\x05R\x05gagal\x12\x1a\n" +
+	"\bdisimpan\x18\x04 \x01(\bR\bdisimpan\x12.\n" +
+	"\x05hasil\x18\x05 \x03(\v2\x18.carapp.HasilImportBarisR\x05hasil\"\x90\x01\n" +
+	"\x16ExportInventoryRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x16\n" +
+	"\x06status\x18\x02 \x03(\tR\x06status\x12+\n" +
+	"\x06filter\x18\x03 \x01(\v2\x13.carapp.FilterMobilR\x06filter\x12\x19\n" +
+	"\bowner_id\x18\x04 \x01(\tR\aownerId\"*\n" +
+	"\x14ExportInventoryChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\x8e\x01\n" +
+	"\x16GetSimilarMobilRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12.\n" +
+	"\x10display_currency\x18\x03 \x01(\tH\x00R\x0fdisplayCurrency\x88\x01\x01B\x13\n" +
+	"\x11_display_currency\"]\n" +
+	"\n" +
+	"MobilMirip\x12#\n" +
+	"\x05mobil\x18\x01 \x01(\v2\r.carapp.MobilR\x05mobil\x12\x12\n" +
+	"\x04skor\x18\x02 \x01(\x05R\x04skor\x12\x16\n" +
+	"\x06alasan\x18\x03 \x03(\tR\x06alasan\"E\n" +
+	"\x17GetSimilarMobilResponse\x12*\n" +
+	"\x06mobils\x18\x01 \x03(\v2\x12.carapp.MobilMiripR\x06mobils\"w\n" +
+	"\x13CompareMobilRequest\x12\x1b\n" +
+	"\tmobil_ids\x18\x01 \x03(\tR\bmobilIds\x12.\n" +
+	"\x10display_currency\x18\x02 \x01(\tH\x00R\x0fdisplayCurrency\x88\x01\x01B\x13\n" +
+	"\x11_display_currency\"\x9a\x01\n" +
+	"\x11BarisPerbandingan\x12\x18\n" +
+	"\aatribut\x18\x01 \x01(\tR\aatribut\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x14\n" +
+	"\x05nilai\x18\x03 \x03(\tR\x05nilai\x12\x18\n" +
+	"\aberbeda\x18\x04 \x01(\bR\aberbeda\x12%\n" +
+	"\x0eindeks_terbaik\x18\x05 \x01(\x05R\rindeksTerbaik\"n\n" +
+	"\x14CompareMobilResponse\x12%\n" +
+	"\x06mobils\x18\x01 \x03(\v2\r.carapp.MobilR\x06mobils\x12/\n" +
+	"\x05baris\x18\x02 \x03(\v2\x19.carapp.BarisPerbandinganR\x05baris\"5\n" +
 	"\x04Make\x12\x19\n" +
 	"\bbrand_id\x18\x01 \x01(\tR\abrandId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"Q\n" +
@@ -1716,18 +10226,82 @@ const file_proto_carapp_proto_rawDesc = "" +
 	"\x17GetModelsForMakeRequest\x12\x19\n" +
 	"\bbrand_id\x18\x01 \x01(\tR\abrandId\"A\n" +
 	"\x18GetModelsForMakeResponse\x12%\n" +
-	"\x06models\x18\x01 \x03(\v2\r.carapp.ModelR\x06models\",\n" +
+	"\x06models\x18\x01 \x03(\v2\r.carapp.ModelR\x06models\"L\n" +
 	"\x0fBuyMobilRequest\x12\x19\n" +
-	"\bmobil_id\x18\x01 \x01(\tR\amobilId\"\xae\x01\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x12\x1e\n" +
+	"\vtrade_in_id\x18\x02 \x01(\tR\ttradeInId\"\xd2\x04\n" +
 	"\x15TransaksiJualResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bmobil_id\x18\x02 \x01(\tR\amobilId\x12\x1d\n" +
 	"\n" +
 	"penjual_id\x18\x03 \x01(\tR\tpenjualId\x12\x1d\n" +
 	"\n" +
-	"pembeli_id\x18\x04 \x01(\tR\tpembeliId\x12\x14\n" +
-	"\x05total\x18\x05 \x01(\x01R\x05total\x12\x16\n" +
-	"\x06status\x18\x06 \x01(\tR\x06status\"{\n" +
+	"pembeli_id\x18\x04 \x01(\tR\tpembeliId\x12\x18\n" +
+	"\x05total\x18\x05 \x01(\x01B\x02\x18\x01R\x05total\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12A\n" +
+	"\x0ereserved_until\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rreservedUntil\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
+	"\falasan_batal\x18\t \x01(\tR\valasanBatal\x12*\n" +
+	"\x11payment_charge_id\x18\n" +
+	" \x01(\tR\x0fpaymentChargeId\x12\x1f\n" +
+	"\vpayment_url\x18\v \x01(\tR\n" +
+	"paymentUrl\x12%\n" +
+	"\x0epayment_status\x18\f \x01(\tR\rpaymentStatus\x12.\n" +
+	"\vtotal_money\x18\r \x01(\v2\r.carapp.MoneyR\n" +
+	"totalMoney\x12\x1e\n" +
+	"\vtrade_in_id\x18\x0e \x01(\tR\ttradeInId\x129\n" +
+	"\x11potongan_trade_in\x18\x0f \x01(\v2\r.carapp.MoneyR\x0fpotonganTradeIn\"8\n" +
+	"\x13PayTransaksiRequest\x12!\n" +
+	"\ftransaksi_id\x18\x01 \x01(\tR\vtransaksiId\"<\n" +
+	"\x17ConfirmTransaksiRequest\x12!\n" +
+	"\ftransaksi_id\x18\x01 \x01(\tR\vtransaksiId\"=\n" +
+	"\x18CompleteTransaksiRequest\x12!\n" +
+	"\ftransaksi_id\x18\x01 \x01(\tR\vtransaksiId\"S\n" +
+	"\x16CancelTransaksiRequest\x12!\n" +
+	"\ftransaksi_id\x18\x01 \x01(\tR\vtransaksiId\x12\x16\n" +
+	"\x06alasan\x18\x02 \x01(\tR\x06alasan\"\xfb\x01\n" +
+	"\x19ListMyTransactionsRequest\x12\x14\n" +
+	"\x05peran\x18\x01 \x01(\tR\x05peran\x12(\n" +
+	"\rfilter_status\x18\x02 \x01(\tH\x00R\ffilterStatus\x88\x01\x01\x12.\n" +
+	"\x04dari\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04dari\x122\n" +
+	"\x06sampai\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06sampai\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limitB\x10\n" +
+	"\x0e_filter_status\"i\n" +
+	"\x1aListMyTransactionsResponse\x125\n" +
+	"\ttransaksi\x18\x01 \x03(\v2\x17.carapp.TransaksiDetailR\ttransaksi\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\":\n" +
+	"\x15GetTransactionRequest\x12!\n" +
+	"\ftransaksi_id\x18\x01 \x01(\tR\vtransaksiId\"`\n" +
+	"\x0ePihakTransaksi\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\"\xdf\x03\n" +
+	"\x0fTransaksiDetail\x12;\n" +
+	"\ttransaksi\x18\x01 \x01(\v2\x1d.carapp.TransaksiJualResponseR\ttransaksi\x12#\n" +
+	"\x05mobil\x18\x02 \x01(\v2\r.carapp.MobilR\x05mobil\x120\n" +
+	"\apenjual\x18\x03 \x01(\v2\x16.carapp.PihakTransaksiR\apenjual\x120\n" +
+	"\apembeli\x18\x04 \x01(\v2\x16.carapp.PihakTransaksiR\apembeli\x12\x14\n" +
+	"\x05peran\x18\x05 \x01(\tR\x05peran\x123\n" +
+	"\apaid_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06paidAt\x12=\n" +
+	"\fconfirmed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vconfirmedAt\x12=\n" +
+	"\fcompleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12=\n" +
+	"\fcancelled_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\"6\n" +
+	"\x11GetInvoiceRequest\x12!\n" +
+	"\ftransaksi_id\x18\x01 \x01(\tR\vtransaksiId\"\xa9\x02\n" +
+	"\x0fInvoiceResponse\x12\x14\n" +
+	"\x05nomor\x18\x01 \x01(\tR\x05nomor\x12!\n" +
+	"\ftransaksi_id\x18\x02 \x01(\tR\vtransaksiId\x12\x18\n" +
+	"\x05total\x18\x03 \x01(\x01B\x02\x18\x01R\x05total\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1a\n" +
+	"\bfilename\x18\x05 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x06 \x01(\tR\vcontentType\x12\x19\n" +
+	"\bpdf_data\x18\a \x01(\fR\apdfData\x12.\n" +
+	"\vtotal_money\x18\b \x01(\v2\r.carapp.MoneyR\n" +
+	"totalMoney\"{\n" +
 	"\x10RentMobilRequest\x12\x19\n" +
 	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x12#\n" +
 	"\rtanggal_mulai\x18\x02 \x01(\tR\ftanggalMulai\x12'\n" +
@@ -1746,32 +10320,619 @@ const file_proto_carapp_proto_rawDesc = "" +
 	"\x05total\x18\a \x01(\x01R\x05total\x12\x16\n" +
 	"\x06status\x18\b \x01(\tR\x06status\x12\x14\n" +
 	"\x05denda\x18\t \x01(\x01R\x05denda\"\x19\n" +
-	"\x17GetNotificationsRequest\"\xbf\x01\n" +
+	"\x17GetNotificationsRequest\"\x8e\x02\n" +
 	"\x10DashboardSummary\x12(\n" +
 	"\x10total_mobil_anda\x18\x01 \x01(\x05R\x0etotalMobilAnda\x12'\n" +
-	"\x0ftransaksi_aktif\x18\x02 \x01(\x05R\x0etransaksiAktif\x12/\n" +
-	"\x13pendapatan_terakhir\x18\x03 \x01(\x01R\x12pendapatanTerakhir\x12'\n" +
-	"\x0fnotifikasi_baru\x18\x04 \x01(\x05R\x0enotifikasiBaru2}\n" +
+	"\x0ftransaksi_aktif\x18\x02 \x01(\x05R\x0etransaksiAktif\x123\n" +
+	"\x13pendapatan_terakhir\x18\x03 \x01(\x01B\x02\x18\x01R\x12pendapatanTerakhir\x12'\n" +
+	"\x0fnotifikasi_baru\x18\x04 \x01(\x05R\x0enotifikasiBaru\x12I\n" +
+	"\x19pendapatan_terakhir_money\x18\x05 \x01(\v2\r.carapp.MoneyR\x17pendapatanTerakhirMoney\"\xe0\x04\n" +
+	"\tPenawaran\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bmobil_id\x18\x02 \x01(\tR\amobilId\x12\x1d\n" +
+	"\n" +
+	"pembeli_id\x18\x03 \x01(\tR\tpembeliId\x12\x1d\n" +
+	"\n" +
+	"penjual_id\x18\x04 \x01(\tR\tpenjualId\x12\x18\n" +
+	"\x05harga\x18\x05 \x01(\x01B\x02\x18\x01R\x05harga\x12!\n" +
+	"\n" +
+	"harga_jual\x18\x06 \x01(\x01B\x02\x18\x01R\thargaJual\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x18\n" +
+	"\agiliran\x18\b \x01(\tR\agiliran\x12\x14\n" +
+	"\x05pesan\x18\t \x01(\tR\x05pesan\x12!\n" +
+	"\ftransaksi_id\x18\n" +
+	" \x01(\tR\vtransaksiId\x129\n" +
+	"\n" +
+	"expires_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04merk\x18\x0e \x01(\tR\x04merk\x12\x14\n" +
+	"\x05model\x18\x0f \x01(\tR\x05model\x12.\n" +
+	"\vharga_money\x18\x10 \x01(\v2\r.carapp.MoneyR\n" +
+	"hargaMoney\x127\n" +
+	"\x10harga_jual_money\x18\x11 \x01(\v2\r.carapp.MoneyR\x0ehargaJualMoney\"\x93\x01\n" +
+	"\x16CreatePenawaranRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x12\x18\n" +
+	"\x05harga\x18\x02 \x01(\x01B\x02\x18\x01R\x05harga\x12\x14\n" +
+	"\x05pesan\x18\x03 \x01(\tR\x05pesan\x12.\n" +
+	"\vharga_money\x18\x04 \x01(\v2\r.carapp.MoneyR\n" +
+	"hargaMoney\"\xb0\x01\n" +
+	"\x17RespondPenawaranRequest\x12!\n" +
+	"\fpenawaran_id\x18\x01 \x01(\tR\vpenawaranId\x12\x12\n" +
+	"\x04aksi\x18\x02 \x01(\tR\x04aksi\x12\x18\n" +
+	"\x05harga\x18\x03 \x01(\x01B\x02\x18\x01R\x05harga\x12\x14\n" +
+	"\x05pesan\x18\x04 \x01(\tR\x05pesan\x12.\n" +
+	"\vharga_money\x18\x05 \x01(\v2\r.carapp.MoneyR\n" +
+	"hargaMoney\"\x88\x01\n" +
+	"\x18RespondPenawaranResponse\x12/\n" +
+	"\tpenawaran\x18\x01 \x01(\v2\x11.carapp.PenawaranR\tpenawaran\x12;\n" +
+	"\ttransaksi\x18\x02 \x01(\v2\x1d.carapp.TransaksiJualResponseR\ttransaksi\";\n" +
+	"\x16CancelPenawaranRequest\x12!\n" +
+	"\fpenawaran_id\x18\x01 \x01(\tR\vpenawaranId\"\x83\x01\n" +
+	"\x14ListPenawaranRequest\x12\x14\n" +
+	"\x05peran\x18\x01 \x01(\tR\x05peran\x12(\n" +
+	"\rfilter_status\x18\x02 \x01(\tH\x00R\ffilterStatus\x88\x01\x01\x12\x19\n" +
+	"\bmobil_id\x18\x03 \x01(\tR\amobilIdB\x10\n" +
+	"\x0e_filter_status\"H\n" +
+	"\x15ListPenawaranResponse\x12/\n" +
+	"\tpenawaran\x18\x01 \x03(\v2\x11.carapp.PenawaranR\tpenawaran\"\xfd\x02\n" +
+	"\n" +
+	"Percakapan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bmobil_id\x18\x02 \x01(\tR\amobilId\x12\x1d\n" +
+	"\n" +
+	"pembeli_id\x18\x03 \x01(\tR\tpembeliId\x12\x1d\n" +
+	"\n" +
+	"penjual_id\x18\x04 \x01(\tR\tpenjualId\x12!\n" +
+	"\fpembeli_name\x18\x05 \x01(\tR\vpembeliName\x12!\n" +
+	"\fpenjual_name\x18\x06 \x01(\tR\vpenjualName\x12\x12\n" +
+	"\x04merk\x18\a \x01(\tR\x04merk\x12\x14\n" +
+	"\x05model\x18\b \x01(\tR\x05model\x128\n" +
+	"\x0epesan_terakhir\x18\t \x01(\v2\x11.carapp.PesanChatR\rpesanTerakhir\x12!\n" +
+	"\fbelum_dibaca\x18\n" +
+	" \x01(\x05R\vbelumDibaca\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xe3\x01\n" +
+	"\tPesanChat\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rpercakapan_id\x18\x02 \x01(\tR\fpercakapanId\x12\x1f\n" +
+	"\vpengirim_id\x18\x03 \x01(\tR\n" +
+	"pengirimId\x12\x10\n" +
+	"\x03isi\x18\x04 \x01(\tR\x03isi\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x123\n" +
+	"\aread_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06readAt\"\xc7\x01\n" +
+	"\tChatEvent\x12\x12\n" +
+	"\x04tipe\x18\x01 \x01(\tR\x04tipe\x12'\n" +
+	"\x05pesan\x18\x02 \x01(\v2\x11.carapp.PesanChatR\x05pesan\x12#\n" +
+	"\rpercakapan_id\x18\x03 \x01(\tR\fpercakapanId\x12\x1f\n" +
+	"\vdibaca_oleh\x18\x04 \x01(\tR\n" +
+	"dibacaOleh\x127\n" +
+	"\tdibaca_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdibacaAt\"3\n" +
+	"\x16StartPercakapanRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\"\x17\n" +
+	"\x15ListPercakapanRequest\"L\n" +
+	"\x16ListPercakapanResponse\x122\n" +
+	"\n" +
+	"percakapan\x18\x01 \x03(\v2\x12.carapp.PercakapanR\n" +
+	"percakapan\"I\n" +
+	"\x10SendPesanRequest\x12#\n" +
+	"\rpercakapan_id\x18\x01 \x01(\tR\fpercakapanId\x12\x10\n" +
+	"\x03isi\x18\x02 \x01(\tR\x03isi\"\x83\x01\n" +
+	"\x10ListPesanRequest\x12#\n" +
+	"\rpercakapan_id\x18\x01 \x01(\tR\fpercakapanId\x124\n" +
+	"\asetelah\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\asetelah\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"<\n" +
+	"\x11ListPesanResponse\x12'\n" +
+	"\x05pesan\x18\x01 \x03(\v2\x11.carapp.PesanChatR\x05pesan\"9\n" +
+	"\x12StreamPesanRequest\x12#\n" +
+	"\rpercakapan_id\x18\x01 \x01(\tR\fpercakapanId\"8\n" +
+	"\x11MarkDibacaRequest\x12#\n" +
+	"\rpercakapan_id\x18\x01 \x01(\tR\fpercakapanId\",\n" +
+	"\x12MarkDibacaResponse\x12\x16\n" +
+	"\x06jumlah\x18\x01 \x01(\x05R\x06jumlah\"+\n" +
+	"\x10BlockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"i\n" +
+	"\x11ReportUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
+	"\rpercakapan_id\x18\x02 \x01(\tR\fpercakapanId\x12\x16\n" +
+	"\x06alasan\x18\x03 \x01(\tR\x06alasan\"\xee\x01\n" +
+	"\n" +
+	"SlotJadwal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"penjual_id\x18\x02 \x01(\tR\tpenjualId\x12\x19\n" +
+	"\bmobil_id\x18\x03 \x01(\tR\amobilId\x12\x16\n" +
+	"\x06lokasi\x18\x04 \x01(\tR\x06lokasi\x120\n" +
+	"\x05mulai\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05mulai\x124\n" +
+	"\aselesai\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aselesai\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\"\xae\x01\n" +
+	"\x11CreateSlotRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x12\x16\n" +
+	"\x06lokasi\x18\x02 \x01(\tR\x06lokasi\x120\n" +
+	"\x05mulai\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05mulai\x124\n" +
+	"\aselesai\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aselesai\"c\n" +
+	"\x0fListSlotRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x12\x1d\n" +
+	"\n" +
+	"penjual_id\x18\x02 \x01(\tR\tpenjualId\x12\x16\n" +
+	"\x06lokasi\x18\x03 \x01(\tR\x06lokasi\":\n" +
+	"\x10ListSlotResponse\x12&\n" +
+	"\x04slot\x18\x01 \x03(\v2\x12.carapp.SlotJadwalR\x04slot\",\n" +
+	"\x11DeleteSlotRequest\x12\x17\n" +
+	"\aslot_id\x18\x01 \x01(\tR\x06slotId\"\xdb\x03\n" +
+	"\tJanjiTemu\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aslot_id\x18\x02 \x01(\tR\x06slotId\x12\x19\n" +
+	"\bmobil_id\x18\x03 \x01(\tR\amobilId\x12\x1d\n" +
+	"\n" +
+	"pembeli_id\x18\x04 \x01(\tR\tpembeliId\x12\x1d\n" +
+	"\n" +
+	"penjual_id\x18\x05 \x01(\tR\tpenjualId\x12\x12\n" +
+	"\x04tipe\x18\x06 \x01(\tR\x04tipe\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x18\n" +
+	"\acatatan\x18\b \x01(\tR\acatatan\x12!\n" +
+	"\falasan_batal\x18\t \x01(\tR\valasanBatal\x120\n" +
+	"\x05mulai\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x05mulai\x124\n" +
+	"\aselesai\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\aselesai\x12\x16\n" +
+	"\x06lokasi\x18\f \x01(\tR\x06lokasi\x12\x12\n" +
+	"\x04merk\x18\r \x01(\tR\x04merk\x12\x14\n" +
+	"\x05model\x18\x0e \x01(\tR\x05model\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"x\n" +
+	"\x14BookJanjiTemuRequest\x12\x17\n" +
+	"\aslot_id\x18\x01 \x01(\tR\x06slotId\x12\x19\n" +
+	"\bmobil_id\x18\x02 \x01(\tR\amobilId\x12\x12\n" +
+	"\x04tipe\x18\x03 \x01(\tR\x04tipe\x12\x18\n" +
+	"\acatatan\x18\x04 \x01(\tR\acatatan\"T\n" +
+	"\x16CancelJanjiTemuRequest\x12\"\n" +
+	"\rjanji_temu_id\x18\x01 \x01(\tR\vjanjiTemuId\x12\x16\n" +
+	"\x06alasan\x18\x02 \x01(\tR\x06alasan\"U\n" +
+	"\x14ListJanjiTemuRequest\x12\x14\n" +
+	"\x05peran\x18\x01 \x01(\tR\x05peran\x12'\n" +
+	"\x0ftermasuk_lampau\x18\x02 \x01(\bR\x0etermasukLampau\"I\n" +
+	"\x15ListJanjiTemuResponse\x120\n" +
+	"\n" +
+	"janji_temu\x18\x01 \x03(\v2\x11.carapp.JanjiTemuR\tjanjiTemu\"\x8f\x01\n" +
+	"\x04Kurs\x12$\n" +
+	"\x0emata_uang_asal\x18\x01 \x01(\tR\fmataUangAsal\x12(\n" +
+	"\x10mata_uang_tujuan\x18\x02 \x01(\tR\x0emataUangTujuan\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\x12#\n" +
+	"\rberlaku_mulai\x18\x04 \x01(\tR\fberlakuMulai\".\n" +
+	"\x11ImportKursRequest\x12\x19\n" +
+	"\bcsv_data\x18\x01 \x01(\fR\acsvData\"D\n" +
+	"\x12ImportKursResponse\x12\x16\n" +
+	"\x06jumlah\x18\x01 \x01(\x05R\x06jumlah\x12\x16\n" +
+	"\x06errors\x18\x02 \x03(\tR\x06errors\"a\n" +
+	"\x0fListKursRequest\x12$\n" +
+	"\x0emata_uang_asal\x18\x01 \x01(\tR\fmataUangAsal\x12(\n" +
+	"\x10mata_uang_tujuan\x18\x02 \x01(\tR\x0emataUangTujuan\"4\n" +
+	"\x10ListKursResponse\x12 \n" +
+	"\x04kurs\x18\x01 \x03(\v2\f.carapp.KursR\x04kurs\"\x90\x03\n" +
+	"\fProdukKredit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04nama\x18\x02 \x01(\tR\x04nama\x12\x18\n" +
+	"\alembaga\x18\x03 \x01(\tR\alembaga\x12*\n" +
+	"\x11bunga_flat_persen\x18\x04 \x01(\tR\x0fbungaFlatPersen\x120\n" +
+	"\x14bunga_efektif_persen\x18\x05 \x01(\tR\x12bungaEfektifPersen\x12&\n" +
+	"\x0ftenor_min_bulan\x18\x06 \x01(\x05R\rtenorMinBulan\x12&\n" +
+	"\x0ftenor_max_bulan\x18\a \x01(\x05R\rtenorMaxBulan\x12\"\n" +
+	"\rdp_min_persen\x18\b \x01(\tR\vdpMinPersen\x12.\n" +
+	"\vbiaya_admin\x18\t \x01(\v2\r.carapp.MoneyR\n" +
+	"biayaAdmin\x12\x18\n" +
+	"\akondisi\x18\n" +
+	" \x01(\tR\akondisi\x12&\n" +
+	"\x0fusia_maks_tahun\x18\v \x01(\x05R\rusiaMaksTahun\"4\n" +
+	"\x17ListProdukKreditRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\"H\n" +
+	"\x18ListProdukKreditResponse\x12,\n" +
+	"\x06produk\x18\x01 \x03(\v2\x14.carapp.ProdukKreditR\x06produk\"\xa3\x01\n" +
+	"\x15SimulateKreditRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x12\x1b\n" +
+	"\tproduk_id\x18\x02 \x01(\tR\bprodukId\x12\x1b\n" +
+	"\tdp_persen\x18\x03 \x01(\x01R\bdpPersen\x12\x1f\n" +
+	"\vtenor_bulan\x18\x04 \x01(\x05R\n" +
+	"tenorBulan\x12\x14\n" +
+	"\x05skema\x18\x05 \x01(\tR\x05skema\"\xce\x01\n" +
+	"\x0eAngsuranKredit\x12\x19\n" +
+	"\bbulan_ke\x18\x01 \x01(\x05R\abulanKe\x12)\n" +
+	"\bangsuran\x18\x02 \x01(\v2\r.carapp.MoneyR\bangsuran\x12#\n" +
+	"\x05pokok\x18\x03 \x01(\v2\r.carapp.MoneyR\x05pokok\x12#\n" +
+	"\x05bunga\x18\x04 \x01(\v2\r.carapp.MoneyR\x05bunga\x12,\n" +
+	"\n" +
+	"sisa_pokok\x18\x05 \x01(\v2\r.carapp.MoneyR\tsisaPokok\"\xfa\x04\n" +
+	"\x0eSimulasiKredit\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x12,\n" +
+	"\x06produk\x18\x02 \x01(\v2\x14.carapp.ProdukKreditR\x06produk\x12\x14\n" +
+	"\x05skema\x18\x03 \x01(\tR\x05skema\x123\n" +
+	"\x16bunga_per_tahun_persen\x18\x04 \x01(\tR\x13bungaPerTahunPersen\x12\x1f\n" +
+	"\vtenor_bulan\x18\x05 \x01(\x05R\n" +
+	"tenorBulan\x12#\n" +
+	"\x05harga\x18\x06 \x01(\v2\r.carapp.MoneyR\x05harga\x12\x1d\n" +
+	"\x02dp\x18\a \x01(\v2\r.carapp.MoneyR\x02dp\x124\n" +
+	"\x0epokok_pinjaman\x18\b \x01(\v2\r.carapp.MoneyR\rpokokPinjaman\x12;\n" +
+	"\x12angsuran_per_bulan\x18\t \x01(\v2\r.carapp.MoneyR\x10angsuranPerBulan\x12.\n" +
+	"\vtotal_bunga\x18\n" +
+	" \x01(\v2\r.carapp.MoneyR\n" +
+	"totalBunga\x12.\n" +
+	"\vbiaya_admin\x18\v \x01(\v2\r.carapp.MoneyR\n" +
+	"biayaAdmin\x12<\n" +
+	"\x12pembayaran_pertama\x18\f \x01(\v2\r.carapp.MoneyR\x11pembayaranPertama\x12.\n" +
+	"\vtotal_bayar\x18\r \x01(\v2\r.carapp.MoneyR\n" +
+	"totalBayar\x12.\n" +
+	"\x06jadwal\x18\x0e \x03(\v2\x16.carapp.AngsuranKreditR\x06jadwal\"\x83\x05\n" +
+	"\aTradeIn\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"pembeli_id\x18\x02 \x01(\tR\tpembeliId\x12\x1d\n" +
+	"\n" +
+	"penjual_id\x18\x03 \x01(\tR\tpenjualId\x12\x19\n" +
+	"\bmobil_id\x18\x04 \x01(\tR\amobilId\x12\x12\n" +
+	"\x04merk\x18\x05 \x01(\tR\x04merk\x12\x14\n" +
+	"\x05model\x18\x06 \x01(\tR\x05model\x12\x14\n" +
+	"\x05tahun\x18\a \x01(\x05R\x05tahun\x12\x18\n" +
+	"\akondisi\x18\b \x01(\tR\akondisi\x12\x1c\n" +
+	"\tkilometer\x18\t \x01(\x05R\tkilometer\x12\x1c\n" +
+	"\tdeskripsi\x18\n" +
+	" \x01(\tR\tdeskripsi\x12\x10\n" +
+	"\x03vin\x18\v \x01(\tR\x03vin\x12+\n" +
+	"\x11vin_terverifikasi\x18\f \x01(\bR\x10vinTerverifikasi\x12\x16\n" +
+	"\x06status\x18\r \x01(\tR\x06status\x124\n" +
+	"\x0enilai_taksiran\x18\x0e \x01(\v2\r.carapp.MoneyR\rnilaiTaksiran\x12'\n" +
+	"\x0fcatatan_penilai\x18\x0f \x01(\tR\x0ecatatanPenilai\x12A\n" +
+	"\x0eberlaku_sampai\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\rberlakuSampai\x12!\n" +
+	"\ftransaksi_id\x18\x11 \x01(\tR\vtransaksiId\x12\"\n" +
+	"\rmobil_baru_id\x18\x12 \x01(\tR\vmobilBaruId\x129\n" +
+	"\n" +
+	"created_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"$\n" +
+	"\x10DecodeVinRequest\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\"\xa3\x01\n" +
+	"\x11DecodeVinResponse\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\x12\x12\n" +
+	"\x04merk\x18\x02 \x01(\tR\x04merk\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12\x14\n" +
+	"\x05tahun\x18\x04 \x01(\x05R\x05tahun\x12\x1b\n" +
+	"\ttipe_bodi\x18\x05 \x01(\tR\btipeBodi\x12\x1f\n" +
+	"\vbahan_bakar\x18\x06 \x01(\tR\n" +
+	"bahanBakar\"\xd9\x01\n" +
+	"\x14CreateTradeInRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x12\x12\n" +
+	"\x04merk\x18\x02 \x01(\tR\x04merk\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12\x14\n" +
+	"\x05tahun\x18\x04 \x01(\x05R\x05tahun\x12\x18\n" +
+	"\akondisi\x18\x05 \x01(\tR\akondisi\x12\x1c\n" +
+	"\tkilometer\x18\x06 \x01(\x05R\tkilometer\x12\x1c\n" +
+	"\tdeskripsi\x18\a \x01(\tR\tdeskripsi\x12\x10\n" +
+	"\x03vin\x18\b \x01(\tR\x03vin\"\xab\x01\n" +
+	"\x16AppraiseTradeInRequest\x12\x1e\n" +
+	"\vtrade_in_id\x18\x01 \x01(\tR\ttradeInId\x124\n" +
+	"\x0enilai_taksiran\x18\x02 \x01(\v2\r.carapp.MoneyR\rnilaiTaksiran\x12\x18\n" +
+	"\acatatan\x18\x03 \x01(\tR\acatatan\x12!\n" +
+	"\fberlaku_hari\x18\x04 \x01(\x05R\vberlakuHari\"O\n" +
+	"\x15RespondTradeInRequest\x12\x1e\n" +
+	"\vtrade_in_id\x18\x01 \x01(\tR\ttradeInId\x12\x16\n" +
+	"\x06terima\x18\x02 \x01(\bR\x06terima\"6\n" +
+	"\x14CancelTradeInRequest\x12\x1e\n" +
+	"\vtrade_in_id\x18\x01 \x01(\tR\ttradeInId\"f\n" +
+	"\x12ListTradeInRequest\x12\x14\n" +
+	"\x05peran\x18\x01 \x01(\tR\x05peran\x12(\n" +
+	"\rfilter_status\x18\x02 \x01(\tH\x00R\ffilterStatus\x88\x01\x01B\x10\n" +
+	"\x0e_filter_status\"A\n" +
+	"\x13ListTradeInResponse\x12*\n" +
+	"\btrade_in\x18\x01 \x03(\v2\x0f.carapp.TradeInR\atradeIn\"\xb1\x03\n" +
+	"\x06Ulasan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\ftransaksi_id\x18\x02 \x01(\tR\vtransaksiId\x12\x1d\n" +
+	"\n" +
+	"penulis_id\x18\x03 \x01(\tR\tpenulisId\x12!\n" +
+	"\fpenulis_name\x18\x04 \x01(\tR\vpenulisName\x12\x1b\n" +
+	"\ttarget_id\x18\x05 \x01(\tR\btargetId\x12#\n" +
+	"\rperan_penulis\x18\x06 \x01(\tR\fperanPenulis\x12\x16\n" +
+	"\x06rating\x18\a \x01(\x05R\x06rating\x12\x1a\n" +
+	"\bkomentar\x18\b \x01(\tR\bkomentar\x12$\n" +
+	"\rdisembunyikan\x18\t \x01(\bR\rdisembunyikan\x121\n" +
+	"\x14alasan_disembunyikan\x18\n" +
+	" \x01(\tR\x13alasanDisembunyikan\x12\x12\n" +
+	"\x04merk\x18\v \x01(\tR\x04merk\x12\x14\n" +
+	"\x05model\x18\f \x01(\tR\x05model\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"l\n" +
+	"\x13CreateUlasanRequest\x12!\n" +
+	"\ftransaksi_id\x18\x01 \x01(\tR\vtransaksiId\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x05R\x06rating\x12\x1a\n" +
+	"\bkomentar\x18\x03 \x01(\tR\bkomentar\"\xa3\x01\n" +
+	"\x11ListUlasanRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05peran\x18\x02 \x01(\tR\x05peran\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x125\n" +
+	"\x16termasuk_disembunyikan\x18\x05 \x01(\bR\x15termasukDisembunyikan\"R\n" +
+	"\x12ListUlasanResponse\x12&\n" +
+	"\x06ulasan\x18\x01 \x03(\v2\x0e.carapp.UlasanR\x06ulasan\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"2\n" +
+	"\x17GetProfilPenjualRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xfb\x02\n" +
+	"\rProfilPenjual\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12C\n" +
+	"\x0fbergabung_sejak\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0ebergabungSejak\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\x01R\x06rating\x12#\n" +
+	"\rjumlah_ulasan\x18\x05 \x01(\x05R\fjumlahUlasan\x12+\n" +
+	"\x11distribusi_rating\x18\x06 \x03(\x05R\x10distribusiRating\x12%\n" +
+	"\x0ejumlah_terjual\x18\a \x01(\x05R\rjumlahTerjual\x120\n" +
+	"\x14jumlah_listing_aktif\x18\b \x01(\x05R\x12jumlahListingAktif\x125\n" +
+	"\x0eulasan_terbaru\x18\t \x03(\v2\x0e.carapp.UlasanR\rulasanTerbaru\"j\n" +
+	"\x11HideUlasanRequest\x12\x1b\n" +
+	"\tulasan_id\x18\x01 \x01(\tR\bulasanId\x12 \n" +
+	"\vsembunyikan\x18\x02 \x01(\bR\vsembunyikan\x12\x16\n" +
+	"\x06alasan\x18\x03 \x01(\tR\x06alasan\"\xdb\x01\n" +
+	"\rWatchlistItem\x12#\n" +
+	"\x05mobil\x18\x01 \x01(\v2\r.carapp.MobilR\x05mobil\x12=\n" +
+	"\x13harga_saat_ditambah\x18\x02 \x01(\v2\r.carapp.MoneyR\x11hargaSaatDitambah\x12\x1f\n" +
+	"\vharga_turun\x18\x03 \x01(\bR\n" +
+	"hargaTurun\x12E\n" +
+	"\x10ditambahkan_pada\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0fditambahkanPada\"2\n" +
+	"\x15AddToWatchlistRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\"7\n" +
+	"\x1aRemoveFromWatchlistRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\"@\n" +
+	"\x14ListWatchlistRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"Z\n" +
+	"\x15ListWatchlistResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.carapp.WatchlistItemR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x89\x02\n" +
+	"\vSavedSearch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04nama\x18\x02 \x01(\tR\x04nama\x12+\n" +
+	"\x06filter\x18\x03 \x01(\v2\x13.carapp.FilterMobilR\x06filter\x12'\n" +
+	"\x0ffrekuensi_alert\x18\x04 \x01(\tR\x0efrekuensiAlert\x12E\n" +
+	"\x10terakhir_dikirim\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0fterakhirDikirim\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x84\x01\n" +
+	"\x18CreateSavedSearchRequest\x12\x12\n" +
+	"\x04nama\x18\x01 \x01(\tR\x04nama\x12+\n" +
+	"\x06filter\x18\x02 \x01(\v2\x13.carapp.FilterMobilR\x06filter\x12'\n" +
+	"\x0ffrekuensi_alert\x18\x03 \x01(\tR\x0efrekuensiAlert\"\x18\n" +
+	"\x16ListSavedSearchRequest\"Q\n" +
+	"\x17ListSavedSearchResponse\x126\n" +
+	"\fsaved_search\x18\x01 \x03(\v2\x13.carapp.SavedSearchR\vsavedSearch\"\x7f\n" +
+	"\x18UpdateSavedSearchRequest\x12&\n" +
+	"\x0fsaved_search_id\x18\x01 \x01(\tR\rsavedSearchId\x12\x12\n" +
+	"\x04nama\x18\x02 \x01(\tR\x04nama\x12'\n" +
+	"\x0ffrekuensi_alert\x18\x03 \x01(\tR\x0efrekuensiAlert\"G\n" +
+	"\x1dUnsubscribeSavedSearchRequest\x12&\n" +
+	"\x0fsaved_search_id\x18\x01 \x01(\tR\rsavedSearchId\"B\n" +
+	"\x18DeleteSavedSearchRequest\x12&\n" +
+	"\x0fsaved_search_id\x18\x01 \x01(\tR\rsavedSearchId\"\xb2\x01\n" +
+	"\fRiwayatHarga\x12\x14\n" +
+	"\x05jenis\x18\x01 \x01(\tR\x05jenis\x12#\n" +
+	"\x05harga\x18\x02 \x01(\v2\r.carapp.MoneyR\x05harga\x12,\n" +
+	"\n" +
+	"harga_asli\x18\x03 \x01(\v2\r.carapp.MoneyR\thargaAsli\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"3\n" +
+	"\x16GetPriceHistoryRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\"d\n" +
+	"\x17GetPriceHistoryResponse\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x12.\n" +
+	"\ariwayat\x18\x02 \x03(\v2\x14.carapp.RiwayatHargaR\ariwayat\"\xc0\x01\n" +
+	"\x15GetMarketPriceRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x12\x12\n" +
+	"\x04merk\x18\x02 \x01(\tR\x04merk\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12\x14\n" +
+	"\x05tahun\x18\x04 \x01(\x05R\x05tahun\x12'\n" +
+	"\x0ftoleransi_tahun\x18\x05 \x01(\x05R\x0etoleransiTahun\x12#\n" +
+	"\rperiode_bulan\x18\x06 \x01(\x05R\fperiodeBulan\"\xe5\x01\n" +
+	"\x0eStatistikHarga\x12\x16\n" +
+	"\x06jumlah\x18\x01 \x01(\x05R\x06jumlah\x12'\n" +
+	"\aminimum\x18\x02 \x01(\v2\r.carapp.MoneyR\aminimum\x12\x1f\n" +
+	"\x03p25\x18\x03 \x01(\v2\r.carapp.MoneyR\x03p25\x12%\n" +
+	"\x06median\x18\x04 \x01(\v2\r.carapp.MoneyR\x06median\x12\x1f\n" +
+	"\x03p75\x18\x05 \x01(\v2\r.carapp.MoneyR\x03p75\x12)\n" +
+	"\bmaksimum\x18\x06 \x01(\v2\r.carapp.MoneyR\bmaksimum\"u\n" +
+	"\fListingPasar\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x12#\n" +
+	"\x05harga\x18\x02 \x01(\v2\r.carapp.MoneyR\x05harga\x12%\n" +
+	"\x0eselisih_persen\x18\x03 \x01(\x01R\rselisihPersen\"\x9a\x03\n" +
+	"\vMarketPrice\x12\x12\n" +
+	"\x04merk\x18\x01 \x01(\tR\x04merk\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x12\x1b\n" +
+	"\ttahun_min\x18\x03 \x01(\x05R\btahunMin\x12\x1b\n" +
+	"\ttahun_max\x18\x04 \x01(\x05R\btahunMax\x127\n" +
+	"\vharga_minta\x18\x05 \x01(\v2\x16.carapp.StatistikHargaR\n" +
+	"hargaMinta\x12;\n" +
+	"\rharga_terjual\x18\x06 \x01(\v2\x16.carapp.StatistikHargaR\fhargaTerjual\x12\x14\n" +
+	"\x05acuan\x18\a \x01(\tR\x05acuan\x12.\n" +
+	"\alisting\x18\b \x01(\v2\x14.carapp.ListingPasarR\alisting\x121\n" +
+	"\x15listing_di_atas_pasar\x18\t \x01(\bR\x12listingDiAtasPasar\x128\n" +
+	"\rdi_atas_pasar\x18\n" +
+	" \x03(\v2\x14.carapp.ListingPasarR\vdiAtasPasar\"\xe8\x03\n" +
+	"\x0eLaporanListing\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bmobil_id\x18\x02 \x01(\tR\amobilId\x12\x1d\n" +
+	"\n" +
+	"pelapor_id\x18\x03 \x01(\tR\tpelaporId\x12\x16\n" +
+	"\x06alasan\x18\x04 \x01(\tR\x06alasan\x12\x1e\n" +
+	"\n" +
+	"keterangan\x18\x05 \x01(\tR\n" +
+	"keterangan\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12#\n" +
+	"\rcatatan_admin\x18\a \x01(\tR\fcatatanAdmin\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12A\n" +
+	"\x0editangani_pada\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\rditanganiPada\x12\x1f\n" +
+	"\vjudul_mobil\x18\n" +
+	" \x01(\tR\n" +
+	"judulMobil\x12!\n" +
+	"\fstatus_mobil\x18\v \x01(\tR\vstatusMobil\x124\n" +
+	"\x16jumlah_laporan_terbuka\x18\f \x01(\x05R\x14jumlahLaporanTerbuka\x12\x1f\n" +
+	"\vskor_risiko\x18\r \x01(\x05R\n" +
+	"skorRisiko\"V\n" +
+	"\x12ListLaporanRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"]\n" +
+	"\x13ListLaporanResponse\x120\n" +
+	"\alaporan\x18\x01 \x03(\v2\x16.carapp.LaporanListingR\alaporan\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"l\n" +
+	"\x15TanganiLaporanRequest\x12\x1d\n" +
+	"\n" +
+	"laporan_id\x18\x01 \x01(\tR\tlaporanId\x12\x1a\n" +
+	"\btindakan\x18\x02 \x01(\tR\btindakan\x12\x18\n" +
+	"\acatatan\x18\x03 \x01(\tR\acatatan\"\xd7\x03\n" +
+	"\vLaporanUser\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"pelapor_id\x18\x02 \x01(\tR\tpelaporId\x12!\n" +
+	"\fpelapor_nama\x18\x03 \x01(\tR\vpelaporNama\x12#\n" +
+	"\rdilaporkan_id\x18\x04 \x01(\tR\fdilaporkanId\x12'\n" +
+	"\x0fdilaporkan_nama\x18\x05 \x01(\tR\x0edilaporkanNama\x12#\n" +
+	"\rpercakapan_id\x18\x06 \x01(\tR\fpercakapanId\x12\x16\n" +
+	"\x06alasan\x18\a \x01(\tR\x06alasan\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12#\n" +
+	"\rcatatan_admin\x18\t \x01(\tR\fcatatanAdmin\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12A\n" +
+	"\x0editangani_pada\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\rditanganiPada\x120\n" +
+	"\x14jumlah_laporan_aktif\x18\f \x01(\x05R\x12jumlahLaporanAktif\"Z\n" +
+	"\x16ListLaporanUserRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"^\n" +
+	"\x17ListLaporanUserResponse\x12-\n" +
+	"\alaporan\x18\x01 \x03(\v2\x13.carapp.LaporanUserR\alaporan\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"p\n" +
+	"\x19TanganiLaporanUserRequest\x12\x1d\n" +
+	"\n" +
+	"laporan_id\x18\x01 \x01(\tR\tlaporanId\x12\x1a\n" +
+	"\btindakan\x18\x02 \x01(\tR\btindakan\x12\x18\n" +
+	"\acatatan\x18\x03 \x01(\tR\acatatan\"2\n" +
+	"\x15GetRisikoMobilRequest\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\"Z\n" +
+	"\fSinyalRisiko\x12\x16\n" +
+	"\x06aturan\x18\x01 \x01(\tR\x06aturan\x12\x12\n" +
+	"\x04skor\x18\x02 \x01(\x05R\x04skor\x12\x1e\n" +
+	"\n" +
+	"keterangan\x18\x03 \x01(\tR\n" +
+	"keterangan\"\xc7\x01\n" +
+	"\x0fPenilaianRisiko\x12\x19\n" +
+	"\bmobil_id\x18\x01 \x01(\tR\amobilId\x12\x12\n" +
+	"\x04skor\x18\x02 \x01(\x05R\x04skor\x12,\n" +
+	"\x06sinyal\x18\x03 \x03(\v2\x14.carapp.SinyalRisikoR\x06sinyal\x12\x18\n" +
+	"\aditahan\x18\x04 \x01(\bR\aditahan\x12=\n" +
+	"\fdinilai_pada\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vdinilaiPada2}\n" +
 	"\vAuthService\x129\n" +
 	"\bRegister\x12\x17.carapp.RegisterRequest\x1a\x14.carapp.AuthResponse\x123\n" +
-	"\x05Login\x12\x14.carapp.LoginRequest\x1a\x14.carapp.AuthResponse2\x83\x02\n" +
+	"\x05Login\x12\x14.carapp.LoginRequest\x1a\x14.carapp.AuthResponse2\xd6\b\n" +
 	"\fMobilService\x128\n" +
 	"\vCreateMobil\x12\x1a.carapp.CreateMobilRequest\x1a\r.carapp.Mobil\x12@\n" +
 	"\tListMobil\x12\x18.carapp.ListMobilRequest\x1a\x19.carapp.ListMobilResponse\x122\n" +
 	"\bGetMobil\x12\x17.carapp.GetMobilRequest\x1a\r.carapp.Mobil\x12C\n" +
 	"\n" +
-	"UploadFoto\x12\x19.carapp.UploadFotoRequest\x1a\x1a.carapp.UploadFotoResponse2\xa8\x01\n" +
+	"UploadFoto\x12\x19.carapp.UploadFotoRequest\x1a\x1a.carapp.UploadFotoResponse\x12B\n" +
+	"\x10UpdateHargaMobil\x12\x1f.carapp.UpdateHargaMobilRequest\x1a\r.carapp.Mobil\x12<\n" +
+	"\rWithdrawMobil\x12\x1c.carapp.WithdrawMobilRequest\x1a\r.carapp.Mobil\x126\n" +
+	"\n" +
+	"RenewMobil\x12\x19.carapp.RenewMobilRequest\x1a\r.carapp.Mobil\x12R\n" +
+	"\x0fGetSimilarMobil\x12\x1e.carapp.GetSimilarMobilRequest\x1a\x1f.carapp.GetSimilarMobilResponse\x12I\n" +
+	"\fCompareMobil\x12\x1b.carapp.CompareMobilRequest\x1a\x1c.carapp.CompareMobilResponse\x12B\n" +
+	"\x10UpdateDraftMobil\x12\x1f.carapp.UpdateDraftMobilRequest\x1a\r.carapp.Mobil\x12:\n" +
+	"\fPublishMobil\x12\x1b.carapp.PublishMobilRequest\x1a\r.carapp.Mobil\x12P\n" +
+	"\x11ListModerasiMobil\x12 .carapp.ListModerasiMobilRequest\x1a\x19.carapp.ListMobilResponse\x12<\n" +
+	"\rModerasiMobil\x12\x1c.carapp.ModerasiMobilRequest\x1a\r.carapp.Mobil\x12A\n" +
+	"\vReportMobil\x12\x1a.carapp.ReportMobilRequest\x1a\x16.carapp.LaporanListing\x12R\n" +
+	"\x0fBulkImportMobil\x12\x1e.carapp.BulkImportMobilRequest\x1a\x1f.carapp.BulkImportMobilResponse\x12Q\n" +
+	"\x0fExportInventory\x12\x1e.carapp.ExportInventoryRequest\x1a\x1c.carapp.ExportInventoryChunk0\x012\xa8\x01\n" +
 	"\x10NhtsaDataService\x12=\n" +
 	"\bGetMakes\x12\x17.carapp.GetMakesRequest\x1a\x18.carapp.GetMakesResponse\x12U\n" +
-	"\x10GetModelsForMake\x12\x1f.carapp.GetModelsForMakeRequest\x1a .carapp.GetModelsForMakeResponse2\xf0\x01\n" +
+	"\x10GetModelsForMake\x12\x1f.carapp.GetModelsForMakeRequest\x1a .carapp.GetModelsForMakeResponse2\xa1\x06\n" +
 	"\x10TransaksiService\x12B\n" +
-	"\bBuyMobil\x12\x17.carapp.BuyMobilRequest\x1a\x1d.carapp.TransaksiJualResponse\x12F\n" +
+	"\bBuyMobil\x12\x17.carapp.BuyMobilRequest\x1a\x1d.carapp.TransaksiJualResponse\x12J\n" +
+	"\fPayTransaksi\x12\x1b.carapp.PayTransaksiRequest\x1a\x1d.carapp.TransaksiJualResponse\x12R\n" +
+	"\x10ConfirmTransaksi\x12\x1f.carapp.ConfirmTransaksiRequest\x1a\x1d.carapp.TransaksiJualResponse\x12T\n" +
+	"\x11CompleteTransaksi\x12 .carapp.CompleteTransaksiRequest\x1a\x1d.carapp.TransaksiJualResponse\x12P\n" +
+	"\x0fCancelTransaksi\x12\x1e.carapp.CancelTransaksiRequest\x1a\x1d.carapp.TransaksiJualResponse\x12[\n" +
+	"\x12ListMyTransactions\x12!.carapp.ListMyTransactionsRequest\x1a\".carapp.ListMyTransactionsResponse\x12H\n" +
+	"\x0eGetTransaction\x12\x1d.carapp.GetTransactionRequest\x1a\x17.carapp.TransaksiDetail\x12@\n" +
+	"\n" +
+	"GetInvoice\x12\x19.carapp.GetInvoiceRequest\x1a\x17.carapp.InvoiceResponse\x12F\n" +
 	"\tRentMobil\x12\x18.carapp.RentMobilRequest\x1a\x1f.carapp.TransaksiRentalResponse\x12P\n" +
 	"\x0eCompleteRental\x12\x1d.carapp.CompleteRentalRequest\x1a\x1f.carapp.TransaksiRentalResponse2^\n" +
 	"\x11NotifikasiService\x12I\n" +
 	"\x10GetNotifications\x12\x1f.carapp.GetNotificationsRequest\x1a\x12.carapp.Notifikasi0\x012T\n" +
 	"\x10DashboardService\x12@\n" +
-	"\fGetDashboard\x12\x16.google.protobuf.Empty\x1a\x18.carapp.DashboardSummaryB\x14Z\x12carapp.com/m/protob\x06proto3"
+	"\fGetDashboard\x12\x16.google.protobuf.Empty\x1a\x18.carapp.DashboardSummary2\xc3\x02\n" +
+	"\x10PenawaranService\x12D\n" +
+	"\x0fCreatePenawaran\x12\x1e.carapp.CreatePenawaranRequest\x1a\x11.carapp.Penawaran\x12U\n" +
+	"\x10RespondPenawaran\x12\x1f.carapp.RespondPenawaranRequest\x1a .carapp.RespondPenawaranResponse\x12D\n" +
+	"\x0fCancelPenawaran\x12\x1e.carapp.CancelPenawaranRequest\x1a\x11.carapp.Penawaran\x12L\n" +
+	"\rListPenawaran\x12\x1c.carapp.ListPenawaranRequest\x1a\x1d.carapp.ListPenawaranResponse2\xe7\x04\n" +
+	"\vChatService\x12E\n" +
+	"\x0fStartPercakapan\x12\x1e.carapp.StartPercakapanRequest\x1a\x12.carapp.Percakapan\x12O\n" +
+	"\x0eListPercakapan\x12\x1d.carapp.ListPercakapanRequest\x1a\x1e.carapp.ListPercakapanResponse\x128\n" +
+	"\tSendPesan\x12\x18.carapp.SendPesanRequest\x1a\x11.carapp.PesanChat\x12@\n" +
+	"\tListPesan\x12\x18.carapp.ListPesanRequest\x1a\x19.carapp.ListPesanResponse\x12>\n" +
+	"\vStreamPesan\x12\x1a.carapp.StreamPesanRequest\x1a\x11.carapp.ChatEvent0\x01\x12C\n" +
+	"\n" +
+	"MarkDibaca\x12\x19.carapp.MarkDibacaRequest\x1a\x1a.carapp.MarkDibacaResponse\x12=\n" +
+	"\tBlockUser\x12\x18.carapp.BlockUserRequest\x1a\x16.google.protobuf.Empty\x12?\n" +
+	"\vUnblockUser\x12\x18.carapp.BlockUserRequest\x1a\x16.google.protobuf.Empty\x12?\n" +
+	"\n" +
+	"ReportUser\x12\x19.carapp.ReportUserRequest\x1a\x16.google.protobuf.Empty2\xa5\x03\n" +
+	"\x10JanjiTemuService\x12;\n" +
+	"\n" +
+	"CreateSlot\x12\x19.carapp.CreateSlotRequest\x1a\x12.carapp.SlotJadwal\x12=\n" +
+	"\bListSlot\x12\x17.carapp.ListSlotRequest\x1a\x18.carapp.ListSlotResponse\x12?\n" +
+	"\n" +
+	"DeleteSlot\x12\x19.carapp.DeleteSlotRequest\x1a\x16.google.protobuf.Empty\x12@\n" +
+	"\rBookJanjiTemu\x12\x1c.carapp.BookJanjiTemuRequest\x1a\x11.carapp.JanjiTemu\x12D\n" +
+	"\x0fCancelJanjiTemu\x12\x1e.carapp.CancelJanjiTemuRequest\x1a\x11.carapp.JanjiTemu\x12L\n" +
+	"\rListJanjiTemu\x12\x1c.carapp.ListJanjiTemuRequest\x1a\x1d.carapp.ListJanjiTemuResponse2\x91\x01\n" +
+	"\vKursService\x12C\n" +
+	"\n" +
+	"ImportKurs\x12\x19.carapp.ImportKursRequest\x1a\x1a.carapp.ImportKursResponse\x12=\n" +
+	"\bListKurs\x12\x17.carapp.ListKursRequest\x1a\x18.carapp.ListKursResponse2\xaf\x01\n" +
+	"\rKreditService\x12U\n" +
+	"\x10ListProdukKredit\x12\x1f.carapp.ListProdukKreditRequest\x1a .carapp.ListProdukKreditResponse\x12G\n" +
+	"\x0eSimulateKredit\x12\x1d.carapp.SimulateKreditRequest\x1a\x16.carapp.SimulasiKredit2\xa0\x03\n" +
+	"\x0eTradeInService\x12@\n" +
+	"\tDecodeVin\x12\x18.carapp.DecodeVinRequest\x1a\x19.carapp.DecodeVinResponse\x12>\n" +
+	"\rCreateTradeIn\x12\x1c.carapp.CreateTradeInRequest\x1a\x0f.carapp.TradeIn\x12B\n" +
+	"\x0fAppraiseTradeIn\x12\x1e.carapp.AppraiseTradeInRequest\x1a\x0f.carapp.TradeIn\x12@\n" +
+	"\x0eRespondTradeIn\x12\x1d.carapp.RespondTradeInRequest\x1a\x0f.carapp.TradeIn\x12>\n" +
+	"\rCancelTradeIn\x12\x1c.carapp.CancelTradeInRequest\x1a\x0f.carapp.TradeIn\x12F\n" +
+	"\vListTradeIn\x12\x1a.carapp.ListTradeInRequest\x1a\x1b.carapp.ListTradeInResponse2\x96\x02\n" +
+	"\rUlasanService\x12;\n" +
+	"\fCreateUlasan\x12\x1b.carapp.CreateUlasanRequest\x1a\x0e.carapp.Ulasan\x12C\n" +
+	"\n" +
+	"ListUlasan\x12\x19.carapp.ListUlasanRequest\x1a\x1a.carapp.ListUlasanResponse\x12J\n" +
+	"\x10GetProfilPenjual\x12\x1f.carapp.GetProfilPenjualRequest\x1a\x15.carapp.ProfilPenjual\x127\n" +
+	"\n" +
+	"HideUlasan\x12\x19.carapp.HideUlasanRequest\x1a\x0e.carapp.Ulasan2\xfb\x01\n" +
+	"\x10WatchlistService\x12F\n" +
+	"\x0eAddToWatchlist\x12\x1d.carapp.AddToWatchlistRequest\x1a\x15.carapp.WatchlistItem\x12Q\n" +
+	"\x13RemoveFromWatchlist\x12\".carapp.RemoveFromWatchlistRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
+	"\rListWatchlist\x12\x1c.carapp.ListWatchlistRequest\x1a\x1d.carapp.ListWatchlistResponse2\xa5\x03\n" +
+	"\x12SavedSearchService\x12J\n" +
+	"\x11CreateSavedSearch\x12 .carapp.CreateSavedSearchRequest\x1a\x13.carapp.SavedSearch\x12R\n" +
+	"\x0fListSavedSearch\x12\x1e.carapp.ListSavedSearchRequest\x1a\x1f.carapp.ListSavedSearchResponse\x12J\n" +
+	"\x11UpdateSavedSearch\x12 .carapp.UpdateSavedSearchRequest\x1a\x13.carapp.SavedSearch\x12T\n" +
+	"\x16UnsubscribeSavedSearch\x12%.carapp.UnsubscribeSavedSearchRequest\x1a\x13.carapp.SavedSearch\x12M\n" +
+	"\x11DeleteSavedSearch\x12 .carapp.DeleteSavedSearchRequest\x1a\x16.google.protobuf.Empty2\xad\x01\n" +
+	"\x11HargaPasarService\x12R\n" +
+	"\x0fGetPriceHistory\x12\x1e.carapp.GetPriceHistoryRequest\x1a\x1f.carapp.GetPriceHistoryResponse\x12D\n" +
+	"\x0eGetMarketPrice\x12\x1d.carapp.GetMarketPriceRequest\x1a\x13.carapp.MarketPrice2\x8b\x03\n" +
+	"\fAdminService\x12F\n" +
+	"\vListLaporan\x12\x1a.carapp.ListLaporanRequest\x1a\x1b.carapp.ListLaporanResponse\x12G\n" +
+	"\x0eTanganiLaporan\x12\x1d.carapp.TanganiLaporanRequest\x1a\x16.carapp.LaporanListing\x12H\n" +
+	"\x0eGetRisikoMobil\x12\x1d.carapp.GetRisikoMobilRequest\x1a\x17.carapp.PenilaianRisiko\x12R\n" +
+	"\x0fListLaporanUser\x12\x1e.carapp.ListLaporanUserRequest\x1a\x1f.carapp.ListLaporanUserResponse\x12L\n" +
+	"\x12TanganiLaporanUser\x12!.carapp.TanganiLaporanUserRequest\x1a\x13.carapp.LaporanUserB\x14Z\x12carapp.com/m/protob\x06proto3"
 
 var (
 	file_proto_carapp_proto_rawDescOnce sync.Once
@@ -1785,76 +10946,449 @@ func file_proto_carapp_proto_rawDescGZIP() []byte {
 	return file_proto_carapp_proto_rawDescData
 }
 
-var file_proto_carapp_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_proto_carapp_proto_msgTypes = make([]protoimpl.MessageInfo, 145)
 var file_proto_carapp_proto_goTypes = []any{
-	(*User)(nil),                     // 0: carapp.User
-	(*Mobil)(nil),                    // 1: carapp.Mobil
-	(*Notifikasi)(nil),               // 2: carapp.Notifikasi
-	(*RegisterRequest)(nil),          // 3: carapp.RegisterRequest
-	(*LoginRequest)(nil),             // 4: carapp.LoginRequest
-	(*AuthResponse)(nil),             // 5: carapp.AuthResponse
-	(*CreateMobilRequest)(nil),       // 6: carapp.CreateMobilRequest
-	(*ListMobilRequest)(nil),         // 7: carapp.ListMobilRequest
-	(*ListMobilResponse)(nil),        // 8: carapp.ListMobilResponse
-	(*GetMobilRequest)(nil),          // 9: carapp.GetMobilRequest
-	(*UploadFotoRequest)(nil),        // 10: carapp.UploadFotoRequest
-	(*UploadFotoResponse)(nil),       // 11: carapp.UploadFotoResponse
-	(*Make)(nil),                     // 12: carapp.Make
-	(*Model)(nil),                    // 13: carapp.Model
-	(*GetMakesRequest)(nil),          // 14: carapp.GetMakesRequest
-	(*GetMakesResponse)(nil),         // 15: carapp.GetMakesResponse
-	(*GetModelsForMakeRequest)(nil),  // 16: carapp.GetModelsForMakeRequest
-	(*GetModelsForMakeResponse)(nil), // 17: carapp.GetModelsForMakeResponse
-	(*BuyMobilRequest)(nil),          // 18: carapp.BuyMobilRequest
-	(*TransaksiJualResponse)(nil),    // 19: carapp.TransaksiJualResponse
-	(*RentMobilRequest)(nil),         // 20: carapp.RentMobilRequest
-	(*CompleteRentalRequest)(nil),    // 21: carapp.CompleteRentalRequest
-	(*TransaksiRentalResponse)(nil),  // 22: carapp.TransaksiRentalResponse
-	(*GetNotificationsRequest)(nil),  // 23: carapp.GetNotificationsRequest
-	(*DashboardSummary)(nil),         // 24: carapp.DashboardSummary
-	(*timestamppb.Timestamp)(nil),    // 25: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),            // 26: google.protobuf.Empty
+	(*Money)(nil),                         // 0: carapp.Money
+	(*User)(nil),                          // 1: carapp.User
+	(*Mobil)(nil),                         // 2: carapp.Mobil
+	(*Notifikasi)(nil),                    // 3: carapp.Notifikasi
+	(*RegisterRequest)(nil),               // 4: carapp.RegisterRequest
+	(*LoginRequest)(nil),                  // 5: carapp.LoginRequest
+	(*AuthResponse)(nil),                  // 6: carapp.AuthResponse
+	(*CreateMobilRequest)(nil),            // 7: carapp.CreateMobilRequest
+	(*ListMobilRequest)(nil),              // 8: carapp.ListMobilRequest
+	(*FilterMobil)(nil),                   // 9: carapp.FilterMobil
+	(*ListMobilResponse)(nil),             // 10: carapp.ListMobilResponse
+	(*GetMobilRequest)(nil),               // 11: carapp.GetMobilRequest
+	(*UploadFotoRequest)(nil),             // 12: carapp.UploadFotoRequest
+	(*UploadFotoResponse)(nil),            // 13: carapp.UploadFotoResponse
+	(*UpdateHargaMobilRequest)(nil),       // 14: carapp.UpdateHargaMobilRequest
+	(*WithdrawMobilRequest)(nil),          // 15: carapp.WithdrawMobilRequest
+	(*RenewMobilRequest)(nil),             // 16: carapp.RenewMobilRequest
+	(*UpdateDraftMobilRequest)(nil),       // 17: carapp.UpdateDraftMobilRequest
+	(*PublishMobilRequest)(nil),           // 18: carapp.PublishMobilRequest
+	(*ListModerasiMobilRequest)(nil),      // 19: carapp.ListModerasiMobilRequest
+	(*ModerasiMobilRequest)(nil),          // 20: carapp.ModerasiMobilRequest
+	(*ReportMobilRequest)(nil),            // 21: carapp.ReportMobilRequest
+	(*BulkImportMobilRequest)(nil),        // 22: carapp.BulkImportMobilRequest
+	(*HasilImportBaris)(nil),              // 23: carapp.HasilImportBaris
+	(*BulkImportMobilResponse)(nil),       // 24: carapp.BulkImportMobilResponse
+	(*ExportInventoryRequest)(nil),        // 25: carapp.ExportInventoryRequest
+	(*ExportInventoryChunk)(nil),          // 26: carapp.ExportInventoryChunk
+	(*GetSimilarMobilRequest)(nil),        // 27: carapp.GetSimilarMobilRequest
+	(*MobilMirip)(nil),                    // 28: carapp.MobilMirip
+	(*GetSimilarMobilResponse)(nil),       // 29: carapp.GetSimilarMobilResponse
+	(*CompareMobilRequest)(nil),           // 30: carapp.CompareMobilRequest
+	(*BarisPerbandingan)(nil),             // 31: carapp.BarisPerbandingan
+	(*CompareMobilResponse)(nil),          // 32: carapp.CompareMobilResponse
+	(*Make)(nil),                          // 33: carapp.Make
+	(*Model)(nil),                         // 34: carapp.Model
+	(*GetMakesRequest)(nil),               // 35: carapp.GetMakesRequest
+	(*GetMakesResponse)(nil),              // 36: carapp.GetMakesResponse
+	(*GetModelsForMakeRequest)(nil),       // 37: carapp.GetModelsForMakeRequest
+	(*GetModelsForMakeResponse)(nil),      // 38: carapp.GetModelsForMakeResponse
+	(*BuyMobilRequest)(nil),               // 39: carapp.BuyMobilRequest
+	(*TransaksiJualResponse)(nil),         // 40: carapp.TransaksiJualResponse
+	(*PayTransaksiRequest)(nil),           // 41: carapp.PayTransaksiRequest
+	(*ConfirmTransaksiRequest)(nil),       // 42: carapp.ConfirmTransaksiRequest
+	(*CompleteTransaksiRequest)(nil),      // 43: carapp.CompleteTransaksiRequest
+	(*CancelTransaksiRequest)(nil),        // 44: carapp.CancelTransaksiRequest
+	(*ListMyTransactionsRequest)(nil),     // 45: carapp.ListMyTransactionsRequest
+	(*ListMyTransactionsResponse)(nil),    // 46: carapp.ListMyTransactionsResponse
+	(*GetTransactionRequest)(nil),         // 47: carapp.GetTransactionRequest
+	(*PihakTransaksi)(nil),                // 48: carapp.PihakTransaksi
+	(*TransaksiDetail)(nil),               // 49: carapp.TransaksiDetail
+	(*GetInvoiceRequest)(nil),             // 50: carapp.GetInvoiceRequest
+	(*InvoiceResponse)(nil),               // 51: carapp.InvoiceResponse
+	(*RentMobilRequest)(nil),              // 52: carapp.RentMobilRequest
+	(*CompleteRentalRequest)(nil),         // 53: carapp.CompleteRentalRequest
+	(*TransaksiRentalResponse)(nil),       // 54: carapp.TransaksiRentalResponse
+	(*GetNotificationsRequest)(nil),       // 55: carapp.GetNotificationsRequest
+	(*DashboardSummary)(nil),              // 56: carapp.DashboardSummary
+	(*Penawaran)(nil),                     // 57: carapp.Penawaran
+	(*CreatePenawaranRequest)(nil),        // 58: carapp.CreatePenawaranRequest
+	(*RespondPenawaranRequest)(nil),       // 59: carapp.RespondPenawaranRequest
+	(*RespondPenawaranResponse)(nil),      // 60: carapp.RespondPenawaranResponse
+	(*CancelPenawaranRequest)(nil),        // 61: carapp.CancelPenawaranRequest
+	(*ListPenawaranRequest)(nil),          // 62: carapp.ListPenawaranRequest
+	(*ListPenawaranResponse)(nil),         // 63: carapp.ListPenawaranResponse
+	(*Percakapan)(nil),                    // 64: carapp.Percakapan
+	(*PesanChat)(nil),                     // 65: carapp.PesanChat
+	(*ChatEvent)(nil),                     // 66: carapp.ChatEvent
+	(*StartPercakapanRequest)(nil),        // 67: carapp.StartPercakapanRequest
+	(*ListPercakapanRequest)(nil),         // 68: carapp.ListPercakapanRequest
+	(*ListPercakapanResponse)(nil),        // 69: carapp.ListPercakapanResponse
+	(*SendPesanRequest)(nil),              // 70: carapp.SendPesanRequest
+	(*ListPesanRequest)(nil),              // 71: carapp.ListPesanRequest
+	(*ListPesanResponse)(nil),             // 72: carapp.ListPesanResponse
+	(*StreamPesanRequest)(nil),            // 73: carapp.StreamPesanRequest
+	(*MarkDibacaRequest)(nil),             // 74: carapp.MarkDibacaRequest
+	(*MarkDibacaResponse)(nil),            // 75: carapp.MarkDibacaResponse
+	(*BlockUserRequest)(nil),              // 76: carapp.BlockUserRequest
+	(*ReportUserRequest)(nil),             // 77: carapp.ReportUserRequest
+	(*SlotJadwal)(nil),                    // 78: carapp.SlotJadwal
+	(*CreateSlotRequest)(nil),             // 79: carapp.CreateSlotRequest
+	(*ListSlotRequest)(nil),               // 80: carapp.ListSlotRequest
+	(*ListSlotResponse)(nil),              // 81: carapp.ListSlotResponse
+	(*DeleteSlotRequest)(nil),             // 82: carapp.DeleteSlotRequest
+	(*JanjiTemu)(nil),                     // 83: carapp.JanjiTemu
+	(*BookJanjiTemuRequest)(nil),          // 84: carapp.BookJanjiTemuRequest
+	(*CancelJanjiTemuRequest)(nil),        // 85: carapp.CancelJanjiTemuRequest
+	(*ListJanjiTemuRequest)(nil),          // 86: carapp.ListJanjiTemuRequest
+	(*ListJanjiTemuResponse)(nil),         // 87: carapp.ListJanjiTemuResponse
+	(*Kurs)(nil),                          // 88: carapp.Kurs
+	(*ImportKursRequest)(nil),             // 89: carapp.ImportKursRequest
+	(*ImportKursResponse)(nil),            // 90: carapp.ImportKursResponse
+	(*ListKursRequest)(nil),               // 91: carapp.ListKursRequest
+	(*ListKursResponse)(nil),              // 92: carapp.ListKursResponse
+	(*ProdukKredit)(nil),                  // 93: carapp.ProdukKredit
+	(*ListProdukKreditRequest)(nil),       // 94: carapp.ListProdukKreditRequest
+	(*ListProdukKreditResponse)(nil),      // 95: carapp.ListProdukKreditResponse
+	(*SimulateKreditRequest)(nil),         // 96: carapp.SimulateKreditRequest
+	(*AngsuranKredit)(nil),                // 97: carapp.AngsuranKredit
+	(*SimulasiKredit)(nil),                // 98: carapp.SimulasiKredit
+	(*TradeIn)(nil),                       // 99: carapp.TradeIn
+	(*DecodeVinRequest)(nil),              // 100: carapp.DecodeVinRequest
+	(*DecodeVinResponse)(nil),             // 101: carapp.DecodeVinResponse
+	(*CreateTradeInRequest)(nil),          // 102: carapp.CreateTradeInRequest
+	(*AppraiseTradeInRequest)(nil),        // 103: carapp.AppraiseTradeInRequest
+	(*RespondTradeInRequest)(nil),         // 104: carapp.RespondTradeInRequest
+	(*CancelTradeInRequest)(nil),          // 105: carapp.CancelTradeInRequest
+	(*ListTradeInRequest)(nil),            // 106: carapp.ListTradeInRequest
+	(*ListTradeInResponse)(nil),           // 107: carapp.ListTradeInResponse
+	(*Ulasan)(nil),                        // 108: carapp.Ulasan
+	(*CreateUlasanRequest)(nil),           // 109: carapp.CreateUlasanRequest
+	(*ListUlasanRequest)(nil),             // 110: carapp.ListUlasanRequest
+	(*ListUlasanResponse)(nil),            // 111: carapp.ListUlasanResponse
+	(*GetProfilPenjualRequest)(nil),       // 112: carapp.GetProfilPenjualRequest
+	(*ProfilPenjual)(nil),                 // 113: carapp.ProfilPenjual
+	(*HideUlasanRequest)(nil),             // 114: carapp.HideUlasanRequest
+	(*WatchlistItem)(nil),                 // 115: carapp.WatchlistItem
+	(*AddToWatchlistRequest)(nil),         // 116: carapp.AddToWatchlistRequest
+	(*RemoveFromWatchlistRequest)(nil),    // 117: carapp.RemoveFromWatchlistRequest
+	(*ListWatchlistRequest)(nil),          // 118: carapp.ListWatchlistRequest
+	(*ListWatchlistResponse)(nil),         // 119: carapp.ListWatchlistResponse
+	(*SavedSearch)(nil),                   // 120: carapp.SavedSearch
+	(*CreateSavedSearchRequest)(nil),      // 121: carapp.CreateSavedSearchRequest
+	(*ListSavedSearchRequest)(nil),        // 122: carapp.ListSavedSearchRequest
+	(*ListSavedSearchResponse)(nil),       // 123: carapp.ListSavedSearchResponse
+	(*UpdateSavedSearchRequest)(nil),      // 124: carapp.UpdateSavedSearchRequest
+	(*UnsubscribeSavedSearchRequest)(nil), // 125: carapp.UnsubscribeSavedSearchRequest
+	(*DeleteSavedSearchRequest)(nil),      // 126: carapp.DeleteSavedSearchRequest
+	(*RiwayatHarga)(nil),                  // 127: carapp.RiwayatHarga
+	(*GetPriceHistoryRequest)(nil),        // 128: carapp.GetPriceHistoryRequest
+	(*GetPriceHistoryResponse)(nil),       // 129: carapp.GetPriceHistoryResponse
+	(*GetMarketPriceRequest)(nil),         // 130: carapp.GetMarketPriceRequest
+	(*StatistikHarga)(nil),                // 131: carapp.StatistikHarga
+	(*ListingPasar)(nil),                  // 132: carapp.ListingPasar
+	(*MarketPrice)(nil),                   // 133: carapp.MarketPrice
+	(*LaporanListing)(nil),                // 134: carapp.LaporanListing
+	(*ListLaporanRequest)(nil),            // 135: carapp.ListLaporanRequest
+	(*ListLaporanResponse)(nil),           // 136: carapp.ListLaporanResponse
+	(*TanganiLaporanRequest)(nil),         // 137: carapp.TanganiLaporanRequest
+	(*LaporanUser)(nil),                   // 138: carapp.LaporanUser
+	(*ListLaporanUserRequest)(nil),        // 139: carapp.ListLaporanUserRequest
+	(*ListLaporanUserResponse)(nil),       // 140: carapp.ListLaporanUserResponse
+	(*TanganiLaporanUserRequest)(nil),     // 141: carapp.TanganiLaporanUserRequest
+	(*GetRisikoMobilRequest)(nil),         // 142: carapp.GetRisikoMobilRequest
+	(*SinyalRisiko)(nil),                  // 143: carapp.SinyalRisiko
+	(*PenilaianRisiko)(nil),               // 144: carapp.PenilaianRisiko
+	(*timestamppb.Timestamp)(nil),         // 145: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                 // 146: google.protobuf.Empty
 }
 var file_proto_carapp_proto_depIdxs = []int32{
-	25, // 0: carapp.User.created_at:type_name -> google.protobuf.Timestamp
-	25, // 1: carapp.Mobil.created_at:type_name -> google.protobuf.Timestamp
-	25, // 2: carapp.Notifikasi.read_at:type_name -> google.protobuf.Timestamp
-	25, // 3: carapp.Notifikasi.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 4: carapp.AuthResponse.user:type_name -> carapp.User
-	1,  // 5: carapp.ListMobilResponse.mobils:type_name -> carapp.Mobil
-	12, // 6: carapp.GetMakesResponse.makes:type_name -> carapp.Make
-	13, // 7: carapp.GetModelsForMakeResponse.models:type_name -> carapp.Model
-	3,  // 8: carapp.AuthService.Register:input_type -> carapp.RegisterRequest
-	4,  // 9: carapp.AuthService.Login:input_type -> carapp.LoginRequest
-	6,  // 10: carapp.MobilService.CreateMobil:input_type -> carapp.CreateMobilRequest
-	7,  // 11: carapp.MobilService.ListMobil:input_type -> carapp.ListMobilRequest
-	9,  // 12: carapp.MobilService.GetMobil:input_type -> carapp.GetMobilRequest
-	10, // 13: carapp.MobilService.UploadFoto:input_type -> carapp.UploadFotoRequest
-	14, // 14: carapp.NhtsaDataService.GetMakes:input_type -> carapp.GetMakesRequest
-	16, // 15: carapp.NhtsaDataService.GetModelsForMake:input_type -> carapp.GetModelsForMakeRequest
-	18, // 16: carapp.TransaksiService.BuyMobil:input_type -> carapp.BuyMobilRequest
-	20, // 17: carapp.TransaksiService.RentMobil:input_type -> carapp.RentMobilRequest
-	21, // 18: carapp.TransaksiService.CompleteRental:input_type -> carapp.CompleteRentalRequest
-	23, // 19: carapp.NotifikasiService.GetNotifications:input_type -> carapp.GetNotificationsRequest
-	26, // 20: carapp.DashboardService.GetDashboard:input_type -> google.protobuf.Empty
-	5,  // 21: carapp.AuthService.Register:output_type -> carapp.AuthResponse
-	5,  // 22: carapp.AuthService.Login:output_type -> carapp.AuthResponse
-	1,  // 23: carapp.MobilService.CreateMobil:output_type -> carapp.Mobil
-	8,  // 24: carapp.MobilService.ListMobil:output_type -> carapp.ListMobilResponse
-	1,  // 25: carapp.MobilService.GetMobil:output_type -> carapp.Mobil
-	11, // 26: carapp.MobilService.UploadFoto:output_type -> carapp.UploadFotoResponse
-	15, // 27: carapp.NhtsaDataService.GetMakes:output_type -> carapp.GetMakesResponse
-	17, // 28: carapp.NhtsaDataService.GetModelsForMake:output_type -> carapp.GetModelsForMakeResponse
-	19, // 29: carapp.TransaksiService.BuyMobil:output_type -> carapp.TransaksiJualResponse
-	22, // 30: carapp.TransaksiService.RentMobil:output_type -> carapp.TransaksiRentalResponse
-	22, // 31: carapp.TransaksiService.CompleteRental:output_type -> carapp.TransaksiRentalResponse
-	2,  // 32: carapp.NotifikasiService.GetNotifications:output_type -> carapp.Notifikasi
-	24, // 33: carapp.DashboardService.GetDashboard:output_type -> carapp.DashboardSummary
-	21, // [21:34] is the sub-list for method output_type
-	8,  // [8:21] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	145, // 0: carapp.User.created_at:type_name -> google.protobuf.Timestamp
+	145, // 1: carapp.Mobil.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 2: carapp.Mobil.harga_jual_money:type_name -> carapp.Money
+	0,   // 3: carapp.Mobil.harga_asli_money:type_name -> carapp.Money
+	0,   // 4: carapp.Mobil.harga_tampil_money:type_name -> carapp.Money
+	145, // 5: carapp.Mobil.berlaku_sampai:type_name -> google.protobuf.Timestamp
+	145, // 6: carapp.Notifikasi.read_at:type_name -> google.protobuf.Timestamp
+	145, // 7: carapp.Notifikasi.created_at:type_name -> google.protobuf.Timestamp
+	1,   // 8: carapp.AuthResponse.user:type_name -> carapp.User
+	0,   // 9: carapp.CreateMobilRequest.harga_jual_money:type_name -> carapp.Money
+	9,   // 10: carapp.ListMobilRequest.filter:type_name -> carapp.FilterMobil
+	0,   // 11: carapp.FilterMobil.harga_min:type_name -> carapp.Money
+	0,   // 12: carapp.FilterMobil.harga_max:type_name -> carapp.Money
+	2,   // 13: carapp.ListMobilResponse.mobils:type_name -> carapp.Mobil
+	0,   // 14: carapp.UpdateHargaMobilRequest.harga_jual_money:type_name -> carapp.Money
+	7,   // 15: carapp.UpdateDraftMobilRequest.data:type_name -> carapp.CreateMobilRequest
+	23,  // 16: carapp.BulkImportMobilResponse.hasil:type_name -> carapp.HasilImportBaris
+	9,   // 17: carapp.ExportInventoryRequest.filter:type_name -> carapp.FilterMobil
+	2,   // 18: carapp.MobilMirip.mobil:type_name -> carapp.Mobil
+	28,  // 19: carapp.GetSimilarMobilResponse.mobils:type_name -> carapp.MobilMirip
+	2,   // 20: carapp.CompareMobilResponse.mobils:type_name -> carapp.Mobil
+	31,  // 21: carapp.CompareMobilResponse.baris:type_name -> carapp.BarisPerbandingan
+	33,  // 22: carapp.GetMakesResponse.makes:type_name -> carapp.Make
+	34,  // 23: carapp.GetModelsForMakeResponse.models:type_name -> carapp.Model
+	145, // 24: carapp.TransaksiJualResponse.reserved_until:type_name -> google.protobuf.Timestamp
+	145, // 25: carapp.TransaksiJualResponse.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 26: carapp.TransaksiJualResponse.total_money:type_name -> carapp.Money
+	0,   // 27: carapp.TransaksiJualResponse.potongan_trade_in:type_name -> carapp.Money
+	145, // 28: carapp.ListMyTransactionsRequest.dari:type_name -> google.protobuf.Timestamp
+	145, // 29: carapp.ListMyTransactionsRequest.sampai:type_name -> google.protobuf.Timestamp
+	49,  // 30: carapp.ListMyTransactionsResponse.transaksi:type_name -> carapp.TransaksiDetail
+	40,  // 31: carapp.TransaksiDetail.transaksi:type_name -> carapp.TransaksiJualResponse
+	2,   // 32: carapp.TransaksiDetail.mobil:type_name -> carapp.Mobil
+	48,  // 33: carapp.TransaksiDetail.penjual:type_name -> carapp.PihakTransaksi
+	48,  // 34: carapp.TransaksiDetail.pembeli:type_name -> carapp.PihakTransaksi
+	145, // 35: carapp.TransaksiDetail.paid_at:type_name -> google.protobuf.Timestamp
+	145, // 36: carapp.TransaksiDetail.confirmed_at:type_name -> google.protobuf.Timestamp
+	145, // 37: carapp.TransaksiDetail.completed_at:type_name -> google.protobuf.Timestamp
+	145, // 38: carapp.TransaksiDetail.cancelled_at:type_name -> google.protobuf.Timestamp
+	145, // 39: carapp.InvoiceResponse.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 40: carapp.InvoiceResponse.total_money:type_name -> carapp.Money
+	0,   // 41: carapp.DashboardSummary.pendapatan_terakhir_money:type_name -> carapp.Money
+	145, // 42: carapp.Penawaran.expires_at:type_name -> google.protobuf.Timestamp
+	145, // 43: carapp.Penawaran.created_at:type_name -> google.protobuf.Timestamp
+	145, // 44: carapp.Penawaran.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 45: carapp.Penawaran.harga_money:type_name -> carapp.Money
+	0,   // 46: carapp.Penawaran.harga_jual_money:type_name -> carapp.Money
+	0,   // 47: carapp.CreatePenawaranRequest.harga_money:type_name -> carapp.Money
+	0,   // 48: carapp.RespondPenawaranRequest.harga_money:type_name -> carapp.Money
+	57,  // 49: carapp.RespondPenawaranResponse.penawaran:type_name -> carapp.Penawaran
+	40,  // 50: carapp.RespondPenawaranResponse.transaksi:type_name -> carapp.TransaksiJualResponse
+	57,  // 51: carapp.ListPenawaranResponse.penawaran:type_name -> carapp.Penawaran
+	65,  // 52: carapp.Percakapan.pesan_terakhir:type_name -> carapp.PesanChat
+	145, // 53: carapp.Percakapan.created_at:type_name -> google.protobuf.Timestamp
+	145, // 54: carapp.PesanChat.created_at:type_name -> google.protobuf.Timestamp
+	145, // 55: carapp.PesanChat.read_at:type_name -> google.protobuf.Timestamp
+	65,  // 56: carapp.ChatEvent.pesan:type_name -> carapp.PesanChat
+	145, // 57: carapp.ChatEvent.dibaca_at:type_name -> google.protobuf.Timestamp
+	64,  // 58: carapp.ListPercakapanResponse.percakapan:type_name -> carapp.Percakapan
+	145, // 59: carapp.ListPesanRequest.setelah:type_name -> google.protobuf.Timestamp
+	65,  // 60: carapp.ListPesanResponse.pesan:type_name -> carapp.PesanChat
+	145, // 61: carapp.SlotJadwal.mulai:type_name -> google.protobuf.Timestamp
+	145, // 62: carapp.SlotJadwal.selesai:type_name -> google.protobuf.Timestamp
+	145, // 63: carapp.CreateSlotRequest.mulai:type_name -> google.protobuf.Timestamp
+	145, // 64: carapp.CreateSlotRequest.selesai:type_name -> google.protobuf.Timestamp
+	78,  // 65: carapp.ListSlotResponse.slot:type_name -> carapp.SlotJadwal
+	145, // 66: carapp.JanjiTemu.mulai:type_name -> google.protobuf.Timestamp
+	145, // 67: carapp.JanjiTemu.selesai:type_name -> google.protobuf.Timestamp
+	145, // 68: carapp.JanjiTemu.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 69: carapp.ListJanjiTemuResponse.janji_temu:type_name -> carapp.JanjiTemu
+	88,  // 70: carapp.ListKursResponse.kurs:type_name -> carapp.Kurs
+	0,   // 71: carapp.ProdukKredit.biaya_admin:type_name -> carapp.Money
+	93,  // 72: carapp.ListProdukKreditResponse.produk:type_name -> carapp.ProdukKredit
+	0,   // 73: carapp.AngsuranKredit.angsuran:type_name -> carapp.Money
+	0,   // 74: carapp.AngsuranKredit.pokok:type_name -> carapp.Money
+	0,   // 75: carapp.AngsuranKredit.bunga:type_name -> carapp.Money
+	0,   // 76: carapp.AngsuranKredit.sisa_pokok:type_name -> carapp.Money
+	93,  // 77: carapp.SimulasiKredit.produk:type_name -> carapp.ProdukKredit
+	0,   // 78: carapp.SimulasiKredit.harga:type_name -> carapp.Money
+	0,   // 79: carapp.SimulasiKredit.dp:type_name -> carapp.Money
+	0,   // 80: carapp.SimulasiKredit.pokok_pinjaman:type_name -> carapp.Money
+	0,   // 81: carapp.SimulasiKredit.angsuran_per_bulan:type_name -> carapp.Money
+	0,   // 82: carapp.SimulasiKredit.total_bunga:type_name -> carapp.Money
+	0,   // 83: carapp.SimulasiKredit.biaya_admin:type_name -> carapp.Money
+	0,   // 84: carapp.SimulasiKredit.pembayaran_pertama:type_name -> carapp.Money
+	0,   // 85: carapp.SimulasiKredit.total_bayar:type_name -> carapp.Money
+	97,  // 86: carapp.SimulasiKredit.jadwal:type_name -> carapp.AngsuranKredit
+	0,   // 87: carapp.TradeIn.nilai_taksiran:type_name -> carapp.Money
+	145, // 88: carapp.TradeIn.berlaku_sampai:type_name -> google.protobuf.Timestamp
+	145, // 89: carapp.TradeIn.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 90: carapp.AppraiseTradeInRequest.nilai_taksiran:type_name -> carapp.Money
+	99,  // 91: carapp.ListTradeInResponse.trade_in:type_name -> carapp.TradeIn
+	145, // 92: carapp.Ulasan.created_at:type_name -> google.protobuf.Timestamp
+	108, // 93: carapp.ListUlasanResponse.ulasan:type_name -> carapp.Ulasan
+	145, // 94: carapp.ProfilPenjual.bergabung_sejak:type_name -> google.protobuf.Timestamp
+	108, // 95: carapp.ProfilPenjual.ulasan_terbaru:type_name -> carapp.Ulasan
+	2,   // 96: carapp.WatchlistItem.mobil:type_name -> carapp.Mobil
+	0,   // 97: carapp.WatchlistItem.harga_saat_ditambah:type_name -> carapp.Money
+	145, // 98: carapp.WatchlistItem.ditambahkan_pada:type_name -> google.protobuf.Timestamp
+	115, // 99: carapp.ListWatchlistResponse.items:type_name -> carapp.WatchlistItem
+	9,   // 100: carapp.SavedSearch.filter:type_name -> carapp.FilterMobil
+	145, // 101: carapp.SavedSearch.terakhir_dikirim:type_name -> google.protobuf.Timestamp
+	145, // 102: carapp.SavedSearch.created_at:type_name -> google.protobuf.Timestamp
+	9,   // 103: carapp.CreateSavedSearchRequest.filter:type_name -> carapp.FilterMobil
+	120, // 104: carapp.ListSavedSearchResponse.saved_search:type_name -> carapp.SavedSearch
+	0,   // 105: carapp.RiwayatHarga.harga:type_name -> carapp.Money
+	0,   // 106: carapp.RiwayatHarga.harga_asli:type_name -> carapp.Money
+	145, // 107: carapp.RiwayatHarga.created_at:type_name -> google.protobuf.Timestamp
+	127, // 108: carapp.GetPriceHistoryResponse.riwayat:type_name -> carapp.RiwayatHarga
+	0,   // 109: carapp.StatistikHarga.minimum:type_name -> carapp.Money
+	0,   // 110: carapp.StatistikHarga.p25:type_name -> carapp.Money
+	0,   // 111: carapp.StatistikHarga.median:type_name -> carapp.Money
+	0,   // 112: carapp.StatistikHarga.p75:type_name -> carapp.Money
+	0,   // 113: carapp.StatistikHarga.maksimum:type_name -> carapp.Money
+	0,   // 114: carapp.ListingPasar.harga:type_name -> carapp.Money
+	131, // 115: carapp.MarketPrice.harga_minta:type_name -> carapp.StatistikHarga
+	131, // 116: carapp.MarketPrice.harga_terjual:type_name -> carapp.StatistikHarga
+	132, // 117: carapp.MarketPrice.listing:type_name -> carapp.ListingPasar
+	132, // 118: carapp.MarketPrice.di_atas_pasar:type_name -> carapp.ListingPasar
+	145, // 119: carapp.LaporanListing.created_at:type_name -> google.protobuf.Timestamp
+	145, // 120: carapp.LaporanListing.ditangani_pada:type_name -> google.protobuf.Timestamp
+	134, // 121: carapp.ListLaporanResponse.laporan:type_name -> carapp.LaporanListing
+	145, // 122: carapp.LaporanUser.created_at:type_name -> google.protobuf.Timestamp
+	145, // 123: carapp.LaporanUser.ditangani_pada:type_name -> google.protobuf.Timestamp
+	138, // 124: carapp.ListLaporanUserResponse.laporan:type_name -> carapp.LaporanUser
+	143, // 125: carapp.PenilaianRisiko.sinyal:type_name -> carapp.SinyalRisiko
+	145, // 126: carapp.PenilaianRisiko.dinilai_pada:type_name -> google.protobuf.Timestamp
+	4,   // 127: carapp.AuthService.Register:input_type -> carapp.RegisterRequest
+	5,   // 128: carapp.AuthService.Login:input_type -> carapp.LoginRequest
+	7,   // 129: carapp.MobilService.CreateMobil:input_type -> carapp.CreateMobilRequest
+	8,   // 130: carapp.MobilService.ListMobil:input_type -> carapp.ListMobilRequest
+	11,  // 131: carapp.MobilService.GetMobil:input_type -> carapp.GetMobilRequest
+	12,  // 132: carapp.MobilService.UploadFoto:input_type -> carapp.UploadFotoRequest
+	14,  // 133: carapp.MobilService.UpdateHargaMobil:input_type -> carapp.UpdateHargaMobilRequest
+	15,  // 134: carapp.MobilService.WithdrawMobil:input_type -> carapp.WithdrawMobilRequest
+	16,  // 135: carapp.MobilService.RenewMobil:input_type -> carapp.RenewMobilRequest
+	27,  // 136: carapp.MobilService.GetSimilarMobil:input_type -> carapp.GetSimilarMobilRequest
+	30,  // 137: carapp.MobilService.CompareMobil:input_type -> carapp.CompareMobilRequest
+	17,  // 138: carapp.MobilService.UpdateDraftMobil:input_type -> carapp.UpdateDraftMobilRequest
+	18,  // 139: carapp.MobilService.PublishMobil:input_type -> carapp.PublishMobilRequest
+	19,  // 140: carapp.MobilService.ListModerasiMobil:input_type -> carapp.ListModerasiMobilRequest
+	20,  // 141: carapp.MobilService.ModerasiMobil:input_type -> carapp.ModerasiMobilRequest
+	21,  // 142: carapp.MobilService.ReportMobil:input_type -> carapp.ReportMobilRequest
+	22,  // 143: carapp.MobilService.BulkImportMobil:input_type -> carapp.BulkImportMobilRequest
+	25,  // 144: carapp.MobilService.ExportInventory:input_type -> carapp.ExportInventoryRequest
+	35,  // 145: carapp.NhtsaDataService.GetMakes:input_type -> carapp.GetMakesRequest
+	37,  // 146: carapp.NhtsaDataService.GetModelsForMake:input_type -> carapp.GetModelsForMakeRequest
+	39,  // 147: carapp.TransaksiService.BuyMobil:input_type -> carapp.BuyMobilRequest
+	41,  // 148: carapp.TransaksiService.PayTransaksi:input_type -> carapp.PayTransaksiRequest
+	42,  // 149: carapp.TransaksiService.ConfirmTransaksi:input_type -> carapp.ConfirmTransaksiRequest
+	43,  // 150: carapp.TransaksiService.CompleteTransaksi:input_type -> carapp.CompleteTransaksiRequest
+	44,  // 151: carapp.TransaksiService.CancelTransaksi:input_type -> carapp.CancelTransaksiRequest
+	45,  // 152: carapp.TransaksiService.ListMyTransactions:input_type -> carapp.ListMyTransactionsRequest
+	47,  // 153: carapp.TransaksiService.GetTransaction:input_type -> carapp.GetTransactionRequest
+	50,  // 154: carapp.TransaksiService.GetInvoice:input_type -> carapp.GetInvoiceRequest
+	52,  // 155: carapp.TransaksiService.RentMobil:input_type -> carapp.RentMobilRequest
+	53,  // 156: carapp.TransaksiService.CompleteRental:input_type -> carapp.CompleteRentalRequest
+	55,  // 157: carapp.NotifikasiService.GetNotifications:input_type -> carapp.GetNotificationsRequest
+	146, // 158: carapp.DashboardService.GetDashboard:input_type -> google.protobuf.Empty
+	58,  // 159: carapp.PenawaranService.CreatePenawaran:input_type -> carapp.CreatePenawaranRequest
+	59,  // 160: carapp.PenawaranService.RespondPenawaran:input_type -> carapp.RespondPenawaranRequest
+	61,  // 161: carapp.PenawaranService.CancelPenawaran:input_type -> carapp.CancelPenawaranRequest
+	62,  // 162: carapp.PenawaranService.ListPenawaran:input_type -> carapp.ListPenawaranRequest
+	67,  // 163: carapp.ChatService.StartPercakapan:input_type -> carapp.StartPercakapanRequest
+	68,  // 164: carapp.ChatService.ListPercakapan:input_type -> carapp.ListPercakapanRequest
+	70,  // 165: carapp.ChatService.SendPesan:input_type -> carapp.SendPesanRequest
+	71,  // 166: carapp.ChatService.ListPesan:input_type -> carapp.ListPesanRequest
+	73,  // 167: carapp.ChatService.StreamPesan:input_type -> carapp.StreamPesanRequest
+	74,  // 168: carapp.ChatService.MarkDibaca:input_type -> carapp.MarkDibacaRequest
+	76,  // 169: carapp.ChatService.BlockUser:input_type -> carapp.BlockUserRequest
+	76,  // 170: carapp.ChatService.UnblockUser:input_type -> carapp.BlockUserRequest
+	77,  // 171: carapp.ChatService.ReportUser:input_type -> carapp.ReportUserRequest
+	79,  // 172: carapp.JanjiTemuService.CreateSlot:input_type -> carapp.CreateSlotRequest
+	80,  // 173: carapp.JanjiTemuService.ListSlot:input_type -> carapp.ListSlotRequest
+	82,  // 174: carapp.JanjiTemuService.DeleteSlot:input_type -> carapp.DeleteSlotRequest
+	84,  // 175: carapp.JanjiTemuService.BookJanjiTemu:input_type -> carapp.BookJanjiTemuRequest
+	85,  // 176: carapp.JanjiTemuService.CancelJanjiTemu:input_type -> carapp.CancelJanjiTemuRequest
+	86,  // 177: carapp.JanjiTemuService.ListJanjiTemu:input_type -> carapp.ListJanjiTemuRequest
+	89,  // 178: carapp.KursService.ImportKurs:input_type -> carapp.ImportKursRequest
+	91,  // 179: carapp.KursService.ListKurs:input_type -> carapp.ListKursRequest
+	94,  // 180: carapp.KreditService.ListProdukKredit:input_type -> carapp.ListProdukKreditRequest
+	96,  // 181: carapp.KreditService.SimulateKredit:input_type -> carapp.SimulateKreditRequest
+	100, // 182: carapp.TradeInService.DecodeVin:input_type -> carapp.DecodeVinRequest
+	102, // 183: carapp.TradeInService.CreateTradeIn:input_type -> carapp.CreateTradeInRequest
+	103, // 184: carapp.TradeInService.AppraiseTradeIn:input_type -> carapp.AppraiseTradeInRequest
+	104, // 185: carapp.TradeInService.RespondTradeIn:input_type -> carapp.RespondTradeInRequest
+	105, // 186: carapp.TradeInService.CancelTradeIn:input_type -> carapp.CancelTradeInRequest
+	106, // 187: carapp.TradeInService.ListTradeIn:input_type -> carapp.ListTradeInRequest
+	109, // 188: carapp.UlasanService.CreateUlasan:input_type -> carapp.CreateUlasanRequest
+	110, // 189: carapp.UlasanService.ListUlasan:input_type -> carapp.ListUlasanRequest
+	112, // 190: carapp.UlasanService.GetProfilPenjual:input_type -> carapp.GetProfilPenjualRequest
+	114, // 191: carapp.UlasanService.HideUlasan:input_type -> carapp.HideUlasanRequest
+	116, // 192: carapp.WatchlistService.AddToWatchlist:input_type -> carapp.AddToWatchlistRequest
+	117, // 193: carapp.WatchlistService.RemoveFromWatchlist:input_type -> carapp.RemoveFromWatchlistRequest
+	118, // 194: carapp.WatchlistService.ListWatchlist:input_type -> carapp.ListWatchlistRequest
+	121, // 195: carapp.SavedSearchService.CreateSavedSearch:input_type -> carapp.CreateSavedSearchRequest
+	122, // 196: carapp.SavedSearchService.ListSavedSearch:input_type -> carapp.ListSavedSearchRequest
+	124, // 197: carapp.SavedSearchService.UpdateSavedSearch:input_type -> carapp.UpdateSavedSearchRequest
+	125, // 198: carapp.SavedSearchService.UnsubscribeSavedSearch:input_type -> carapp.UnsubscribeSavedSearchRequest
+	126, // 199: carapp.SavedSearchService.DeleteSavedSearch:input_type -> carapp.DeleteSavedSearchRequest
+	128, // 200: carapp.HargaPasarService.GetPriceHistory:input_type -> carapp.GetPriceHistoryRequest
+	130, // 201: carapp.HargaPasarService.GetMarketPrice:input_type -> carapp.GetMarketPriceRequest
+	135, // 202: carapp.AdminService.ListLaporan:input_type -> carapp.ListLaporanRequest
+	137, // 203: carapp.AdminService.TanganiLaporan:input_type -> carapp.TanganiLaporanRequest
+	142, // 204: carapp.AdminService.GetRisikoMobil:input_type -> carapp.GetRisikoMobilRequest
+	139, // 205: carapp.AdminService.ListLaporanUser:input_type -> carapp.ListLaporanUserRequest
+	141, // 206: carapp.AdminService.TanganiLaporanUser:input_type -> carapp.TanganiLaporanUserRequest
+	6,   // 207: carapp.AuthService.Register:output_type -> carapp.AuthResponse
+	6,   // 208: carapp.AuthService.Login:output_type -> carapp.AuthResponse
+	2,   // 209: carapp.MobilService.CreateMobil:output_type -> carapp.Mobil
+	10,  // 210: carapp.MobilService.ListMobil:output_type -> carapp.ListMobilResponse
+	2,   // 211: carapp.MobilService.GetMobil:output_type -> carapp.Mobil
+	13,  // 212: carapp.MobilService.UploadFoto:output_type -> carapp.UploadFotoResponse
+	2,   // 213: carapp.MobilService.UpdateHargaMobil:output_type -> carapp.Mobil
+	2,   // 214: carapp.MobilService.WithdrawMobil:output_type -> carapp.Mobil
+	2,   // 215: carapp.MobilService.RenewMobil:output_type -> carapp.Mobil
+	29,  // 216: carapp.MobilService.GetSimilarMobil:output_type -> carapp.GetSimilarMobilResponse
+	32,  // 217: carapp.MobilService.CompareMobil:output_type -> carapp.CompareMobilResponse
+	2,   // 218: carapp.MobilService.UpdateDraftMobil:output_type -> carapp.Mobil
+	2,   // 219: carapp.MobilService.PublishMobil:output_type -> carapp.Mobil
+	10,  // 220: carapp.MobilService.ListModerasiMobil:output_type -> carapp.ListMobilResponse
+	2,   // 221: carapp.MobilService.ModerasiMobil:output_type -> carapp.Mobil
+	134, // 222: carapp.MobilService.ReportMobil:output_type -> carapp.LaporanListing
+	24,  // 223: carapp.MobilService.BulkImportMobil:output_type -> carapp.BulkImportMobilResponse
+	26,  // 224: carapp.MobilService.ExportInventory:output_type -> carapp.ExportInventoryChunk
+	36,  // 225: carapp.NhtsaDataService.GetMakes:output_type -> carapp.GetMakesResponse
+	38,  // 226: carapp.NhtsaDataService.GetModelsForMake:output_type -> carapp.GetModelsForMakeResponse
+	40,  // 227: carapp.TransaksiService.BuyMobil:output_type -> carapp.TransaksiJualResponse
+	40,  // 228: carapp.TransaksiService.PayTransaksi:output_type -> carapp.TransaksiJualResponse
+	40,  // 229: carapp.TransaksiService.ConfirmTransaksi:output_type -> carapp.TransaksiJualResponse
+	40,  // 230: carapp.TransaksiService.CompleteTransaksi:output_type -> carapp.TransaksiJualResponse
+	40,  // 231: carapp.TransaksiService.CancelTransaksi:output_type -> carapp.TransaksiJualResponse
+	46,  // 232: carapp.TransaksiService.ListMyTransactions:output_type -> carapp.ListMyTransactionsResponse
+	49,  // 233: carapp.TransaksiService.GetTransaction:output_type -> carapp.TransaksiDetail
+	51,  // 234: carapp.TransaksiService.GetInvoice:output_type -> carapp.InvoiceResponse
+	54,  // 235: carapp.TransaksiService.RentMobil:output_type -> carapp.TransaksiRentalResponse
+	54,  // 236: carapp.TransaksiService.CompleteRental:output_type -> carapp.TransaksiRentalResponse
+	3,   // 237: carapp.NotifikasiService.GetNotifications:output_type -> carapp.Notifikasi
+	56,  // 238: carapp.DashboardService.GetDashboard:output_type -> carapp.DashboardSummary
+	57,  // 239: carapp.PenawaranService.CreatePenawaran:output_type -> carapp.Penawaran
+	60,  // 240: carapp.PenawaranService.RespondPenawaran:output_type -> carapp.RespondPenawaranResponse
+	57,  // 241: carapp.PenawaranService.CancelPenawaran:output_type -> carapp.Penawaran
+	63,  // 242: carapp.PenawaranService.ListPenawaran:output_type -> carapp.ListPenawaranResponse
+	64,  // 243: carapp.ChatService.StartPercakapan:output_type -> carapp.Percakapan
+	69,  // 244: carapp.ChatService.ListPercakapan:output_type -> carapp.ListPercakapanResponse
+	65,  // 245: carapp.ChatService.SendPesan:output_type -> carapp.PesanChat
+	72,  // 246: carapp.ChatService.ListPesan:output_type -> carapp.ListPesanResponse
+	66,  // 247: carapp.ChatService.StreamPesan:output_type -> carapp.ChatEvent
+	75,  // 248: carapp.ChatService.MarkDibaca:output_type -> carapp.MarkDibacaResponse
+	146, // 249: carapp.ChatService.BlockUser:output_type -> google.protobuf.Empty
+	146, // 250: carapp.ChatService.UnblockUser:output_type -> google.protobuf.Empty
+	146, // 251: carapp.ChatService.ReportUser:output_type -> google.protobuf.Empty
+	78,  // 252: carapp.JanjiTemuService.CreateSlot:output_type -> carapp.SlotJadwal
+	81,  // 253: carapp.JanjiTemuService.ListSlot:output_type -> carapp.ListSlotResponse
+	146, // 254: carapp.JanjiTemuService.DeleteSlot:output_type -> google.protobuf.Empty
+	83,  // 255: carapp.JanjiTemuService.BookJanjiTemu:output_type -> carapp.JanjiTemu
+	83,  // 256: carapp.JanjiTemuService.CancelJanjiTemu:output_type -> carapp.JanjiTemu
+	87,  // 257: carapp.JanjiTemuService.ListJanjiTemu:output_type -> carapp.ListJanjiTemuResponse
+	90,  // 258: carapp.KursService.ImportKurs:output_type -> carapp.ImportKursResponse
+	92,  // 259: carapp.KursService.ListKurs:output_type -> carapp.ListKursResponse
+	95,  // 260: carapp.KreditService.ListProdukKredit:output_type -> carapp.ListProdukKreditResponse
+	98,  // 261: carapp.KreditService.SimulateKredit:output_type -> carapp.SimulasiKredit
+	101, // 262: carapp.TradeInService.DecodeVin:output_type -> carapp.DecodeVinResponse
+	99,  // 263: carapp.TradeInService.CreateTradeIn:output_type -> carapp.TradeIn
+	99,  // 264: carapp.TradeInService.AppraiseTradeIn:output_type -> carapp.TradeIn
+	99,  // 265: carapp.TradeInService.RespondTradeIn:output_type -> carapp.TradeIn
+	99,  // 266: carapp.TradeInService.CancelTradeIn:output_type -> carapp.TradeIn
+	107, // 267: carapp.TradeInService.ListTradeIn:output_type -> carapp.ListTradeInResponse
+	108, // 268: carapp.UlasanService.CreateUlasan:output_type -> carapp.Ulasan
+	111, // 269: carapp.UlasanService.ListUlasan:output_type -> carapp.ListUlasanResponse
+	113, // 270: carapp.UlasanService.GetProfilPenjual:output_type -> carapp.ProfilPenjual
+	108, // 271: carapp.UlasanService.HideUlasan:output_type -> carapp.Ulasan
+	115, // 272: carapp.WatchlistService.AddToWatchlist:output_type -> carapp.WatchlistItem
+	146, // 273: carapp.WatchlistService.RemoveFromWatchlist:output_type -> google.protobuf.Empty
+	119, // 274: carapp.WatchlistService.ListWatchlist:output_type -> carapp.ListWatchlistResponse
+	120, // 275: carapp.SavedSearchService.CreateSavedSearch:output_type -> carapp.SavedSearch
+	123, // 276: carapp.SavedSearchService.ListSavedSearch:output_type -> carapp.ListSavedSearchResponse
+	120, // 277: carapp.SavedSearchService.UpdateSavedSearch:output_type -> carapp.SavedSearch
+	120, // 278: carapp.SavedSearchService.UnsubscribeSavedSearch:output_type -> carapp.SavedSearch
+	146, // 279: carapp.SavedSearchService.DeleteSavedSearch:output_type -> google.protobuf.Empty
+	129, // 280: carapp.HargaPasarService.GetPriceHistory:output_type -> carapp.GetPriceHistoryResponse
+	133, // 281: carapp.HargaPasarService.GetMarketPrice:output_type -> carapp.MarketPrice
+	136, // 282: carapp.AdminService.ListLaporan:output_type -> carapp.ListLaporanResponse
+	134, // 283: carapp.AdminService.TanganiLaporan:output_type -> carapp.LaporanListing
+	144, // 284: carapp.AdminService.GetRisikoMobil:output_type -> carapp.PenilaianRisiko
+	140, // 285: carapp.AdminService.ListLaporanUser:output_type -> carapp.ListLaporanUserResponse
+	138, // 286: carapp.AdminService.TanganiLaporanUser:output_type -> carapp.LaporanUser
+	207, // [207:287] is the sub-list for method output_type
+	127, // [127:207] is the sub-list for method input_type
+	127, // [127:127] is the sub-list for extension type_name
+	127, // [127:127] is the sub-list for extension extendee
+	0,   // [0:127] is the sub-list for field type_name
 }
 
 func init() { file_proto_carapp_proto_init() }
@@ -1862,16 +11396,25 @@ func file_proto_carapp_proto_init() {
 	if File_proto_carapp_proto != nil {
 		return
 	}
+	file_proto_carapp_proto_msgTypes[2].OneofWrappers = []any{}
 	file_proto_carapp_proto_msgTypes[7].OneofWrappers = []any{}
+	file_proto_carapp_proto_msgTypes[8].OneofWrappers = []any{}
+	file_proto_carapp_proto_msgTypes[9].OneofWrappers = []any{}
+	file_proto_carapp_proto_msgTypes[11].OneofWrappers = []any{}
+	file_proto_carapp_proto_msgTypes[27].OneofWrappers = []any{}
+	file_proto_carapp_proto_msgTypes[30].OneofWrappers = []any{}
+	file_proto_carapp_proto_msgTypes[45].OneofWrappers = []any{}
+	file_proto_carapp_proto_msgTypes[62].OneofWrappers = []any{}
+	file_proto_carapp_proto_msgTypes[106].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_carapp_proto_rawDesc), len(file_proto_carapp_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   145,
 			NumExtensions: 0,
-			NumServices:   6,
+			NumServices:   17,
 		},
 		GoTypes:           file_proto_carapp_proto_goTypes,
 		DependencyIndexes: file_proto_carapp_proto_depIdxs,
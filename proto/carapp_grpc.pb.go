@@ -160,10 +160,22 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	MobilService_CreateMobil_FullMethodName = "/carapp.MobilService/CreateMobil"
-	MobilService_ListMobil_FullMethodName   = "/carapp.MobilService/ListMobil"
-	MobilService_GetMobil_FullMethodName    = "/carapp.MobilService/GetMobil"
-	MobilService_UploadFoto_FullMethodName  = "/carapp.MobilService/UploadFoto"
+	MobilService_CreateMobil_FullMethodName       = "/carapp.MobilService/CreateMobil"
+	MobilService_ListMobil_FullMethodName         = "/carapp.MobilService/ListMobil"
+	MobilService_GetMobil_FullMethodName          = "/carapp.MobilService/GetMobil"
+	MobilService_UploadFoto_FullMethodName        = "/carapp.MobilService/UploadFoto"
+	MobilService_UpdateHargaMobil_FullMethodName  = "/carapp.MobilService/UpdateHargaMobil"
+	MobilService_WithdrawMobil_FullMethodName     = "/carapp.MobilService/WithdrawMobil"
+	MobilService_RenewMobil_FullMethodName        = "/carapp.MobilService/RenewMobil"
+	MobilService_GetSimilarMobil_FullMethodName   = "/carapp.MobilService/GetSimilarMobil"
+	MobilService_CompareMobil_FullMethodName      = "/carapp.MobilService/CompareMobil"
+	MobilService_UpdateDraftMobil_FullMethodName  = "/carapp.MobilService/UpdateDraftMobil"
+	MobilService_PublishMobil_FullMethodName      = "/carapp.MobilService/PublishMobil"
+	MobilService_ListModerasiMobil_FullMethodName = "/carapp.MobilService/ListModerasiMobil"
+	MobilService_ModerasiMobil_FullMethodName     = "/carapp.MobilService/ModerasiMobil"
+	MobilService_ReportMobil_FullMethodName       = "/carapp.MobilService/ReportMobil"
+	MobilService_BulkImportMobil_FullMethodName   = "/carapp.MobilService/BulkImportMobil"
+	MobilService_ExportInventory_FullMethodName   = "/carapp.MobilService/ExportInventory"
 )
 
 // MobilServiceClient is the client API for MobilService service.
@@ -178,6 +190,30 @@ type MobilServiceClient interface {
 	GetMobil(ctx context.Context, in *GetMobilRequest, opts ...grpc.CallOption) (*Mobil, error)
 	// Upload foto mobil (unary untuk gRPC-Web compatibility)
 	UploadFoto(ctx context.Context, in *UploadFotoRequest, opts ...grpc.CallOption) (*UploadFotoResponse, error)
+	// Owner mengubah harga listing (watcher diberi tahu jika harga turun)
+	UpdateHargaMobil(ctx context.Context, in *UpdateHargaMobilRequest, opts ...grpc.CallOption) (*Mobil, error)
+	// Owner menarik listing dari penjualan (status 'ditarik')
+	WithdrawMobil(ctx context.Context, in *WithdrawMobilRequest, opts ...grpc.CallOption) (*Mobil, error)
+	// Owner memperpanjang masa tayang listing (juga menayangkan ulang listing 'kedaluwarsa')
+	RenewMobil(ctx context.Context, in *RenewMobilRequest, opts ...grpc.CallOption) (*Mobil, error)
+	// Rekomendasi mobil serupa untuk halaman detail (publik)
+	GetSimilarMobil(ctx context.Context, in *GetSimilarMobilRequest, opts ...grpc.CallOption) (*GetSimilarMobilResponse, error)
+	// Perbandingan 2-4 mobil berdampingan (publik)
+	CompareMobil(ctx context.Context, in *CompareMobilRequest, opts ...grpc.CallOption) (*CompareMobilResponse, error)
+	// Owner melengkapi listing draft / ditolak (semua field ditimpa isi data)
+	UpdateDraftMobil(ctx context.Context, in *UpdateDraftMobilRequest, opts ...grpc.CallOption) (*Mobil, error)
+	// Owner mempublikasikan draft (validasi lengkap; masuk antrean moderasi jika aktif)
+	PublishMobil(ctx context.Context, in *PublishMobilRequest, opts ...grpc.CallOption) (*Mobil, error)
+	// Admin: antrean listing yang menunggu moderasi (paling lama di depan)
+	ListModerasiMobil(ctx context.Context, in *ListModerasiMobilRequest, opts ...grpc.CallOption) (*ListMobilResponse, error)
+	// Admin: setujui atau tolak (dengan alasan) listing di antrean moderasi
+	ModerasiMobil(ctx context.Context, in *ModerasiMobilRequest, opts ...grpc.CallOption) (*Mobil, error)
+	// User melaporkan listing yang mencurigakan (ditahan otomatis jika banyak laporan)
+	ReportMobil(ctx context.Context, in *ReportMobilRequest, opts ...grpc.CallOption) (*LaporanListing, error)
+	// Impor stok dealer dari CSV / JSON, upsert per nomor stok atau VIN (bisa dry run)
+	BulkImportMobil(ctx context.Context, in *BulkImportMobilRequest, opts ...grpc.CallOption) (*BulkImportMobilResponse, error)
+	// Ekspor inventaris milik user (admin: semua) sebagai CSV / JSON Lines, dikirim bertahap
+	ExportInventory(ctx context.Context, in *ExportInventoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportInventoryChunk], error)
 }
 
 type mobilServiceClient struct {
@@ -228,155 +264,3176 @@ func (c *mobilServiceClient) UploadFoto(ctx context.Context, in *UploadFotoReque
 	return out, nil
 }
 
+func (c *mobilServiceClient) UpdateHargaMobil(ctx context.Context, in *UpdateHargaMobilRequest, opts ...grpc.CallOption) (*Mobil, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Mobil)
+	err := c.cc.Invoke(ctx, MobilService_UpdateHargaMobil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mobilServiceClient) WithdrawMobil(ctx context.Context, in *WithdrawMobilRequest, opts ...grpc.CallOption) (*Mobil, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Mobil)
+	err := c.cc.Invoke(ctx, MobilService_WithdrawMobil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mobilServiceClient) RenewMobil(ctx context.Context, in *RenewMobilRequest, opts ...grpc.CallOption) (*Mobil, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Mobil)
+	err := c.cc.Invoke(ctx, MobilService_RenewMobil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mobilServiceClient) GetSimilarMobil(ctx context.Context, in *GetSimilarMobilRequest, opts ...grpc.CallOption) (*GetSimilarMobilResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSimilarMobilResponse)
+	err := c.cc.Invoke(ctx, MobilService_GetSimilarMobil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mobilServiceClient) CompareMobil(ctx context.Context, in *CompareMobilRequest, opts ...grpc.CallOption) (*CompareMobilResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareMobilResponse)
+	err := c.cc.Invoke(ctx, MobilService_CompareMobil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mobilServiceClient) UpdateDraftMobil(ctx context.Context, in *UpdateDraftMobilRequest, opts ...grpc.CallOption) (*Mobil, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Mobil)
+	err := c.cc.Invoke(ctx, MobilService_UpdateDraftMobil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mobilServiceClient) PublishMobil(ctx context.Context, in *PublishMobilRequest, opts ...grpc.CallOption) (*Mobil, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Mobil)
+	err := c.cc.Invoke(ctx, MobilService_PublishMobil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mobilServiceClient) ListModerasiMobil(ctx context.Context, in *ListModerasiMobilRequest, opts ...grpc.CallOption) (*ListMobilResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMobilResponse)
+	err := c.cc.Invoke(ctx, MobilService_ListModerasiMobil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mobilServiceClient) ModerasiMobil(ctx context.Context, in *ModerasiMobilRequest, opts ...grpc.CallOption) (*Mobil, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Mobil)
+	err := c.cc.Invoke(ctx, MobilService_ModerasiMobil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mobilServiceClient) ReportMobil(ctx context.Context, in *ReportMobilRequest, opts ...grpc.CallOption) (*LaporanListing, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LaporanListing)
+	err := c.cc.Invoke(ctx, MobilService_ReportMobil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mobilServiceClient) BulkImportMobil(ctx context.Context, in *BulkImportMobilRequest, opts ...grpc.CallOption) (*BulkImportMobilResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkImportMobilResponse)
+	err := c.cc.Invoke(ctx, MobilService_BulkImportMobil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mobilServiceClient) ExportInventory(ctx context.Context, in *ExportInventoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportInventoryChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MobilService_ServiceDesc.Streams[0], MobilService_ExportInventory_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportInventoryRequest, ExportInventoryChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MobilService_ExportInventoryClient = grpc.ServerStreamingClient[ExportInventoryChunk]
+
 // MobilServiceServer is the server API for MobilService service.
 // All implementations must embed UnimplementedMobilServiceServer
 // for forward compatibility.
-type MobilServiceServer interface {
-	// Fitur 4: Jual Mobil
-	CreateMobil(context.Context, *CreateMobilRequest) (*Mobil, error)
-	// Fitur 2: List Mobil (dari DB kita)
-	ListMobil(context.Context, *ListMobilRequest) (*ListMobilResponse, error)
-	// Fitur 3: Detail Mobil
-	GetMobil(context.Context, *GetMobilRequest) (*Mobil, error)
-	// Upload foto mobil (unary untuk gRPC-Web compatibility)
-	UploadFoto(context.Context, *UploadFotoRequest) (*UploadFotoResponse, error)
-	mustEmbedUnimplementedMobilServiceServer()
+type MobilServiceServer interface {
+	// Fitur 4: Jual Mobil
+	CreateMobil(context.Context, *CreateMobilRequest) (*Mobil, error)
+	// Fitur 2: List Mobil (dari DB kita)
+	ListMobil(context.Context, *ListMobilRequest) (*ListMobilResponse, error)
+	// Fitur 3: Detail Mobil
+	GetMobil(context.Context, *GetMobilRequest) (*Mobil, error)
+	// Upload foto mobil (unary untuk gRPC-Web compatibility)
+	UploadFoto(context.Context, *UploadFotoRequest) (*UploadFotoResponse, error)
+	// Owner mengubah harga listing (watcher diberi tahu jika harga turun)
+	UpdateHargaMobil(context.Context, *UpdateHargaMobilRequest) (*Mobil, error)
+	// Owner menarik listing dari penjualan (status 'ditarik')
+	WithdrawMobil(context.Context, *WithdrawMobilRequest) (*Mobil, error)
+	// Owner memperpanjang masa tayang listing (juga menayangkan ulang listing 'kedaluwarsa')
+	RenewMobil(context.Context, *RenewMobilRequest) (*Mobil, error)
+	// Rekomendasi mobil serupa untuk halaman detail (publik)
+	GetSimilarMobil(context.Context, *GetSimilarMobilRequest) (*GetSimilarMobilResponse, error)
+	// Perbandingan 2-4 mobil berdampingan (publik)
+	CompareMobil(context.Context, *CompareMobilRequest) (*CompareMobilResponse, error)
+	// Owner melengkapi listing draft / ditolak (semua field ditimpa isi data)
+	UpdateDraftMobil(context.Context, *UpdateDraftMobilRequest) (*Mobil, error)
+	// Owner mempublikasikan draft (validasi lengkap; masuk antrean moderasi jika aktif)
+	PublishMobil(context.Context, *PublishMobilRequest) (*Mobil, error)
+	// Admin: antrean listing yang menunggu moderasi (paling lama di depan)
+	ListModerasiMobil(context.Context, *ListModerasiMobilRequest) (*ListMobilResponse, error)
+	// Admin: setujui atau tolak (dengan alasan) listing di antrean moderasi
+	ModerasiMobil(context.Context, *ModerasiMobilRequest) (*Mobil, error)
+	// User melaporkan listing yang mencurigakan (ditahan otomatis jika banyak laporan)
+	ReportMobil(context.Context, *ReportMobilRequest) (*LaporanListing, error)
+	// Impor stok dealer dari CSV / JSON, upsert per nomor stok atau VIN (bisa dry run)
+	BulkImportMobil(context.Context, *BulkImportMobilRequest) (*BulkImportMobilResponse, error)
+	// Ekspor inventaris milik user (admin: semua) sebagai CSV / JSON Lines, dikirim bertahap
+	ExportInventory(*ExportInventoryRequest, grpc.ServerStreamingServer[ExportInventoryChunk]) error
+	mustEmbedUnimplementedMobilServiceServer()
+}
+
+// UnimplementedMobilServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMobilServiceServer struct{}
+
+func (UnimplementedMobilServiceServer) CreateMobil(context.Context, *CreateMobilRequest) (*Mobil, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMobil not implemented")
+}
+func (UnimplementedMobilServiceServer) ListMobil(context.Context, *ListMobilRequest) (*ListMobilResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMobil not implemented")
+}
+func (UnimplementedMobilServiceServer) GetMobil(context.Context, *GetMobilRequest) (*Mobil, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMobil not implemented")
+}
+func (UnimplementedMobilServiceServer) UploadFoto(context.Context, *UploadFotoRequest) (*UploadFotoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadFoto not implemented")
+}
+func (UnimplementedMobilServiceServer) UpdateHargaMobil(context.Context, *UpdateHargaMobilRequest) (*Mobil, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHargaMobil not implemented")
+}
+func (UnimplementedMobilServiceServer) WithdrawMobil(context.Context, *WithdrawMobilRequest) (*Mobil, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawMobil not implemented")
+}
+func (UnimplementedMobilServiceServer) RenewMobil(context.Context, *RenewMobilRequest) (*Mobil, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewMobil not implemented")
+}
+func (UnimplementedMobilServiceServer) GetSimilarMobil(context.Context, *GetSimilarMobilRequest) (*GetSimilarMobilResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSimilarMobil not implemented")
+}
+func (UnimplementedMobilServiceServer) CompareMobil(context.Context, *CompareMobilRequest) (*CompareMobilResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareMobil not implemented")
+}
+func (UnimplementedMobilServiceServer) UpdateDraftMobil(context.Context, *UpdateDraftMobilRequest) (*Mobil, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDraftMobil not implemented")
+}
+func (UnimplementedMobilServiceServer) PublishMobil(context.Context, *PublishMobilRequest) (*Mobil, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishMobil not implemented")
+}
+func (UnimplementedMobilServiceServer) ListModerasiMobil(context.Context, *ListModerasiMobilRequest) (*ListMobilResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerasiMobil not implemented")
+}
+func (UnimplementedMobilServiceServer) ModerasiMobil(context.Context, *ModerasiMobilRequest) (*Mobil, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerasiMobil not implemented")
+}
+func (UnimplementedMobilServiceServer) ReportMobil(context.Context, *ReportMobilRequest) (*LaporanListing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportMobil not implemented")
+}
+func (UnimplementedMobilServiceServer) BulkImportMobil(context.Context, *BulkImportMobilRequest) (*BulkImportMobilResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkImportMobil not implemented")
+}
+func (UnimplementedMobilServiceServer) ExportInventory(*ExportInventoryRequest, grpc.ServerStreamingServer[ExportInventoryChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportInventory not implemented")
+}
+func (UnimplementedMobilServiceServer) mustEmbedUnimplementedMobilServiceServer() {}
+func (UnimplementedMobilServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeMobilServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MobilServiceServer will
+// result in compilation errors.
+type UnsafeMobilServiceServer interface {
+	mustEmbedUnimplementedMobilServiceServer()
+}
+
+func RegisterMobilServiceServer(s grpc.ServiceRegistrar, srv MobilServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMobilServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MobilService_ServiceDesc, srv)
+}
+
+func _MobilService_CreateMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMobilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MobilServiceServer).CreateMobil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MobilService_CreateMobil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MobilServiceServer).CreateMobil(ctx, req.(*CreateMobilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MobilService_ListMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMobilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MobilServiceServer).ListMobil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MobilService_ListMobil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MobilServiceServer).ListMobil(ctx, req.(*ListMobilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MobilService_GetMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMobilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MobilServiceServer).GetMobil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MobilService_GetMobil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MobilServiceServer).GetMobil(ctx, req.(*GetMobilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MobilService_UploadFoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadFotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MobilServiceServer).UploadFoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MobilService_UploadFoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MobilServiceServer).UploadFoto(ctx, req.(*UploadFotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MobilService_UpdateHargaMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHargaMobilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MobilServiceServer).UpdateHargaMobil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MobilService_UpdateHargaMobil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MobilServiceServer).UpdateHargaMobil(ctx, req.(*UpdateHargaMobilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MobilService_WithdrawMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawMobilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MobilServiceServer).WithdrawMobil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MobilService_WithdrawMobil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MobilServiceServer).WithdrawMobil(ctx, req.(*WithdrawMobilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MobilService_RenewMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewMobilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MobilServiceServer).RenewMobil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MobilService_RenewMobil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MobilServiceServer).RenewMobil(ctx, req.(*RenewMobilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MobilService_GetSimilarMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSimilarMobilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MobilServiceServer).GetSimilarMobil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MobilService_GetSimilarMobil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MobilServiceServer).GetSimilarMobil(ctx, req.(*GetSimilarMobilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MobilService_CompareMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareMobilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MobilServiceServer).CompareMobil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MobilService_CompareMobil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MobilServiceServer).CompareMobil(ctx, req.(*CompareMobilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MobilService_UpdateDraftMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDraftMobilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MobilServiceServer).UpdateDraftMobil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MobilService_UpdateDraftMobil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MobilServiceServer).UpdateDraftMobil(ctx, req.(*UpdateDraftMobilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MobilService_PublishMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishMobilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MobilServiceServer).PublishMobil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MobilService_PublishMobil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MobilServiceServer).PublishMobil(ctx, req.(*PublishMobilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MobilService_ListModerasiMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerasiMobilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MobilServiceServer).ListModerasiMobil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MobilService_ListModerasiMobil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MobilServiceServer).ListModerasiMobil(ctx, req.(*ListModerasiMobilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MobilService_ModerasiMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerasiMobilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MobilServiceServer).ModerasiMobil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MobilService_ModerasiMobil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MobilServiceServer).ModerasiMobil(ctx, req.(*ModerasiMobilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MobilService_ReportMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportMobilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MobilServiceServer).ReportMobil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MobilService_ReportMobil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MobilServiceServer).ReportMobil(ctx, req.(*ReportMobilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MobilService_BulkImportMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkImportMobilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MobilServiceServer).BulkImportMobil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MobilService_BulkImportMobil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MobilServiceServer).BulkImportMobil(ctx, req.(*BulkImportMobilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MobilService_ExportInventory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportInventoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MobilServiceServer).ExportInventory(m, &grpc.GenericServerStream[ExportInventoryRequest, ExportInventoryChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MobilService_ExportInventoryServer = grpc.ServerStreamingServer[ExportInventoryChunk]
+
+// MobilService_ServiceDesc is the grpc.ServiceDesc for MobilService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MobilService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carapp.MobilService",
+	HandlerType: (*MobilServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMobil",
+			Handler:    _MobilService_CreateMobil_Handler,
+		},
+		{
+			MethodName: "ListMobil",
+			Handler:    _MobilService_ListMobil_Handler,
+		},
+		{
+			MethodName: "GetMobil",
+			Handler:    _MobilService_GetMobil_Handler,
+		},
+		{
+			MethodName: "UploadFoto",
+			Handler:    _MobilService_UploadFoto_Handler,
+		},
+		{
+			MethodName: "UpdateHargaMobil",
+			Handler:    _MobilService_UpdateHargaMobil_Handler,
+		},
+		{
+			MethodName: "WithdrawMobil",
+			Handler:    _MobilService_WithdrawMobil_Handler,
+		},
+		{
+			MethodName: "RenewMobil",
+			Handler:    _MobilService_RenewMobil_Handler,
+		},
+		{
+			MethodName: "GetSimilarMobil",
+			Handler:    _MobilService_GetSimilarMobil_Handler,
+		},
+		{
+			MethodName: "CompareMobil",
+			Handler:    _MobilService_CompareMobil_Handler,
+		},
+		{
+			MethodName: "UpdateDraftMobil",
+			Handler:    _MobilService_UpdateDraftMobil_Handler,
+		},
+		{
+			MethodName: "PublishMobil",
+			Handler:    _MobilService_PublishMobil_Handler,
+		},
+		{
+			MethodName: "ListModerasiMobil",
+			Handler:    _MobilService_ListModerasiMobil_Handler,
+		},
+		{
+			MethodName: "ModerasiMobil",
+			Handler:    _MobilService_ModerasiMobil_Handler,
+		},
+		{
+			MethodName: "ReportMobil",
+			Handler:    _MobilService_ReportMobil_Handler,
+		},
+		{
+			MethodName: "BulkImportMobil",
+			Handler:    _MobilService_BulkImportMobil_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportInventory",
+			Handler:       _MobilService_ExportInventory_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/carapp.proto",
+}
+
+const (
+	NhtsaDataService_GetMakes_FullMethodName         = "/carapp.NhtsaDataService/GetMakes"
+	NhtsaDataService_GetModelsForMake_FullMethodName = "/carapp.NhtsaDataService/GetModelsForMake"
+)
+
+// NhtsaDataServiceClient is the client API for NhtsaDataService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NhtsaDataServiceClient interface {
+	// Prasyarat Fitur 4: Mendapat data dari NHTSA API (Cache)
+	GetMakes(ctx context.Context, in *GetMakesRequest, opts ...grpc.CallOption) (*GetMakesResponse, error)
+	GetModelsForMake(ctx context.Context, in *GetModelsForMakeRequest, opts ...grpc.CallOption) (*GetModelsForMakeResponse, error)
+}
+
+type nhtsaDataServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNhtsaDataServiceClient(cc grpc.ClientConnInterface) NhtsaDataServiceClient {
+	return &nhtsaDataServiceClient{cc}
+}
+
+func (c *nhtsaDataServiceClient) GetMakes(ctx context.Context, in *GetMakesRequest, opts ...grpc.CallOption) (*GetMakesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMakesResponse)
+	err := c.cc.Invoke(ctx, NhtsaDataService_GetMakes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nhtsaDataServiceClient) GetModelsForMake(ctx context.Context, in *GetModelsForMakeRequest, opts ...grpc.CallOption) (*GetModelsForMakeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetModelsForMakeResponse)
+	err := c.cc.Invoke(ctx, NhtsaDataService_GetModelsForMake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NhtsaDataServiceServer is the server API for NhtsaDataService service.
+// All implementations must embed UnimplementedNhtsaDataServiceServer
+// for forward compatibility.
+type NhtsaDataServiceServer interface {
+	// Prasyarat Fitur 4: Mendapat data dari NHTSA API (Cache)
+	GetMakes(context.Context, *GetMakesRequest) (*GetMakesResponse, error)
+	GetModelsForMake(context.Context, *GetModelsForMakeRequest) (*GetModelsForMakeResponse, error)
+	mustEmbedUnimplementedNhtsaDataServiceServer()
+}
+
+// UnimplementedNhtsaDataServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNhtsaDataServiceServer struct{}
+
+func (UnimplementedNhtsaDataServiceServer) GetMakes(context.Context, *GetMakesRequest) (*GetMakesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMakes not implemented")
+}
+func (UnimplementedNhtsaDataServiceServer) GetModelsForMake(context.Context, *GetModelsForMakeRequest) (*GetModelsForMakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModelsForMake not implemented")
+}
+func (UnimplementedNhtsaDataServiceServer) mustEmbedUnimplementedNhtsaDataServiceServer() {}
+func (UnimplementedNhtsaDataServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeNhtsaDataServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NhtsaDataServiceServer will
+// result in compilation errors.
+type UnsafeNhtsaDataServiceServer interface {
+	mustEmbedUnimplementedNhtsaDataServiceServer()
+}
+
+func RegisterNhtsaDataServiceServer(s grpc.ServiceRegistrar, srv NhtsaDataServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNhtsaDataServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NhtsaDataService_ServiceDesc, srv)
+}
+
+func _NhtsaDataService_GetMakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMakesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NhtsaDataServiceServer).GetMakes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NhtsaDataService_GetMakes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NhtsaDataServiceServer).GetMakes(ctx, req.(*GetMakesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NhtsaDataService_GetModelsForMake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModelsForMakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NhtsaDataServiceServer).GetModelsForMake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NhtsaDataService_GetModelsForMake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NhtsaDataServiceServer).GetModelsForMake(ctx, req.(*GetModelsForMakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NhtsaDataService_ServiceDesc is the grpc.ServiceDesc for NhtsaDataService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NhtsaDataService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carapp.NhtsaDataService",
+	HandlerType: (*NhtsaDataServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMakes",
+			Handler:    _NhtsaDataService_GetMakes_Handler,
+		},
+		{
+			MethodName: "GetModelsForMake",
+			Handler:    _NhtsaDataService_GetModelsForMake_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/carapp.proto",
+}
+
+const (
+	TransaksiService_BuyMobil_FullMethodName           = "/carapp.TransaksiService/BuyMobil"
+	TransaksiService_PayTransaksi_FullMethodName       = "/carapp.TransaksiService/PayTransaksi"
+	TransaksiService_ConfirmTransaksi_FullMethodName   = "/carapp.TransaksiService/ConfirmTransaksi"
+	TransaksiService_CompleteTransaksi_FullMethodName  = "/carapp.TransaksiService/CompleteTransaksi"
+	TransaksiService_CancelTransaksi_FullMethodName    = "/carapp.TransaksiService/CancelTransaksi"
+	TransaksiService_ListMyTransactions_FullMethodName = "/carapp.TransaksiService/ListMyTransactions"
+	TransaksiService_GetTransaction_FullMethodName     = "/carapp.TransaksiService/GetTransaction"
+	TransaksiService_GetInvoice_FullMethodName         = "/carapp.TransaksiService/GetInvoice"
+	TransaksiService_RentMobil_FullMethodName          = "/carapp.TransaksiService/RentMobil"
+	TransaksiService_CompleteRental_FullMethodName     = "/carapp.TransaksiService/CompleteRental"
+)
+
+// TransaksiServiceClient is the client API for TransaksiService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TransaksiServiceClient interface {
+	// Fitur 3: Beli Mobil (membuat reservasi yang menunggu pembayaran)
+	BuyMobil(ctx context.Context, in *BuyMobilRequest, opts ...grpc.CallOption) (*TransaksiJualResponse, error)
+	// Alur status transaksi jual: bayar -> konfirmasi -> selesai / batal
+	// PayTransaksi membuat tagihan di payment provider; status 'dibayar' diisi lewat webhook
+	PayTransaksi(ctx context.Context, in *PayTransaksiRequest, opts ...grpc.CallOption) (*TransaksiJualResponse, error)
+	ConfirmTransaksi(ctx context.Context, in *ConfirmTransaksiRequest, opts ...grpc.CallOption) (*TransaksiJualResponse, error)
+	CompleteTransaksi(ctx context.Context, in *CompleteTransaksiRequest, opts ...grpc.CallOption) (*TransaksiJualResponse, error)
+	CancelTransaksi(ctx context.Context, in *CancelTransaksiRequest, opts ...grpc.CallOption) (*TransaksiJualResponse, error)
+	// Riwayat transaksi jual (sebagai pembeli dan/atau penjual)
+	ListMyTransactions(ctx context.Context, in *ListMyTransactionsRequest, opts ...grpc.CallOption) (*ListMyTransactionsResponse, error)
+	// Detail satu transaksi (hanya pembeli, penjual, atau admin)
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*TransaksiDetail, error)
+	// Invoice PDF untuk transaksi 'selesai' (hanya pembeli dan penjual)
+	GetInvoice(ctx context.Context, in *GetInvoiceRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	// Fitur 3 & 5: Rental Mobil
+	RentMobil(ctx context.Context, in *RentMobilRequest, opts ...grpc.CallOption) (*TransaksiRentalResponse, error)
+	CompleteRental(ctx context.Context, in *CompleteRentalRequest, opts ...grpc.CallOption) (*TransaksiRentalResponse, error)
+}
+
+type transaksiServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTransaksiServiceClient(cc grpc.ClientConnInterface) TransaksiServiceClient {
+	return &transaksiServiceClient{cc}
+}
+
+func (c *transaksiServiceClient) BuyMobil(ctx context.Context, in *BuyMobilRequest, opts ...grpc.CallOption) (*TransaksiJualResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransaksiJualResponse)
+	err := c.cc.Invoke(ctx, TransaksiService_BuyMobil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transaksiServiceClient) PayTransaksi(ctx context.Context, in *PayTransaksiRequest, opts ...grpc.CallOption) (*TransaksiJualResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransaksiJualResponse)
+	err := c.cc.Invoke(ctx, TransaksiService_PayTransaksi_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transaksiServiceClient) ConfirmTransaksi(ctx context.Context, in *ConfirmTransaksiRequest, opts ...grpc.CallOption) (*TransaksiJualResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransaksiJualResponse)
+	err := c.cc.Invoke(ctx, TransaksiService_ConfirmTransaksi_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transaksiServiceClient) CompleteTransaksi(ctx context.Context, in *CompleteTransaksiRequest, opts ...grpc.CallOption) (*TransaksiJualResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransaksiJualResponse)
+	err := c.cc.Invoke(ctx, TransaksiService_CompleteTransaksi_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transaksiServiceClient) CancelTransaksi(ctx context.Context, in *CancelTransaksiRequest, opts ...grpc.CallOption) (*TransaksiJualResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransaksiJualResponse)
+	err := c.cc.Invoke(ctx, TransaksiService_CancelTransaksi_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transaksiServiceClient) ListMyTransactions(ctx context.Context, in *ListMyTransactionsRequest, opts ...grpc.CallOption) (*ListMyTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyTransactionsResponse)
+	err := c.cc.Invoke(ctx, TransaksiService_ListMyTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transaksiServiceClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*TransaksiDetail, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransaksiDetail)
+	err := c.cc.Invoke(ctx, TransaksiService_GetTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transaksiServiceClient) GetInvoice(ctx context.Context, in *GetInvoiceRequest, opts ...grpc.CallOption) (*InvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoiceResponse)
+	err := c.cc.Invoke(ctx, TransaksiService_GetInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transaksiServiceClient) RentMobil(ctx context.Context, in *RentMobilRequest, opts ...grpc.CallOption) (*TransaksiRentalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransaksiRentalResponse)
+	err := c.cc.Invoke(ctx, TransaksiService_RentMobil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transaksiServiceClient) CompleteRental(ctx context.Context, in *CompleteRentalRequest, opts ...grpc.CallOption) (*TransaksiRentalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransaksiRentalResponse)
+	err := c.cc.Invoke(ctx, TransaksiService_CompleteRental_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransaksiServiceServer is the server API for TransaksiService service.
+// All implementations must embed UnimplementedTransaksiServiceServer
+// for forward compatibility.
+type TransaksiServiceServer interface {
+	// Fitur 3: Beli Mobil (membuat reservasi yang menunggu pembayaran)
+	BuyMobil(context.Context, *BuyMobilRequest) (*TransaksiJualResponse, error)
+	// Alur status transaksi jual: bayar -> konfirmasi -> selesai / batal
+	// PayTransaksi membuat tagihan di payment provider; status 'dibayar' diisi lewat webhook
+	PayTransaksi(context.Context, *PayTransaksiRequest) (*TransaksiJualResponse, error)
+	ConfirmTransaksi(context.Context, *ConfirmTransaksiRequest) (*TransaksiJualResponse, error)
+	CompleteTransaksi(context.Context, *CompleteTransaksiRequest) (*TransaksiJualResponse, error)
+	CancelTransaksi(context.Context, *CancelTransaksiRequest) (*TransaksiJualResponse, error)
+	// Riwayat transaksi jual (sebagai pembeli dan/atau penjual)
+	ListMyTransactions(context.Context, *ListMyTransactionsRequest) (*ListMyTransactionsResponse, error)
+	// Detail satu transaksi (hanya pembeli, penjual, atau admin)
+	GetTransaction(context.Context, *GetTransactionRequest) (*TransaksiDetail, error)
+	// Invoice PDF untuk transaksi 'selesai' (hanya pembeli dan penjual)
+	GetInvoice(context.Context, *GetInvoiceRequest) (*InvoiceResponse, error)
+	// Fitur 3 & 5: Rental Mobil
+	RentMobil(context.Context, *RentMobilRequest) (*TransaksiRentalResponse, error)
+	CompleteRental(context.Context, *CompleteRentalRequest) (*TransaksiRentalResponse, error)
+	mustEmbedUnimplementedTransaksiServiceServer()
+}
+
+// UnimplementedTransaksiServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTransaksiServiceServer struct{}
+
+func (UnimplementedTransaksiServiceServer) BuyMobil(context.Context, *BuyMobilRequest) (*TransaksiJualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyMobil not implemented")
+}
+func (UnimplementedTransaksiServiceServer) PayTransaksi(context.Context, *PayTransaksiRequest) (*TransaksiJualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayTransaksi not implemented")
+}
+func (UnimplementedTransaksiServiceServer) ConfirmTransaksi(context.Context, *ConfirmTransaksiRequest) (*TransaksiJualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTransaksi not implemented")
+}
+func (UnimplementedTransaksiServiceServer) CompleteTransaksi(context.Context, *CompleteTransaksiRequest) (*TransaksiJualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteTransaksi not implemented")
+}
+func (UnimplementedTransaksiServiceServer) CancelTransaksi(context.Context, *CancelTransaksiRequest) (*TransaksiJualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTransaksi not implemented")
+}
+func (UnimplementedTransaksiServiceServer) ListMyTransactions(context.Context, *ListMyTransactionsRequest) (*ListMyTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyTransactions not implemented")
+}
+func (UnimplementedTransaksiServiceServer) GetTransaction(context.Context, *GetTransactionRequest) (*TransaksiDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedTransaksiServiceServer) GetInvoice(context.Context, *GetInvoiceRequest) (*InvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoice not implemented")
+}
+func (UnimplementedTransaksiServiceServer) RentMobil(context.Context, *RentMobilRequest) (*TransaksiRentalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RentMobil not implemented")
+}
+func (UnimplementedTransaksiServiceServer) CompleteRental(context.Context, *CompleteRentalRequest) (*TransaksiRentalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteRental not implemented")
+}
+func (UnimplementedTransaksiServiceServer) mustEmbedUnimplementedTransaksiServiceServer() {}
+func (UnimplementedTransaksiServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeTransaksiServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TransaksiServiceServer will
+// result in compilation errors.
+type UnsafeTransaksiServiceServer interface {
+	mustEmbedUnimplementedTransaksiServiceServer()
+}
+
+func RegisterTransaksiServiceServer(s grpc.ServiceRegistrar, srv TransaksiServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTransaksiServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TransaksiService_ServiceDesc, srv)
+}
+
+func _TransaksiService_BuyMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyMobilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransaksiServiceServer).BuyMobil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransaksiService_BuyMobil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransaksiServiceServer).BuyMobil(ctx, req.(*BuyMobilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransaksiService_PayTransaksi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayTransaksiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransaksiServiceServer).PayTransaksi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransaksiService_PayTransaksi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransaksiServiceServer).PayTransaksi(ctx, req.(*PayTransaksiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransaksiService_ConfirmTransaksi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTransaksiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransaksiServiceServer).ConfirmTransaksi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransaksiService_ConfirmTransaksi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransaksiServiceServer).ConfirmTransaksi(ctx, req.(*ConfirmTransaksiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransaksiService_CompleteTransaksi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteTransaksiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransaksiServiceServer).CompleteTransaksi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransaksiService_CompleteTransaksi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransaksiServiceServer).CompleteTransaksi(ctx, req.(*CompleteTransaksiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransaksiService_CancelTransaksi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTransaksiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransaksiServiceServer).CancelTransaksi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransaksiService_CancelTransaksi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransaksiServiceServer).CancelTransaksi(ctx, req.(*CancelTransaksiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransaksiService_ListMyTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransaksiServiceServer).ListMyTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransaksiService_ListMyTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransaksiServiceServer).ListMyTransactions(ctx, req.(*ListMyTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransaksiService_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransaksiServiceServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransaksiService_GetTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransaksiServiceServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransaksiService_GetInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransaksiServiceServer).GetInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransaksiService_GetInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransaksiServiceServer).GetInvoice(ctx, req.(*GetInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransaksiService_RentMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RentMobilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransaksiServiceServer).RentMobil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransaksiService_RentMobil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransaksiServiceServer).RentMobil(ctx, req.(*RentMobilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransaksiService_CompleteRental_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteRentalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransaksiServiceServer).CompleteRental(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransaksiService_CompleteRental_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransaksiServiceServer).CompleteRental(ctx, req.(*CompleteRentalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TransaksiService_ServiceDesc is the grpc.ServiceDesc for TransaksiService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TransaksiService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carapp.TransaksiService",
+	HandlerType: (*TransaksiServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BuyMobil",
+			Handler:    _TransaksiService_BuyMobil_Handler,
+		},
+		{
+			MethodName: "PayTransaksi",
+			Handler:    _TransaksiService_PayTransaksi_Handler,
+		},
+		{
+			MethodName: "ConfirmTransaksi",
+			Handler:    _TransaksiService_ConfirmTransaksi_Handler,
+		},
+		{
+			MethodName: "CompleteTransaksi",
+			Handler:    _TransaksiService_CompleteTransaksi_Handler,
+		},
+		{
+			MethodName: "CancelTransaksi",
+			Handler:    _TransaksiService_CancelTransaksi_Handler,
+		},
+		{
+			MethodName: "ListMyTransactions",
+			Handler:    _TransaksiService_ListMyTransactions_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _TransaksiService_GetTransaction_Handler,
+		},
+		{
+			MethodName: "GetInvoice",
+			Handler:    _TransaksiService_GetInvoice_Handler,
+		},
+		{
+			MethodName: "RentMobil",
+			Handler:    _TransaksiService_RentMobil_Handler,
+		},
+		{
+			MethodName: "CompleteRental",
+			Handler:    _TransaksiService_CompleteRental_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/carapp.proto",
+}
+
+const (
+	NotifikasiService_GetNotifications_FullMethodName = "/carapp.NotifikasiService/GetNotifications"
+)
+
+// NotifikasiServiceClient is the client API for NotifikasiService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NotifikasiServiceClient interface {
+	// Fitur 6: Notifikasi
+	GetNotifications(ctx context.Context, in *GetNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Notifikasi], error)
+}
+
+type notifikasiServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotifikasiServiceClient(cc grpc.ClientConnInterface) NotifikasiServiceClient {
+	return &notifikasiServiceClient{cc}
+}
+
+func (c *notifikasiServiceClient) GetNotifications(ctx context.Context, in *GetNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Notifikasi], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NotifikasiService_ServiceDesc.Streams[0], NotifikasiService_GetNotifications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetNotificationsRequest, Notifikasi]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotifikasiService_GetNotificationsClient = grpc.ServerStreamingClient[Notifikasi]
+
+// NotifikasiServiceServer is the server API for NotifikasiService service.
+// All implementations must embed UnimplementedNotifikasiServiceServer
+// for forward compatibility.
+type NotifikasiServiceServer interface {
+	// Fitur 6: Notifikasi
+	GetNotifications(*GetNotificationsRequest, grpc.ServerStreamingServer[Notifikasi]) error
+	mustEmbedUnimplementedNotifikasiServiceServer()
+}
+
+// UnimplementedNotifikasiServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotifikasiServiceServer struct{}
+
+func (UnimplementedNotifikasiServiceServer) GetNotifications(*GetNotificationsRequest, grpc.ServerStreamingServer[Notifikasi]) error {
+	return status.Errorf(codes.Unimplemented, "method GetNotifications not implemented")
+}
+func (UnimplementedNotifikasiServiceServer) mustEmbedUnimplementedNotifikasiServiceServer() {}
+func (UnimplementedNotifikasiServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeNotifikasiServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotifikasiServiceServer will
+// result in compilation errors.
+type UnsafeNotifikasiServiceServer interface {
+	mustEmbedUnimplementedNotifikasiServiceServer()
+}
+
+func RegisterNotifikasiServiceServer(s grpc.ServiceRegistrar, srv NotifikasiServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNotifikasiServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotifikasiService_ServiceDesc, srv)
+}
+
+func _NotifikasiService_GetNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NotifikasiServiceServer).GetNotifications(m, &grpc.GenericServerStream[GetNotificationsRequest, Notifikasi]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotifikasiService_GetNotificationsServer = grpc.ServerStreamingServer[Notifikasi]
+
+// NotifikasiService_ServiceDesc is the grpc.ServiceDesc for NotifikasiService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotifikasiService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carapp.NotifikasiService",
+	HandlerType: (*NotifikasiServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetNotifications",
+			Handler:       _NotifikasiService_GetNotifications_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/carapp.proto",
+}
+
+const (
+	DashboardService_GetDashboard_FullMethodName = "/carapp.DashboardService/GetDashboard"
+)
+
+// DashboardServiceClient is the client API for DashboardService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DashboardServiceClient interface {
+	// Fitur Rencana: Dashboard
+	GetDashboard(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DashboardSummary, error)
+}
+
+type dashboardServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDashboardServiceClient(cc grpc.ClientConnInterface) DashboardServiceClient {
+	return &dashboardServiceClient{cc}
+}
+
+func (c *dashboardServiceClient) GetDashboard(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DashboardSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DashboardSummary)
+	err := c.cc.Invoke(ctx, DashboardService_GetDashboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DashboardServiceServer is the server API for DashboardService service.
+// All implementations must embed UnimplementedDashboardServiceServer
+// for forward compatibility.
+type DashboardServiceServer interface {
+	// Fitur Rencana: Dashboard
+	GetDashboard(context.Context, *emptypb.Empty) (*DashboardSummary, error)
+	mustEmbedUnimplementedDashboardServiceServer()
+}
+
+// UnimplementedDashboardServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDashboardServiceServer struct{}
+
+func (UnimplementedDashboardServiceServer) GetDashboard(context.Context, *emptypb.Empty) (*DashboardSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDashboard not implemented")
+}
+func (UnimplementedDashboardServiceServer) mustEmbedUnimplementedDashboardServiceServer() {}
+func (UnimplementedDashboardServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeDashboardServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DashboardServiceServer will
+// result in compilation errors.
+type UnsafeDashboardServiceServer interface {
+	mustEmbedUnimplementedDashboardServiceServer()
+}
+
+func RegisterDashboardServiceServer(s grpc.ServiceRegistrar, srv DashboardServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDashboardServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DashboardService_ServiceDesc, srv)
+}
+
+func _DashboardService_GetDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardServiceServer).GetDashboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardService_GetDashboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardServiceServer).GetDashboard(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DashboardService_ServiceDesc is the grpc.ServiceDesc for DashboardService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DashboardService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carapp.DashboardService",
+	HandlerType: (*DashboardServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDashboard",
+			Handler:    _DashboardService_GetDashboard_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/carapp.proto",
+}
+
+const (
+	PenawaranService_CreatePenawaran_FullMethodName  = "/carapp.PenawaranService/CreatePenawaran"
+	PenawaranService_RespondPenawaran_FullMethodName = "/carapp.PenawaranService/RespondPenawaran"
+	PenawaranService_CancelPenawaran_FullMethodName  = "/carapp.PenawaranService/CancelPenawaran"
+	PenawaranService_ListPenawaran_FullMethodName    = "/carapp.PenawaranService/ListPenawaran"
+)
+
+// PenawaranServiceClient is the client API for PenawaranService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PenawaranServiceClient interface {
+	// Pembeli mengajukan penawaran harga untuk mobil
+	CreatePenawaran(ctx context.Context, in *CreatePenawaranRequest, opts ...grpc.CallOption) (*Penawaran, error)
+	// Pihak yang mendapat giliran: terima / tolak / tawar balik
+	RespondPenawaran(ctx context.Context, in *RespondPenawaranRequest, opts ...grpc.CallOption) (*RespondPenawaranResponse, error)
+	// Pembeli menarik penawaran yang masih berjalan
+	CancelPenawaran(ctx context.Context, in *CancelPenawaranRequest, opts ...grpc.CallOption) (*Penawaran, error)
+	// Daftar penawaran sebagai pembeli atau penjual
+	ListPenawaran(ctx context.Context, in *ListPenawaranRequest, opts ...grpc.CallOption) (*ListPenawaranResponse, error)
+}
+
+type penawaranServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPenawaranServiceClient(cc grpc.ClientConnInterface) PenawaranServiceClient {
+	return &penawaranServiceClient{cc}
+}
+
+func (c *penawaranServiceClient) CreatePenawaran(ctx context.Context, in *CreatePenawaranRequest, opts ...grpc.CallOption) (*Penawaran, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Penawaran)
+	err := c.cc.Invoke(ctx, PenawaranService_CreatePenawaran_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *penawaranServiceClient) RespondPenawaran(ctx context.Context, in *RespondPenawaranRequest, opts ...grpc.CallOption) (*RespondPenawaranResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RespondPenawaranResponse)
+	err := c.cc.Invoke(ctx, PenawaranService_RespondPenawaran_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *penawaranServiceClient) CancelPenawaran(ctx context.Context, in *CancelPenawaranRequest, opts ...grpc.CallOption) (*Penawaran, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Penawaran)
+	err := c.cc.Invoke(ctx, PenawaranService_CancelPenawaran_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *penawaranServiceClient) ListPenawaran(ctx context.Context, in *ListPenawaranRequest, opts ...grpc.CallOption) (*ListPenawaranResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPenawaranResponse)
+	err := c.cc.Invoke(ctx, PenawaranService_ListPenawaran_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PenawaranServiceServer is the server API for PenawaranService service.
+// All implementations must embed UnimplementedPenawaranServiceServer
+// for forward compatibility.
+type PenawaranServiceServer interface {
+	// Pembeli mengajukan penawaran harga untuk mobil
+	CreatePenawaran(context.Context, *CreatePenawaranRequest) (*Penawaran, error)
+	// Pihak yang mendapat giliran: terima / tolak / tawar balik
+	RespondPenawaran(context.Context, *RespondPenawaranRequest) (*RespondPenawaranResponse, error)
+	// Pembeli menarik penawaran yang masih berjalan
+	CancelPenawaran(context.Context, *CancelPenawaranRequest) (*Penawaran, error)
+	// Daftar penawaran sebagai pembeli atau penjual
+	ListPenawaran(context.Context, *ListPenawaranRequest) (*ListPenawaranResponse, error)
+	mustEmbedUnimplementedPenawaranServiceServer()
+}
+
+// UnimplementedPenawaranServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPenawaranServiceServer struct{}
+
+func (UnimplementedPenawaranServiceServer) CreatePenawaran(context.Context, *CreatePenawaranRequest) (*Penawaran, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePenawaran not implemented")
+}
+func (UnimplementedPenawaranServiceServer) RespondPenawaran(context.Context, *RespondPenawaranRequest) (*RespondPenawaranResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondPenawaran not implemented")
+}
+func (UnimplementedPenawaranServiceServer) CancelPenawaran(context.Context, *CancelPenawaranRequest) (*Penawaran, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPenawaran not implemented")
+}
+func (UnimplementedPenawaranServiceServer) ListPenawaran(context.Context, *ListPenawaranRequest) (*ListPenawaranResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPenawaran not implemented")
+}
+func (UnimplementedPenawaranServiceServer) mustEmbedUnimplementedPenawaranServiceServer() {}
+func (UnimplementedPenawaranServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafePenawaranServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PenawaranServiceServer will
+// result in compilation errors.
+type UnsafePenawaranServiceServer interface {
+	mustEmbedUnimplementedPenawaranServiceServer()
+}
+
+func RegisterPenawaranServiceServer(s grpc.ServiceRegistrar, srv PenawaranServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPenawaranServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PenawaranService_ServiceDesc, srv)
+}
+
+func _PenawaranService_CreatePenawaran_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePenawaranRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PenawaranServiceServer).CreatePenawaran(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PenawaranService_CreatePenawaran_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PenawaranServiceServer).CreatePenawaran(ctx, req.(*CreatePenawaranRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PenawaranService_RespondPenawaran_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondPenawaranRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PenawaranServiceServer).RespondPenawaran(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PenawaranService_RespondPenawaran_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PenawaranServiceServer).RespondPenawaran(ctx, req.(*RespondPenawaranRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PenawaranService_CancelPenawaran_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPenawaranRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PenawaranServiceServer).CancelPenawaran(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PenawaranService_CancelPenawaran_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PenawaranServiceServer).CancelPenawaran(ctx, req.(*CancelPenawaranRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PenawaranService_ListPenawaran_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPenawaranRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PenawaranServiceServer).ListPenawaran(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PenawaranService_ListPenawaran_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PenawaranServiceServer).ListPenawaran(ctx, req.(*ListPenawaranRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PenawaranService_ServiceDesc is the grpc.ServiceDesc for PenawaranService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PenawaranService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carapp.PenawaranService",
+	HandlerType: (*PenawaranServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePenawaran",
+			Handler:    _PenawaranService_CreatePenawaran_Handler,
+		},
+		{
+			MethodName: "RespondPenawaran",
+			Handler:    _PenawaranService_RespondPenawaran_Handler,
+		},
+		{
+			MethodName: "CancelPenawaran",
+			Handler:    _PenawaranService_CancelPenawaran_Handler,
+		},
+		{
+			MethodName: "ListPenawaran",
+			Handler:    _PenawaranService_ListPenawaran_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/carapp.proto",
+}
+
+const (
+	ChatService_StartPercakapan_FullMethodName = "/carapp.ChatService/StartPercakapan"
+	ChatService_ListPercakapan_FullMethodName  = "/carapp.ChatService/ListPercakapan"
+	ChatService_SendPesan_FullMethodName       = "/carapp.ChatService/SendPesan"
+	ChatService_ListPesan_FullMethodName       = "/carapp.ChatService/ListPesan"
+	ChatService_StreamPesan_FullMethodName     = "/carapp.ChatService/StreamPesan"
+	ChatService_MarkDibaca_FullMethodName      = "/carapp.ChatService/MarkDibaca"
+	ChatService_BlockUser_FullMethodName       = "/carapp.ChatService/BlockUser"
+	ChatService_UnblockUser_FullMethodName     = "/carapp.ChatService/UnblockUser"
+	ChatService_ReportUser_FullMethodName      = "/carapp.ChatService/ReportUser"
+)
+
+// ChatServiceClient is the client API for ChatService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChatServiceClient interface {
+	// Buka (atau ambil) percakapan pembeli dengan penjual untuk satu mobil
+	StartPercakapan(ctx context.Context, in *StartPercakapanRequest, opts ...grpc.CallOption) (*Percakapan, error)
+	ListPercakapan(ctx context.Context, in *ListPercakapanRequest, opts ...grpc.CallOption) (*ListPercakapanResponse, error)
+	SendPesan(ctx context.Context, in *SendPesanRequest, opts ...grpc.CallOption) (*PesanChat, error)
+	// Polling fallback untuk gRPC-Web: ambil pesan setelah waktu tertentu
+	ListPesan(ctx context.Context, in *ListPesanRequest, opts ...grpc.CallOption) (*ListPesanResponse, error)
+	// Pesan & tanda dibaca secara live (server streaming)
+	StreamPesan(ctx context.Context, in *StreamPesanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatEvent], error)
+	// Tandai semua pesan dari lawan bicara sebagai sudah dibaca
+	MarkDibaca(ctx context.Context, in *MarkDibacaRequest, opts ...grpc.CallOption) (*MarkDibacaResponse, error)
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnblockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReportUser(ctx context.Context, in *ReportUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type chatServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChatServiceClient(cc grpc.ClientConnInterface) ChatServiceClient {
+	return &chatServiceClient{cc}
+}
+
+func (c *chatServiceClient) StartPercakapan(ctx context.Context, in *StartPercakapanRequest, opts ...grpc.CallOption) (*Percakapan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Percakapan)
+	err := c.cc.Invoke(ctx, ChatService_StartPercakapan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ListPercakapan(ctx context.Context, in *ListPercakapanRequest, opts ...grpc.CallOption) (*ListPercakapanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPercakapanResponse)
+	err := c.cc.Invoke(ctx, ChatService_ListPercakapan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SendPesan(ctx context.Context, in *SendPesanRequest, opts ...grpc.CallOption) (*PesanChat, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PesanChat)
+	err := c.cc.Invoke(ctx, ChatService_SendPesan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ListPesan(ctx context.Context, in *ListPesanRequest, opts ...grpc.CallOption) (*ListPesanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPesanResponse)
+	err := c.cc.Invoke(ctx, ChatService_ListPesan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) StreamPesan(ctx context.Context, in *StreamPesanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ChatService_ServiceDesc.Streams[0], ChatService_StreamPesan_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamPesanRequest, ChatEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_StreamPesanClient = grpc.ServerStreamingClient[ChatEvent]
+
+func (c *chatServiceClient) MarkDibaca(ctx context.Context, in *MarkDibacaRequest, opts ...grpc.CallOption) (*MarkDibacaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkDibacaResponse)
+	err := c.cc.Invoke(ctx, ChatService_MarkDibaca_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) UnblockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ReportUser(ctx context.Context, in *ReportUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_ReportUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChatServiceServer is the server API for ChatService service.
+// All implementations must embed UnimplementedChatServiceServer
+// for forward compatibility.
+type ChatServiceServer interface {
+	// Buka (atau ambil) percakapan pembeli dengan penjual untuk satu mobil
+	StartPercakapan(context.Context, *StartPercakapanRequest) (*Percakapan, error)
+	ListPercakapan(context.Context, *ListPercakapanRequest) (*ListPercakapanResponse, error)
+	SendPesan(context.Context, *SendPesanRequest) (*PesanChat, error)
+	// Polling fallback untuk gRPC-Web: ambil pesan setelah waktu tertentu
+	ListPesan(context.Context, *ListPesanRequest) (*ListPesanResponse, error)
+	// Pesan & tanda dibaca secara live (server streaming)
+	StreamPesan(*StreamPesanRequest, grpc.ServerStreamingServer[ChatEvent]) error
+	// Tandai semua pesan dari lawan bicara sebagai sudah dibaca
+	MarkDibaca(context.Context, *MarkDibacaRequest) (*MarkDibacaResponse, error)
+	BlockUser(context.Context, *BlockUserRequest) (*emptypb.Empty, error)
+	UnblockUser(context.Context, *BlockUserRequest) (*emptypb.Empty, error)
+	ReportUser(context.Context, *ReportUserRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedChatServiceServer()
+}
+
+// UnimplementedChatServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedChatServiceServer struct{}
+
+func (UnimplementedChatServiceServer) StartPercakapan(context.Context, *StartPercakapanRequest) (*Percakapan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartPercakapan not implemented")
+}
+func (UnimplementedChatServiceServer) ListPercakapan(context.Context, *ListPercakapanRequest) (*ListPercakapanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPercakapan not implemented")
+}
+func (UnimplementedChatServiceServer) SendPesan(context.Context, *SendPesanRequest) (*PesanChat, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPesan not implemented")
+}
+func (UnimplementedChatServiceServer) ListPesan(context.Context, *ListPesanRequest) (*ListPesanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPesan not implemented")
+}
+func (UnimplementedChatServiceServer) StreamPesan(*StreamPesanRequest, grpc.ServerStreamingServer[ChatEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPesan not implemented")
+}
+func (UnimplementedChatServiceServer) MarkDibaca(context.Context, *MarkDibacaRequest) (*MarkDibacaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkDibaca not implemented")
+}
+func (UnimplementedChatServiceServer) BlockUser(context.Context, *BlockUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedChatServiceServer) UnblockUser(context.Context, *BlockUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedChatServiceServer) ReportUser(context.Context, *ReportUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportUser not implemented")
+}
+func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
+func (UnimplementedChatServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeChatServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChatServiceServer will
+// result in compilation errors.
+type UnsafeChatServiceServer interface {
+	mustEmbedUnimplementedChatServiceServer()
+}
+
+func RegisterChatServiceServer(s grpc.ServiceRegistrar, srv ChatServiceServer) {
+	// If the following call pancis, it indicates UnimplementedChatServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ChatService_ServiceDesc, srv)
+}
+
+func _ChatService_StartPercakapan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPercakapanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).StartPercakapan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_StartPercakapan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).StartPercakapan(ctx, req.(*StartPercakapanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ListPercakapan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPercakapanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListPercakapan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListPercakapan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListPercakapan(ctx, req.(*ListPercakapanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SendPesan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPesanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SendPesan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SendPesan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SendPesan(ctx, req.(*SendPesanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ListPesan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPesanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListPesan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListPesan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListPesan(ctx, req.(*ListPesanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_StreamPesan_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPesanRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatServiceServer).StreamPesan(m, &grpc.GenericServerStream[StreamPesanRequest, ChatEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_StreamPesanServer = grpc.ServerStreamingServer[ChatEvent]
+
+func _ChatService_MarkDibaca_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkDibacaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).MarkDibaca(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_MarkDibaca_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).MarkDibaca(ctx, req.(*MarkDibacaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).UnblockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ReportUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ReportUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ReportUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ReportUser(ctx, req.(*ReportUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChatService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carapp.ChatService",
+	HandlerType: (*ChatServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartPercakapan",
+			Handler:    _ChatService_StartPercakapan_Handler,
+		},
+		{
+			MethodName: "ListPercakapan",
+			Handler:    _ChatService_ListPercakapan_Handler,
+		},
+		{
+			MethodName: "SendPesan",
+			Handler:    _ChatService_SendPesan_Handler,
+		},
+		{
+			MethodName: "ListPesan",
+			Handler:    _ChatService_ListPesan_Handler,
+		},
+		{
+			MethodName: "MarkDibaca",
+			Handler:    _ChatService_MarkDibaca_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _ChatService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _ChatService_UnblockUser_Handler,
+		},
+		{
+			MethodName: "ReportUser",
+			Handler:    _ChatService_ReportUser_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPesan",
+			Handler:       _ChatService_StreamPesan_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/carapp.proto",
+}
+
+const (
+	JanjiTemuService_CreateSlot_FullMethodName      = "/carapp.JanjiTemuService/CreateSlot"
+	JanjiTemuService_ListSlot_FullMethodName        = "/carapp.JanjiTemuService/ListSlot"
+	JanjiTemuService_DeleteSlot_FullMethodName      = "/carapp.JanjiTemuService/DeleteSlot"
+	JanjiTemuService_BookJanjiTemu_FullMethodName   = "/carapp.JanjiTemuService/BookJanjiTemu"
+	JanjiTemuService_CancelJanjiTemu_FullMethodName = "/carapp.JanjiTemuService/CancelJanjiTemu"
+	JanjiTemuService_ListJanjiTemu_FullMethodName   = "/carapp.JanjiTemuService/ListJanjiTemu"
+)
+
+// JanjiTemuServiceClient is the client API for JanjiTemuService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type JanjiTemuServiceClient interface {
+	// Penjual membuka slot ketersediaan (per mobil atau per lokasi dealer)
+	CreateSlot(ctx context.Context, in *CreateSlotRequest, opts ...grpc.CallOption) (*SlotJadwal, error)
+	// Daftar slot yang masih bisa dipesan
+	ListSlot(ctx context.Context, in *ListSlotRequest, opts ...grpc.CallOption) (*ListSlotResponse, error)
+	// Penjual menutup slot yang belum dipesan
+	DeleteSlot(ctx context.Context, in *DeleteSlotRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Pembeli memesan slot (satu slot hanya untuk satu pembeli)
+	BookJanjiTemu(ctx context.Context, in *BookJanjiTemuRequest, opts ...grpc.CallOption) (*JanjiTemu, error)
+	// Pembeli atau penjual membatalkan janji temu
+	CancelJanjiTemu(ctx context.Context, in *CancelJanjiTemuRequest, opts ...grpc.CallOption) (*JanjiTemu, error)
+	// Daftar janji temu sebagai pembeli atau penjual
+	ListJanjiTemu(ctx context.Context, in *ListJanjiTemuRequest, opts ...grpc.CallOption) (*ListJanjiTemuResponse, error)
+}
+
+type janjiTemuServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJanjiTemuServiceClient(cc grpc.ClientConnInterface) JanjiTemuServiceClient {
+	return &janjiTemuServiceClient{cc}
+}
+
+func (c *janjiTemuServiceClient) CreateSlot(ctx context.Context, in *CreateSlotRequest, opts ...grpc.CallOption) (*SlotJadwal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotJadwal)
+	err := c.cc.Invoke(ctx, JanjiTemuService_CreateSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *janjiTemuServiceClient) ListSlot(ctx context.Context, in *ListSlotRequest, opts ...grpc.CallOption) (*ListSlotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSlotResponse)
+	err := c.cc.Invoke(ctx, JanjiTemuService_ListSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *janjiTemuServiceClient) DeleteSlot(ctx context.Context, in *DeleteSlotRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, JanjiTemuService_DeleteSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *janjiTemuServiceClient) BookJanjiTemu(ctx context.Context, in *BookJanjiTemuRequest, opts ...grpc.CallOption) (*JanjiTemu, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JanjiTemu)
+	err := c.cc.Invoke(ctx, JanjiTemuService_BookJanjiTemu_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *janjiTemuServiceClient) CancelJanjiTemu(ctx context.Context, in *CancelJanjiTemuRequest, opts ...grpc.CallOption) (*JanjiTemu, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JanjiTemu)
+	err := c.cc.Invoke(ctx, JanjiTemuService_CancelJanjiTemu_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *janjiTemuServiceClient) ListJanjiTemu(ctx context.Context, in *ListJanjiTemuRequest, opts ...grpc.CallOption) (*ListJanjiTemuResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJanjiTemuResponse)
+	err := c.cc.Invoke(ctx, JanjiTemuService_ListJanjiTemu_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JanjiTemuServiceServer is the server API for JanjiTemuService service.
+// All implementations must embed UnimplementedJanjiTemuServiceServer
+// for forward compatibility.
+type JanjiTemuServiceServer interface {
+	// Penjual membuka slot ketersediaan (per mobil atau per lokasi dealer)
+	CreateSlot(context.Context, *CreateSlotRequest) (*SlotJadwal, error)
+	// Daftar slot yang masih bisa dipesan
+	ListSlot(context.Context, *ListSlotRequest) (*ListSlotResponse, error)
+	// Penjual menutup slot yang belum dipesan
+	DeleteSlot(context.Context, *DeleteSlotRequest) (*emptypb.Empty, error)
+	// Pembeli memesan slot (satu slot hanya untuk satu pembeli)
+	BookJanjiTemu(context.Context, *BookJanjiTemuRequest) (*JanjiTemu, error)
+	// Pembeli atau penjual membatalkan janji temu
+	CancelJanjiTemu(context.Context, *CancelJanjiTemuRequest) (*JanjiTemu, error)
+	// Daftar janji temu sebagai pembeli atau penjual
+	ListJanjiTemu(context.Context, *ListJanjiTemuRequest) (*ListJanjiTemuResponse, error)
+	mustEmbedUnimplementedJanjiTemuServiceServer()
+}
+
+// UnimplementedJanjiTemuServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedJanjiTemuServiceServer struct{}
+
+func (UnimplementedJanjiTemuServiceServer) CreateSlot(context.Context, *CreateSlotRequest) (*SlotJadwal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSlot not implemented")
+}
+func (UnimplementedJanjiTemuServiceServer) ListSlot(context.Context, *ListSlotRequest) (*ListSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSlot not implemented")
+}
+func (UnimplementedJanjiTemuServiceServer) DeleteSlot(context.Context, *DeleteSlotRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSlot not implemented")
+}
+func (UnimplementedJanjiTemuServiceServer) BookJanjiTemu(context.Context, *BookJanjiTemuRequest) (*JanjiTemu, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookJanjiTemu not implemented")
+}
+func (UnimplementedJanjiTemuServiceServer) CancelJanjiTemu(context.Context, *CancelJanjiTemuRequest) (*JanjiTemu, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJanjiTemu not implemented")
+}
+func (UnimplementedJanjiTemuServiceServer) ListJanjiTemu(context.Context, *ListJanjiTemuRequest) (*ListJanjiTemuResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJanjiTemu not implemented")
+}
+func (UnimplementedJanjiTemuServiceServer) mustEmbedUnimplementedJanjiTemuServiceServer() {}
+func (UnimplementedJanjiTemuServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeJanjiTemuServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JanjiTemuServiceServer will
+// result in compilation errors.
+type UnsafeJanjiTemuServiceServer interface {
+	mustEmbedUnimplementedJanjiTemuServiceServer()
+}
+
+func RegisterJanjiTemuServiceServer(s grpc.ServiceRegistrar, srv JanjiTemuServiceServer) {
+	// If the following call pancis, it indicates UnimplementedJanjiTemuServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&JanjiTemuService_ServiceDesc, srv)
+}
+
+func _JanjiTemuService_CreateSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JanjiTemuServiceServer).CreateSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JanjiTemuService_CreateSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JanjiTemuServiceServer).CreateSlot(ctx, req.(*CreateSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JanjiTemuService_ListSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JanjiTemuServiceServer).ListSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JanjiTemuService_ListSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JanjiTemuServiceServer).ListSlot(ctx, req.(*ListSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JanjiTemuService_DeleteSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JanjiTemuServiceServer).DeleteSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JanjiTemuService_DeleteSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JanjiTemuServiceServer).DeleteSlot(ctx, req.(*DeleteSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JanjiTemuService_BookJanjiTemu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookJanjiTemuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JanjiTemuServiceServer).BookJanjiTemu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JanjiTemuService_BookJanjiTemu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JanjiTemuServiceServer).BookJanjiTemu(ctx, req.(*BookJanjiTemuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JanjiTemuService_CancelJanjiTemu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJanjiTemuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JanjiTemuServiceServer).CancelJanjiTemu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JanjiTemuService_CancelJanjiTemu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JanjiTemuServiceServer).CancelJanjiTemu(ctx, req.(*CancelJanjiTemuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JanjiTemuService_ListJanjiTemu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJanjiTemuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JanjiTemuServiceServer).ListJanjiTemu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JanjiTemuService_ListJanjiTemu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JanjiTemuServiceServer).ListJanjiTemu(ctx, req.(*ListJanjiTemuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JanjiTemuService_ServiceDesc is the grpc.ServiceDesc for JanjiTemuService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JanjiTemuService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carapp.JanjiTemuService",
+	HandlerType: (*JanjiTemuServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSlot",
+			Handler:    _JanjiTemuService_CreateSlot_Handler,
+		},
+		{
+			MethodName: "ListSlot",
+			Handler:    _JanjiTemuService_ListSlot_Handler,
+		},
+		{
+			MethodName: "DeleteSlot",
+			Handler:    _JanjiTemuService_DeleteSlot_Handler,
+		},
+		{
+			MethodName: "BookJanjiTemu",
+			Handler:    _JanjiTemuService_BookJanjiTemu_Handler,
+		},
+		{
+			MethodName: "CancelJanjiTemu",
+			Handler:    _JanjiTemuService_CancelJanjiTemu_Handler,
+		},
+		{
+			MethodName: "ListJanjiTemu",
+			Handler:    _JanjiTemuService_ListJanjiTemu_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/carapp.proto",
+}
+
+const (
+	KursService_ImportKurs_FullMethodName = "/carapp.KursService/ImportKurs"
+	KursService_ListKurs_FullMethodName   = "/carapp.KursService/ListKurs"
+)
+
+// KursServiceClient is the client API for KursService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type KursServiceClient interface {
+	// Admin mengimpor kurs dari CSV (mata_uang_asal,mata_uang_tujuan,rate,berlaku_mulai)
+	ImportKurs(ctx context.Context, in *ImportKursRequest, opts ...grpc.CallOption) (*ImportKursResponse, error)
+	// Daftar kurs yang tersimpan (terbaru dulu)
+	ListKurs(ctx context.Context, in *ListKursRequest, opts ...grpc.CallOption) (*ListKursResponse, error)
+}
+
+type kursServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKursServiceClient(cc grpc.ClientConnInterface) KursServiceClient {
+	return &kursServiceClient{cc}
+}
+
+func (c *kursServiceClient) ImportKurs(ctx context.Context, in *ImportKursRequest, opts ...grpc.CallOption) (*ImportKursResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportKursResponse)
+	err := c.cc.Invoke(ctx, KursService_ImportKurs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kursServiceClient) ListKurs(ctx context.Context, in *ListKursRequest, opts ...grpc.CallOption) (*ListKursResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListKursResponse)
+	err := c.cc.Invoke(ctx, KursService_ListKurs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KursServiceServer is the server API for KursService service.
+// All implementations must embed UnimplementedKursServiceServer
+// for forward compatibility.
+type KursServiceServer interface {
+	// Admin mengimpor kurs dari CSV (mata_uang_asal,mata_uang_tujuan,rate,berlaku_mulai)
+	ImportKurs(context.Context, *ImportKursRequest) (*ImportKursResponse, error)
+	// Daftar kurs yang tersimpan (terbaru dulu)
+	ListKurs(context.Context, *ListKursRequest) (*ListKursResponse, error)
+	mustEmbedUnimplementedKursServiceServer()
+}
+
+// UnimplementedKursServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedKursServiceServer struct{}
+
+func (UnimplementedKursServiceServer) ImportKurs(context.Context, *ImportKursRequest) (*ImportKursResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportKurs not implemented")
+}
+func (UnimplementedKursServiceServer) ListKurs(context.Context, *ListKursRequest) (*ListKursResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKurs not implemented")
+}
+func (UnimplementedKursServiceServer) mustEmbedUnimplementedKursServiceServer() {}
+func (UnimplementedKursServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeKursServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KursServiceServer will
+// result in compilation errors.
+type UnsafeKursServiceServer interface {
+	mustEmbedUnimplementedKursServiceServer()
+}
+
+func RegisterKursServiceServer(s grpc.ServiceRegistrar, srv KursServiceServer) {
+	// If the following call pancis, it indicates UnimplementedKursServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&KursService_ServiceDesc, srv)
+}
+
+func _KursService_ImportKurs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportKursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KursServiceServer).ImportKurs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KursService_ImportKurs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KursServiceServer).ImportKurs(ctx, req.(*ImportKursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KursService_ListKurs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KursServiceServer).ListKurs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KursService_ListKurs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KursServiceServer).ListKurs(ctx, req.(*ListKursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// KursService_ServiceDesc is the grpc.ServiceDesc for KursService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var KursService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carapp.KursService",
+	HandlerType: (*KursServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ImportKurs",
+			Handler:    _KursService_ImportKurs_Handler,
+		},
+		{
+			MethodName: "ListKurs",
+			Handler:    _KursService_ListKurs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/carapp.proto",
+}
+
+const (
+	KreditService_ListProdukKredit_FullMethodName = "/carapp.KreditService/ListProdukKredit"
+	KreditService_SimulateKredit_FullMethodName   = "/carapp.KreditService/SimulateKredit"
+)
+
+// KreditServiceClient is the client API for KreditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type KreditServiceClient interface {
+	// Daftar produk kredit aktif (opsional: hanya yang berlaku untuk mobil tertentu)
+	ListProdukKredit(ctx context.Context, in *ListProdukKreditRequest, opts ...grpc.CallOption) (*ListProdukKreditResponse, error)
+	// Simulasi cicilan: jadwal angsuran lengkap + total biaya
+	SimulateKredit(ctx context.Context, in *SimulateKreditRequest, opts ...grpc.CallOption) (*SimulasiKredit, error)
+}
+
+type kreditServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKreditServiceClient(cc grpc.ClientConnInterface) KreditServiceClient {
+	return &kreditServiceClient{cc}
+}
+
+func (c *kreditServiceClient) ListProdukKredit(ctx context.Context, in *ListProdukKreditRequest, opts ...grpc.CallOption) (*ListProdukKreditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProdukKreditResponse)
+	err := c.cc.Invoke(ctx, KreditService_ListProdukKredit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kreditServiceClient) SimulateKredit(ctx context.Context, in *SimulateKreditRequest, opts ...grpc.CallOption) (*SimulasiKredit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulasiKredit)
+	err := c.cc.Invoke(ctx, KreditService_SimulateKredit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KreditServiceServer is the server API for KreditService service.
+// All implementations must embed UnimplementedKreditServiceServer
+// for forward compatibility.
+type KreditServiceServer interface {
+	// Daftar produk kredit aktif (opsional: hanya yang berlaku untuk mobil tertentu)
+	ListProdukKredit(context.Context, *ListProdukKreditRequest) (*ListProdukKreditResponse, error)
+	// Simulasi cicilan: jadwal angsuran lengkap + total biaya
+	SimulateKredit(context.Context, *SimulateKreditRequest) (*SimulasiKredit, error)
+	mustEmbedUnimplementedKreditServiceServer()
+}
+
+// UnimplementedKreditServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedKreditServiceServer struct{}
+
+func (UnimplementedKreditServiceServer) ListProdukKredit(context.Context, *ListProdukKreditRequest) (*ListProdukKreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProdukKredit not implemented")
+}
+func (UnimplementedKreditServiceServer) SimulateKredit(context.Context, *SimulateKreditRequest) (*SimulasiKredit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateKredit not implemented")
+}
+func (UnimplementedKreditServiceServer) mustEmbedUnimplementedKreditServiceServer() {}
+func (UnimplementedKreditServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeKreditServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KreditServiceServer will
+// result in compilation errors.
+type UnsafeKreditServiceServer interface {
+	mustEmbedUnimplementedKreditServiceServer()
+}
+
+func RegisterKreditServiceServer(s grpc.ServiceRegistrar, srv KreditServiceServer) {
+	// If the following call pancis, it indicates UnimplementedKreditServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&KreditService_ServiceDesc, srv)
+}
+
+func _KreditService_ListProdukKredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProdukKreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KreditServiceServer).ListProdukKredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KreditService_ListProdukKredit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KreditServiceServer).ListProdukKredit(ctx, req.(*ListProdukKreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KreditService_SimulateKredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateKreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KreditServiceServer).SimulateKredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KreditService_SimulateKredit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KreditServiceServer).SimulateKredit(ctx, req.(*SimulateKreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// KreditService_ServiceDesc is the grpc.ServiceDesc for KreditService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var KreditService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carapp.KreditService",
+	HandlerType: (*KreditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListProdukKredit",
+			Handler:    _KreditService_ListProdukKredit_Handler,
+		},
+		{
+			MethodName: "SimulateKredit",
+			Handler:    _KreditService_SimulateKredit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/carapp.proto",
+}
+
+const (
+	TradeInService_DecodeVin_FullMethodName       = "/carapp.TradeInService/DecodeVin"
+	TradeInService_CreateTradeIn_FullMethodName   = "/carapp.TradeInService/CreateTradeIn"
+	TradeInService_AppraiseTradeIn_FullMethodName = "/carapp.TradeInService/AppraiseTradeIn"
+	TradeInService_RespondTradeIn_FullMethodName  = "/carapp.TradeInService/RespondTradeIn"
+	TradeInService_CancelTradeIn_FullMethodName   = "/carapp.TradeInService/CancelTradeIn"
+	TradeInService_ListTradeIn_FullMethodName     = "/carapp.TradeInService/ListTradeIn"
+)
+
+// TradeInServiceClient is the client API for TradeInService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TradeInServiceClient interface {
+	// Decode VIN lewat NHTSA untuk mengisi merk/model/tahun otomatis
+	DecodeVin(ctx context.Context, in *DecodeVinRequest, opts ...grpc.CallOption) (*DecodeVinResponse, error)
+	// Pembeli mengajukan mobil lamanya untuk ditaksir dealer
+	CreateTradeIn(ctx context.Context, in *CreateTradeInRequest, opts ...grpc.CallOption) (*TradeIn, error)
+	// Dealer memberi nilai taksiran
+	AppraiseTradeIn(ctx context.Context, in *AppraiseTradeInRequest, opts ...grpc.CallOption) (*TradeIn, error)
+	// Pembeli menerima / menolak taksiran
+	RespondTradeIn(ctx context.Context, in *RespondTradeInRequest, opts ...grpc.CallOption) (*TradeIn, error)
+	// Pembeli membatalkan pengajuan yang belum dipakai
+	CancelTradeIn(ctx context.Context, in *CancelTradeInRequest, opts ...grpc.CallOption) (*TradeIn, error)
+	// Daftar trade-in sebagai pembeli atau penjual
+	ListTradeIn(ctx context.Context, in *ListTradeInRequest, opts ...grpc.CallOption) (*ListTradeInResponse, error)
+}
+
+type tradeInServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTradeInServiceClient(cc grpc.ClientConnInterface) TradeInServiceClient {
+	return &tradeInServiceClient{cc}
+}
+
+func (c *tradeInServiceClient) DecodeVin(ctx context.Context, in *DecodeVinRequest, opts ...grpc.CallOption) (*DecodeVinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecodeVinResponse)
+	err := c.cc.Invoke(ctx, TradeInService_DecodeVin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeInServiceClient) CreateTradeIn(ctx context.Context, in *CreateTradeInRequest, opts ...grpc.CallOption) (*TradeIn, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TradeIn)
+	err := c.cc.Invoke(ctx, TradeInService_CreateTradeIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeInServiceClient) AppraiseTradeIn(ctx context.Context, in *AppraiseTradeInRequest, opts ...grpc.CallOption) (*TradeIn, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TradeIn)
+	err := c.cc.Invoke(ctx, TradeInService_AppraiseTradeIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeInServiceClient) RespondTradeIn(ctx context.Context, in *RespondTradeInRequest, opts ...grpc.CallOption) (*TradeIn, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TradeIn)
+	err := c.cc.Invoke(ctx, TradeInService_RespondTradeIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeInServiceClient) CancelTradeIn(ctx context.Context, in *CancelTradeInRequest, opts ...grpc.CallOption) (*TradeIn, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TradeIn)
+	err := c.cc.Invoke(ctx, TradeInService_CancelTradeIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeInServiceClient) ListTradeIn(ctx context.Context, in *ListTradeInRequest, opts ...grpc.CallOption) (*ListTradeInResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTradeInResponse)
+	err := c.cc.Invoke(ctx, TradeInService_ListTradeIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TradeInServiceServer is the server API for TradeInService service.
+// All implementations must embed UnimplementedTradeInServiceServer
+// for forward compatibility.
+type TradeInServiceServer interface {
+	// Decode VIN lewat NHTSA untuk mengisi merk/model/tahun otomatis
+	DecodeVin(context.Context, *DecodeVinRequest) (*DecodeVinResponse, error)
+	// Pembeli mengajukan mobil lamanya untuk ditaksir dealer
+	CreateTradeIn(context.Context, *CreateTradeInRequest) (*TradeIn, error)
+	// Dealer memberi nilai taksiran
+	AppraiseTradeIn(context.Context, *AppraiseTradeInRequest) (*TradeIn, error)
+	// Pembeli menerima / menolak taksiran
+	RespondTradeIn(context.Context, *RespondTradeInRequest) (*TradeIn, error)
+	// Pembeli membatalkan pengajuan yang belum dipakai
+	CancelTradeIn(context.Context, *CancelTradeInRequest) (*TradeIn, error)
+	// Daftar trade-in sebagai pembeli atau penjual
+	ListTradeIn(context.Context, *ListTradeInRequest) (*ListTradeInResponse, error)
+	mustEmbedUnimplementedTradeInServiceServer()
+}
+
+// UnimplementedTradeInServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTradeInServiceServer struct{}
+
+func (UnimplementedTradeInServiceServer) DecodeVin(context.Context, *DecodeVinRequest) (*DecodeVinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeVin not implemented")
+}
+func (UnimplementedTradeInServiceServer) CreateTradeIn(context.Context, *CreateTradeInRequest) (*TradeIn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTradeIn not implemented")
+}
+func (UnimplementedTradeInServiceServer) AppraiseTradeIn(context.Context, *AppraiseTradeInRequest) (*TradeIn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppraiseTradeIn not implemented")
+}
+func (UnimplementedTradeInServiceServer) RespondTradeIn(context.Context, *RespondTradeInRequest) (*TradeIn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondTradeIn not implemented")
+}
+func (UnimplementedTradeInServiceServer) CancelTradeIn(context.Context, *CancelTradeInRequest) (*TradeIn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTradeIn not implemented")
+}
+func (UnimplementedTradeInServiceServer) ListTradeIn(context.Context, *ListTradeInRequest) (*ListTradeInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTradeIn not implemented")
+}
+func (UnimplementedTradeInServiceServer) mustEmbedUnimplementedTradeInServiceServer() {}
+func (UnimplementedTradeInServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeTradeInServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TradeInServiceServer will
+// result in compilation errors.
+type UnsafeTradeInServiceServer interface {
+	mustEmbedUnimplementedTradeInServiceServer()
+}
+
+func RegisterTradeInServiceServer(s grpc.ServiceRegistrar, srv TradeInServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTradeInServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TradeInService_ServiceDesc, srv)
+}
+
+func _TradeInService_DecodeVin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeVinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeInServiceServer).DecodeVin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TradeInService_DecodeVin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeInServiceServer).DecodeVin(ctx, req.(*DecodeVinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradeInService_CreateTradeIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTradeInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeInServiceServer).CreateTradeIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TradeInService_CreateTradeIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeInServiceServer).CreateTradeIn(ctx, req.(*CreateTradeInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradeInService_AppraiseTradeIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppraiseTradeInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeInServiceServer).AppraiseTradeIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TradeInService_AppraiseTradeIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeInServiceServer).AppraiseTradeIn(ctx, req.(*AppraiseTradeInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradeInService_RespondTradeIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondTradeInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeInServiceServer).RespondTradeIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TradeInService_RespondTradeIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeInServiceServer).RespondTradeIn(ctx, req.(*RespondTradeInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradeInService_CancelTradeIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTradeInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeInServiceServer).CancelTradeIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TradeInService_CancelTradeIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeInServiceServer).CancelTradeIn(ctx, req.(*CancelTradeInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradeInService_ListTradeIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTradeInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeInServiceServer).ListTradeIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TradeInService_ListTradeIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeInServiceServer).ListTradeIn(ctx, req.(*ListTradeInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TradeInService_ServiceDesc is the grpc.ServiceDesc for TradeInService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TradeInService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carapp.TradeInService",
+	HandlerType: (*TradeInServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DecodeVin",
+			Handler:    _TradeInService_DecodeVin_Handler,
+		},
+		{
+			MethodName: "CreateTradeIn",
+			Handler:    _TradeInService_CreateTradeIn_Handler,
+		},
+		{
+			MethodName: "AppraiseTradeIn",
+			Handler:    _TradeInService_AppraiseTradeIn_Handler,
+		},
+		{
+			MethodName: "RespondTradeIn",
+			Handler:    _TradeInService_RespondTradeIn_Handler,
+		},
+		{
+			MethodName: "CancelTradeIn",
+			Handler:    _TradeInService_CancelTradeIn_Handler,
+		},
+		{
+			MethodName: "ListTradeIn",
+			Handler:    _TradeInService_ListTradeIn_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/carapp.proto",
+}
+
+const (
+	UlasanService_CreateUlasan_FullMethodName     = "/carapp.UlasanService/CreateUlasan"
+	UlasanService_ListUlasan_FullMethodName       = "/carapp.UlasanService/ListUlasan"
+	UlasanService_GetProfilPenjual_FullMethodName = "/carapp.UlasanService/GetProfilPenjual"
+	UlasanService_HideUlasan_FullMethodName       = "/carapp.UlasanService/HideUlasan"
+)
+
+// UlasanServiceClient is the client API for UlasanService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UlasanServiceClient interface {
+	// Pembeli/penjual transaksi 'selesai' memberi satu ulasan untuk pihak lain
+	CreateUlasan(ctx context.Context, in *CreateUlasanRequest, opts ...grpc.CallOption) (*Ulasan, error)
+	// Daftar ulasan yang diterima user (publik)
+	ListUlasan(ctx context.Context, in *ListUlasanRequest, opts ...grpc.CallOption) (*ListUlasanResponse, error)
+	// Profil penjual: rating rata-rata, jumlah ulasan, jumlah mobil terjual (publik)
+	GetProfilPenjual(ctx context.Context, in *GetProfilPenjualRequest, opts ...grpc.CallOption) (*ProfilPenjual, error)
+	// Admin menyembunyikan / menampilkan kembali ulasan
+	HideUlasan(ctx context.Context, in *HideUlasanRequest, opts ...grpc.CallOption) (*Ulasan, error)
+}
+
+type ulasanServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUlasanServiceClient(cc grpc.ClientConnInterface) UlasanServiceClient {
+	return &ulasanServiceClient{cc}
+}
+
+func (c *ulasanServiceClient) CreateUlasan(ctx context.Context, in *CreateUlasanRequest, opts ...grpc.CallOption) (*Ulasan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ulasan)
+	err := c.cc.Invoke(ctx, UlasanService_CreateUlasan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ulasanServiceClient) ListUlasan(ctx context.Context, in *ListUlasanRequest, opts ...grpc.CallOption) (*ListUlasanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUlasanResponse)
+	err := c.cc.Invoke(ctx, UlasanService_ListUlasan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ulasanServiceClient) GetProfilPenjual(ctx context.Context, in *GetProfilPenjualRequest, opts ...grpc.CallOption) (*ProfilPenjual, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfilPenjual)
+	err := c.cc.Invoke(ctx, UlasanService_GetProfilPenjual_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ulasanServiceClient) HideUlasan(ctx context.Context, in *HideUlasanRequest, opts ...grpc.CallOption) (*Ulasan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ulasan)
+	err := c.cc.Invoke(ctx, UlasanService_HideUlasan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UlasanServiceServer is the server API for UlasanService service.
+// All implementations must embed UnimplementedUlasanServiceServer
+// for forward compatibility.
+type UlasanServiceServer interface {
+	// Pembeli/penjual transaksi 'selesai' memberi satu ulasan untuk pihak lain
+	CreateUlasan(context.Context, *CreateUlasanRequest) (*Ulasan, error)
+	// Daftar ulasan yang diterima user (publik)
+	ListUlasan(context.Context, *ListUlasanRequest) (*ListUlasanResponse, error)
+	// Profil penjual: rating rata-rata, jumlah ulasan, jumlah mobil terjual (publik)
+	GetProfilPenjual(context.Context, *GetProfilPenjualRequest) (*ProfilPenjual, error)
+	// Admin menyembunyikan / menampilkan kembali ulasan
+	HideUlasan(context.Context, *HideUlasanRequest) (*Ulasan, error)
+	mustEmbedUnimplementedUlasanServiceServer()
 }
 
-// UnimplementedMobilServiceServer must be embedded to have
+// UnimplementedUlasanServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedMobilServiceServer struct{}
+type UnimplementedUlasanServiceServer struct{}
 
-func (UnimplementedMobilServiceServer) CreateMobil(context.Context, *CreateMobilRequest) (*Mobil, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateMobil not implemented")
+func (UnimplementedUlasanServiceServer) CreateUlasan(context.Context, *CreateUlasanRequest) (*Ulasan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUlasan not implemented")
 }
-func (UnimplementedMobilServiceServer) ListMobil(context.Context, *ListMobilRequest) (*ListMobilResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListMobil not implemented")
+func (UnimplementedUlasanServiceServer) ListUlasan(context.Context, *ListUlasanRequest) (*ListUlasanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUlasan not implemented")
 }
-func (UnimplementedMobilServiceServer) GetMobil(context.Context, *GetMobilRequest) (*Mobil, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMobil not implemented")
+func (UnimplementedUlasanServiceServer) GetProfilPenjual(context.Context, *GetProfilPenjualRequest) (*ProfilPenjual, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfilPenjual not implemented")
 }
-func (UnimplementedMobilServiceServer) UploadFoto(context.Context, *UploadFotoRequest) (*UploadFotoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UploadFoto not implemented")
+func (UnimplementedUlasanServiceServer) HideUlasan(context.Context, *HideUlasanRequest) (*Ulasan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HideUlasan not implemented")
 }
-func (UnimplementedMobilServiceServer) mustEmbedUnimplementedMobilServiceServer() {}
-func (UnimplementedMobilServiceServer) testEmbeddedByValue()                      {}
+func (UnimplementedUlasanServiceServer) mustEmbedUnimplementedUlasanServiceServer() {}
+func (UnimplementedUlasanServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeMobilServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MobilServiceServer will
+// UnsafeUlasanServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UlasanServiceServer will
 // result in compilation errors.
-type UnsafeMobilServiceServer interface {
-	mustEmbedUnimplementedMobilServiceServer()
+type UnsafeUlasanServiceServer interface {
+	mustEmbedUnimplementedUlasanServiceServer()
 }
 
-func RegisterMobilServiceServer(s grpc.ServiceRegistrar, srv MobilServiceServer) {
-	// If the following call pancis, it indicates UnimplementedMobilServiceServer was
+func RegisterUlasanServiceServer(s grpc.ServiceRegistrar, srv UlasanServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUlasanServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&MobilService_ServiceDesc, srv)
+	s.RegisterService(&UlasanService_ServiceDesc, srv)
 }
 
-func _MobilService_CreateMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateMobilRequest)
+func _UlasanService_CreateUlasan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUlasanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MobilServiceServer).CreateMobil(ctx, in)
+		return srv.(UlasanServiceServer).CreateUlasan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MobilService_CreateMobil_FullMethodName,
+		FullMethod: UlasanService_CreateUlasan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MobilServiceServer).CreateMobil(ctx, req.(*CreateMobilRequest))
+		return srv.(UlasanServiceServer).CreateUlasan(ctx, req.(*CreateUlasanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MobilService_ListMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMobilRequest)
+func _UlasanService_ListUlasan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUlasanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MobilServiceServer).ListMobil(ctx, in)
+		return srv.(UlasanServiceServer).ListUlasan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MobilService_ListMobil_FullMethodName,
+		FullMethod: UlasanService_ListUlasan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MobilServiceServer).ListMobil(ctx, req.(*ListMobilRequest))
+		return srv.(UlasanServiceServer).ListUlasan(ctx, req.(*ListUlasanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MobilService_GetMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMobilRequest)
+func _UlasanService_GetProfilPenjual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfilPenjualRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MobilServiceServer).GetMobil(ctx, in)
+		return srv.(UlasanServiceServer).GetProfilPenjual(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MobilService_GetMobil_FullMethodName,
+		FullMethod: UlasanService_GetProfilPenjual_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MobilServiceServer).GetMobil(ctx, req.(*GetMobilRequest))
+		return srv.(UlasanServiceServer).GetProfilPenjual(ctx, req.(*GetProfilPenjualRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MobilService_UploadFoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UploadFotoRequest)
+func _UlasanService_HideUlasan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HideUlasanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MobilServiceServer).UploadFoto(ctx, in)
+		return srv.(UlasanServiceServer).HideUlasan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MobilService_UploadFoto_FullMethodName,
+		FullMethod: UlasanService_HideUlasan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MobilServiceServer).UploadFoto(ctx, req.(*UploadFotoRequest))
+		return srv.(UlasanServiceServer).HideUlasan(ctx, req.(*HideUlasanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// MobilService_ServiceDesc is the grpc.ServiceDesc for MobilService service.
+// UlasanService_ServiceDesc is the grpc.ServiceDesc for UlasanService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var MobilService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "carapp.MobilService",
-	HandlerType: (*MobilServiceServer)(nil),
+var UlasanService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carapp.UlasanService",
+	HandlerType: (*UlasanServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateMobil",
-			Handler:    _MobilService_CreateMobil_Handler,
+			MethodName: "CreateUlasan",
+			Handler:    _UlasanService_CreateUlasan_Handler,
 		},
 		{
-			MethodName: "ListMobil",
-			Handler:    _MobilService_ListMobil_Handler,
+			MethodName: "ListUlasan",
+			Handler:    _UlasanService_ListUlasan_Handler,
 		},
 		{
-			MethodName: "GetMobil",
-			Handler:    _MobilService_GetMobil_Handler,
+			MethodName: "GetProfilPenjual",
+			Handler:    _UlasanService_GetProfilPenjual_Handler,
 		},
 		{
-			MethodName: "UploadFoto",
-			Handler:    _MobilService_UploadFoto_Handler,
+			MethodName: "HideUlasan",
+			Handler:    _UlasanService_HideUlasan_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -384,141 +3441,179 @@ var MobilService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	NhtsaDataService_GetMakes_FullMethodName         = "/carapp.NhtsaDataService/GetMakes"
-	NhtsaDataService_GetModelsForMake_FullMethodName = "/carapp.NhtsaDataService/GetModelsForMake"
+	WatchlistService_AddToWatchlist_FullMethodName      = "/carapp.WatchlistService/AddToWatchlist"
+	WatchlistService_RemoveFromWatchlist_FullMethodName = "/carapp.WatchlistService/RemoveFromWatchlist"
+	WatchlistService_ListWatchlist_FullMethodName       = "/carapp.WatchlistService/ListWatchlist"
 )
 
-// NhtsaDataServiceClient is the client API for NhtsaDataService service.
+// WatchlistServiceClient is the client API for WatchlistService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type NhtsaDataServiceClient interface {
-	// Prasyarat Fitur 4: Mendapat data dari NHTSA API (Cache)
-	GetMakes(ctx context.Context, in *GetMakesRequest, opts ...grpc.CallOption) (*GetMakesResponse, error)
-	GetModelsForMake(ctx context.Context, in *GetModelsForMakeRequest, opts ...grpc.CallOption) (*GetModelsForMakeResponse, error)
+type WatchlistServiceClient interface {
+	// Simpan mobil ke watchlist (idempoten)
+	AddToWatchlist(ctx context.Context, in *AddToWatchlistRequest, opts ...grpc.CallOption) (*WatchlistItem, error)
+	RemoveFromWatchlist(ctx context.Context, in *RemoveFromWatchlistRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListWatchlist(ctx context.Context, in *ListWatchlistRequest, opts ...grpc.CallOption) (*ListWatchlistResponse, error)
 }
 
-type nhtsaDataServiceClient struct {
+type watchlistServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewNhtsaDataServiceClient(cc grpc.ClientConnInterface) NhtsaDataServiceClient {
-	return &nhtsaDataServiceClient{cc}
+func NewWatchlistServiceClient(cc grpc.ClientConnInterface) WatchlistServiceClient {
+	return &watchlistServiceClient{cc}
 }
 
-func (c *nhtsaDataServiceClient) GetMakes(ctx context.Context, in *GetMakesRequest, opts ...grpc.CallOption) (*GetMakesResponse, error) {
+func (c *watchlistServiceClient) AddToWatchlist(ctx context.Context, in *AddToWatchlistRequest, opts ...grpc.CallOption) (*WatchlistItem, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetMakesResponse)
-	err := c.cc.Invoke(ctx, NhtsaDataService_GetMakes_FullMethodName, in, out, cOpts...)
+	out := new(WatchlistItem)
+	err := c.cc.Invoke(ctx, WatchlistService_AddToWatchlist_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *nhtsaDataServiceClient) GetModelsForMake(ctx context.Context, in *GetModelsForMakeRequest, opts ...grpc.CallOption) (*GetModelsForMakeResponse, error) {
+func (c *watchlistServiceClient) RemoveFromWatchlist(ctx context.Context, in *RemoveFromWatchlistRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetModelsForMakeResponse)
-	err := c.cc.Invoke(ctx, NhtsaDataService_GetModelsForMake_FullMethodName, in, out, cOpts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WatchlistService_RemoveFromWatchlist_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// NhtsaDataServiceServer is the server API for NhtsaDataService service.
-// All implementations must embed UnimplementedNhtsaDataServiceServer
+func (c *watchlistServiceClient) ListWatchlist(ctx context.Context, in *ListWatchlistRequest, opts ...grpc.CallOption) (*ListWatchlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWatchlistResponse)
+	err := c.cc.Invoke(ctx, WatchlistService_ListWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WatchlistServiceServer is the server API for WatchlistService service.
+// All implementations must embed UnimplementedWatchlistServiceServer
 // for forward compatibility.
-type NhtsaDataServiceServer interface {
-	// Prasyarat Fitur 4: Mendapat data dari NHTSA API (Cache)
-	GetMakes(context.Context, *GetMakesRequest) (*GetMakesResponse, error)
-	GetModelsForMake(context.Context, *GetModelsForMakeRequest) (*GetModelsForMakeResponse, error)
-	mustEmbedUnimplementedNhtsaDataServiceServer()
+type WatchlistServiceServer interface {
+	// Simpan mobil ke watchlist (idempoten)
+	AddToWatchlist(context.Context, *AddToWatchlistRequest) (*WatchlistItem, error)
+	RemoveFromWatchlist(context.Context, *RemoveFromWatchlistRequest) (*emptypb.Empty, error)
+	ListWatchlist(context.Context, *ListWatchlistRequest) (*ListWatchlistResponse, error)
+	mustEmbedUnimplementedWatchlistServiceServer()
 }
 
-// UnimplementedNhtsaDataServiceServer must be embedded to have
+// UnimplementedWatchlistServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedNhtsaDataServiceServer struct{}
+type UnimplementedWatchlistServiceServer struct{}
 
-func (UnimplementedNhtsaDataServiceServer) GetMakes(context.Context, *GetMakesRequest) (*GetMakesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMakes not implemented")
+func (UnimplementedWatchlistServiceServer) AddToWatchlist(context.Context, *AddToWatchlistRequest) (*WatchlistItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToWatchlist not implemented")
 }
-func (UnimplementedNhtsaDataServiceServer) GetModelsForMake(context.Context, *GetModelsForMakeRequest) (*GetModelsForMakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetModelsForMake not implemented")
+func (UnimplementedWatchlistServiceServer) RemoveFromWatchlist(context.Context, *RemoveFromWatchlistRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWatchlist not implemented")
 }
-func (UnimplementedNhtsaDataServiceServer) mustEmbedUnimplementedNhtsaDataServiceServer() {}
-func (UnimplementedNhtsaDataServiceServer) testEmbeddedByValue()                          {}
+func (UnimplementedWatchlistServiceServer) ListWatchlist(context.Context, *ListWatchlistRequest) (*ListWatchlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWatchlist not implemented")
+}
+func (UnimplementedWatchlistServiceServer) mustEmbedUnimplementedWatchlistServiceServer() {}
+func (UnimplementedWatchlistServiceServer) testEmbeddedByValue()                          {}
 
-// UnsafeNhtsaDataServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to NhtsaDataServiceServer will
+// UnsafeWatchlistServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WatchlistServiceServer will
 // result in compilation errors.
-type UnsafeNhtsaDataServiceServer interface {
-	mustEmbedUnimplementedNhtsaDataServiceServer()
+type UnsafeWatchlistServiceServer interface {
+	mustEmbedUnimplementedWatchlistServiceServer()
 }
 
-func RegisterNhtsaDataServiceServer(s grpc.ServiceRegistrar, srv NhtsaDataServiceServer) {
-	// If the following call pancis, it indicates UnimplementedNhtsaDataServiceServer was
+func RegisterWatchlistServiceServer(s grpc.ServiceRegistrar, srv WatchlistServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWatchlistServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&NhtsaDataService_ServiceDesc, srv)
+	s.RegisterService(&WatchlistService_ServiceDesc, srv)
 }
 
-func _NhtsaDataService_GetMakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMakesRequest)
+func _WatchlistService_AddToWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToWatchlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NhtsaDataServiceServer).GetMakes(ctx, in)
+		return srv.(WatchlistServiceServer).AddToWatchlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NhtsaDataService_GetMakes_FullMethodName,
+		FullMethod: WatchlistService_AddToWatchlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NhtsaDataServiceServer).GetMakes(ctx, req.(*GetMakesRequest))
+		return srv.(WatchlistServiceServer).AddToWatchlist(ctx, req.(*AddToWatchlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NhtsaDataService_GetModelsForMake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetModelsForMakeRequest)
+func _WatchlistService_RemoveFromWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromWatchlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NhtsaDataServiceServer).GetModelsForMake(ctx, in)
+		return srv.(WatchlistServiceServer).RemoveFromWatchlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NhtsaDataService_GetModelsForMake_FullMethodName,
+		FullMethod: WatchlistService_RemoveFromWatchlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NhtsaDataServiceServer).GetModelsForMake(ctx, req.(*GetModelsForMakeRequest))
+		return srv.(WatchlistServiceServer).RemoveFromWatchlist(ctx, req.(*RemoveFromWatchlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// NhtsaDataService_ServiceDesc is the grpc.ServiceDesc for NhtsaDataService service.
+func _WatchlistService_ListWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).ListWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_ListWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).ListWatchlist(ctx, req.(*ListWatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WatchlistService_ServiceDesc is the grpc.ServiceDesc for WatchlistService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var NhtsaDataService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "carapp.NhtsaDataService",
-	HandlerType: (*NhtsaDataServiceServer)(nil),
+var WatchlistService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carapp.WatchlistService",
+	HandlerType: (*WatchlistServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetMakes",
-			Handler:    _NhtsaDataService_GetMakes_Handler,
+			MethodName: "AddToWatchlist",
+			Handler:    _WatchlistService_AddToWatchlist_Handler,
 		},
 		{
-			MethodName: "GetModelsForMake",
-			Handler:    _NhtsaDataService_GetModelsForMake_Handler,
+			MethodName: "RemoveFromWatchlist",
+			Handler:    _WatchlistService_RemoveFromWatchlist_Handler,
+		},
+		{
+			MethodName: "ListWatchlist",
+			Handler:    _WatchlistService_ListWatchlist_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -526,181 +3621,259 @@ var NhtsaDataService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	TransaksiService_BuyMobil_FullMethodName       = "/carapp.TransaksiService/BuyMobil"
-	TransaksiService_RentMobil_FullMethodName      = "/carapp.TransaksiService/RentMobil"
-	TransaksiService_CompleteRental_FullMethodName = "/carapp.TransaksiService/CompleteRental"
+	SavedSearchService_CreateSavedSearch_FullMethodName      = "/carapp.SavedSearchService/CreateSavedSearch"
+	SavedSearchService_ListSavedSearch_FullMethodName        = "/carapp.SavedSearchService/ListSavedSearch"
+	SavedSearchService_UpdateSavedSearch_FullMethodName      = "/carapp.SavedSearchService/UpdateSavedSearch"
+	SavedSearchService_UnsubscribeSavedSearch_FullMethodName = "/carapp.SavedSearchService/UnsubscribeSavedSearch"
+	SavedSearchService_DeleteSavedSearch_FullMethodName      = "/carapp.SavedSearchService/DeleteSavedSearch"
 )
 
-// TransaksiServiceClient is the client API for TransaksiService service.
+// SavedSearchServiceClient is the client API for SavedSearchService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type TransaksiServiceClient interface {
-	// Fitur 3: Beli Mobil
-	BuyMobil(ctx context.Context, in *BuyMobilRequest, opts ...grpc.CallOption) (*TransaksiJualResponse, error)
-	// Fitur 3 & 5: Rental Mobil
-	RentMobil(ctx context.Context, in *RentMobilRequest, opts ...grpc.CallOption) (*TransaksiRentalResponse, error)
-	CompleteRental(ctx context.Context, in *CompleteRentalRequest, opts ...grpc.CallOption) (*TransaksiRentalResponse, error)
+type SavedSearchServiceClient interface {
+	// Simpan filter ListMobil; listing baru yang cocok dikirim sebagai notifikasi
+	CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearch, error)
+	ListSavedSearch(ctx context.Context, in *ListSavedSearchRequest, opts ...grpc.CallOption) (*ListSavedSearchResponse, error)
+	// Ubah nama / frekuensi alert (termasuk mengaktifkan kembali)
+	UpdateSavedSearch(ctx context.Context, in *UpdateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearch, error)
+	// Berhenti menerima alert, pencarian tetap tersimpan
+	UnsubscribeSavedSearch(ctx context.Context, in *UnsubscribeSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
-type transaksiServiceClient struct {
+type savedSearchServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewTransaksiServiceClient(cc grpc.ClientConnInterface) TransaksiServiceClient {
-	return &transaksiServiceClient{cc}
+func NewSavedSearchServiceClient(cc grpc.ClientConnInterface) SavedSearchServiceClient {
+	return &savedSearchServiceClient{cc}
 }
 
-func (c *transaksiServiceClient) BuyMobil(ctx context.Context, in *BuyMobilRequest, opts ...grpc.CallOption) (*TransaksiJualResponse, error) {
+func (c *savedSearchServiceClient) CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearch, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TransaksiJualResponse)
-	err := c.cc.Invoke(ctx, TransaksiService_BuyMobil_FullMethodName, in, out, cOpts...)
+	out := new(SavedSearch)
+	err := c.cc.Invoke(ctx, SavedSearchService_CreateSavedSearch_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *transaksiServiceClient) RentMobil(ctx context.Context, in *RentMobilRequest, opts ...grpc.CallOption) (*TransaksiRentalResponse, error) {
+func (c *savedSearchServiceClient) ListSavedSearch(ctx context.Context, in *ListSavedSearchRequest, opts ...grpc.CallOption) (*ListSavedSearchResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TransaksiRentalResponse)
-	err := c.cc.Invoke(ctx, TransaksiService_RentMobil_FullMethodName, in, out, cOpts...)
+	out := new(ListSavedSearchResponse)
+	err := c.cc.Invoke(ctx, SavedSearchService_ListSavedSearch_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *transaksiServiceClient) CompleteRental(ctx context.Context, in *CompleteRentalRequest, opts ...grpc.CallOption) (*TransaksiRentalResponse, error) {
+func (c *savedSearchServiceClient) UpdateSavedSearch(ctx context.Context, in *UpdateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearch, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TransaksiRentalResponse)
-	err := c.cc.Invoke(ctx, TransaksiService_CompleteRental_FullMethodName, in, out, cOpts...)
+	out := new(SavedSearch)
+	err := c.cc.Invoke(ctx, SavedSearchService_UpdateSavedSearch_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// TransaksiServiceServer is the server API for TransaksiService service.
-// All implementations must embed UnimplementedTransaksiServiceServer
+func (c *savedSearchServiceClient) UnsubscribeSavedSearch(ctx context.Context, in *UnsubscribeSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearch, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearch)
+	err := c.cc.Invoke(ctx, SavedSearchService_UnsubscribeSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchServiceClient) DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SavedSearchService_DeleteSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SavedSearchServiceServer is the server API for SavedSearchService service.
+// All implementations must embed UnimplementedSavedSearchServiceServer
 // for forward compatibility.
-type TransaksiServiceServer interface {
-	// Fitur 3: Beli Mobil
-	BuyMobil(context.Context, *BuyMobilRequest) (*TransaksiJualResponse, error)
-	// Fitur 3 & 5: Rental Mobil
-	RentMobil(context.Context, *RentMobilRequest) (*TransaksiRentalResponse, error)
-	CompleteRental(context.Context, *CompleteRentalRequest) (*TransaksiRentalResponse, error)
-	mustEmbedUnimplementedTransaksiServiceServer()
+type SavedSearchServiceServer interface {
+	// Simpan filter ListMobil; listing baru yang cocok dikirim sebagai notifikasi
+	CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*SavedSearch, error)
+	ListSavedSearch(context.Context, *ListSavedSearchRequest) (*ListSavedSearchResponse, error)
+	// Ubah nama / frekuensi alert (termasuk mengaktifkan kembali)
+	UpdateSavedSearch(context.Context, *UpdateSavedSearchRequest) (*SavedSearch, error)
+	// Berhenti menerima alert, pencarian tetap tersimpan
+	UnsubscribeSavedSearch(context.Context, *UnsubscribeSavedSearchRequest) (*SavedSearch, error)
+	DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedSavedSearchServiceServer()
 }
 
-// UnimplementedTransaksiServiceServer must be embedded to have
+// UnimplementedSavedSearchServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedTransaksiServiceServer struct{}
+type UnimplementedSavedSearchServiceServer struct{}
 
-func (UnimplementedTransaksiServiceServer) BuyMobil(context.Context, *BuyMobilRequest) (*TransaksiJualResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BuyMobil not implemented")
+func (UnimplementedSavedSearchServiceServer) CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*SavedSearch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSavedSearch not implemented")
 }
-func (UnimplementedTransaksiServiceServer) RentMobil(context.Context, *RentMobilRequest) (*TransaksiRentalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RentMobil not implemented")
+func (UnimplementedSavedSearchServiceServer) ListSavedSearch(context.Context, *ListSavedSearchRequest) (*ListSavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedSearch not implemented")
 }
-func (UnimplementedTransaksiServiceServer) CompleteRental(context.Context, *CompleteRentalRequest) (*TransaksiRentalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompleteRental not implemented")
+func (UnimplementedSavedSearchServiceServer) UpdateSavedSearch(context.Context, *UpdateSavedSearchRequest) (*SavedSearch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSavedSearch not implemented")
 }
-func (UnimplementedTransaksiServiceServer) mustEmbedUnimplementedTransaksiServiceServer() {}
-func (UnimplementedTransaksiServiceServer) testEmbeddedByValue()                          {}
+func (UnimplementedSavedSearchServiceServer) UnsubscribeSavedSearch(context.Context, *UnsubscribeSavedSearchRequest) (*SavedSearch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeSavedSearch not implemented")
+}
+func (UnimplementedSavedSearchServiceServer) DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedSearch not implemented")
+}
+func (UnimplementedSavedSearchServiceServer) mustEmbedUnimplementedSavedSearchServiceServer() {}
+func (UnimplementedSavedSearchServiceServer) testEmbeddedByValue()                            {}
 
-// UnsafeTransaksiServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to TransaksiServiceServer will
+// UnsafeSavedSearchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SavedSearchServiceServer will
 // result in compilation errors.
-type UnsafeTransaksiServiceServer interface {
-	mustEmbedUnimplementedTransaksiServiceServer()
+type UnsafeSavedSearchServiceServer interface {
+	mustEmbedUnimplementedSavedSearchServiceServer()
 }
 
-func RegisterTransaksiServiceServer(s grpc.ServiceRegistrar, srv TransaksiServiceServer) {
-	// If the following call pancis, it indicates UnimplementedTransaksiServiceServer was
+func RegisterSavedSearchServiceServer(s grpc.ServiceRegistrar, srv SavedSearchServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSavedSearchServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&TransaksiService_ServiceDesc, srv)
+	s.RegisterService(&SavedSearchService_ServiceDesc, srv)
+}
+
+func _SavedSearchService_CreateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServiceServer).CreateSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearchService_CreateSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServiceServer).CreateSavedSearch(ctx, req.(*CreateSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearchService_ListSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServiceServer).ListSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearchService_ListSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServiceServer).ListSavedSearch(ctx, req.(*ListSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _TransaksiService_BuyMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BuyMobilRequest)
+func _SavedSearchService_UpdateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSavedSearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TransaksiServiceServer).BuyMobil(ctx, in)
+		return srv.(SavedSearchServiceServer).UpdateSavedSearch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TransaksiService_BuyMobil_FullMethodName,
+		FullMethod: SavedSearchService_UpdateSavedSearch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransaksiServiceServer).BuyMobil(ctx, req.(*BuyMobilRequest))
+		return srv.(SavedSearchServiceServer).UpdateSavedSearch(ctx, req.(*UpdateSavedSearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TransaksiService_RentMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RentMobilRequest)
+func _SavedSearchService_UnsubscribeSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeSavedSearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TransaksiServiceServer).RentMobil(ctx, in)
+		return srv.(SavedSearchServiceServer).UnsubscribeSavedSearch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TransaksiService_RentMobil_FullMethodName,
+		FullMethod: SavedSearchService_UnsubscribeSavedSearch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransaksiServiceServer).RentMobil(ctx, req.(*RentMobilRequest))
+		return srv.(SavedSearchServiceServer).UnsubscribeSavedSearch(ctx, req.(*UnsubscribeSavedSearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TransaksiService_CompleteRental_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CompleteRentalRequest)
+func _SavedSearchService_DeleteSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedSearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TransaksiServiceServer).CompleteRental(ctx, in)
+		return srv.(SavedSearchServiceServer).DeleteSavedSearch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TransaksiService_CompleteRental_FullMethodName,
+		FullMethod: SavedSearchService_DeleteSavedSearch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransaksiServiceServer).CompleteRental(ctx, req.(*CompleteRentalRequest))
+		return srv.(SavedSearchServiceServer).DeleteSavedSearch(ctx, req.(*DeleteSavedSearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// TransaksiService_ServiceDesc is the grpc.ServiceDesc for TransaksiService service.
+// SavedSearchService_ServiceDesc is the grpc.ServiceDesc for SavedSearchService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var TransaksiService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "carapp.TransaksiService",
-	HandlerType: (*TransaksiServiceServer)(nil),
+var SavedSearchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carapp.SavedSearchService",
+	HandlerType: (*SavedSearchServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "BuyMobil",
-			Handler:    _TransaksiService_BuyMobil_Handler,
+			MethodName: "CreateSavedSearch",
+			Handler:    _SavedSearchService_CreateSavedSearch_Handler,
 		},
 		{
-			MethodName: "RentMobil",
-			Handler:    _TransaksiService_RentMobil_Handler,
+			MethodName: "ListSavedSearch",
+			Handler:    _SavedSearchService_ListSavedSearch_Handler,
 		},
 		{
-			MethodName: "CompleteRental",
-			Handler:    _TransaksiService_CompleteRental_Handler,
+			MethodName: "UpdateSavedSearch",
+			Handler:    _SavedSearchService_UpdateSavedSearch_Handler,
+		},
+		{
+			MethodName: "UnsubscribeSavedSearch",
+			Handler:    _SavedSearchService_UnsubscribeSavedSearch_Handler,
+		},
+		{
+			MethodName: "DeleteSavedSearch",
+			Handler:    _SavedSearchService_DeleteSavedSearch_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -708,210 +3881,407 @@ var TransaksiService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	NotifikasiService_GetNotifications_FullMethodName = "/carapp.NotifikasiService/GetNotifications"
+	HargaPasarService_GetPriceHistory_FullMethodName = "/carapp.HargaPasarService/GetPriceHistory"
+	HargaPasarService_GetMarketPrice_FullMethodName  = "/carapp.HargaPasarService/GetMarketPrice"
 )
 
-// NotifikasiServiceClient is the client API for NotifikasiService service.
+// HargaPasarServiceClient is the client API for HargaPasarService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type NotifikasiServiceClient interface {
-	// Fitur 6: Notifikasi
-	GetNotifications(ctx context.Context, in *GetNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Notifikasi], error)
+type HargaPasarServiceClient interface {
+	// Riwayat harga satu mobil: harga awal, perubahan, dan harga terjual (publik)
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	// Statistik harga minta & harga terjual untuk merk/model/tahun (publik)
+	GetMarketPrice(ctx context.Context, in *GetMarketPriceRequest, opts ...grpc.CallOption) (*MarketPrice, error)
 }
 
-type notifikasiServiceClient struct {
+type hargaPasarServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewNotifikasiServiceClient(cc grpc.ClientConnInterface) NotifikasiServiceClient {
-	return &notifikasiServiceClient{cc}
+func NewHargaPasarServiceClient(cc grpc.ClientConnInterface) HargaPasarServiceClient {
+	return &hargaPasarServiceClient{cc}
 }
 
-func (c *notifikasiServiceClient) GetNotifications(ctx context.Context, in *GetNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Notifikasi], error) {
+func (c *hargaPasarServiceClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &NotifikasiService_ServiceDesc.Streams[0], NotifikasiService_GetNotifications_FullMethodName, cOpts...)
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, HargaPasarService_GetPriceHistory_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[GetNotificationsRequest, Notifikasi]{ClientStream: stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *hargaPasarServiceClient) GetMarketPrice(ctx context.Context, in *GetMarketPriceRequest, opts ...grpc.CallOption) (*MarketPrice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketPrice)
+	err := c.cc.Invoke(ctx, HargaPasarService_GetMarketPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	return x, nil
+	return out, nil
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type NotifikasiService_GetNotificationsClient = grpc.ServerStreamingClient[Notifikasi]
-
-// NotifikasiServiceServer is the server API for NotifikasiService service.
-// All implementations must embed UnimplementedNotifikasiServiceServer
+// HargaPasarServiceServer is the server API for HargaPasarService service.
+// All implementations must embed UnimplementedHargaPasarServiceServer
 // for forward compatibility.
-type NotifikasiServiceServer interface {
-	// Fitur 6: Notifikasi
-	GetNotifications(*GetNotificationsRequest, grpc.ServerStreamingServer[Notifikasi]) error
-	mustEmbedUnimplementedNotifikasiServiceServer()
+type HargaPasarServiceServer interface {
+	// Riwayat harga satu mobil: harga awal, perubahan, dan harga terjual (publik)
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	// Statistik harga minta & harga terjual untuk merk/model/tahun (publik)
+	GetMarketPrice(context.Context, *GetMarketPriceRequest) (*MarketPrice, error)
+	mustEmbedUnimplementedHargaPasarServiceServer()
 }
 
-// UnimplementedNotifikasiServiceServer must be embedded to have
+// UnimplementedHargaPasarServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedNotifikasiServiceServer struct{}
+type UnimplementedHargaPasarServiceServer struct{}
 
-func (UnimplementedNotifikasiServiceServer) GetNotifications(*GetNotificationsRequest, grpc.ServerStreamingServer[Notifikasi]) error {
-	return status.Errorf(codes.Unimplemented, "method GetNotifications not implemented")
+func (UnimplementedHargaPasarServiceServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
 }
-func (UnimplementedNotifikasiServiceServer) mustEmbedUnimplementedNotifikasiServiceServer() {}
-func (UnimplementedNotifikasiServiceServer) testEmbeddedByValue()                           {}
+func (UnimplementedHargaPasarServiceServer) GetMarketPrice(context.Context, *GetMarketPriceRequest) (*MarketPrice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketPrice not implemented")
+}
+func (UnimplementedHargaPasarServiceServer) mustEmbedUnimplementedHargaPasarServiceServer() {}
+func (UnimplementedHargaPasarServiceServer) testEmbeddedByValue()                           {}
 
-// UnsafeNotifikasiServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to NotifikasiServiceServer will
+// UnsafeHargaPasarServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HargaPasarServiceServer will
 // result in compilation errors.
-type UnsafeNotifikasiServiceServer interface {
-	mustEmbedUnimplementedNotifikasiServiceServer()
+type UnsafeHargaPasarServiceServer interface {
+	mustEmbedUnimplementedHargaPasarServiceServer()
 }
 
-func RegisterNotifikasiServiceServer(s grpc.ServiceRegistrar, srv NotifikasiServiceServer) {
-	// If the following call pancis, it indicates UnimplementedNotifikasiServiceServer was
+func RegisterHargaPasarServiceServer(s grpc.ServiceRegistrar, srv HargaPasarServiceServer) {
+	// If the following call pancis, it indicates UnimplementedHargaPasarServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&NotifikasiService_ServiceDesc, srv)
+	s.RegisterService(&HargaPasarService_ServiceDesc, srv)
 }
 
-func _NotifikasiService_GetNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetNotificationsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _HargaPasarService_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(NotifikasiServiceServer).GetNotifications(m, &grpc.GenericServerStream[GetNotificationsRequest, Notifikasi]{ServerStream: stream})
+	if interceptor == nil {
+		return srv.(HargaPasarServiceServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HargaPasarService_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HargaPasarServiceServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type NotifikasiService_GetNotificationsServer = grpc.ServerStreamingServer[Notifikasi]
+func _HargaPasarService_GetMarketPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HargaPasarServiceServer).GetMarketPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HargaPasarService_GetMarketPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HargaPasarServiceServer).GetMarketPrice(ctx, req.(*GetMarketPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// NotifikasiService_ServiceDesc is the grpc.ServiceDesc for NotifikasiService service.
+// HargaPasarService_ServiceDesc is the grpc.ServiceDesc for HargaPasarService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var NotifikasiService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "carapp.NotifikasiService",
-	HandlerType: (*NotifikasiServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams: []grpc.StreamDesc{
+var HargaPasarService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carapp.HargaPasarService",
+	HandlerType: (*HargaPasarServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			StreamName:    "GetNotifications",
-			Handler:       _NotifikasiService_GetNotifications_Handler,
-			ServerStreams: true,
+			MethodName: "GetPriceHistory",
+			Handler:    _HargaPasarService_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetMarketPrice",
+			Handler:    _HargaPasarService_GetMarketPrice_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/carapp.proto",
 }
 
 const (
-	DashboardService_GetDashboard_FullMethodName = "/carapp.DashboardService/GetDashboard"
+	AdminService_ListLaporan_FullMethodName        = "/carapp.AdminService/ListLaporan"
+	AdminService_TanganiLaporan_FullMethodName     = "/carapp.AdminService/TanganiLaporan"
+	AdminService_GetRisikoMobil_FullMethodName     = "/carapp.AdminService/GetRisikoMobil"
+	AdminService_ListLaporanUser_FullMethodName    = "/carapp.AdminService/ListLaporanUser"
+	AdminService_TanganiLaporanUser_FullMethodName = "/carapp.AdminService/TanganiLaporanUser"
 )
 
-// DashboardServiceClient is the client API for DashboardService service.
+// AdminServiceClient is the client API for AdminService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type DashboardServiceClient interface {
-	// Fitur Rencana: Dashboard
-	GetDashboard(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DashboardSummary, error)
+type AdminServiceClient interface {
+	// Antrean laporan listing dari user (default: status 'terbuka')
+	ListLaporan(ctx context.Context, in *ListLaporanRequest, opts ...grpc.CallOption) (*ListLaporanResponse, error)
+	// Tindak lanjut laporan: 'tahan' (listing masuk antrean moderasi) atau 'abaikan'
+	TanganiLaporan(ctx context.Context, in *TanganiLaporanRequest, opts ...grpc.CallOption) (*LaporanListing, error)
+	// Skor risiko penipuan listing beserta sinyal aturan yang terpicu
+	GetRisikoMobil(ctx context.Context, in *GetRisikoMobilRequest, opts ...grpc.CallOption) (*PenilaianRisiko, error)
+	// Antrean laporan user dari chat (ChatService.ReportUser; default: status 'baru')
+	ListLaporanUser(ctx context.Context, in *ListLaporanUserRequest, opts ...grpc.CallOption) (*ListLaporanUserResponse, error)
+	// Tindak lanjut laporan user: 'tinjau' (sedang ditinjau) atau 'tutup' (selesai, dengan catatan)
+	TanganiLaporanUser(ctx context.Context, in *TanganiLaporanUserRequest, opts ...grpc.CallOption) (*LaporanUser, error)
 }
 
-type dashboardServiceClient struct {
+type adminServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewDashboardServiceClient(cc grpc.ClientConnInterface) DashboardServiceClient {
-	return &dashboardServiceClient{cc}
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
 }
 
-func (c *dashboardServiceClient) GetDashboard(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DashboardSummary, error) {
+func (c *adminServiceClient) ListLaporan(ctx context.Context, in *ListLaporanRequest, opts ...grpc.CallOption) (*ListLaporanResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DashboardSummary)
-	err := c.cc.Invoke(ctx, DashboardService_GetDashboard_FullMethodName, in, out, cOpts...)
+	out := new(ListLaporanResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListLaporan_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// DashboardServiceServer is the server API for DashboardService service.
-// All implementations must embed UnimplementedDashboardServiceServer
+func (c *adminServiceClient) TanganiLaporan(ctx context.Context, in *TanganiLaporanRequest, opts ...grpc.CallOption) (*LaporanListing, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LaporanListing)
+	err := c.cc.Invoke(ctx, AdminService_TanganiLaporan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetRisikoMobil(ctx context.Context, in *GetRisikoMobilRequest, opts ...grpc.CallOption) (*PenilaianRisiko, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PenilaianRisiko)
+	err := c.cc.Invoke(ctx, AdminService_GetRisikoMobil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListLaporanUser(ctx context.Context, in *ListLaporanUserRequest, opts ...grpc.CallOption) (*ListLaporanUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLaporanUserResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListLaporanUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) TanganiLaporanUser(ctx context.Context, in *TanganiLaporanUserRequest, opts ...grpc.CallOption) (*LaporanUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LaporanUser)
+	err := c.cc.Invoke(ctx, AdminService_TanganiLaporanUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility.
-type DashboardServiceServer interface {
-	// Fitur Rencana: Dashboard
-	GetDashboard(context.Context, *emptypb.Empty) (*DashboardSummary, error)
-	mustEmbedUnimplementedDashboardServiceServer()
+type AdminServiceServer interface {
+	// Antrean laporan listing dari user (default: status 'terbuka')
+	ListLaporan(context.Context, *ListLaporanRequest) (*ListLaporanResponse, error)
+	// Tindak lanjut laporan: 'tahan' (listing masuk antrean moderasi) atau 'abaikan'
+	TanganiLaporan(context.Context, *TanganiLaporanRequest) (*LaporanListing, error)
+	// Skor risiko penipuan listing beserta sinyal aturan yang terpicu
+	GetRisikoMobil(context.Context, *GetRisikoMobilRequest) (*PenilaianRisiko, error)
+	// Antrean laporan user dari chat (ChatService.ReportUser; default: status 'baru')
+	ListLaporanUser(context.Context, *ListLaporanUserRequest) (*ListLaporanUserResponse, error)
+	// Tindak lanjut laporan user: 'tinjau' (sedang ditinjau) atau 'tutup' (selesai, dengan catatan)
+	TanganiLaporanUser(context.Context, *TanganiLaporanUserRequest) (*LaporanUser, error)
+	mustEmbedUnimplementedAdminServiceServer()
 }
 
-// UnimplementedDashboardServiceServer must be embedded to have
+// UnimplementedAdminServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedDashboardServiceServer struct{}
+type UnimplementedAdminServiceServer struct{}
 
-func (UnimplementedDashboardServiceServer) GetDashboard(context.Context, *emptypb.Empty) (*DashboardSummary, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDashboard not implemented")
+func (UnimplementedAdminServiceServer) ListLaporan(context.Context, *ListLaporanRequest) (*ListLaporanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLaporan not implemented")
 }
-func (UnimplementedDashboardServiceServer) mustEmbedUnimplementedDashboardServiceServer() {}
-func (UnimplementedDashboardServiceServer) testEmbeddedByValue()                          {}
+func (UnimplementedAdminServiceServer) TanganiLaporan(context.Context, *TanganiLaporanRequest) (*LaporanListing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TanganiLaporan not implemented")
+}
+func (UnimplementedAdminServiceServer) GetRisikoMobil(context.Context, *GetRisikoMobilRequest) (*PenilaianRisiko, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRisikoMobil not implemented")
+}
+func (UnimplementedAdminServiceServer) ListLaporanUser(context.Context, *ListLaporanUserRequest) (*ListLaporanUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLaporanUser not implemented")
+}
+func (UnimplementedAdminServiceServer) TanganiLaporanUser(context.Context, *TanganiLaporanUserRequest) (*LaporanUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TanganiLaporanUser not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
 
-// UnsafeDashboardServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to DashboardServiceServer will
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
 // result in compilation errors.
-type UnsafeDashboardServiceServer interface {
-	mustEmbedUnimplementedDashboardServiceServer()
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
 }
 
-func RegisterDashboardServiceServer(s grpc.ServiceRegistrar, srv DashboardServiceServer) {
-	// If the following call pancis, it indicates UnimplementedDashboardServiceServer was
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&DashboardService_ServiceDesc, srv)
+	s.RegisterService(&AdminService_ServiceDesc, srv)
 }
 
-func _DashboardService_GetDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _AdminService_ListLaporan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLaporanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DashboardServiceServer).GetDashboard(ctx, in)
+		return srv.(AdminServiceServer).ListLaporan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DashboardService_GetDashboard_FullMethodName,
+		FullMethod: AdminService_ListLaporan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DashboardServiceServer).GetDashboard(ctx, req.(*emptypb.Empty))
+		return srv.(AdminServiceServer).ListLaporan(ctx, req.(*ListLaporanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// DashboardService_ServiceDesc is the grpc.ServiceDesc for DashboardService service.
+func _AdminService_TanganiLaporan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TanganiLaporanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).TanganiLaporan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_TanganiLaporan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).TanganiLaporan(ctx, req.(*TanganiLaporanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetRisikoMobil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRisikoMobilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetRisikoMobil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetRisikoMobil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetRisikoMobil(ctx, req.(*GetRisikoMobilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListLaporanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLaporanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListLaporanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListLaporanUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListLaporanUser(ctx, req.(*ListLaporanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_TanganiLaporanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TanganiLaporanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).TanganiLaporanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_TanganiLaporanUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).TanganiLaporanUser(ctx, req.(*TanganiLaporanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var DashboardService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "carapp.DashboardService",
-	HandlerType: (*DashboardServiceServer)(nil),
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carapp.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetDashboard",
-			Handler:    _DashboardService_GetDashboard_Handler,
+			MethodName: "ListLaporan",
+			Handler:    _AdminService_ListLaporan_Handler,
+		},
+		{
+			MethodName: "TanganiLaporan",
+			Handler:    _AdminService_TanganiLaporan_Handler,
+		},
+		{
+			MethodName: "GetRisikoMobil",
+			Handler:    _AdminService_GetRisikoMobil_Handler,
+		},
+		{
+			MethodName: "ListLaporanUser",
+			Handler:    _AdminService_ListLaporanUser_Handler,
+		},
+		{
+			MethodName: "TanganiLaporanUser",
+			Handler:    _AdminService_TanganiLaporanUser_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},